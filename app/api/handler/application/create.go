@@ -0,0 +1,61 @@
+package application
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/controller/application"
+	"github.com/cloudness-io/cloudness/app/request"
+	"github.com/cloudness-io/cloudness/types"
+)
+
+// HandleCreate creates a new application from a public git repository.
+func HandleCreate(appCtrl *application.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		session, _ := request.AuthSessionFrom(ctx)
+		tenant, _ := request.TenantFrom(ctx)
+		project, _ := request.ProjectFrom(ctx)
+		env, _ := request.EnvironmentFrom(ctx)
+
+		in := new(types.ApplicationInput)
+		if err := json.NewDecoder(r.Body).Decode(in); err != nil {
+			render.BadRequestf(ctx, w, "Invalid request body: %s.", err)
+			return
+		}
+
+		app, err := appCtrl.Create(ctx, session.Principal.DisplayName, tenant, project, env, in)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.JSON(w, http.StatusCreated, app)
+	}
+}
+
+// HandleCreateRegistry creates a new application from a container image.
+func HandleCreateRegistry(appCtrl *application.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		session, _ := request.AuthSessionFrom(ctx)
+		tenant, _ := request.TenantFrom(ctx)
+		project, _ := request.ProjectFrom(ctx)
+		env, _ := request.EnvironmentFrom(ctx)
+
+		in := new(types.RegistryInput)
+		if err := json.NewDecoder(r.Body).Decode(in); err != nil {
+			render.BadRequestf(ctx, w, "Invalid request body: %s.", err)
+			return
+		}
+
+		app, err := appCtrl.CreateRegistry(ctx, session.Principal.DisplayName, tenant, project, env, in)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.JSON(w, http.StatusCreated, app)
+	}
+}
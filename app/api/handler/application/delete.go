@@ -0,0 +1,31 @@
+package application
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/controller/application"
+	"github.com/cloudness-io/cloudness/app/request"
+)
+
+// HandleDelete soft deletes the application in the request context,
+// the attached volumes are deleted when the volume query param is set.
+func HandleDelete(appCtrl *application.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		app, _ := request.ApplicationFrom(ctx)
+
+		volume, err := request.QueryParamAsBoolOrDefault(r, "volume", false)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		if err := appCtrl.SoftDelete(ctx, app, &application.AppDeleteOption{Volume: volume}); err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.DeleteSuccessful(w)
+	}
+}
@@ -0,0 +1,44 @@
+package application
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/controller/application"
+	"github.com/cloudness-io/cloudness/app/request"
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+// HandleDeploy triggers a new deployment of the application.
+func HandleDeploy(appCtrl *application.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		session, _ := request.AuthSessionFrom(ctx)
+		app, _ := request.ApplicationFrom(ctx)
+
+		deployment, err := appCtrl.Deploy(ctx, session.Principal.DisplayName, enum.TriggerActionManual, app)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.JSON(w, http.StatusCreated, deployment)
+	}
+}
+
+// HandleRedeploy redeploys the current deployment of the application.
+func HandleRedeploy(appCtrl *application.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		session, _ := request.AuthSessionFrom(ctx)
+		app, _ := request.ApplicationFrom(ctx)
+
+		deployment, err := appCtrl.Redeploy(ctx, session.Principal.DisplayName, app)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.JSON(w, http.StatusCreated, deployment)
+	}
+}
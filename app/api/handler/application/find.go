@@ -0,0 +1,18 @@
+package application
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/request"
+)
+
+// HandleFind returns the application in the request context.
+func HandleFind() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		app, _ := request.ApplicationFrom(ctx)
+
+		render.JSON(w, http.StatusOK, app)
+	}
+}
@@ -0,0 +1,34 @@
+package application
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/controller/application"
+	"github.com/cloudness-io/cloudness/app/request"
+)
+
+// HandleList lists the applications of the environment.
+func HandleList(appCtrl *application.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tenant, _ := request.TenantFrom(ctx)
+		project, _ := request.ProjectFrom(ctx)
+		env, _ := request.EnvironmentFrom(ctx)
+
+		filter, err := request.ParseListQueryFilterFromRequest(r)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		apps, count, err := appCtrl.ListPaginated(ctx, tenant.ID, project.ID, env.ID, filter)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.Pagination(r, w, filter.Page, filter.Size, int(count))
+		render.JSON(w, http.StatusOK, apps)
+	}
+}
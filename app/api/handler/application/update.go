@@ -0,0 +1,37 @@
+package application
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/controller/application"
+	"github.com/cloudness-io/cloudness/app/request"
+	"github.com/cloudness-io/cloudness/types"
+)
+
+// HandleUpdate updates the application in the request context.
+func HandleUpdate(appCtrl *application.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		session, _ := request.AuthSessionFrom(ctx)
+		tenant, _ := request.TenantFrom(ctx)
+		project, _ := request.ProjectFrom(ctx)
+		env, _ := request.EnvironmentFrom(ctx)
+		app, _ := request.ApplicationFrom(ctx)
+
+		in := new(types.ApplicationInput)
+		if err := json.NewDecoder(r.Body).Decode(in); err != nil {
+			render.BadRequestf(ctx, w, "Invalid request body: %s.", err)
+			return
+		}
+
+		app, err := appCtrl.Update(ctx, session, tenant, project, env, app, in)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.JSON(w, http.StatusOK, app)
+	}
+}
@@ -0,0 +1,18 @@
+package deployment
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/request"
+)
+
+// HandleFind returns the deployment in the request context.
+func HandleFind() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		deployment, _ := request.DeploymentFrom(ctx)
+
+		render.JSON(w, http.StatusOK, deployment)
+	}
+}
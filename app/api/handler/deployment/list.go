@@ -0,0 +1,32 @@
+package deployment
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/controller/deployment"
+	"github.com/cloudness-io/cloudness/app/request"
+)
+
+// HandleList lists the deployments of the application, newest first.
+func HandleList(deploymentCtrl *deployment.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		app, _ := request.ApplicationFrom(ctx)
+
+		filter, err := request.ParseListQueryFilterFromRequest(r)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		deployments, count, err := deploymentCtrl.List(ctx, app.ID, &filter)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.Pagination(r, w, filter.Page, filter.Size, int(count))
+		render.JSON(w, http.StatusOK, deployments)
+	}
+}
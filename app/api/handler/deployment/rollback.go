@@ -0,0 +1,27 @@
+package deployment
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/controller/deployment"
+	"github.com/cloudness-io/cloudness/app/request"
+)
+
+// HandleRollback redeploys the image of the deployment in the request context.
+func HandleRollback(deploymentCtrl *deployment.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		session, _ := request.AuthSessionFrom(ctx)
+		app, _ := request.ApplicationFrom(ctx)
+		source, _ := request.DeploymentFrom(ctx)
+
+		deployment, err := deploymentCtrl.Rollback(ctx, session.Principal.DisplayName, app, source)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.JSON(w, http.StatusCreated, deployment)
+	}
+}
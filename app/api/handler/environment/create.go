@@ -0,0 +1,34 @@
+package environment
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/controller/environment"
+	"github.com/cloudness-io/cloudness/app/request"
+)
+
+// HandleCreate creates a new environment in the project.
+func HandleCreate(envCtrl *environment.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		session, _ := request.AuthSessionFrom(ctx)
+		tenant, _ := request.TenantFrom(ctx)
+		project, _ := request.ProjectFrom(ctx)
+
+		in := new(environment.CreateEnvironmentInput)
+		if err := json.NewDecoder(r.Body).Decode(in); err != nil {
+			render.BadRequestf(ctx, w, "Invalid request body: %s.", err)
+			return
+		}
+
+		env, err := envCtrl.Create(ctx, session, tenant, project, in)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.JSON(w, http.StatusCreated, env)
+	}
+}
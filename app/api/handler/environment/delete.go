@@ -0,0 +1,24 @@
+package environment
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/controller/environment"
+	"github.com/cloudness-io/cloudness/app/request"
+)
+
+// HandleDelete soft deletes the environment in the request context.
+func HandleDelete(envCtrl *environment.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		env, _ := request.EnvironmentFrom(ctx)
+
+		if err := envCtrl.SoftDelete(ctx, env); err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.DeleteSuccessful(w)
+	}
+}
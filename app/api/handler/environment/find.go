@@ -0,0 +1,18 @@
+package environment
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/request"
+)
+
+// HandleFind returns the environment in the request context.
+func HandleFind() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		env, _ := request.EnvironmentFrom(ctx)
+
+		render.JSON(w, http.StatusOK, env)
+	}
+}
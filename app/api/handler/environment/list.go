@@ -0,0 +1,33 @@
+package environment
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/controller/environment"
+	"github.com/cloudness-io/cloudness/app/request"
+)
+
+// HandleList lists the environments of the project.
+func HandleList(envCtrl *environment.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tenant, _ := request.TenantFrom(ctx)
+		project, _ := request.ProjectFrom(ctx)
+
+		filter, err := request.ParseListQueryFilterFromRequest(r)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		envs, err := envCtrl.ListPaginated(ctx, tenant.ID, project.ID, filter)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.PaginationNoTotal(r, w, filter.Page, filter.Size, len(envs) < filter.Size)
+		render.JSON(w, http.StatusOK, envs)
+	}
+}
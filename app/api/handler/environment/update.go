@@ -0,0 +1,32 @@
+package environment
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/controller/environment"
+	"github.com/cloudness-io/cloudness/app/request"
+)
+
+// HandleUpdate updates the environment in the request context.
+func HandleUpdate(envCtrl *environment.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		env, _ := request.EnvironmentFrom(ctx)
+
+		in := new(environment.CreateEnvironmentInput)
+		if err := json.NewDecoder(r.Body).Decode(in); err != nil {
+			render.BadRequestf(ctx, w, "Invalid request body: %s.", err)
+			return
+		}
+
+		env, err := envCtrl.Update(ctx, env.ID, in)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.JSON(w, http.StatusOK, env)
+	}
+}
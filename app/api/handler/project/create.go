@@ -0,0 +1,33 @@
+package project
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/controller/project"
+	"github.com/cloudness-io/cloudness/app/request"
+)
+
+// HandleCreate creates a new project in the tenant.
+func HandleCreate(projectCtrl *project.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		session, _ := request.AuthSessionFrom(ctx)
+		tenant, _ := request.TenantFrom(ctx)
+
+		in := new(project.CreateProjectInput)
+		if err := json.NewDecoder(r.Body).Decode(in); err != nil {
+			render.BadRequestf(ctx, w, "Invalid request body: %s.", err)
+			return
+		}
+
+		project, err := projectCtrl.Create(ctx, session, tenant, in)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.JSON(w, http.StatusCreated, project)
+	}
+}
@@ -0,0 +1,24 @@
+package project
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/controller/project"
+	"github.com/cloudness-io/cloudness/app/request"
+)
+
+// HandleDelete soft deletes the project in the request context.
+func HandleDelete(projectCtrl *project.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		p, _ := request.ProjectFrom(ctx)
+
+		if err := projectCtrl.SoftDelete(ctx, p); err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.DeleteSuccessful(w)
+	}
+}
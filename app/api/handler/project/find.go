@@ -0,0 +1,18 @@
+package project
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/request"
+)
+
+// HandleFind returns the project in the request context.
+func HandleFind() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		project, _ := request.ProjectFrom(ctx)
+
+		render.JSON(w, http.StatusOK, project)
+	}
+}
@@ -0,0 +1,33 @@
+package project
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/controller/project"
+	"github.com/cloudness-io/cloudness/app/request"
+)
+
+// HandleList lists the projects in the tenant visible to the principal.
+func HandleList(projectCtrl *project.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tenant, _ := request.TenantFrom(ctx)
+		principal, _ := request.PrincipalFrom(ctx)
+
+		filter, err := request.ParseListQueryFilterFromRequest(r)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		projects, count, err := projectCtrl.ListPaginated(ctx, tenant.ID, principal.ID, filter)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.Pagination(r, w, filter.Page, filter.Size, int(count))
+		render.JSON(w, http.StatusOK, projects)
+	}
+}
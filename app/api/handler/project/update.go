@@ -0,0 +1,32 @@
+package project
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/controller/project"
+	"github.com/cloudness-io/cloudness/app/request"
+)
+
+// HandleUpdate updates the project in the request context.
+func HandleUpdate(projectCtrl *project.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		p, _ := request.ProjectFrom(ctx)
+
+		in := new(project.CreateProjectInput)
+		if err := json.NewDecoder(r.Body).Decode(in); err != nil {
+			render.BadRequestf(ctx, w, "Invalid request body: %s.", err)
+			return
+		}
+
+		p, err := projectCtrl.Update(ctx, p.ID, in)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.JSON(w, http.StatusOK, p)
+	}
+}
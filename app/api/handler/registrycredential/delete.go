@@ -0,0 +1,30 @@
+package registrycredential
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/controller/registrycredential"
+	"github.com/cloudness-io/cloudness/app/request"
+)
+
+// HandleDelete deletes the registry credential of the tenant.
+func HandleDelete(regCredCtrl *registrycredential.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tenant, _ := request.TenantFrom(ctx)
+
+		uid, err := request.GetRegistryCredentialUIDFromPath(r)
+		if err != nil {
+			render.BadRequestf(ctx, w, "Invalid registry credential uid")
+			return
+		}
+
+		if err := regCredCtrl.Delete(ctx, tenant.ID, uid); err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.DeleteSuccessful(w)
+	}
+}
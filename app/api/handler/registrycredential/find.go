@@ -0,0 +1,31 @@
+package registrycredential
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/controller/registrycredential"
+	"github.com/cloudness-io/cloudness/app/request"
+)
+
+// HandleFind returns the registry credential of the tenant.
+func HandleFind(regCredCtrl *registrycredential.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tenant, _ := request.TenantFrom(ctx)
+
+		uid, err := request.GetRegistryCredentialUIDFromPath(r)
+		if err != nil {
+			render.BadRequestf(ctx, w, "Invalid registry credential uid")
+			return
+		}
+
+		cred, err := regCredCtrl.FindByUID(ctx, tenant.ID, uid)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.JSON(w, http.StatusOK, cred)
+	}
+}
@@ -0,0 +1,25 @@
+package registrycredential
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/controller/registrycredential"
+	"github.com/cloudness-io/cloudness/app/request"
+)
+
+// HandleList lists the registry credentials of the tenant.
+func HandleList(regCredCtrl *registrycredential.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tenant, _ := request.TenantFrom(ctx)
+
+		credentials, err := regCredCtrl.List(ctx, tenant.ID)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.JSON(w, http.StatusOK, credentials)
+	}
+}
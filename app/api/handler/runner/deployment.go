@@ -0,0 +1,137 @@
+package runner
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/pipeline/manager"
+	"github.com/cloudness-io/cloudness/app/request"
+	"github.com/cloudness-io/cloudness/types"
+)
+
+// HandleRequest waits for the next deployment of the server scheduled for execution,
+// no content is returned when none is scheduled before the long poll expires.
+func HandleRequest(runnerManager manager.RunnerManager) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		serverUID, err := request.GetServerUIDFromPath(r)
+		if err != nil {
+			render.BadRequestf(r.Context(), w, "Invalid server uid")
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), longPollTimeout)
+		defer cancel()
+
+		deployment, err := runnerManager.Request(ctx, serverUID)
+		if errors.Is(err, context.DeadlineExceeded) || (err == nil && deployment == nil) {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		if err != nil {
+			logError(r, err, "runner: error requesting deployment")
+			render.TranslatedUserError(r.Context(), w, err)
+			return
+		}
+
+		render.JSON(w, http.StatusOK, &types.RunnerDeployment{ID: deployment.ID, Deployment: *deployment})
+	}
+}
+
+// HandleAccept assigns the deployment to the machine of the agent.
+func HandleAccept(runnerManager manager.RunnerManager) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		id, ok := deploymentIDOrError(w, r)
+		if !ok {
+			return
+		}
+
+		in := new(types.RunnerAcceptInput)
+		if err := json.NewDecoder(r.Body).Decode(in); err != nil {
+			render.BadRequestf(ctx, w, "Invalid request body: %s.", err)
+			return
+		}
+
+		deployment, err := runnerManager.Accept(ctx, id, in.Machine)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.JSON(w, http.StatusOK, &types.RunnerDeployment{ID: deployment.ID, Deployment: *deployment})
+	}
+}
+
+// HandleDetails returns the runner context of the deployment.
+func HandleDetails(runnerManager manager.RunnerManager) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		id, ok := deploymentIDOrError(w, r)
+		if !ok {
+			return
+		}
+
+		runnerCtx, err := runnerManager.Details(ctx, id)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.JSON(w, http.StatusOK, runnerCtx)
+	}
+}
+
+// HandleUpdate updates the status of the deployment.
+func HandleUpdate(runnerManager manager.RunnerManager) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		id, ok := deploymentIDOrError(w, r)
+		if !ok {
+			return
+		}
+
+		in := new(types.RunnerDeployment)
+		if err := json.NewDecoder(r.Body).Decode(in); err != nil {
+			render.BadRequestf(ctx, w, "Invalid request body: %s.", err)
+			return
+		}
+		in.Deployment.ID = id
+
+		deployment, err := runnerManager.Update(ctx, &in.Deployment)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.JSON(w, http.StatusOK, &types.RunnerDeployment{ID: deployment.ID, Deployment: *deployment})
+	}
+}
+
+// HandleWatch waits for the cancellation of the deployment, agents are asked to retry
+// when the long poll expires before the deployment is done.
+func HandleWatch(runnerManager manager.RunnerManager) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, ok := deploymentIDOrError(w, r)
+		if !ok {
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), longPollTimeout)
+		defer cancel()
+
+		done, err := runnerManager.Watch(ctx, id)
+		if errors.Is(err, context.DeadlineExceeded) {
+			render.JSON(w, http.StatusOK, &types.RunnerWatchResult{Retry: true})
+			return
+		}
+		if err != nil {
+			render.TranslatedUserError(r.Context(), w, err)
+			return
+		}
+
+		render.JSON(w, http.StatusOK, &types.RunnerWatchResult{Done: done})
+	}
+}
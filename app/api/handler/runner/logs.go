@@ -0,0 +1,52 @@
+package runner
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/pipeline/manager"
+	"github.com/cloudness-io/cloudness/types"
+)
+
+// HandleStream writes the log lines to the live log stream of the deployment.
+func HandleStream(runnerManager manager.RunnerManager) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		id, ok := deploymentIDOrError(w, r)
+		if !ok {
+			return
+		}
+
+		lines := []*types.LogLine{}
+		if err := json.NewDecoder(r.Body).Decode(&lines); err != nil {
+			render.BadRequestf(ctx, w, "Invalid request body: %s.", err)
+			return
+		}
+
+		if err := runnerManager.Stream(ctx, id, lines); err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// HandleUpload stores the full logs of the deployment, the body is the json encoded log lines.
+func HandleUpload(runnerManager manager.RunnerManager) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		id, ok := deploymentIDOrError(w, r)
+		if !ok {
+			return
+		}
+
+		if err := runnerManager.Upload(ctx, id, r.Body); err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
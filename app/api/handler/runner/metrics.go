@@ -0,0 +1,89 @@
+package runner
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/pipeline/manager"
+	"github.com/cloudness-io/cloudness/app/request"
+	"github.com/cloudness-io/cloudness/types"
+)
+
+// HandleUploadMetrics stores the resource usage scraped by the agent.
+func HandleUploadMetrics(runnerManager manager.RunnerManager) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		metrics := []*types.AppMetrics{}
+		if err := json.NewDecoder(r.Body).Decode(&metrics); err != nil {
+			render.BadRequestf(ctx, w, "Invalid request body: %s.", err)
+			return
+		}
+
+		apps, ok := serverApplicationsOrError(w, r, runnerManager)
+		if !ok {
+			return
+		}
+		metrics = slices.DeleteFunc(metrics, func(m *types.AppMetrics) bool {
+			return apps[m.ApplicationUID] == nil
+		})
+
+		if err := runnerManager.UploadMetrics(ctx, metrics); err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// HandleUploadAppStatus updates the status of the applications monitored by the agent.
+func HandleUploadAppStatus(runnerManager manager.RunnerManager) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		statuses := []*types.AppStatus{}
+		if err := json.NewDecoder(r.Body).Decode(&statuses); err != nil {
+			render.BadRequestf(ctx, w, "Invalid request body: %s.", err)
+			return
+		}
+
+		apps, ok := serverApplicationsOrError(w, r, runnerManager)
+		if !ok {
+			return
+		}
+		statuses = slices.DeleteFunc(statuses, func(s *types.AppStatus) bool {
+			app := apps[s.ApplicationUID]
+			if app == nil {
+				return true
+			}
+			// the project is used to publish the status, it is not taken from the agent
+			s.ProjectID = app.ProjectID
+			return false
+		})
+
+		if err := runnerManager.UploadAppStatus(ctx, statuses); err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// serverApplicationsOrError returns the applications of the server of the runner token by uid, agents
+// only report on them. The error is rendered when they can not be listed.
+func serverApplicationsOrError(w http.ResponseWriter, r *http.Request, runnerManager manager.RunnerManager) (map[int64]*types.Application, bool) {
+	ctx := r.Context()
+	server, _ := request.ServerFrom(ctx)
+
+	apps, err := runnerManager.ListServerApplications(ctx, server.ID)
+	if err != nil {
+		logError(r, err, "runner: error listing applications of server")
+		render.TranslatedUserError(ctx, w, err)
+		return nil, false
+	}
+	return apps, true
+}
@@ -0,0 +1,85 @@
+package runner
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/pipeline/manager"
+	"github.com/cloudness-io/cloudness/app/request"
+	baseStore "github.com/cloudness-io/cloudness/store"
+
+	"github.com/rs/zerolog/log"
+)
+
+// longPollTimeout bounds the blocking requests of remote agents, agents poll again when it expires.
+const longPollTimeout = 30 * time.Second
+
+// deploymentIDOrError returns the deployment id of the path, the error is rendered when it is invalid.
+func deploymentIDOrError(w http.ResponseWriter, r *http.Request) (int64, bool) {
+	id, err := request.GetDeploymentIDFromPath(r)
+	if err != nil {
+		render.BadRequestf(r.Context(), w, "Invalid deployment id")
+		return 0, false
+	}
+	return id, true
+}
+
+func logError(r *http.Request, err error, msg string) {
+	log.Ctx(r.Context()).Error().Err(err).Msg(msg)
+}
+
+// RequireServer rejects requests for servers other than the server of the runner token.
+func RequireServer() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			server, _ := request.ServerFrom(ctx)
+
+			serverUID, err := request.GetServerUIDFromPath(r)
+			if err != nil {
+				render.BadRequestf(ctx, w, "Invalid server uid")
+				return
+			}
+			if server == nil || server.UID != serverUID {
+				render.Forbidden(ctx, w)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// RequireDeployment rejects requests for deployments of applications on other servers than the
+// server of the runner token.
+func RequireDeployment(runnerManager manager.RunnerManager) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			server, _ := request.ServerFrom(ctx)
+
+			id, ok := deploymentIDOrError(w, r)
+			if !ok {
+				return
+			}
+
+			serverID, err := runnerManager.FindDeploymentServerID(ctx, id)
+			if baseStore.IsNotFound(err) {
+				render.NotFound(ctx, w)
+				return
+			}
+			if err != nil {
+				logError(r, err, "runner: error finding server of deployment")
+				render.TranslatedUserError(ctx, w, err)
+				return
+			}
+			if server == nil || server.ID != serverID {
+				render.Forbidden(ctx, w)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
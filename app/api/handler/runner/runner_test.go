@@ -0,0 +1,147 @@
+package runner
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	middlewareauthn "github.com/cloudness-io/cloudness/app/api/middleware/authn"
+	"github.com/cloudness-io/cloudness/app/pipeline/manager"
+	"github.com/cloudness-io/cloudness/app/request"
+	baseStore "github.com/cloudness-io/cloudness/store"
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// stubManager serves two servers with one application and deployment each.
+type stubManager struct {
+	manager.RunnerManager
+
+	uploaded []*types.AppMetrics
+	statuses []*types.AppStatus
+}
+
+var stubServers = map[string]*types.Server{
+	"token-1": {ID: 1, UID: 101},
+	"token-2": {ID: 2, UID: 102},
+}
+
+func (m *stubManager) FindServerByRunnerToken(_ context.Context, token string) (*types.Server, error) {
+	if server, ok := stubServers[token]; ok {
+		return server, nil
+	}
+	return nil, baseStore.ErrResourceNotFound
+}
+
+func (m *stubManager) FindDeploymentServerID(_ context.Context, deploymentID int64) (int64, error) {
+	if deploymentID != 11 && deploymentID != 12 {
+		return 0, baseStore.ErrResourceNotFound
+	}
+	return deploymentID - 10, nil
+}
+
+func (m *stubManager) ListServerApplications(_ context.Context, serverID int64) (map[int64]*types.Application, error) {
+	uid := 200 + serverID
+	return map[int64]*types.Application{uid: {UID: uid, ProjectID: serverID, ServerID: serverID}}, nil
+}
+
+func (m *stubManager) Config(_ context.Context, _ int64) (*types.RunnerConfig, error) {
+	return &types.RunnerConfig{}, nil
+}
+
+func (m *stubManager) Watch(_ context.Context, _ int64) (bool, error) {
+	return true, nil
+}
+
+func (m *stubManager) UploadMetrics(_ context.Context, metrics []*types.AppMetrics) error {
+	m.uploaded = metrics
+	return nil
+}
+
+func (m *stubManager) UploadAppStatus(_ context.Context, statuses []*types.AppStatus) error {
+	m.statuses = statuses
+	return nil
+}
+
+func newTestRouter(m manager.RunnerManager) http.Handler {
+	r := chi.NewRouter()
+	r.Use(middlewareauthn.Runner(m))
+	r.Get("/server", HandleServer())
+	r.Route(fmt.Sprintf("/servers/{%s}", request.PathParamServerUID), func(r chi.Router) {
+		r.Use(RequireServer())
+		r.Get("/config", HandleConfig(m))
+	})
+	r.Post("/metrics", HandleUploadMetrics(m))
+	r.Post("/status", HandleUploadAppStatus(m))
+	r.Route(fmt.Sprintf("/deployments/{%s}", request.PathParamDeploymentID), func(r chi.Router) {
+		r.Use(RequireDeployment(m))
+		r.Post("/watch", HandleWatch(m))
+	})
+	return r
+}
+
+func TestRunnerScope(t *testing.T) {
+	router := newTestRouter(&stubManager{})
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		token  string
+		want   int
+	}{
+		{"no token", http.MethodGet, "/server", "", http.StatusUnauthorized},
+		{"unknown token", http.MethodGet, "/server", "token-3", http.StatusUnauthorized},
+		{"server of token", http.MethodGet, "/server", "token-1", http.StatusOK},
+		{"own config", http.MethodGet, "/servers/101/config", "token-1", http.StatusOK},
+		{"config of other server", http.MethodGet, "/servers/102/config", "token-1", http.StatusForbidden},
+		{"own deployment", http.MethodPost, "/deployments/11/watch", "token-1", http.StatusOK},
+		{"deployment of other server", http.MethodPost, "/deployments/12/watch", "token-1", http.StatusForbidden},
+		{"unknown deployment", http.MethodPost, "/deployments/13/watch", "token-1", http.StatusNotFound},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(tc.method, tc.path, nil)
+			if tc.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tc.token)
+			}
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+			if rec.Code != tc.want {
+				t.Errorf("status = %d, want %d: %s", rec.Code, tc.want, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestRunnerUploadsOnlyOwnApplications(t *testing.T) {
+	m := &stubManager{}
+	router := newTestRouter(m)
+
+	post := func(path, body string) {
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer token-1")
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		if rec.Code != http.StatusNoContent {
+			t.Fatalf("%s: status = %d, want %d", path, rec.Code, http.StatusNoContent)
+		}
+	}
+
+	post("/metrics", `[{"application_uid":201},{"application_uid":202}]`)
+	if len(m.uploaded) != 1 || m.uploaded[0].ApplicationUID != 201 {
+		t.Errorf("uploaded metrics = %+v, want only application 201", m.uploaded)
+	}
+
+	post("/status", `[{"application_uid":201,"project_id":2},{"application_uid":202,"project_id":2}]`)
+	if len(m.statuses) != 1 || m.statuses[0].ApplicationUID != 201 {
+		t.Fatalf("uploaded statuses = %+v, want only application 201", m.statuses)
+	}
+	if m.statuses[0].ProjectID != 1 {
+		t.Errorf("project = %d, want the project of the application", m.statuses[0].ProjectID)
+	}
+}
@@ -0,0 +1,40 @@
+package runner
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/pipeline/manager"
+	"github.com/cloudness-io/cloudness/app/request"
+	"github.com/cloudness-io/cloudness/types"
+)
+
+// HandleServer returns the server of the runner token, the agent runs its deployments.
+func HandleServer() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		server, _ := request.ServerFrom(r.Context())
+
+		render.JSON(w, http.StatusOK, &types.RunnerServer{UID: server.UID})
+	}
+}
+
+// HandleConfig returns the runner config of the server.
+func HandleConfig(runnerManager manager.RunnerManager) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		serverUID, err := request.GetServerUIDFromPath(r)
+		if err != nil {
+			render.BadRequestf(ctx, w, "Invalid server uid")
+			return
+		}
+
+		config, err := runnerManager.Config(ctx, serverUID)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.JSON(w, http.StatusOK, config)
+	}
+}
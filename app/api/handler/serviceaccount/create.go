@@ -0,0 +1,33 @@
+package serviceaccount
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/controller/serviceaccount"
+	"github.com/cloudness-io/cloudness/app/request"
+)
+
+// HandleCreate creates a new service account in the tenant.
+func HandleCreate(saCtrl *serviceaccount.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tenant, _ := request.TenantFrom(ctx)
+		principal, _ := request.PrincipalFrom(ctx)
+
+		in := new(serviceaccount.CreateInput)
+		if err := json.NewDecoder(r.Body).Decode(in); err != nil {
+			render.BadRequestf(ctx, w, "Invalid request body: %s.", err)
+			return
+		}
+
+		sa, err := saCtrl.Create(ctx, tenant, principal, in)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.JSON(w, http.StatusCreated, sa)
+	}
+}
@@ -0,0 +1,30 @@
+package serviceaccount
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/controller/serviceaccount"
+	"github.com/cloudness-io/cloudness/app/request"
+)
+
+// HandleDelete deletes the service account of the tenant.
+func HandleDelete(saCtrl *serviceaccount.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tenant, _ := request.TenantFrom(ctx)
+
+		uid, err := request.GetServiceAccountUIDFromPath(r)
+		if err != nil {
+			render.BadRequestf(ctx, w, "Invalid service account uid")
+			return
+		}
+
+		if err := saCtrl.Delete(ctx, tenant.ID, uid); err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.DeleteSuccessful(w)
+	}
+}
@@ -0,0 +1,31 @@
+package serviceaccount
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/controller/serviceaccount"
+	"github.com/cloudness-io/cloudness/app/request"
+)
+
+// HandleFind returns the service account of the tenant.
+func HandleFind(saCtrl *serviceaccount.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tenant, _ := request.TenantFrom(ctx)
+
+		uid, err := request.GetServiceAccountUIDFromPath(r)
+		if err != nil {
+			render.BadRequestf(ctx, w, "Invalid service account uid")
+			return
+		}
+
+		sa, err := saCtrl.FindByUID(ctx, tenant.ID, uid)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.JSON(w, http.StatusOK, sa)
+	}
+}
@@ -0,0 +1,25 @@
+package serviceaccount
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/controller/serviceaccount"
+	"github.com/cloudness-io/cloudness/app/request"
+)
+
+// HandleList lists the service accounts of the tenant.
+func HandleList(saCtrl *serviceaccount.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tenant, _ := request.TenantFrom(ctx)
+
+		accounts, err := saCtrl.List(ctx, tenant.ID)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.JSON(w, http.StatusOK, accounts)
+	}
+}
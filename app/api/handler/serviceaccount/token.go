@@ -0,0 +1,88 @@
+package serviceaccount
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/controller/serviceaccount"
+	"github.com/cloudness-io/cloudness/app/request"
+)
+
+// HandleListTokens lists the tokens of the service account.
+func HandleListTokens(saCtrl *serviceaccount.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tenant, _ := request.TenantFrom(ctx)
+
+		uid, err := request.GetServiceAccountUIDFromPath(r)
+		if err != nil {
+			render.BadRequestf(ctx, w, "Invalid service account uid")
+			return
+		}
+
+		tokens, err := saCtrl.ListTokens(ctx, tenant.ID, uid)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.JSON(w, http.StatusOK, tokens)
+	}
+}
+
+// HandleCreateToken mints a new token for the service account.
+func HandleCreateToken(saCtrl *serviceaccount.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tenant, _ := request.TenantFrom(ctx)
+		principal, _ := request.PrincipalFrom(ctx)
+
+		uid, err := request.GetServiceAccountUIDFromPath(r)
+		if err != nil {
+			render.BadRequestf(ctx, w, "Invalid service account uid")
+			return
+		}
+
+		in := new(serviceaccount.CreateTokenInput)
+		if err := json.NewDecoder(r.Body).Decode(in); err != nil {
+			render.BadRequestf(ctx, w, "Invalid request body: %s.", err)
+			return
+		}
+
+		token, err := saCtrl.CreateToken(ctx, tenant.ID, principal, uid, in)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.JSON(w, http.StatusCreated, token)
+	}
+}
+
+// HandleDeleteToken revokes the token of the service account.
+func HandleDeleteToken(saCtrl *serviceaccount.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tenant, _ := request.TenantFrom(ctx)
+
+		uid, err := request.GetServiceAccountUIDFromPath(r)
+		if err != nil {
+			render.BadRequestf(ctx, w, "Invalid service account uid")
+			return
+		}
+
+		identifier, err := request.GetTokenIdentifierFromPath(r)
+		if err != nil {
+			render.BadRequestf(ctx, w, "Invalid token identifier")
+			return
+		}
+
+		if err := saCtrl.DeleteToken(ctx, tenant.ID, uid, identifier); err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.DeleteSuccessful(w)
+	}
+}
@@ -0,0 +1,59 @@
+package tenant
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/controller/tenant"
+	"github.com/cloudness-io/cloudness/app/request"
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/rs/zerolog/log"
+)
+
+// HandleListAuditEvents lists the audit events of the tenant in the request context.
+func HandleListAuditEvents(tenantCtrl *tenant.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tenant, _ := request.TenantFrom(ctx)
+
+		filter, projectUID, err := request.ParseAuditFilterFromRequest(r)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		events, count, err := tenantCtrl.ListAuditEvents(ctx, tenant, projectUID, filter)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		out := make([]*types.AuditEventDTO, len(events))
+		for i, event := range events {
+			out[i] = event.ToDTO()
+		}
+
+		render.Pagination(r, w, filter.Page, filter.Size, int(count))
+		render.JSON(w, http.StatusOK, out)
+	}
+}
+
+// HandleExportAuditEvents streams all audit events of the tenant matching the filter as json lines.
+func HandleExportAuditEvents(tenantCtrl *tenant.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tenant, _ := request.TenantFrom(ctx)
+
+		filter, projectUID, err := request.ParseAuditFilterFromRequest(r)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		if err := tenantCtrl.ExportAuditEvents(ctx, tenant, projectUID, filter, w); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error exporting audit events of tenant")
+		}
+	}
+}
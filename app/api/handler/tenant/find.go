@@ -0,0 +1,18 @@
+package tenant
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/request"
+)
+
+// HandleFind returns the tenant in the request context.
+func HandleFind() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tenant, _ := request.TenantFrom(ctx)
+
+		render.JSON(w, http.StatusOK, tenant)
+	}
+}
@@ -0,0 +1,25 @@
+package tenant
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/controller/tenant"
+	"github.com/cloudness-io/cloudness/app/request"
+)
+
+// HandleList lists the tenants the principal is a member of.
+func HandleList(tenantCtrl *tenant.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		principal, _ := request.PrincipalFrom(ctx)
+
+		memberships, err := tenantCtrl.ListMembership(ctx, principal.ID)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.JSON(w, http.StatusOK, memberships)
+	}
+}
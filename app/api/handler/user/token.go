@@ -0,0 +1,69 @@
+package user
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/controller/user"
+	"github.com/cloudness-io/cloudness/app/request"
+)
+
+// HandleListTokens lists the personal access tokens of the current user.
+func HandleListTokens(userCtrl *user.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		principal, _ := request.PrincipalFrom(ctx)
+
+		tokens, err := userCtrl.ListTokens(ctx, principal.ID)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.JSON(w, http.StatusOK, tokens)
+	}
+}
+
+// HandleCreateToken mints a new personal access token for the current user.
+func HandleCreateToken(userCtrl *user.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		principal, _ := request.PrincipalFrom(ctx)
+
+		in := new(user.CreateTokenInput)
+		if err := json.NewDecoder(r.Body).Decode(in); err != nil {
+			render.BadRequestf(ctx, w, "Invalid request body: %s.", err)
+			return
+		}
+
+		token, err := userCtrl.CreateToken(ctx, principal.ID, in)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.JSON(w, http.StatusCreated, token)
+	}
+}
+
+// HandleDeleteToken revokes the personal access token of the current user.
+func HandleDeleteToken(userCtrl *user.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		principal, _ := request.PrincipalFrom(ctx)
+
+		identifier, err := request.GetTokenIdentifierFromPath(r)
+		if err != nil {
+			render.BadRequestf(ctx, w, "Invalid token identifier")
+			return
+		}
+
+		if err := userCtrl.DeleteToken(ctx, principal.ID, identifier); err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.DeleteSuccessful(w)
+	}
+}
@@ -0,0 +1,41 @@
+package variable
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/controller/application"
+	"github.com/cloudness-io/cloudness/app/controller/variable"
+	"github.com/cloudness-io/cloudness/app/request"
+)
+
+// HandleCreate adds a variable to the application.
+func HandleCreate(appCtrl *application.Controller, varCtrl *variable.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		env, _ := request.EnvironmentFrom(ctx)
+		app, _ := request.ApplicationFrom(ctx)
+
+		in := new(variable.AddVariableInput)
+		if err := json.NewDecoder(r.Body).Decode(in); err != nil {
+			render.BadRequestf(ctx, w, "Invalid request body: %s.", err)
+			return
+		}
+
+		if err := varCtrl.Add(ctx, env.ID, app.ID, in); err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		appCtrl.NeedsDeployment(ctx, app)
+
+		vars, err := varCtrl.ListDTO(ctx, env.ID, app.ID)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.JSON(w, http.StatusCreated, vars)
+	}
+}
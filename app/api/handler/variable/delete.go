@@ -0,0 +1,33 @@
+package variable
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/controller/application"
+	"github.com/cloudness-io/cloudness/app/controller/variable"
+	"github.com/cloudness-io/cloudness/app/request"
+)
+
+// HandleDelete deletes a variable of the application.
+func HandleDelete(appCtrl *application.Controller, varCtrl *variable.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		app, _ := request.ApplicationFrom(ctx)
+
+		variableUID, err := request.GetVariableUIDFromPath(r)
+		if err != nil {
+			render.BadRequestf(ctx, w, "Invalid variable uid")
+			return
+		}
+
+		if err := varCtrl.Delete(ctx, app.ID, variableUID); err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		appCtrl.NeedsDeployment(ctx, app)
+
+		render.DeleteSuccessful(w)
+	}
+}
@@ -0,0 +1,26 @@
+package variable
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/controller/variable"
+	"github.com/cloudness-io/cloudness/app/request"
+)
+
+// HandleList lists the system and user variables of the application.
+func HandleList(varCtrl *variable.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		env, _ := request.EnvironmentFrom(ctx)
+		app, _ := request.ApplicationFrom(ctx)
+
+		vars, err := varCtrl.ListDTO(ctx, env.ID, app.ID)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.JSON(w, http.StatusOK, vars)
+	}
+}
@@ -0,0 +1,47 @@
+package variable
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/controller/application"
+	"github.com/cloudness-io/cloudness/app/controller/variable"
+	"github.com/cloudness-io/cloudness/app/request"
+)
+
+// HandleUpdate updates the value and type of an application variable.
+func HandleUpdate(appCtrl *application.Controller, varCtrl *variable.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		env, _ := request.EnvironmentFrom(ctx)
+		app, _ := request.ApplicationFrom(ctx)
+
+		variableUID, err := request.GetVariableUIDFromPath(r)
+		if err != nil {
+			render.BadRequestf(ctx, w, "Invalid variable uid")
+			return
+		}
+
+		in := new(variable.UpdateVariableInput)
+		if err := json.NewDecoder(r.Body).Decode(in); err != nil {
+			render.BadRequestf(ctx, w, "Invalid request body: %s.", err)
+			return
+		}
+
+		if err := varCtrl.Update(ctx, env.ID, app.ID, variableUID, in); err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		appCtrl.NeedsDeployment(ctx, app)
+
+		vars, err := varCtrl.ListDTO(ctx, env.ID, app.ID)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.JSON(w, http.StatusOK, vars)
+	}
+}
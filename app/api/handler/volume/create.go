@@ -0,0 +1,43 @@
+package volume
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/controller/application"
+	"github.com/cloudness-io/cloudness/app/controller/volume"
+	"github.com/cloudness-io/cloudness/app/request"
+	"github.com/cloudness-io/cloudness/types"
+)
+
+// HandleCreate creates a new volume and attaches it to the application.
+func HandleCreate(appCtrl *application.Controller, volumeCtrl *volume.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		session, _ := request.AuthSessionFrom(ctx)
+		tenant, _ := request.TenantFrom(ctx)
+		project, _ := request.ProjectFrom(ctx)
+		env, _ := request.EnvironmentFrom(ctx)
+		app, _ := request.ApplicationFrom(ctx)
+
+		in := new(types.VolumeCreateInput)
+		if err := json.NewDecoder(r.Body).Decode(in); err != nil {
+			render.BadRequestf(ctx, w, "Invalid request body: %s.", err)
+			return
+		}
+
+		if _, err := appCtrl.AddVolume(ctx, session, tenant, project, env, app, in); err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		volumes, err := volumeCtrl.ListForApp(ctx, app)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.JSON(w, http.StatusCreated, volumes)
+	}
+}
@@ -0,0 +1,24 @@
+package volume
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/controller/volume"
+	"github.com/cloudness-io/cloudness/app/request"
+)
+
+// HandleDelete soft deletes the volume in the request context.
+func HandleDelete(volumeCtrl *volume.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		volume, _ := request.VolumeFrom(ctx)
+
+		if err := volumeCtrl.SoftDelete(ctx, volume); err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.DeleteSuccessful(w)
+	}
+}
@@ -0,0 +1,25 @@
+package volume
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/controller/volume"
+	"github.com/cloudness-io/cloudness/app/request"
+)
+
+// HandleList lists the volumes attached to the application.
+func HandleList(volumeCtrl *volume.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		app, _ := request.ApplicationFrom(ctx)
+
+		volumes, err := volumeCtrl.ListForApp(ctx, app)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.JSON(w, http.StatusOK, volumes)
+	}
+}
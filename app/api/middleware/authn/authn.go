@@ -0,0 +1,41 @@
+package authn
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/auth/authn"
+	"github.com/cloudness-io/cloudness/app/request"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/hlog"
+)
+
+// Required returns an http.HandlerFunc middleware that authenticates
+// the http.Request and fails with 401 if no valid credentials are present.
+func Required(authenticator authn.Authenticator) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			log := hlog.FromRequest(r)
+
+			session, err := authenticator.Authenticate(r)
+			if err != nil {
+				log.Debug().Err(err).Msg("api authentication failed")
+				render.Unauthorized(ctx, w)
+				return
+			}
+
+			// Update the logging context and inject principal in context
+			log.UpdateContext(func(c zerolog.Context) zerolog.Context {
+				return c.
+					Str("principal_name", session.Principal.DisplayName).
+					Str("principal_type", string(session.Principal.Type))
+			})
+
+			next.ServeHTTP(w, r.WithContext(
+				request.WithAuthSession(ctx, session),
+			))
+		})
+	}
+}
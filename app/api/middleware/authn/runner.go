@@ -0,0 +1,42 @@
+package authn
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/pipeline/manager"
+	"github.com/cloudness-io/cloudness/app/request"
+	baseStore "github.com/cloudness-io/cloudness/store"
+
+	"github.com/rs/zerolog/hlog"
+)
+
+// Runner returns an http.HandlerFunc middleware that authenticates remote agents with the runner
+// token of their server, the server is injected into the request context.
+func Runner(runnerManager manager.RunnerManager) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !ok || token == "" {
+				render.Unauthorized(ctx, w)
+				return
+			}
+
+			server, err := runnerManager.FindServerByRunnerToken(ctx, token)
+			if baseStore.IsNotFound(err) {
+				hlog.FromRequest(r).Debug().Msg("runner authentication failed")
+				render.Unauthorized(ctx, w)
+				return
+			}
+			if err != nil {
+				render.TranslatedUserError(ctx, w, err)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(request.WithServer(ctx, server)))
+		})
+	}
+}
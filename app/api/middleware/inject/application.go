@@ -0,0 +1,43 @@
+package inject
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/controller/application"
+	"github.com/cloudness-io/cloudness/app/request"
+	"github.com/cloudness-io/cloudness/store"
+)
+
+func InjectApplication(appCtrl *application.Controller) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			applicationUID, err := request.GetApplicationUIDFromPath(r)
+			if err != nil {
+				render.BadRequestf(ctx, w, "Invalid application uid")
+				return
+			}
+
+			tenant, _ := request.TenantFrom(ctx)
+			project, _ := request.ProjectFrom(ctx)
+			environment, _ := request.EnvironmentFrom(ctx)
+
+			application, err := appCtrl.Get(ctx, tenant.ID, project.ID, environment.ID, applicationUID)
+			if err != nil && !errors.Is(err, store.ErrResourceNotFound) {
+				render.TranslatedUserError(ctx, w, err)
+				return
+			}
+			if application == nil {
+				render.NotFound(ctx, w)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(
+				request.WithApplication(ctx, application),
+			))
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package inject
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/controller/deployment"
+	"github.com/cloudness-io/cloudness/app/request"
+	"github.com/cloudness-io/cloudness/store"
+)
+
+func InjectDeployment(deploymentCtrl *deployment.Controller) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			deploymentUID, err := request.GetDeploymentUIDFromPath(r)
+			if err != nil {
+				render.BadRequestf(ctx, w, "Invalid deployment uid")
+				return
+			}
+
+			application, _ := request.ApplicationFrom(ctx)
+
+			deployment, err := deploymentCtrl.Get(ctx, application.ID, deploymentUID)
+			if err != nil && !errors.Is(err, store.ErrResourceNotFound) {
+				render.TranslatedUserError(ctx, w, err)
+				return
+			}
+			if deployment == nil {
+				render.NotFound(ctx, w)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(
+				request.WithDeployment(ctx, deployment),
+			))
+		})
+	}
+}
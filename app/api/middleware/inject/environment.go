@@ -0,0 +1,40 @@
+package inject
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/controller/environment"
+	"github.com/cloudness-io/cloudness/app/request"
+	"github.com/cloudness-io/cloudness/store"
+)
+
+func InjectEnvironment(envCtrl *environment.Controller) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			project, _ := request.ProjectFrom(ctx)
+
+			envUID, err := request.GetEnvironmentUIDFromPath(r)
+			if err != nil {
+				render.BadRequestf(ctx, w, "Invalid environment uid")
+				return
+			}
+
+			environment, err := envCtrl.Get(ctx, project.ID, envUID)
+			if err != nil && !errors.Is(err, store.ErrResourceNotFound) {
+				render.TranslatedUserError(ctx, w, err)
+				return
+			}
+			if environment == nil {
+				render.NotFound(ctx, w)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(
+				request.WithEnvironment(ctx, environment),
+			))
+		})
+	}
+}
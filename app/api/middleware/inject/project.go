@@ -0,0 +1,53 @@
+package inject
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/controller/project"
+	"github.com/cloudness-io/cloudness/app/request"
+	"github.com/cloudness-io/cloudness/store"
+)
+
+func InjectProject(projectCtrl *project.Controller) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			tenant, _ := request.TenantFrom(ctx)
+			principal, _ := request.PrincipalFrom(ctx)
+
+			projectUID, err := request.GetProjectUIDFromPath(r)
+			if err != nil {
+				render.BadRequestf(ctx, w, "Invalid project uid")
+				return
+			}
+
+			project, err := projectCtrl.FindByUID(ctx, tenant.ID, projectUID)
+			if err != nil && !errors.Is(err, store.ErrResourceNotFound) {
+				render.TranslatedUserError(ctx, w, err)
+				return
+			}
+			if project == nil {
+				render.NotFound(ctx, w)
+				return
+			}
+
+			if !request.IsTeamAdmin(ctx) {
+				membership, err := projectCtrl.FindMembership(ctx, tenant.ID, project.ID, principal.ID)
+				if err != nil && !errors.Is(err, store.ErrResourceNotFound) {
+					render.TranslatedUserError(ctx, w, err)
+					return
+				}
+				if membership == nil {
+					render.NotFound(ctx, w)
+					return
+				}
+				ctx = request.WithProjectMembership(ctx, membership)
+			}
+
+			ctx = request.WithProject(ctx, project)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package inject
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/controller/tenant"
+	"github.com/cloudness-io/cloudness/app/request"
+	"github.com/cloudness-io/cloudness/store"
+)
+
+func InjectTenant(tenantCtrl *tenant.Controller) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			principal, _ := request.PrincipalFrom(ctx)
+
+			tenantUID, err := request.GetTenantUIDFromPath(r)
+			if err != nil {
+				render.BadRequestf(ctx, w, "Invalid tenant uid")
+				return
+			}
+
+			tenant, err := tenantCtrl.FindByUID(ctx, tenantUID)
+			if err != nil && !errors.Is(err, store.ErrResourceNotFound) {
+				render.TranslatedUserError(ctx, w, err)
+				return
+			}
+			if tenant == nil {
+				render.NotFound(ctx, w)
+				return
+			}
+
+			membership, err := tenantCtrl.FindMembership(ctx, tenant.ID, principal.ID)
+			if err != nil && !errors.Is(err, store.ErrResourceNotFound) {
+				render.TranslatedUserError(ctx, w, err)
+				return
+			}
+			if membership == nil {
+				render.NotFound(ctx, w)
+				return
+			}
+
+			ctx = request.WithTenant(ctx, tenant)
+			ctx = request.WithTenantMembership(ctx, membership)
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package inject
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/controller/volume"
+	"github.com/cloudness-io/cloudness/app/request"
+	"github.com/cloudness-io/cloudness/store"
+)
+
+func InjectVolume(volumeCtrl *volume.Controller) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			volumeUID, err := request.GetVolumeUIDFromPath(r)
+			if err != nil {
+				render.BadRequestf(ctx, w, "Invalid volume uid")
+				return
+			}
+
+			tenant, _ := request.TenantFrom(ctx)
+			project, _ := request.ProjectFrom(ctx)
+			environment, _ := request.EnvironmentFrom(ctx)
+
+			volume, err := volumeCtrl.Get(ctx, tenant.ID, project.ID, environment.ID, volumeUID)
+			if err != nil && !errors.Is(err, store.ErrResourceNotFound) {
+				render.TranslatedUserError(ctx, w, err)
+				return
+			}
+			if volume == nil {
+				render.NotFound(ctx, w)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(
+				request.WithVolume(ctx, volume),
+			))
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package restrict
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/request"
+)
+
+func ToTeamAdmin() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			if !request.IsTeamAdmin(ctx) {
+				render.Forbidden(ctx, w)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func ToProjectOwner() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			if !request.IsProjectOwner(ctx) {
+				render.Forbidden(ctx, w)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// ToProjectRole restricts the request method by the project role of the principal,
+// team admins are allowed all the methods.
+func ToProjectRole() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			if !request.IsTeamAdmin(ctx) {
+				if !checkMethodByProjectRole(ctx, r.Method) {
+					render.Forbidden(ctx, w)
+					return
+				}
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func checkMethodByProjectRole(ctx context.Context, method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodDelete:
+		return request.IsProjectOwner(ctx)
+	case http.MethodPatch, http.MethodPut:
+		return request.IsProjectContributor(ctx)
+	}
+
+	return true
+}
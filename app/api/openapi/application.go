@@ -0,0 +1,80 @@
+package openapi
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/swaggest/openapi-go/openapi3"
+)
+
+const applicationsPath = "/tenants/{tenant_uid}/projects/{project_uid}/environments/{environment_uid}/applications"
+
+type listApplicationsRequest struct {
+	environmentRequest
+	paginationRequest
+}
+
+type createApplicationRequest struct {
+	environmentRequest
+	types.ApplicationInput
+}
+
+type createRegistryApplicationRequest struct {
+	environmentRequest
+	types.RegistryInput
+}
+
+type updateApplicationRequest struct {
+	applicationRequest
+	types.ApplicationInput
+}
+
+type deleteApplicationRequest struct {
+	applicationRequest
+	Volume bool `query:"volume"`
+}
+
+func buildApplication(reflector *openapi3.Reflector) {
+	const base = applicationsPath
+
+	addOperation(reflector, operation{
+		method: http.MethodGet, path: base, id: "listApplications", tag: "application",
+		req: new(listApplicationsRequest), resp: []*types.Application{}, status: http.StatusOK, errs: errsFind,
+	})
+
+	addOperation(reflector, operation{
+		method: http.MethodPost, path: base, id: "createApplication", tag: "application",
+		req: new(createApplicationRequest), resp: new(types.Application), status: http.StatusCreated, errs: errsCreate,
+	})
+
+	addOperation(reflector, operation{
+		method: http.MethodPost, path: base + "/registry", id: "createRegistryApplication", tag: "application",
+		req: new(createRegistryApplicationRequest), resp: new(types.Application), status: http.StatusCreated, errs: errsCreate,
+	})
+
+	addOperation(reflector, operation{
+		method: http.MethodGet, path: base + "/{application_uid}", id: "findApplication", tag: "application",
+		req: new(applicationRequest), resp: new(types.Application), status: http.StatusOK, errs: errsFind,
+	})
+
+	addOperation(reflector, operation{
+		method: http.MethodPatch, path: base + "/{application_uid}", id: "updateApplication", tag: "application",
+		req: new(updateApplicationRequest), resp: new(types.Application), status: http.StatusOK, errs: errsCreate,
+	})
+
+	addOperation(reflector, operation{
+		method: http.MethodDelete, path: base + "/{application_uid}", id: "deleteApplication", tag: "application",
+		req: new(deleteApplicationRequest), resp: nil, status: http.StatusNoContent, errs: errsFind,
+	})
+
+	addOperation(reflector, operation{
+		method: http.MethodPost, path: base + "/{application_uid}/deploy", id: "deployApplication", tag: "application",
+		req: new(applicationRequest), resp: new(types.Deployment), status: http.StatusCreated, errs: errsCreate,
+	})
+
+	addOperation(reflector, operation{
+		method: http.MethodPost, path: base + "/{application_uid}/redeploy", id: "redeployApplication", tag: "application",
+		req: new(applicationRequest), resp: new(types.Deployment), status: http.StatusCreated, errs: errsCreate,
+	})
+}
@@ -0,0 +1,35 @@
+package openapi
+
+import (
+	"net/http"
+)
+
+var (
+	errsFind   = []int{http.StatusNotFound, http.StatusForbidden}
+	errsCreate = []int{http.StatusBadRequest, http.StatusNotFound, http.StatusForbidden}
+)
+
+type paginationRequest struct {
+	Page  int    `query:"page"  default:"1"  minimum:"1"`
+	Limit int    `query:"limit" default:"30" minimum:"1" maximum:"100"`
+	Query string `query:"query"`
+}
+
+type tenantRequest struct {
+	TenantUID int64 `path:"tenant_uid"`
+}
+
+type projectRequest struct {
+	tenantRequest
+	ProjectUID int64 `path:"project_uid"`
+}
+
+type environmentRequest struct {
+	projectRequest
+	EnvironmentUID int64 `path:"environment_uid"`
+}
+
+type applicationRequest struct {
+	environmentRequest
+	ApplicationUID int64 `path:"application_uid"`
+}
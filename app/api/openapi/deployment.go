@@ -0,0 +1,39 @@
+package openapi
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/swaggest/openapi-go/openapi3"
+)
+
+type listDeploymentsRequest struct {
+	applicationRequest
+	paginationRequest
+}
+
+type deploymentRequest struct {
+	applicationRequest
+	DeploymentUID int64 `path:"deployment_uid"`
+}
+
+func buildDeployment(reflector *openapi3.Reflector) {
+	const base = applicationsPath + "/{application_uid}/deployments"
+
+	addOperation(reflector, operation{
+		method: http.MethodGet, path: base, id: "listDeployments", tag: "deployment",
+		req: new(listDeploymentsRequest), resp: []*types.Deployment{}, status: http.StatusOK, errs: errsFind,
+	})
+
+	addOperation(reflector, operation{
+		method: http.MethodGet, path: base + "/{deployment_uid}", id: "findDeployment", tag: "deployment",
+		req: new(deploymentRequest), resp: new(types.Deployment), status: http.StatusOK, errs: errsFind,
+	})
+
+	addOperation(reflector, operation{
+		method: http.MethodPost, path: base + "/{deployment_uid}/rollback", id: "rollbackDeployment", tag: "deployment",
+		req: new(deploymentRequest), resp: new(types.Deployment), status: http.StatusCreated,
+		errs: append(errsFind, http.StatusPreconditionFailed),
+	})
+}
@@ -0,0 +1,54 @@
+package openapi
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/controller/environment"
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/swaggest/openapi-go/openapi3"
+)
+
+type listEnvironmentsRequest struct {
+	projectRequest
+	paginationRequest
+}
+
+type createEnvironmentRequest struct {
+	projectRequest
+	environment.CreateEnvironmentInput
+}
+
+type updateEnvironmentRequest struct {
+	environmentRequest
+	environment.CreateEnvironmentInput
+}
+
+func buildEnvironment(reflector *openapi3.Reflector) {
+	const base = "/tenants/{tenant_uid}/projects/{project_uid}/environments"
+
+	addOperation(reflector, operation{
+		method: http.MethodGet, path: base, id: "listEnvironments", tag: "environment",
+		req: new(listEnvironmentsRequest), resp: []*types.Environment{}, status: http.StatusOK, errs: errsFind,
+	})
+
+	addOperation(reflector, operation{
+		method: http.MethodPost, path: base, id: "createEnvironment", tag: "environment",
+		req: new(createEnvironmentRequest), resp: new(types.Environment), status: http.StatusCreated, errs: errsCreate,
+	})
+
+	addOperation(reflector, operation{
+		method: http.MethodGet, path: base + "/{environment_uid}", id: "findEnvironment", tag: "environment",
+		req: new(environmentRequest), resp: new(types.Environment), status: http.StatusOK, errs: errsFind,
+	})
+
+	addOperation(reflector, operation{
+		method: http.MethodPatch, path: base + "/{environment_uid}", id: "updateEnvironment", tag: "environment",
+		req: new(updateEnvironmentRequest), resp: new(types.Environment), status: http.StatusOK, errs: errsCreate,
+	})
+
+	addOperation(reflector, operation{
+		method: http.MethodDelete, path: base + "/{environment_uid}", id: "deleteEnvironment", tag: "environment",
+		req: new(environmentRequest), resp: nil, status: http.StatusNoContent, errs: errsFind,
+	})
+}
@@ -0,0 +1,90 @@
+package openapi
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/usererror"
+	"github.com/cloudness-io/cloudness/version"
+
+	"github.com/swaggest/openapi-go"
+	"github.com/swaggest/openapi-go/openapi3"
+)
+
+const securityBearer = "bearerAuth"
+
+// Service generates the openapi specification of the rest api.
+type Service interface {
+	Generate() *openapi3.Spec
+}
+
+type service struct{}
+
+// NewOpenAPIService returns a new openapi Service.
+func NewOpenAPIService() Service {
+	return &service{}
+}
+
+// Generate is a helper function that constructs the
+// openapi specification object, which can be marshaled
+// to json or yaml, as needed.
+func (*service) Generate() *openapi3.Spec {
+	reflector := openapi3.NewReflector()
+	reflector.Spec.Openapi = "3.0.0"
+	reflector.Spec.Info.
+		WithTitle("Cloudness API").
+		WithDescription("Versioned JSON API to manage projects, environments and applications.").
+		WithVersion(version.Version.String())
+	reflector.Spec.Servers = []openapi3.Server{{
+		URL: "/api/v1/",
+	}}
+	reflector.Spec.SetHTTPBearerTokenSecurity(securityBearer, "JWT", "Token issued by cloudness")
+	reflector.Spec.WithSecurity(map[string][]string{securityBearer: {}})
+
+	buildUserToken(reflector)
+	buildTenant(reflector)
+	buildServiceAccount(reflector)
+	buildRegistryCredential(reflector)
+	buildProject(reflector)
+	buildEnvironment(reflector)
+	buildApplication(reflector)
+	buildVariable(reflector)
+	buildVolume(reflector)
+	buildDeployment(reflector)
+
+	return reflector.Spec
+}
+
+// operation describes a single rest api operation of a resource.
+type operation struct {
+	method string
+	path   string
+	id     string
+	tag    string
+	req    any
+	resp   any
+	status int
+	// errs lists the http status codes of the user facing errors of the operation.
+	errs []int
+}
+
+func addOperation(reflector *openapi3.Reflector, o operation) {
+	opCtx, err := reflector.NewOperationContext(o.method, o.path)
+	if err != nil {
+		panic(fmt.Sprintf("failed to create openapi operation %s %s: %s", o.method, o.path, err))
+	}
+
+	opCtx.SetID(o.id)
+	opCtx.SetTags(o.tag)
+	if o.req != nil {
+		opCtx.AddReqStructure(o.req)
+	}
+	opCtx.AddRespStructure(o.resp, openapi.WithHTTPStatus(o.status))
+	for _, code := range append(o.errs, http.StatusUnauthorized, http.StatusInternalServerError) {
+		opCtx.AddRespStructure(new(usererror.Error), openapi.WithHTTPStatus(code))
+	}
+
+	if err := reflector.AddOperation(opCtx); err != nil {
+		panic(fmt.Sprintf("failed to add openapi operation %s %s: %s", o.method, o.path, err))
+	}
+}
@@ -0,0 +1,27 @@
+package openapi
+
+import (
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	spec := NewOpenAPIService().Generate()
+
+	if _, err := spec.MarshalYAML(); err != nil {
+		t.Fatalf("failed to marshal openapi spec: %s", err)
+	}
+
+	paths := []string{
+		"/user/tokens",
+		"/tenants/{tenant_uid}/service-accounts/{service_account_uid}/tokens",
+		"/tenants/{tenant_uid}/registry-credentials/{registry_credential_uid}",
+		"/tenants/{tenant_uid}/projects",
+		"/tenants/{tenant_uid}/projects/{project_uid}/environments/{environment_uid}/applications/{application_uid}/deploy",
+		"/tenants/{tenant_uid}/projects/{project_uid}/environments/{environment_uid}/applications/{application_uid}/deployments/{deployment_uid}/rollback",
+	}
+	for _, path := range paths {
+		if _, ok := spec.Paths.MapOfPathItemValues[path]; !ok {
+			t.Errorf("expected path %s in openapi spec", path)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package openapi
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/controller/project"
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/swaggest/openapi-go/openapi3"
+)
+
+type listProjectsRequest struct {
+	tenantRequest
+	paginationRequest
+}
+
+type createProjectRequest struct {
+	tenantRequest
+	project.CreateProjectInput
+}
+
+type updateProjectRequest struct {
+	projectRequest
+	project.CreateProjectInput
+}
+
+func buildProject(reflector *openapi3.Reflector) {
+	const base = "/tenants/{tenant_uid}/projects"
+
+	addOperation(reflector, operation{
+		method: http.MethodGet, path: base, id: "listProjects", tag: "project",
+		req: new(listProjectsRequest), resp: []*types.Project{}, status: http.StatusOK, errs: errsFind,
+	})
+
+	addOperation(reflector, operation{
+		method: http.MethodPost, path: base, id: "createProject", tag: "project",
+		req: new(createProjectRequest), resp: new(types.Project), status: http.StatusCreated, errs: errsCreate,
+	})
+
+	addOperation(reflector, operation{
+		method: http.MethodGet, path: base + "/{project_uid}", id: "findProject", tag: "project",
+		req: new(projectRequest), resp: new(types.Project), status: http.StatusOK, errs: errsFind,
+	})
+
+	addOperation(reflector, operation{
+		method: http.MethodPatch, path: base + "/{project_uid}", id: "updateProject", tag: "project",
+		req: new(updateProjectRequest), resp: new(types.Project), status: http.StatusOK, errs: errsCreate,
+	})
+
+	addOperation(reflector, operation{
+		method: http.MethodDelete, path: base + "/{project_uid}", id: "deleteProject", tag: "project",
+		req: new(projectRequest), resp: nil, status: http.StatusNoContent, errs: errsFind,
+	})
+}
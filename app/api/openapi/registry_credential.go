@@ -0,0 +1,44 @@
+package openapi
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/controller/registrycredential"
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/swaggest/openapi-go/openapi3"
+)
+
+type registryCredentialRequest struct {
+	tenantRequest
+	RegistryCredentialUID int64 `path:"registry_credential_uid"`
+}
+
+type createRegistryCredentialRequest struct {
+	tenantRequest
+	registrycredential.CreateInput
+}
+
+func buildRegistryCredential(reflector *openapi3.Reflector) {
+	const base = "/tenants/{tenant_uid}/registry-credentials"
+
+	addOperation(reflector, operation{
+		method: http.MethodGet, path: base, id: "listRegistryCredentials", tag: "registry_credential",
+		req: new(tenantRequest), resp: []*types.RegistryCredential{}, status: http.StatusOK, errs: errsFind,
+	})
+
+	addOperation(reflector, operation{
+		method: http.MethodPost, path: base, id: "createRegistryCredential", tag: "registry_credential",
+		req: new(createRegistryCredentialRequest), resp: new(types.RegistryCredential), status: http.StatusCreated, errs: errsCreate,
+	})
+
+	addOperation(reflector, operation{
+		method: http.MethodGet, path: base + "/{registry_credential_uid}", id: "findRegistryCredential", tag: "registry_credential",
+		req: new(registryCredentialRequest), resp: new(types.RegistryCredential), status: http.StatusOK, errs: errsFind,
+	})
+
+	addOperation(reflector, operation{
+		method: http.MethodDelete, path: base + "/{registry_credential_uid}", id: "deleteRegistryCredential", tag: "registry_credential",
+		req: new(registryCredentialRequest), resp: nil, status: http.StatusNoContent, errs: errsFind,
+	})
+}
@@ -0,0 +1,42 @@
+package openapi
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/swaggest/openapi-go/openapi3"
+)
+
+type auditEventsRequest struct {
+	tenantRequest
+	paginationRequest
+	Project      int64  `query:"project"`
+	Actor        int64  `query:"actor"`
+	Action       string `query:"action"`
+	ResourceType string `query:"resource_type"`
+	From         string `query:"from" format:"date"`
+	To           string `query:"to"   format:"date"`
+}
+
+func buildTenant(reflector *openapi3.Reflector) {
+	addOperation(reflector, operation{
+		method: http.MethodGet, path: "/tenants", id: "listTenants", tag: "tenant",
+		resp: []*types.TenantMembershipUser{}, status: http.StatusOK,
+	})
+
+	addOperation(reflector, operation{
+		method: http.MethodGet, path: "/tenants/{tenant_uid}", id: "findTenant", tag: "tenant",
+		req: new(tenantRequest), resp: new(types.Tenant), status: http.StatusOK, errs: errsFind,
+	})
+
+	addOperation(reflector, operation{
+		method: http.MethodGet, path: "/tenants/{tenant_uid}/audit-events", id: "listAuditEvents", tag: "tenant",
+		req: new(auditEventsRequest), resp: []*types.AuditEventDTO{}, status: http.StatusOK, errs: errsFind,
+	})
+
+	addOperation(reflector, operation{
+		method: http.MethodGet, path: "/tenants/{tenant_uid}/audit-events/export", id: "exportAuditEvents", tag: "tenant",
+		req: new(auditEventsRequest), resp: []*types.AuditEventDTO{}, status: http.StatusOK, errs: errsFind,
+	})
+}
@@ -0,0 +1,93 @@
+package openapi
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/controller/serviceaccount"
+	"github.com/cloudness-io/cloudness/app/controller/user"
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/swaggest/openapi-go/openapi3"
+)
+
+type userTokenRequest struct {
+	TokenIdentifier string `path:"token_identifier"`
+}
+
+type serviceAccountRequest struct {
+	tenantRequest
+	ServiceAccountUID string `path:"service_account_uid"`
+}
+
+type createServiceAccountRequest struct {
+	tenantRequest
+	serviceaccount.CreateInput
+}
+
+type serviceAccountTokenRequest struct {
+	serviceAccountRequest
+	TokenIdentifier string `path:"token_identifier"`
+}
+
+type createServiceAccountTokenRequest struct {
+	serviceAccountRequest
+	serviceaccount.CreateTokenInput
+}
+
+func buildUserToken(reflector *openapi3.Reflector) {
+	const base = "/user/tokens"
+
+	addOperation(reflector, operation{
+		method: http.MethodGet, path: base, id: "listUserTokens", tag: "user",
+		resp: []*types.Token{}, status: http.StatusOK,
+	})
+
+	addOperation(reflector, operation{
+		method: http.MethodPost, path: base, id: "createUserToken", tag: "user",
+		req: new(user.CreateTokenInput), resp: new(types.TokenResponse), status: http.StatusCreated, errs: errsCreate,
+	})
+
+	addOperation(reflector, operation{
+		method: http.MethodDelete, path: base + "/{token_identifier}", id: "deleteUserToken", tag: "user",
+		req: new(userTokenRequest), resp: nil, status: http.StatusNoContent, errs: errsFind,
+	})
+}
+
+func buildServiceAccount(reflector *openapi3.Reflector) {
+	const base = "/tenants/{tenant_uid}/service-accounts"
+
+	addOperation(reflector, operation{
+		method: http.MethodGet, path: base, id: "listServiceAccounts", tag: "service_account",
+		req: new(tenantRequest), resp: []*types.ServiceAccount{}, status: http.StatusOK, errs: errsFind,
+	})
+
+	addOperation(reflector, operation{
+		method: http.MethodPost, path: base, id: "createServiceAccount", tag: "service_account",
+		req: new(createServiceAccountRequest), resp: new(types.ServiceAccount), status: http.StatusCreated, errs: errsCreate,
+	})
+
+	addOperation(reflector, operation{
+		method: http.MethodGet, path: base + "/{service_account_uid}", id: "findServiceAccount", tag: "service_account",
+		req: new(serviceAccountRequest), resp: new(types.ServiceAccount), status: http.StatusOK, errs: errsFind,
+	})
+
+	addOperation(reflector, operation{
+		method: http.MethodDelete, path: base + "/{service_account_uid}", id: "deleteServiceAccount", tag: "service_account",
+		req: new(serviceAccountRequest), resp: nil, status: http.StatusNoContent, errs: errsFind,
+	})
+
+	addOperation(reflector, operation{
+		method: http.MethodGet, path: base + "/{service_account_uid}/tokens", id: "listServiceAccountTokens", tag: "service_account",
+		req: new(serviceAccountRequest), resp: []*types.Token{}, status: http.StatusOK, errs: errsFind,
+	})
+
+	addOperation(reflector, operation{
+		method: http.MethodPost, path: base + "/{service_account_uid}/tokens", id: "createServiceAccountToken", tag: "service_account",
+		req: new(createServiceAccountTokenRequest), resp: new(types.TokenResponse), status: http.StatusCreated, errs: errsCreate,
+	})
+
+	addOperation(reflector, operation{
+		method: http.MethodDelete, path: base + "/{service_account_uid}/tokens/{token_identifier}", id: "deleteServiceAccountToken", tag: "service_account",
+		req: new(serviceAccountTokenRequest), resp: nil, status: http.StatusNoContent, errs: errsFind,
+	})
+}
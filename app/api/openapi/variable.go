@@ -0,0 +1,49 @@
+package openapi
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/controller/variable"
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/swaggest/openapi-go/openapi3"
+)
+
+type variableRequest struct {
+	applicationRequest
+	VariableUID int64 `path:"variable_uid"`
+}
+
+type createVariableRequest struct {
+	applicationRequest
+	variable.AddVariableInput
+}
+
+type updateVariableRequest struct {
+	variableRequest
+	variable.UpdateVariableInput
+}
+
+func buildVariable(reflector *openapi3.Reflector) {
+	const base = applicationsPath + "/{application_uid}/variables"
+
+	addOperation(reflector, operation{
+		method: http.MethodGet, path: base, id: "listVariables", tag: "variable",
+		req: new(applicationRequest), resp: new(types.VariableDTO), status: http.StatusOK, errs: errsFind,
+	})
+
+	addOperation(reflector, operation{
+		method: http.MethodPost, path: base, id: "createVariable", tag: "variable",
+		req: new(createVariableRequest), resp: new(types.VariableDTO), status: http.StatusCreated, errs: errsCreate,
+	})
+
+	addOperation(reflector, operation{
+		method: http.MethodPatch, path: base + "/{variable_uid}", id: "updateVariable", tag: "variable",
+		req: new(updateVariableRequest), resp: new(types.VariableDTO), status: http.StatusOK, errs: errsCreate,
+	})
+
+	addOperation(reflector, operation{
+		method: http.MethodDelete, path: base + "/{variable_uid}", id: "deleteVariable", tag: "variable",
+		req: new(variableRequest), resp: nil, status: http.StatusNoContent, errs: errsFind,
+	})
+}
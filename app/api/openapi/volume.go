@@ -0,0 +1,43 @@
+package openapi
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/swaggest/openapi-go/openapi3"
+)
+
+type volumeRequest struct {
+	applicationRequest
+	VolumeUID int64 `path:"volume_uid"`
+}
+
+type createVolumeRequest struct {
+	applicationRequest
+	types.VolumeCreateInput
+}
+
+func buildVolume(reflector *openapi3.Reflector) {
+	const base = applicationsPath + "/{application_uid}/volumes"
+
+	addOperation(reflector, operation{
+		method: http.MethodGet, path: base, id: "listVolumes", tag: "volume",
+		req: new(applicationRequest), resp: []*types.Volume{}, status: http.StatusOK, errs: errsFind,
+	})
+
+	addOperation(reflector, operation{
+		method: http.MethodPost, path: base, id: "createVolume", tag: "volume",
+		req: new(createVolumeRequest), resp: []*types.Volume{}, status: http.StatusCreated, errs: errsCreate,
+	})
+
+	addOperation(reflector, operation{
+		method: http.MethodPost, path: base + "/{volume_uid}/detach", id: "detachVolume", tag: "volume",
+		req: new(volumeRequest), resp: nil, status: http.StatusNoContent, errs: errsFind,
+	})
+
+	addOperation(reflector, operation{
+		method: http.MethodDelete, path: base + "/{volume_uid}", id: "deleteVolume", tag: "volume",
+		req: new(volumeRequest), resp: nil, status: http.StatusNoContent, errs: errsFind,
+	})
+}
@@ -0,0 +1,14 @@
+package openapi
+
+import (
+	"github.com/google/wire"
+)
+
+// WireSet provides a wire set for this package.
+var WireSet = wire.NewSet(
+	ProvideOpenAPIService,
+)
+
+func ProvideOpenAPIService() Service {
+	return NewOpenAPIService()
+}
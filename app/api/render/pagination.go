@@ -0,0 +1,106 @@
+package render
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const (
+	HeaderPagination         = "x-page"
+	HeaderPaginationPerPage  = "x-per-page"
+	HeaderPaginationNextPage = "x-next-page"
+	HeaderPaginationPrevPage = "x-prev-page"
+	HeaderPaginationTotal    = "x-total"
+	HeaderPaginationLink     = "Link"
+)
+
+// Pagination writes the pagination and link headers to the http.Response.
+func Pagination(r *http.Request, w http.ResponseWriter, page, size, total int) {
+	var (
+		last = pagelen(size, total)
+		next = page + 1
+		prev = max(page-1, 1)
+	)
+
+	if page < last {
+		w.Header().Set(HeaderPaginationNextPage, strconv.Itoa(next))
+	}
+	if page > 1 {
+		w.Header().Set(HeaderPaginationPrevPage, strconv.Itoa(prev))
+	}
+
+	w.Header().Set(HeaderPagination, strconv.Itoa(page))
+	w.Header().Set(HeaderPaginationPerPage, strconv.Itoa(size))
+	w.Header().Set(HeaderPaginationTotal, strconv.Itoa(total))
+
+	// copy the url and set the page and size parameters used to build the
+	// link header.
+	uri := *r.URL
+	params := uri.Query()
+	params.Set("limit", strconv.Itoa(size))
+
+	var links []string
+	if page < last {
+		links = append(links, link(&uri, params, next, "next"))
+	}
+	if page > 1 {
+		links = append(links, link(&uri, params, prev, "prev"))
+	}
+	links = append(links, link(&uri, params, 1, "first"), link(&uri, params, last, "last"))
+
+	for _, l := range links {
+		w.Header().Add(HeaderPaginationLink, l)
+	}
+}
+
+// PaginationNoTotal writes the pagination and link headers to the http.Response
+// when the total count of the items is not known.
+func PaginationNoTotal(r *http.Request, w http.ResponseWriter, page, size int, isLastPage bool) {
+	var (
+		next = page + 1
+		prev = max(page-1, 1)
+	)
+
+	if !isLastPage {
+		w.Header().Set(HeaderPaginationNextPage, strconv.Itoa(next))
+	}
+	if page > 1 {
+		w.Header().Set(HeaderPaginationPrevPage, strconv.Itoa(prev))
+	}
+
+	w.Header().Set(HeaderPagination, strconv.Itoa(page))
+	w.Header().Set(HeaderPaginationPerPage, strconv.Itoa(size))
+
+	uri := *r.URL
+	params := uri.Query()
+	params.Set("limit", strconv.Itoa(size))
+
+	if !isLastPage {
+		w.Header().Add(HeaderPaginationLink, link(&uri, params, next, "next"))
+	}
+	if page > 1 {
+		w.Header().Add(HeaderPaginationLink, link(&uri, params, prev, "prev"))
+	}
+	w.Header().Add(HeaderPaginationLink, link(&uri, params, 1, "first"))
+}
+
+func link(uri *url.URL, params url.Values, page int, rel string) string {
+	params.Set("page", strconv.Itoa(page))
+	uri.RawQuery = params.Encode()
+	return "<" + uri.String() + ">; rel=\"" + rel + "\""
+}
+
+// pagelen calculates to total number of pages given the
+// page size and total count of all paginated items.
+func pagelen(size, total int) int {
+	quotient, remainder := total/size, total%size
+	switch {
+	case quotient == 0:
+		return 1
+	case remainder == 0:
+		return quotient
+	default:
+		return quotient + 1
+	}
+}
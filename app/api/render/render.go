@@ -0,0 +1,101 @@
+package render
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/cloudness-io/cloudness/app/usererror"
+
+	"github.com/rs/zerolog/log"
+)
+
+// indent the json-encoded API responses.
+var indent bool
+
+func init() {
+	indent, _ = strconv.ParseBool(
+		os.Getenv("CLOUDNESS_HTTP_JSON_INDENT"),
+	)
+}
+
+// DeleteSuccessful writes the header for a successful delete.
+func DeleteSuccessful(w http.ResponseWriter) {
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Accepted writes the header for an accepted request.
+func Accepted(w http.ResponseWriter) {
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// TranslatedUserError writes the translated user error of the provided error.
+func TranslatedUserError(ctx context.Context, w http.ResponseWriter, err error) {
+	UserError(ctx, w, usererror.Translate(ctx, err))
+}
+
+// NotFound writes the json-encoded message for a not found error.
+func NotFound(ctx context.Context, w http.ResponseWriter) {
+	UserError(ctx, w, usererror.ErrNotFound)
+}
+
+// Unauthorized writes the json-encoded message for an unauthorized error.
+func Unauthorized(ctx context.Context, w http.ResponseWriter) {
+	UserError(ctx, w, usererror.ErrUnauthorized)
+}
+
+// Forbidden writes the json-encoded message for a forbidden error.
+func Forbidden(ctx context.Context, w http.ResponseWriter) {
+	UserError(ctx, w, usererror.ErrForbidden)
+}
+
+// BadRequest writes the json-encoded message for a bad request error.
+func BadRequest(ctx context.Context, w http.ResponseWriter) {
+	UserError(ctx, w, usererror.ErrBadRequest)
+}
+
+// BadRequestf writes the json-encoded message with a bad request status code.
+func BadRequestf(ctx context.Context, w http.ResponseWriter, format string, args ...any) {
+	UserError(ctx, w, usererror.Newf(http.StatusBadRequest, format, args...))
+}
+
+// InternalError writes the json-encoded message for an internal error.
+func InternalError(ctx context.Context, w http.ResponseWriter) {
+	UserError(ctx, w, usererror.ErrInternal)
+}
+
+// UserError writes the json-encoded user error.
+func UserError(ctx context.Context, w http.ResponseWriter, err *usererror.Error) {
+	log.Ctx(ctx).Debug().Err(err).Msgf("operation resulted in user facing error")
+
+	JSON(w, err.Status, err)
+}
+
+// JSON writes the json-encoded value to the response
+// with the provides status.
+func JSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(code)
+
+	enc := json.NewEncoder(w)
+	if indent {
+		enc.SetIndent("", "  ")
+	}
+	if err := enc.Encode(v); err != nil {
+		log.Err(err).Msgf("Failed to write json encoding to response body.")
+	}
+}
+
+// Reader writes the raw bytes to the response with the provided content type.
+func Reader(ctx context.Context, w http.ResponseWriter, code int, contentType string, data []byte) {
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Length", fmt.Sprint(len(data)))
+	w.WriteHeader(code)
+
+	if _, err := w.Write(data); err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed to write response body")
+	}
+}
@@ -67,6 +67,10 @@ func (a *JWTAuthenticator) Authenticate(r *http.Request) (*auth.Session, error)
 		return nil, errors.New("JWT: invalid HMAC signature for JWT")
 	}
 
+	if principal.Blocked {
+		return nil, errors.New("JWT: principal is blocked")
+	}
+
 	var metadata auth.Metadata
 	switch {
 	case claims.Token != nil:
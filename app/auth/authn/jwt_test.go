@@ -0,0 +1,131 @@
+package authn
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cloudness-io/cloudness/app/auth"
+	"github.com/cloudness-io/cloudness/app/jwt"
+	"github.com/cloudness-io/cloudness/app/store/database"
+	"github.com/cloudness-io/cloudness/app/store/database/migrate"
+	"github.com/cloudness-io/cloudness/app/token"
+	storedb "github.com/cloudness-io/cloudness/store/database"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+
+	"github.com/gotidy/ptr"
+)
+
+// TestJWTAuthenticatorAccessTokens checks that personal access tokens and service account tokens
+// authenticate their own principal only, and stop working once revoked, expired or blocked.
+func TestJWTAuthenticatorAccessTokens(t *testing.T) {
+	ctx := context.Background()
+	db, err := storedb.ConnectAndMigrate(ctx, "sqlite3", t.TempDir()+"/db.sqlite", migrate.Migrate)
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	principalStore := database.NewPrincipalStore(db)
+	tokenStore := database.NewTokenStore(db)
+	authenticator := NewTokenAuthenticator(principalStore, tokenStore, "token")
+
+	now := time.Now().UnixMilli()
+	user, err := principalStore.CreateUser(ctx, &types.User{
+		UID: "user", Email: "user@example.com", Salt: "user-salt", Created: now, Updated: now,
+	})
+	if err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	blocked, err := principalStore.CreateUser(ctx, &types.User{
+		UID: "blocked", Email: "blocked@example.com", Salt: "blocked-salt", Blocked: true, Created: now, Updated: now,
+	})
+	if err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	sa, err := principalStore.CreateServiceAccount(ctx, &types.ServiceAccount{
+		UID: "sa-ci", Email: "sa-ci@example.com", Salt: "sa-salt", Created: now, Updated: now,
+	})
+	if err != nil {
+		t.Fatalf("failed to create service account: %v", err)
+	}
+
+	_, pat, err := token.CreatePAT(ctx, tokenStore, user, "pat", nil)
+	if err != nil {
+		t.Fatalf("failed to create pat: %v", err)
+	}
+	satToken, sat, err := token.CreateSAT(ctx, tokenStore, user.ToPrincipal(), sa, "sat", ptr.Duration(time.Hour))
+	if err != nil {
+		t.Fatalf("failed to create sat: %v", err)
+	}
+	_, expired, err := token.CreatePAT(ctx, tokenStore, user, "expired", ptr.Duration(-time.Hour))
+	if err != nil {
+		t.Fatalf("failed to create pat: %v", err)
+	}
+	revokedToken, revoked, err := token.CreatePAT(ctx, tokenStore, user, "revoked", nil)
+	if err != nil {
+		t.Fatalf("failed to create pat: %v", err)
+	}
+	if err := tokenStore.Delete(ctx, revokedToken.ID); err != nil {
+		t.Fatalf("failed to delete token: %v", err)
+	}
+	_, blockedPAT, err := token.CreatePAT(ctx, tokenStore, blocked, "pat", nil)
+	if err != nil {
+		t.Fatalf("failed to create pat: %v", err)
+	}
+	// a jwt of the user referencing the token of the service account.
+	forged, err := jwt.GenerateForToken(&types.Token{
+		ID: satToken.ID, Type: enum.TokenTypeSAT, PrincipalID: user.ID, IssuedAt: now,
+	}, user.Salt)
+	if err != nil {
+		t.Fatalf("failed to create jwt: %v", err)
+	}
+
+	tests := []struct {
+		name          string
+		header        string
+		wantPrincipal int64
+		wantType      enum.TokenType
+		wantErr       bool
+	}{
+		{name: "pat", header: "Bearer " + pat, wantPrincipal: user.ID, wantType: enum.TokenTypePAT},
+		{name: "sat", header: "Bearer " + sat, wantPrincipal: sa.ID, wantType: enum.TokenTypeSAT},
+		{name: "raw header", header: pat, wantPrincipal: user.ID, wantType: enum.TokenTypePAT},
+		{name: "expired", header: "Bearer " + expired, wantErr: true},
+		{name: "revoked", header: "Bearer " + revoked, wantErr: true},
+		{name: "blocked principal", header: "Bearer " + blockedPAT, wantErr: true},
+		{name: "token of other principal", header: "Bearer " + forged, wantErr: true},
+		{name: "tampered", header: "Bearer " + pat + "x", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.Header.Set("Authorization", tt.header)
+
+			session, err := authenticator.Authenticate(r)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got session for principal %d", session.Principal.ID)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if session.Principal.ID != tt.wantPrincipal {
+				t.Errorf("principal = %d, want %d", session.Principal.ID, tt.wantPrincipal)
+			}
+			metadata, ok := session.Metadata.(*auth.TokenMetadata)
+			if !ok || metadata.TokenType != tt.wantType {
+				t.Errorf("metadata = %#v, want token type %s", session.Metadata, tt.wantType)
+			}
+		})
+	}
+
+	if _, err := authenticator.Authenticate(httptest.NewRequest(http.MethodGet, "/", nil)); err != ErrNoAuthData {
+		t.Errorf("missing token: err = %v, want %v", err, ErrNoAuthData)
+	}
+}
@@ -0,0 +1,22 @@
+package application
+
+import (
+	"strconv"
+
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+func auditResource(app *types.Application) audit.Resource {
+	return audit.NewResource(enum.AuditResourceApplication, strconv.FormatInt(app.UID, 10), app.Name)
+}
+
+func auditDomainResource(app *types.Application) audit.Resource {
+	return audit.NewResource(enum.AuditResourceDomain, strconv.FormatInt(app.UID, 10), app.Name)
+}
+
+// auditDomain is the audited state of the public domain of an application.
+type auditDomain struct {
+	Domain string `json:"domain"`
+}
@@ -0,0 +1,21 @@
+package application
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/types"
+)
+
+func (c *Controller) GetAutoscalingStatus(ctx context.Context, app *types.Application) (*types.AutoscalingStatus, error) {
+	server, err := c.serverCtrl.FindByID(ctx, app.ServerID)
+	if err != nil {
+		return nil, err
+	}
+
+	mgr, err := c.manager.GetServerManager(server)
+	if err != nil {
+		return nil, err
+	}
+
+	return mgr.GetAutoscalingStatus(ctx, server, app)
+}
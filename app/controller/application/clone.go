@@ -0,0 +1,87 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/cloudness-io/cloudness/helpers"
+	"github.com/cloudness-io/cloudness/types"
+)
+
+// Clone creates a copy of the application with its volumes and variables in the same environment.
+// Volume names are unique per environment so the copies get a random suffix, tcp proxies are dropped
+// as those are allocated per server.
+func (c *Controller) Clone(
+	ctx context.Context,
+	actor string,
+	tenant *types.Tenant,
+	project *types.Project,
+	environment *types.Environment,
+	src *types.Application,
+	name string,
+) (*types.Application, error) {
+	spec := new(types.ApplicationSpec)
+	if err := json.Unmarshal([]byte(src.SpecJSON), spec); err != nil {
+		return nil, err
+	}
+	spec.Name = name
+	if spec.Networking != nil {
+		spec.Networking.TCPProxies = nil
+	}
+	suffix := helpers.GenerateSlug(6)
+	for _, v := range spec.Volumes {
+		v.VolumeName = v.VolumeName + "-" + suffix
+	}
+
+	server, err := c.serverCtrl.FindByID(ctx, environment.ServerID)
+	if err != nil {
+		return nil, err
+	}
+
+	var app *types.Application
+	err = c.tx.WithTx(ctx, func(ctx context.Context) error {
+		dto, err := c.convertSpecToDTO(ctx, spec, tenant, project, environment, actor)
+		if err != nil {
+			return err
+		}
+		dto.Application.GithubAppID = src.GithubAppID
+		dto.Application.GitConnectionID = src.GitConnectionID
+
+		if spec.Networking != nil && spec.Networking.ServiceDomain != nil {
+			fqdn, err := c.SuggestFQDN(ctx, dto.Application)
+			if err != nil {
+				return err
+			}
+			dto.Application.Domain = fqdn
+			dto.Application.Spec.Networking.ServiceDomain.Domain = fqdn
+		}
+
+		app, err = c.CreateWithoutTx(ctx, dto)
+		if err != nil {
+			return err
+		}
+
+		volumes, err := c.volumeCtrl.ListForApp(ctx, src)
+		if err != nil {
+			return err
+		}
+		for _, volume := range volumes {
+			_, err := c.volumeCtrl.Create(ctx, tenant, project, environment, app, &types.VolumeCreateInput{
+				Name:      volume.Name + "-" + suffix,
+				MountPath: volume.MountPath,
+				Size:      volume.Size,
+				Server:    server,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return c.varCtrl.CloneEnvironment(ctx, environment.ID, environment.ID, map[int64]int64{src.ID: app.ID})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return app, nil
+}
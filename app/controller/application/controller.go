@@ -10,6 +10,7 @@ import (
 	"github.com/cloudness-io/cloudness/app/controller/volume"
 	"github.com/cloudness-io/cloudness/app/pipeline/canceler"
 	"github.com/cloudness-io/cloudness/app/pipeline/triggerer"
+	"github.com/cloudness-io/cloudness/app/services/audit"
 	"github.com/cloudness-io/cloudness/app/services/config"
 	"github.com/cloudness-io/cloudness/app/services/manager"
 	"github.com/cloudness-io/cloudness/app/services/schema"
@@ -17,7 +18,6 @@ import (
 	"github.com/cloudness-io/cloudness/app/store"
 	"github.com/cloudness-io/cloudness/store/database/dbtx"
 	"github.com/cloudness-io/cloudness/types"
-	"github.com/cloudness-io/cloudness/types/check"
 	"github.com/cloudness-io/cloudness/types/enum"
 )
 
@@ -28,6 +28,8 @@ type Controller struct {
 	specSvc          *spec.Service
 	applicationStore store.ApplicationStore
 	metricsStore     store.MetricsStore
+	regCredStore     store.RegistryCredentialStore
+	gitConnStore     store.GitConnectionStore
 	serverCtrl       *server.Controller
 	varCtrl          *variable.Controller
 	gitPublicCtrl    *gitpublic.Controller
@@ -35,6 +37,7 @@ type Controller struct {
 	triggerer        triggerer.Triggerer
 	canceler         canceler.Canceler
 	manager          manager.ManagerFactory
+	auditSvc         *audit.Service
 }
 
 func NewController(
@@ -44,6 +47,8 @@ func NewController(
 	specSvc *spec.Service,
 	applicationStore store.ApplicationStore,
 	metricsStore store.MetricsStore,
+	registryCredentialStore store.RegistryCredentialStore,
+	gitConnectionStore store.GitConnectionStore,
 	serverCtrl *server.Controller,
 	varCtrl *variable.Controller,
 	gitPublicCtrl *gitpublic.Controller,
@@ -51,6 +56,7 @@ func NewController(
 	triggerer triggerer.Triggerer,
 	canceler canceler.Canceler,
 	manager manager.ManagerFactory,
+	auditSvc *audit.Service,
 ) *Controller {
 	return &Controller{
 		tx:               tx,
@@ -59,6 +65,8 @@ func NewController(
 		specSvc:          specSvc,
 		applicationStore: applicationStore,
 		metricsStore:     metricsStore,
+		regCredStore:     registryCredentialStore,
+		gitConnStore:     gitConnectionStore,
 		serverCtrl:       serverCtrl,
 		varCtrl:          varCtrl,
 		gitPublicCtrl:    gitPublicCtrl,
@@ -66,6 +74,7 @@ func NewController(
 		triggerer:        triggerer,
 		canceler:         canceler,
 		manager:          manager,
+		auditSvc:         auditSvc,
 	}
 }
 
@@ -111,7 +120,12 @@ func (c *Controller) updateWithoutTx(ctx context.Context, dto *createOrUpdateDto
 		return nil, err
 	}
 
-	server, err := c.serverCtrl.Get(ctx)
+	server, err := c.serverCtrl.FindByID(ctx, application.ServerID)
+	if err != nil {
+		return nil, err
+	}
+
+	old, err := c.applicationStore.Find(ctx, application.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -125,26 +139,16 @@ func (c *Controller) updateWithoutTx(ctx context.Context, dto *createOrUpdateDto
 		return nil, err
 	}
 
+	c.auditSvc.Log(ctx, auditResource(application), enum.AuditActionUpdated,
+		audit.WithTenantID(application.TenantID),
+		audit.WithProjectID(application.ProjectID),
+		audit.WithEnvironmentID(application.EnvironmentID),
+		audit.WithOldObject(old),
+		audit.WithNewObject(application),
+	)
 	return application, nil
 }
 
 func (c *Controller) validateRestrictions(dto *createOrUpdateDto) error {
-	deploySpec := dto.Application.Spec.Deploy
-	restrictions := c.configSvc.GetTenantRestrictions(dto.Tenant)
-
-	err := check.NewValidationErrors()
-	if deploySpec.CPU > restrictions.MaxCPU {
-		err.AddValidationError("cpu", check.NewValidationErrorf("CPU is above max allowed limit"))
-	}
-	if deploySpec.MaxReplicas > restrictions.MaxInstances {
-		err.AddValidationError("maxReplicas", check.NewValidationErrorf("Max Replicas is above max allowed limit"))
-	}
-	if deploySpec.Memory > restrictions.MaxMemory {
-		err.AddValidationError("memory", check.NewValidationErrorf("Memory is above max allowed limit"))
-	}
-
-	if err.HasError() {
-		return err
-	}
-	return nil
+	return spec.ValidateRestrictions(dto.Application.Spec.Deploy, c.configSvc.GetTenantRestrictions(dto.Tenant))
 }
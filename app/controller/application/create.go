@@ -3,6 +3,7 @@ package application
 import (
 	"context"
 
+	"github.com/cloudness-io/cloudness/app/services/audit"
 	"github.com/cloudness-io/cloudness/errors"
 	"github.com/cloudness-io/cloudness/helpers"
 	"github.com/cloudness-io/cloudness/types"
@@ -32,6 +33,28 @@ func (c *Controller) CreateGithub(
 	return c.createWithTx(ctx, dto)
 }
 
+func (c *Controller) CreateGitConnection(
+	ctx context.Context,
+	actor string,
+	tenant *types.Tenant,
+	project *types.Project,
+	environment *types.Environment,
+	conn *types.GitConnection,
+	in *types.ApplicationInput,
+) (*types.Application, error) {
+	in.RepoURL = conn.GetHttpUrl(in.Repo)
+
+	dto, err := c.convertInputToDto(ctx, in, tenant, project, environment, nil, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	// Updating git connection specific application context
+	dto.Application.GitConnectionID = &conn.ID
+
+	return c.createWithTx(ctx, dto)
+}
+
 func (c *Controller) CreateRegistry(
 	ctx context.Context,
 	actor string,
@@ -41,6 +64,7 @@ func (c *Controller) CreateRegistry(
 	in *types.RegistryInput) (*types.Application, error) {
 	//TODO: validation of image
 	appIn := c.GetRegistryIn(in.Image, in.NetworkInput)
+	appIn.CredentialUID = in.CredentialUID
 	dto, err := c.convertInputToDto(ctx, appIn, tenant, project, environment, nil, actor)
 	if err != nil {
 		return nil, err
@@ -92,7 +116,7 @@ func (c *Controller) CreateWithoutTx(ctx context.Context, dto *createOrUpdateDto
 		return nil, errors.PreconditionFailed("Max applications limit reached")
 	}
 	// update server
-	server, err := c.serverCtrl.Get(ctx)
+	server, err := c.serverCtrl.FindByID(ctx, dto.Environment.ServerID)
 	if err != nil {
 		return nil, err
 	}
@@ -117,6 +141,12 @@ func (c *Controller) CreateWithoutTx(ctx context.Context, dto *createOrUpdateDto
 		return nil, err
 	}
 
+	c.auditSvc.Log(ctx, auditResource(application), enum.AuditActionCreated,
+		audit.WithTenantID(application.TenantID),
+		audit.WithProjectID(application.ProjectID),
+		audit.WithEnvironmentID(application.EnvironmentID),
+		audit.WithNewObject(application),
+	)
 	return application, nil
 }
 
@@ -0,0 +1,21 @@
+package application
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/types"
+)
+
+func (c *Controller) ListCronRuns(ctx context.Context, app *types.Application) ([]*types.CronRun, error) {
+	server, err := c.serverCtrl.FindByID(ctx, app.ServerID)
+	if err != nil {
+		return nil, err
+	}
+
+	mgr, err := c.manager.GetServerManager(server)
+	if err != nil {
+		return nil, err
+	}
+
+	return mgr.ListCronRuns(ctx, server, app)
+}
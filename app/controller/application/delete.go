@@ -4,7 +4,9 @@ import (
 	"context"
 	"time"
 
+	"github.com/cloudness-io/cloudness/app/services/audit"
 	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
 
 	"github.com/rs/zerolog/log"
 )
@@ -57,6 +59,9 @@ func (c *Controller) SoftDelete(ctx context.Context, app *types.Application, opt
 		if err := c.clearResources(ctx, server, app); err != nil {
 			log.Ctx(ctx).Error().Err(err).Msg("Error deleting resources, supressing error for now, will be tried during cleanup job")
 		}
+
+		c.auditSvc.Log(ctx, auditResource(app), enum.AuditActionDeleted,
+			audit.WithOldObject(app), audit.WithData("volumes_deleted", opts.Volume))
 		return nil
 	})
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/cloudness-io/cloudness/app/pipeline/triggerer"
+	"github.com/cloudness-io/cloudness/app/services/audit"
 	"github.com/cloudness-io/cloudness/types"
 	"github.com/cloudness-io/cloudness/types/enum"
 
@@ -28,7 +29,7 @@ func (c *Controller) Redeploy(ctx context.Context, actor string, application *ty
 		Action:        enum.TriggerActionManual,
 	}
 
-	return c.triggerer.Trigger(ctx, hook)
+	return c.trigger(ctx, application, hook)
 }
 
 func (c *Controller) Deploy(ctx context.Context, actor string, action enum.TriggerAction, application *types.Application) (*types.Deployment, error) {
@@ -56,5 +57,22 @@ func (c *Controller) Deploy(ctx context.Context, actor string, action enum.Trigg
 		Action:        action,
 	}
 
-	return c.triggerer.Trigger(ctx, hook)
+	return c.trigger(ctx, application, hook)
+}
+
+func (c *Controller) trigger(ctx context.Context, application *types.Application, hook *triggerer.TriggerHook) (*types.Deployment, error) {
+	deployment, err := c.triggerer.Trigger(ctx, hook)
+	if err != nil {
+		return nil, err
+	}
+
+	c.auditSvc.Log(ctx, auditResource(application), enum.AuditActionDeployed,
+		audit.WithTenantID(application.TenantID),
+		audit.WithProjectID(application.ProjectID),
+		audit.WithEnvironmentID(application.EnvironmentID),
+		audit.WithData("triggerer", hook.Triggerer),
+		audit.WithData("title", hook.Title),
+		audit.WithData("trigger_action", hook.Action),
+	)
+	return deployment, nil
 }
@@ -4,8 +4,10 @@ import (
 	"context"
 
 	"github.com/cloudness-io/cloudness/app/auth"
+	"github.com/cloudness-io/cloudness/app/services/audit"
 	"github.com/cloudness-io/cloudness/helpers"
 	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
 )
 
 type UpdateDomainInput struct {
@@ -36,6 +38,7 @@ func (c *Controller) GenerateDomain(
 	environment *types.Environment,
 	application *types.Application,
 ) (*types.Application, error) {
+	oldDomain := application.Domain
 	fqdn, err := c.SuggestFQDN(ctx, application)
 	if err != nil {
 		return nil, err
@@ -56,7 +59,7 @@ func (c *Controller) GenerateDomain(
 		return nil, err
 	}
 
-	return c.updateWithTx(ctx, dto)
+	return c.updateDomainWithTx(ctx, dto, oldDomain)
 }
 
 func (c *Controller) DeleteDomain(
@@ -67,6 +70,7 @@ func (c *Controller) DeleteDomain(
 	environment *types.Environment,
 	application *types.Application,
 ) (*types.Application, error) {
+	oldDomain := application.Domain
 	application.Domain = ""
 
 	networkIn := application.Spec.ToNetorkInput()
@@ -80,7 +84,7 @@ func (c *Controller) DeleteDomain(
 		return nil, err
 	}
 
-	return c.updateWithTx(ctx, dto)
+	return c.updateDomainWithTx(ctx, dto, oldDomain)
 }
 
 func (c *Controller) UpdateDomain(
@@ -92,6 +96,7 @@ func (c *Controller) UpdateDomain(
 	application *types.Application,
 	in *UpdateDomainInput,
 ) (*types.Application, error) {
+	oldDomain := application.Domain
 	//TODO: validate input
 	fqdn := helpers.GenerateFQDN(in.Scheme, helpers.Normalize(in.Subdomain), in.Domain)
 	networkIn := application.Spec.ToNetorkInput()
@@ -109,5 +114,25 @@ func (c *Controller) UpdateDomain(
 		return nil, err
 	}
 
-	return c.updateWithTx(ctx, dto)
+	return c.updateDomainWithTx(ctx, dto, oldDomain)
+}
+
+func (c *Controller) updateDomainWithTx(ctx context.Context, dto *createOrUpdateDto, oldDomain string) (*types.Application, error) {
+	application, err := c.updateWithTx(ctx, dto)
+	if err != nil {
+		return nil, err
+	}
+
+	action := enum.AuditActionUpdated
+	switch {
+	case oldDomain == "":
+		action = enum.AuditActionCreated
+	case application.Domain == "":
+		action = enum.AuditActionDeleted
+	}
+	c.auditSvc.Log(ctx, auditDomainResource(application), action,
+		audit.WithOldObject(&auditDomain{Domain: oldDomain}),
+		audit.WithNewObject(&auditDomain{Domain: application.Domain}),
+	)
+	return application, nil
 }
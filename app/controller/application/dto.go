@@ -32,6 +32,7 @@ func (d *Controller) convertInputToDto(
 	application.ProjectID = project.ID
 	application.EnvironmentID = environment.ID
 	application.EnvironmentUID = environment.UID
+	application.ServerID = environment.ServerID
 
 	return &createOrUpdateDto{
 		Tenant:      tenant,
@@ -3,8 +3,10 @@ package application
 import (
 	"context"
 
+	"github.com/cloudness-io/cloudness/app/services/audit"
 	"github.com/cloudness-io/cloudness/types"
 	"github.com/cloudness-io/cloudness/types/check"
+	"github.com/cloudness-io/cloudness/types/enum"
 )
 
 type UpdateIconInput struct {
@@ -16,12 +18,20 @@ type UpdateNameInput struct {
 }
 
 func (c *Controller) UpdateIcon(ctx context.Context, application *types.Application, in *UpdateIconInput) (*types.Application, error) {
+	oldIcon := application.Spec.Icon
 	application.Spec.Icon = in.Icon
 	err := application.UpdateSpecJSON()
 	if err != nil {
 		return nil, err
 	}
-	return c.applicationStore.UpdateSpec(ctx, application)
+	application, err = c.applicationStore.UpdateSpec(ctx, application)
+	if err != nil {
+		return nil, err
+	}
+
+	c.auditSvc.Log(ctx, auditResource(application), enum.AuditActionUpdated,
+		audit.WithOldObject(&UpdateIconInput{Icon: oldIcon}), audit.WithNewObject(in))
+	return application, nil
 }
 
 func (c *Controller) UpdateName(ctx context.Context, application *types.Application, in *UpdateNameInput) (*types.Application, error) {
@@ -29,13 +39,21 @@ func (c *Controller) UpdateName(ctx context.Context, application *types.Applicat
 		return nil, err
 	}
 
+	oldName := application.Name
 	application.Name = in.Name
 	application.Spec.Name = in.Name
 	err := application.UpdateSpecJSON()
 	if err != nil {
 		return nil, err
 	}
-	return c.applicationStore.UpdateSpec(ctx, application)
+	application, err = c.applicationStore.UpdateSpec(ctx, application)
+	if err != nil {
+		return nil, err
+	}
+
+	c.auditSvc.Log(ctx, auditResource(application), enum.AuditActionUpdated,
+		audit.WithOldObject(&UpdateNameInput{Name: oldName}), audit.WithNewObject(in))
+	return application, nil
 }
 
 func (c *Controller) sanitizeNameUpdateInput(in *UpdateNameInput) error {
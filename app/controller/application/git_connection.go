@@ -0,0 +1,15 @@
+package application
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/types"
+)
+
+// FindGitConnection finds the git connection the application is deployed from, nil for other sources.
+func (c *Controller) FindGitConnection(ctx context.Context, app *types.Application) (*types.GitConnection, error) {
+	if app.GetGitConnectionID() == 0 {
+		return nil, nil
+	}
+	return c.gitConnStore.Find(ctx, app.TenantID, app.ProjectID, app.GetGitConnectionID())
+}
@@ -20,3 +20,25 @@ func (c *Controller) List(ctx context.Context, tenantID, projectID, environmentI
 
 	return apps, nil
 }
+
+// ListPaginated lists the applications of the environment along with the total count.
+func (c *Controller) ListPaginated(ctx context.Context, tenantID, projectID, environmentID int64, filter types.ListQueryFilter) ([]*types.Application, int64, error) {
+	opts := &types.ApplicationFilter{
+		ListQueryFilter: filter,
+		TenantID:        &tenantID,
+		ProjectID:       &projectID,
+		EnvironmentID:   &environmentID,
+	}
+
+	apps, err := c.applicationStore.List(ctx, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := c.applicationStore.Count(ctx, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return apps, count, nil
+}
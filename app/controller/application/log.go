@@ -7,7 +7,7 @@ import (
 )
 
 func (c *Controller) TailLog(ctx context.Context, app *types.Application) (<-chan *types.ArtifactLogLine, <-chan error, error) {
-	server, err := c.serverCtrl.Get(ctx)
+	server, err := c.serverCtrl.FindByID(ctx, app.ServerID)
 	if err != nil {
 		return nil, nil, err
 	}
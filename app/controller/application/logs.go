@@ -7,7 +7,7 @@ import (
 )
 
 func (c *Controller) GetLogs(ctx context.Context, app *types.Application) ([]*types.Artifact, error) {
-	server, err := c.serverCtrl.Get(ctx)
+	server, err := c.serverCtrl.FindByID(ctx, app.ServerID)
 	if err != nil {
 		return nil, err
 	}
@@ -40,7 +40,7 @@ func (c *Controller) validateFQDN(ctx context.Context, fqdn string) error {
 func (c *Controller) SuggestFQDN(ctx context.Context, app *types.Application) (string, error) {
 	subDomain := c.generateSubdomain(app)
 
-	server, err := c.serverCtrl.Get(ctx)
+	server, err := c.serverCtrl.FindByID(ctx, app.ServerID)
 	if err != nil {
 		return "", err
 	}
@@ -0,0 +1,112 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/cloudness-io/cloudness/helpers"
+	"github.com/cloudness-io/cloudness/types"
+)
+
+// PreviewInput describes the pull request a preview environment is built from.
+type PreviewInput struct {
+	Repo        string // base repository full name, e.g. owner/repo
+	BaseBranch  string
+	HeadRepoURL string
+	HeadBranch  string
+	HeadCommit  string
+}
+
+// CloneForPreviewWithoutTx clones every application of the source environment into the preview
+// environment. Applications tracking the pull request base branch are switched to the head branch,
+// public domains are regenerated under the server wildcard domain and volumes are sized from the
+// tenant restrictions.
+func (c *Controller) CloneForPreviewWithoutTx(
+	ctx context.Context,
+	actor string,
+	tenant *types.Tenant,
+	project *types.Project,
+	source *types.Environment,
+	preview *types.Environment,
+	in *PreviewInput,
+) ([]*types.Application, error) {
+	srcApps, err := c.List(ctx, tenant.ID, project.ID, source.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	server, err := c.serverCtrl.FindByID(ctx, preview.ServerID)
+	if err != nil {
+		return nil, err
+	}
+
+	restrictions := c.configSvc.GetTenantRestrictions(tenant)
+	appIDMap := make(map[int64]int64, len(srcApps))
+	apps := make([]*types.Application, 0, len(srcApps))
+
+	for _, src := range srcApps {
+		spec := new(types.ApplicationSpec)
+		if err := json.Unmarshal([]byte(src.SpecJSON), spec); err != nil {
+			return nil, err
+		}
+
+		if src.TracksBranch(in.Repo, in.BaseBranch, helpers.GitRepoFullName) {
+			spec.Build.Source.Git.RepoURL = in.HeadRepoURL
+			spec.Build.Source.Git.Branch = in.HeadBranch
+			spec.Build.Source.Git.Commit = in.HeadCommit
+		}
+		// tcp ports are allocated per server, previews are only reachable over http
+		if spec.Networking != nil {
+			spec.Networking.TCPProxies = nil
+		}
+		for _, v := range spec.Volumes {
+			v.VolumeSize = restrictions.MinVolumeSize
+		}
+
+		dto, err := c.convertSpecToDTO(ctx, spec, tenant, project, preview, actor)
+		if err != nil {
+			return nil, err
+		}
+		dto.Application.GithubAppID = src.GithubAppID
+		dto.Application.GitConnectionID = src.GitConnectionID
+
+		if spec.Networking != nil && spec.Networking.ServiceDomain != nil {
+			fqdn, err := c.SuggestFQDN(ctx, dto.Application)
+			if err != nil {
+				return nil, err
+			}
+			dto.Application.Domain = fqdn
+			dto.Application.Spec.Networking.ServiceDomain.Domain = fqdn
+		}
+
+		app, err := c.CreateWithoutTx(ctx, dto)
+		if err != nil {
+			return nil, err
+		}
+
+		volumes, err := c.volumeCtrl.ListForApp(ctx, src)
+		if err != nil {
+			return nil, err
+		}
+		for _, volume := range volumes {
+			_, err := c.volumeCtrl.Create(ctx, tenant, project, preview, app, &types.VolumeCreateInput{
+				Name:      volume.Name,
+				MountPath: volume.MountPath,
+				Size:      restrictions.MinVolumeSize,
+				Server:    server,
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		appIDMap[src.ID] = app.ID
+		apps = append(apps, app)
+	}
+
+	if err := c.varCtrl.CloneEnvironment(ctx, source.ID, preview.ID, appIDMap); err != nil {
+		return nil, err
+	}
+
+	return apps, nil
+}
@@ -0,0 +1,12 @@
+package application
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/types"
+)
+
+// ListRegistryCredentials lists the registry credentials a registry application of the tenant can pull with.
+func (c *Controller) ListRegistryCredentials(ctx context.Context, tenantID int64) ([]*types.RegistryCredential, error) {
+	return c.regCredStore.List(ctx, tenantID)
+}
@@ -19,7 +19,7 @@ func (c *Controller) CreateFromTemplate(
 	restrictions := c.configSvc.GetTenantRestrictions(tenant)
 	serviceAppMap := make(map[string]*types.Application)
 
-	server, err := c.serverCtrl.Get(ctx)
+	server, err := c.serverCtrl.FindByID(ctx, environment.ServerID)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,35 @@
+package application
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/types"
+)
+
+func (c *Controller) ListExecTargets(ctx context.Context, app *types.Application) ([]*types.ExecTarget, error) {
+	server, err := c.serverCtrl.FindByID(ctx, app.ServerID)
+	if err != nil {
+		return nil, err
+	}
+
+	mgr, err := c.manager.GetServerManager(server)
+	if err != nil {
+		return nil, err
+	}
+
+	return mgr.ListExecTargets(ctx, server, app)
+}
+
+func (c *Controller) Exec(ctx context.Context, app *types.Application, target *types.ExecTarget, streams *types.ExecStreams) error {
+	server, err := c.serverCtrl.FindByID(ctx, app.ServerID)
+	if err != nil {
+		return err
+	}
+
+	mgr, err := c.manager.GetServerManager(server)
+	if err != nil {
+		return err
+	}
+
+	return mgr.Exec(ctx, server, app, target, streams)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/cloudness-io/cloudness/app/controller/volume"
 	"github.com/cloudness-io/cloudness/app/pipeline/canceler"
 	"github.com/cloudness-io/cloudness/app/pipeline/triggerer"
+	"github.com/cloudness-io/cloudness/app/services/audit"
 	"github.com/cloudness-io/cloudness/app/services/config"
 	"github.com/cloudness-io/cloudness/app/services/manager"
 	"github.com/cloudness-io/cloudness/app/services/schema"
@@ -29,6 +30,8 @@ func ProvideController(
 	specSvc *spec.Service,
 	applicationStore store.ApplicationStore,
 	metricsStore store.MetricsStore,
+	registryCredentialStore store.RegistryCredentialStore,
+	gitConnectionStore store.GitConnectionStore,
 	serverCtrl *server.Controller,
 	varCtrl *variable.Controller,
 	gitPublicCtrl *gitpublic.Controller,
@@ -36,6 +39,7 @@ func ProvideController(
 	triggerer triggerer.Triggerer,
 	canceler canceler.Canceler,
 	manager manager.ManagerFactory,
+	auditSvc *audit.Service,
 ) *Controller {
 	return NewController(
 		tx,
@@ -44,6 +48,8 @@ func ProvideController(
 		specSvc,
 		applicationStore,
 		metricsStore,
+		registryCredentialStore,
+		gitConnectionStore,
 		serverCtrl,
 		varCtrl,
 		gitPublicCtrl,
@@ -51,5 +57,6 @@ func ProvideController(
 		triggerer,
 		canceler,
 		manager,
+		auditSvc,
 	)
 }
@@ -8,14 +8,25 @@ import (
 	"github.com/cloudness-io/cloudness/errors"
 	"github.com/cloudness-io/cloudness/types"
 	"github.com/cloudness-io/cloudness/types/enum"
+
+	"github.com/rs/zerolog/log"
 )
 
+// oauthIdentity is the user identity returned by an oauth provider.
+type oauthIdentity struct {
+	Email  string
+	Name   string
+	Groups []string
+}
+
 func (c *Controller) Callback(ctx context.Context, authSetting *types.AuthSetting, code string) (*types.TokenResponse, error) {
-	var userEmail string
+	var identity *oauthIdentity
 	var err error
 	switch authSetting.Provider {
 	case enum.AuthProviderGithub:
-		userEmail, err = c.githubCallback(ctx, authSetting, code)
+		identity, err = c.githubCallback(ctx, authSetting, code)
+	case enum.AuthProviderGitlab, enum.AuthProviderGoogle, enum.AuthProviderOIDC:
+		identity, err = c.oidcCallback(ctx, authSetting, code)
 	default:
 		return nil, errors.BadRequest("Auth provider not implemented")
 	}
@@ -24,13 +35,18 @@ func (c *Controller) Callback(ctx context.Context, authSetting *types.AuthSettin
 		return nil, err
 	}
 
-	if userEmail == "" {
+	if identity.Email == "" {
 		return nil, errors.BadRequest("User email not found")
 	}
 
+	displayName := identity.Name
+	if displayName == "" {
+		displayName = identity.Email
+	}
+
 	in := &user.CreateInput{
-		Email:       userEmail,
-		DisplayName: userEmail,
+		Email:       identity.Email,
+		DisplayName: displayName,
 	}
 
 	user, err := c.userCtrl.CreateNoAuth(ctx, in)
@@ -38,6 +54,11 @@ func (c *Controller) Callback(ctx context.Context, authSetting *types.AuthSettin
 		return nil, err
 	}
 
+	// failing to auto join must not block the login of the user
+	if err := c.autoJoinTenant(ctx, authSetting, user, identity); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Str("email", user.Email).Msg("failed to auto join user into tenant")
+	}
+
 	token, jwtToken, err := token.CreateUserSession(ctx, c.tokenStore, user)
 	if err != nil {
 		return nil, err
@@ -45,3 +66,30 @@ func (c *Controller) Callback(ctx context.Context, authSetting *types.AuthSettin
 
 	return &types.TokenResponse{Token: *token, AccessToken: jwtToken}, nil
 }
+
+// autoJoinTenant adds the user to the tenant configured on the provider when the email domain or
+// one of the groups matches.
+func (c *Controller) autoJoinTenant(ctx context.Context, authSetting *types.AuthSetting, user *types.User, identity *oauthIdentity) error {
+	if !authSetting.ShouldJoinTenant(identity.Email, identity.Groups) {
+		return nil
+	}
+
+	tenant, err := c.tenantCtrl.FindByUID(ctx, authSetting.JoinTenantUID)
+	if err != nil {
+		return err
+	}
+
+	membership, err := c.tenantCtrl.FindMembership(ctx, tenant.ID, user.ID)
+	if err != nil {
+		return err
+	}
+	if membership != nil {
+		return nil
+	}
+
+	role := authSetting.JoinRole
+	if role == "" {
+		role = enum.TenantRoleMember
+	}
+	return c.tenantCtrl.JoinTenant(ctx, tenant, user.ID, role)
+}
@@ -20,19 +20,19 @@ func (c *Controller) githubOAuth2Config(authSetting *types.AuthSetting) *oauth2.
 	}
 }
 
-func (c *Controller) githubCallback(ctx context.Context, authSetting *types.AuthSetting, code string) (string, error) {
+func (c *Controller) githubCallback(ctx context.Context, authSetting *types.AuthSetting, code string) (*oauthIdentity, error) {
 	config := c.githubOAuth2Config(authSetting)
 
 	token, err := config.Exchange(ctx, code, oauth2.AccessTypeOnline)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	client := config.Client(ctx, token)
 
 	resp, err := client.Get("https://api.github.com/user/emails")
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	defer resp.Body.Close()
 
@@ -43,19 +43,20 @@ func (c *Controller) githubCallback(ctx context.Context, authSetting *types.Auth
 	}
 
 	if err := json.NewDecoder(resp.Body).Decode(&emails); err != nil {
-		return "", err
+		return nil, err
 	}
 	var userEmail string
+	// accounts are matched by email, an unverified email could take over the account of its owner
 	for _, e := range emails {
-		if e.Primary {
+		if e.Primary && e.Verified {
 			userEmail = e.Email
 			break
 		}
 	}
 
 	if userEmail == "" {
-		return "", errors.BadRequest("No primary email id found")
+		return nil, errors.BadRequest("No verified primary email id found")
 	}
 
-	return userEmail, nil
+	return &oauthIdentity{Email: userEmail}, nil
 }
@@ -33,4 +33,8 @@ var defaultAuthSettings = []*types.AuthSetting{
 		Provider: enum.AuthProviderGitlab,
 		Enabled:  false,
 	},
+	{
+		Provider: enum.AuthProviderOIDC,
+		Enabled:  false,
+	},
 }
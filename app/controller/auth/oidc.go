@@ -0,0 +1,193 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cloudness-io/cloudness/errors"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	googleIssuer       = "https://accounts.google.com"
+	gitlabIssuer       = "https://gitlab.com"
+	oidcDiscoveryPath  = "/.well-known/openid-configuration"
+	oidcRequestTimeout = 10 * time.Second
+
+	defaultEmailClaim  = "email"
+	defaultNameClaim   = "name"
+	defaultGroupsClaim = "groups"
+)
+
+var defaultOIDCScopes = []string{"openid", "profile", "email"}
+
+// oidcDiscovery is the subset of the openid provider metadata used for the authorization code flow.
+type oidcDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint"`
+}
+
+// oidcIssuer returns the issuer of the provider, gitlab and google are served through their openid connect endpoints.
+func oidcIssuer(authSetting *types.AuthSetting) string {
+	switch authSetting.Provider {
+	case enum.AuthProviderGoogle:
+		return googleIssuer
+	case enum.AuthProviderGitlab:
+		if authSetting.BaseURL != "" {
+			return authSetting.BaseURL
+		}
+		return gitlabIssuer
+	default:
+		return authSetting.BaseURL
+	}
+}
+
+func (c *Controller) discoverOIDC(ctx context.Context, authSetting *types.AuthSetting) (*oidcDiscovery, error) {
+	issuer := strings.TrimSuffix(oidcIssuer(authSetting), "/")
+	if issuer == "" {
+		return nil, errors.BadRequest("Discovery url is not configured for %s", authSetting.Provider)
+	}
+
+	discoveryURL := issuer
+	if !strings.Contains(issuer, "/.well-known/") {
+		discoveryURL = issuer + oidcDiscoveryPath
+	}
+
+	dst := new(oidcDiscovery)
+	if err := getJSON(ctx, http.DefaultClient, discoveryURL, dst); err != nil {
+		return nil, fmt.Errorf("failed to fetch openid configuration: %w", err)
+	}
+	if dst.AuthorizationEndpoint == "" || dst.TokenEndpoint == "" || dst.UserinfoEndpoint == "" {
+		return nil, errors.BadRequest("Openid configuration of %s is incomplete", authSetting.Provider)
+	}
+	return dst, nil
+}
+
+func (c *Controller) oidcOAuth2Config(ctx context.Context, authSetting *types.AuthSetting) (*oauth2.Config, *oidcDiscovery, error) {
+	discovery, err := c.discoverOIDC(ctx, authSetting)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	redirectURL, err := c.callbackURL(ctx, authSetting.Provider)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scopes := authSetting.GetScopes()
+	if len(scopes) == 0 {
+		scopes = defaultOIDCScopes
+	}
+
+	return &oauth2.Config{
+		ClientID:     authSetting.ClientID,
+		ClientSecret: authSetting.ClientSecret,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  discovery.AuthorizationEndpoint,
+			TokenURL: discovery.TokenEndpoint,
+		},
+		RedirectURL: redirectURL,
+		Scopes:      scopes,
+	}, discovery, nil
+}
+
+// oidcCallback exchanges the code and maps the userinfo claims to the identity of the user.
+func (c *Controller) oidcCallback(ctx context.Context, authSetting *types.AuthSetting, code string) (*oauthIdentity, error) {
+	config, discovery, err := c.oidcOAuth2Config(ctx, authSetting)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := config.Exchange(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := map[string]any{}
+	if err := getJSON(ctx, config.Client(ctx, token), discovery.UserinfoEndpoint, &claims); err != nil {
+		return nil, fmt.Errorf("failed to fetch userinfo: %w", err)
+	}
+
+	// users are matched to existing accounts by email, an unverified or unknown email could take one over
+	if !emailVerified(claims) {
+		return nil, errors.BadRequest("Email is not verified with %s", authSetting.GetDisplayName())
+	}
+
+	return &oauthIdentity{
+		Email:  claimString(claims, claimOrDefault(authSetting.EmailClaim, defaultEmailClaim)),
+		Name:   claimString(claims, claimOrDefault(authSetting.NameClaim, defaultNameClaim)),
+		Groups: claimStrings(claims, claimOrDefault(authSetting.GroupsClaim, defaultGroupsClaim)),
+	}, nil
+}
+
+// emailVerified returns true if the provider asserts the email is verified, some providers send the claim as a string.
+func emailVerified(claims map[string]any) bool {
+	switch v := claims["email_verified"].(type) {
+	case bool:
+		return v
+	case string:
+		return v == "true"
+	default:
+		return false
+	}
+}
+
+func claimOrDefault(claim, def string) string {
+	if claim == "" {
+		return def
+	}
+	return claim
+}
+
+func claimString(claims map[string]any, claim string) string {
+	s, _ := claims[claim].(string)
+	return s
+}
+
+func claimStrings(claims map[string]any, claim string) []string {
+	switch v := claims[claim].(type) {
+	case string:
+		return []string{v}
+	case []any:
+		dst := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				dst = append(dst, s)
+			}
+		}
+		return dst
+	default:
+		return nil
+	}
+}
+
+func getJSON(ctx context.Context, client *http.Client, url string, dst any) error {
+	ctx, cancel := context.WithTimeout(ctx, oidcRequestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d from %s", resp.StatusCode, url)
+	}
+	return json.NewDecoder(resp.Body).Decode(dst)
+}
@@ -3,19 +3,34 @@ package auth
 import (
 	"context"
 
+	"github.com/cloudness-io/cloudness/app/utils/routes"
 	"github.com/cloudness-io/cloudness/errors"
 	"github.com/cloudness-io/cloudness/types"
 	"github.com/cloudness-io/cloudness/types/enum"
 
-	"github.com/dchest/uniuri"
 	"golang.org/x/oauth2"
 )
 
-func (c *Controller) GetRedirectUrl(ctx context.Context, authSetting *types.AuthSetting) (string, error) {
+// GetRedirectUrl returns the authorization url of the provider, state is verified again on callback.
+func (c *Controller) GetRedirectUrl(ctx context.Context, authSetting *types.AuthSetting, state string) (string, error) {
 	switch authSetting.Provider {
 	case enum.AuthProviderGithub:
 		config := c.githubOAuth2Config(authSetting)
-		return config.AuthCodeURL(uniuri.NewLen(16), oauth2.AccessTypeOnline), nil
+		return config.AuthCodeURL(state, oauth2.AccessTypeOnline), nil
+	case enum.AuthProviderGitlab, enum.AuthProviderGoogle, enum.AuthProviderOIDC:
+		config, _, err := c.oidcOAuth2Config(ctx, authSetting)
+		if err != nil {
+			return "", err
+		}
+		return config.AuthCodeURL(state), nil
 	}
 	return "", errors.BadRequest("Auth provider %s is not supported", authSetting.Provider)
 }
+
+func (c *Controller) callbackURL(ctx context.Context, provider enum.AuthProvider) (string, error) {
+	instance, err := c.instanceCtrl.Get(ctx)
+	if err != nil {
+		return "", err
+	}
+	return instance.GetHttpDomain() + routes.GetOAuthCallbackUrl(provider), nil
+}
@@ -2,8 +2,11 @@ package auth
 
 import (
 	"context"
+	"net/url"
+	"strings"
 
 	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/check"
 	"github.com/cloudness-io/cloudness/types/enum"
 
 	"github.com/rs/zerolog/log"
@@ -36,7 +39,43 @@ func (c *Controller) UpdateDemoUserAuth(ctx context.Context, in *DemoUserSetting
 
 func (c *Controller) UpdateOauthSettings(ctx context.Context, auth *types.AuthSetting, provider enum.AuthProvider) error {
 	auth.Provider = provider
+	if err := c.sanitizeOauthSettings(auth); err != nil {
+		return err
+	}
 	log.Ctx(ctx).Debug().Any("auth", auth).Msg("Auth settings")
 	_, err := c.authSettingStore.Update(ctx, auth)
 	return err
 }
+
+func (c *Controller) sanitizeOauthSettings(auth *types.AuthSetting) error {
+	errors := check.NewValidationErrors()
+	auth.ClientID = strings.TrimSpace(auth.ClientID)
+	auth.BaseURL = strings.TrimSuffix(strings.TrimSpace(auth.BaseURL), "/")
+
+	if auth.Enabled && auth.ClientID == "" {
+		errors.AddValidationError("client_id", check.NewValidationError("Client ID is required"))
+	}
+
+	if auth.Provider == enum.AuthProviderOIDC && auth.Enabled && auth.BaseURL == "" {
+		errors.AddValidationError("base_url", check.NewValidationError("Discovery url is required"))
+	}
+	if auth.BaseURL != "" {
+		if u, err := url.Parse(auth.BaseURL); err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			errors.AddValidationError("base_url", check.NewValidationError("Url must be a valid http(s) address"))
+		}
+	}
+
+	if auth.JoinTenantUID != 0 {
+		if auth.JoinRole == "" {
+			auth.JoinRole = enum.TenantRoleMember
+		}
+		if enum.TenantRoleFromString(string(auth.JoinRole)) == "" {
+			errors.AddValidationError("join_role", check.NewValidationError("Invalid role"))
+		}
+	}
+
+	if errors.HasError() {
+		return errors
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+package backup
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+func auditResource(backup *types.Backup) audit.Resource {
+	name := time.UnixMilli(backup.Created).UTC().Format("2006-01-02 15:04")
+	return audit.NewResource(enum.AuditResourceBackup, strconv.FormatInt(backup.UID, 10), name)
+}
+
+func auditPolicyResource(app *types.Application) audit.Resource {
+	return audit.NewResource(enum.AuditResourceBackupPolicy, strconv.FormatInt(app.UID, 10), app.Name)
+}
@@ -0,0 +1,48 @@
+package backup
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	"github.com/cloudness-io/cloudness/errors"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+// List lists the backups of the application, newest first.
+func (c *Controller) List(ctx context.Context, app *types.Application) ([]*types.Backup, error) {
+	return c.backupStore.List(ctx, app.ID)
+}
+
+// Get finds the backup of the application by uid.
+func (c *Controller) Get(ctx context.Context, app *types.Application, backupUID int64) (*types.Backup, error) {
+	return c.findByUID(ctx, app.ID, backupUID)
+}
+
+// Start starts a manual backup of the application.
+func (c *Controller) Start(ctx context.Context, actor string, app *types.Application) (*types.Backup, error) {
+	backup, err := c.backupSvc.Start(ctx, app, enum.BackupTriggerManual, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	c.auditSvc.Log(ctx, auditResource(backup), enum.AuditActionCreated, audit.WithNewObject(backup))
+	return backup, nil
+}
+
+// Delete deletes a finished backup that is not being restored.
+func (c *Controller) Delete(ctx context.Context, backup *types.Backup) error {
+	if !backup.Status.IsDone() {
+		return errors.PreconditionFailed("The backup is still running")
+	}
+	if backup.IsRestoring() {
+		return errors.PreconditionFailed("The backup is being restored")
+	}
+
+	if err := c.backupSvc.Delete(ctx, backup); err != nil {
+		return err
+	}
+
+	c.auditSvc.Log(ctx, auditResource(backup), enum.AuditActionDeleted, audit.WithOldObject(backup))
+	return nil
+}
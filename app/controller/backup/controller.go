@@ -0,0 +1,48 @@
+package backup
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/app/controller/application"
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	backupSvc "github.com/cloudness-io/cloudness/app/services/backup"
+	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/blob"
+	"github.com/cloudness-io/cloudness/types"
+)
+
+type Controller struct {
+	backupStore store.BackupStore
+	policyStore store.BackupPolicyStore
+	backupSvc   *backupSvc.Service
+	appCtrl     *application.Controller
+	auditSvc    *audit.Service
+	blobConfig  blob.Config
+}
+
+func NewController(
+	backupStore store.BackupStore,
+	policyStore store.BackupPolicyStore,
+	backupSvc *backupSvc.Service,
+	appCtrl *application.Controller,
+	auditSvc *audit.Service,
+	blobConfig blob.Config,
+) *Controller {
+	return &Controller{
+		backupStore: backupStore,
+		policyStore: policyStore,
+		backupSvc:   backupSvc,
+		appCtrl:     appCtrl,
+		auditSvc:    auditSvc,
+		blobConfig:  blobConfig,
+	}
+}
+
+// IsStoreEphemeral returns true if backups are kept in a blob store that does not survive a restart.
+func (c *Controller) IsStoreEphemeral() bool {
+	return c.blobConfig.IsEphemeral()
+}
+
+func (c *Controller) findByUID(ctx context.Context, applicationID, backupUID int64) (*types.Backup, error) {
+	return c.backupStore.FindByUID(ctx, applicationID, backupUID)
+}
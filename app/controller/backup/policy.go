@@ -0,0 +1,62 @@
+package backup
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	backupSvc "github.com/cloudness-io/cloudness/app/services/backup"
+	baseStore "github.com/cloudness-io/cloudness/store"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+const (
+	defaultSchedule  = "0 3 * * *" // every day at 03:00
+	defaultRetention = 7
+)
+
+// GetPolicy returns the backup policy of the application, a disabled default when none was saved.
+func (c *Controller) GetPolicy(ctx context.Context, app *types.Application) (*types.BackupPolicy, error) {
+	policy, err := c.policyStore.Find(ctx, app.ID)
+	if baseStore.IsNotFound(err) {
+		return &types.BackupPolicy{
+			ApplicationID: app.ID,
+			Schedule:      defaultSchedule,
+			Retention:     defaultRetention,
+		}, nil
+	}
+	return policy, err
+}
+
+// UpdatePolicy saves the backup policy of the application.
+func (c *Controller) UpdatePolicy(ctx context.Context, app *types.Application, in *types.BackupPolicyInput) (*types.BackupPolicy, error) {
+	if err := backupSvc.ValidatePolicy(in); err != nil {
+		return nil, err
+	}
+
+	old, err := c.GetPolicy(ctx, app)
+	if err != nil {
+		return nil, err
+	}
+
+	nextRun, err := backupSvc.NextRun(in.Schedule, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	policy := *old
+	policy.Enabled = in.Enabled
+	policy.Schedule = in.Schedule
+	policy.Retention = in.Retention
+	policy.NextRun = nextRun
+
+	updated, err := c.policyStore.Upsert(ctx, &policy)
+	if err != nil {
+		return nil, err
+	}
+
+	c.auditSvc.Log(ctx, auditPolicyResource(app), enum.AuditActionUpdated,
+		audit.WithOldObject(old.ToInput()), audit.WithNewObject(updated.ToInput()))
+	return updated, nil
+}
@@ -0,0 +1,54 @@
+package backup
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	"github.com/cloudness-io/cloudness/errors"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+// Restore restores the backup into the application it was taken from, or into a copy of the
+// application that is created and deployed first. It returns the application restored into.
+func (c *Controller) Restore(
+	ctx context.Context,
+	actor string,
+	tenant *types.Tenant,
+	project *types.Project,
+	environment *types.Environment,
+	app *types.Application,
+	backup *types.Backup,
+	in *types.BackupRestoreInput,
+) (*types.Application, error) {
+	if backup.Status != enum.BackupStatusSucceeded {
+		return nil, errors.PreconditionFailed("Only successful backups can be restored")
+	}
+	if backup.IsRestoring() {
+		return nil, errors.PreconditionFailed("The backup is already being restored")
+	}
+
+	target := app
+	switch in.Target {
+	case enum.BackupRestoreTargetSame:
+	case enum.BackupRestoreTargetNew:
+		clone, err := c.appCtrl.Clone(ctx, actor, tenant, project, environment, app, app.Name+" restore")
+		if err != nil {
+			return nil, err
+		}
+		if _, err := c.appCtrl.Deploy(ctx, actor, enum.TriggerActionCreate, clone); err != nil {
+			return nil, err
+		}
+		target = clone
+	default:
+		return nil, errors.InvalidArgument("Invalid restore target %q", in.Target)
+	}
+
+	if err := c.backupSvc.Restore(ctx, backup, target); err != nil {
+		return nil, err
+	}
+
+	c.auditSvc.Log(ctx, auditResource(backup), enum.AuditActionRestored,
+		audit.WithData("target", target.Name))
+	return target, nil
+}
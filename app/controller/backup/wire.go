@@ -0,0 +1,26 @@
+package backup
+
+import (
+	"github.com/cloudness-io/cloudness/app/controller/application"
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	backupSvc "github.com/cloudness-io/cloudness/app/services/backup"
+	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/blob"
+
+	"github.com/google/wire"
+)
+
+var WireSet = wire.NewSet(
+	ProvideController,
+)
+
+func ProvideController(
+	backupStore store.BackupStore,
+	policyStore store.BackupPolicyStore,
+	backupSvc *backupSvc.Service,
+	appCtrl *application.Controller,
+	auditSvc *audit.Service,
+	blobConfig blob.Config,
+) *Controller {
+	return NewController(backupStore, policyStore, backupSvc, appCtrl, auditSvc, blobConfig)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/cloudness-io/cloudness/app/pipeline/triggerer"
 	"github.com/cloudness-io/cloudness/app/store"
 	dbStore "github.com/cloudness-io/cloudness/store"
 	"github.com/cloudness-io/cloudness/types"
@@ -11,11 +12,13 @@ import (
 
 type Controller struct {
 	deploymentStore store.DeploymentStore
+	triggerer       triggerer.Triggerer
 }
 
-func NewController(deploymentStore store.DeploymentStore) *Controller {
+func NewController(deploymentStore store.DeploymentStore, triggerer triggerer.Triggerer) *Controller {
 	return &Controller{
 		deploymentStore: deploymentStore,
+		triggerer:       triggerer,
 	}
 }
 
@@ -6,6 +6,16 @@ import (
 	"github.com/cloudness-io/cloudness/types"
 )
 
-func (c *Controller) List(ctx context.Context, applicationID int64) ([]*types.Deployment, error) {
-	return c.deploymentStore.List(ctx, applicationID)
+func (c *Controller) List(ctx context.Context, applicationID int64, filter *types.ListQueryFilter) ([]*types.Deployment, int64, error) {
+	deployments, err := c.deploymentStore.List(ctx, applicationID, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := c.deploymentStore.Count(ctx, applicationID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return deployments, count, nil
 }
@@ -0,0 +1,34 @@
+package deployment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudness-io/cloudness/app/pipeline/triggerer"
+	"github.com/cloudness-io/cloudness/errors"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+// Rollback redeploys the spec and image of a previous successful deployment without rebuilding.
+func (c *Controller) Rollback(
+	ctx context.Context,
+	actor string,
+	application *types.Application,
+	source *types.Deployment,
+) (*types.Deployment, error) {
+	if source.ApplicationID != application.ID {
+		return nil, errors.NotFound("Deployment not found")
+	}
+	if source.Status != enum.DeploymentStatusSuccess {
+		return nil, errors.PreconditionFailed("Only successful deployments can be rolled back to")
+	}
+
+	return c.triggerer.Trigger(ctx, &triggerer.TriggerHook{
+		ApplicaitonID: application.ID,
+		Triggerer:     actor,
+		Title:         fmt.Sprintf("Rollback to #%d: %s", source.UID, source.GetInfo().Title),
+		Action:        enum.TriggerActionRollback,
+		Source:        source,
+	})
+}
@@ -1,6 +1,7 @@
 package deployment
 
 import (
+	"github.com/cloudness-io/cloudness/app/pipeline/triggerer"
 	"github.com/cloudness-io/cloudness/app/store"
 
 	"github.com/google/wire"
@@ -11,6 +12,6 @@ var WireSet = wire.NewSet(
 	ProvideController,
 )
 
-func ProvideController(deploymentStore store.DeploymentStore) *Controller {
-	return NewController(deploymentStore)
+func ProvideController(deploymentStore store.DeploymentStore, triggerer triggerer.Triggerer) *Controller {
+	return NewController(deploymentStore, triggerer)
 }
@@ -0,0 +1,13 @@
+package environment
+
+import (
+	"strconv"
+
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+func auditResource(env *types.Environment) audit.Resource {
+	return audit.NewResource(enum.AuditResourceEnvironment, strconv.FormatInt(env.UID, 10), env.Name)
+}
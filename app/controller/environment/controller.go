@@ -2,10 +2,15 @@ package environment
 
 import (
 	"context"
+	"errors"
 
 	"github.com/cloudness-io/cloudness/app/controller/application"
+	"github.com/cloudness-io/cloudness/app/controller/server"
 	"github.com/cloudness-io/cloudness/app/controller/volume"
+	"github.com/cloudness-io/cloudness/app/services/audit"
 	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/app/usererror"
+	dbStore "github.com/cloudness-io/cloudness/store"
 	"github.com/cloudness-io/cloudness/store/database/dbtx"
 	"github.com/cloudness-io/cloudness/types"
 	"github.com/cloudness-io/cloudness/types/check"
@@ -15,20 +20,26 @@ type Controller struct {
 	tx               dbtx.Transactor
 	appCtrl          *application.Controller
 	volumeCtrl       *volume.Controller
+	serverCtrl       *server.Controller
 	environmentStore store.EnvironmentStore
+	auditSvc         *audit.Service
 }
 
 func NewController(
 	tx dbtx.Transactor,
 	appCtrl *application.Controller,
 	volumeCtrl *volume.Controller,
+	serverCtrl *server.Controller,
 	environmentStore store.EnvironmentStore,
+	auditSvc *audit.Service,
 ) *Controller {
 	return &Controller{
 		tx:               tx,
 		appCtrl:          appCtrl,
 		volumeCtrl:       volumeCtrl,
+		serverCtrl:       serverCtrl,
 		environmentStore: environmentStore,
+		auditSvc:         auditSvc,
 	}
 }
 
@@ -40,6 +51,23 @@ func (c *Controller) findEnvironmentByID(ctx context.Context, environmentID int6
 	return c.environmentStore.Find(ctx, environmentID)
 }
 
+// ListServers lists the servers an environment can be created on.
+func (c *Controller) ListServers(ctx context.Context) ([]*types.Server, error) {
+	return c.serverCtrl.List(ctx)
+}
+
+// resolveServer returns the server of the uid, or the primary server when the uid is zero.
+func (c *Controller) resolveServer(ctx context.Context, serverUID int64) (*types.Server, error) {
+	if serverUID == 0 {
+		return c.serverCtrl.Get(ctx)
+	}
+	server, err := c.serverCtrl.FindByUID(ctx, serverUID)
+	if errors.Is(err, dbStore.ErrResourceNotFound) {
+		return nil, usererror.BadRequest("Server not found")
+	}
+	return server, err
+}
+
 func (c *Controller) sanitizeCreateInput(in *CreateEnvironmentInput) error {
 	errors := check.NewValidationErrors()
 	if err := check.DisplayName(in.Name); err != nil {
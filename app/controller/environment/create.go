@@ -6,14 +6,19 @@ import (
 	"time"
 
 	"github.com/cloudness-io/cloudness/app/auth"
+	"github.com/cloudness-io/cloudness/app/services/audit"
 	"github.com/cloudness-io/cloudness/helpers"
 	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
 
 	"github.com/rs/zerolog/log"
 )
 
+// CreateEnvironmentInput is the input used to create or rename an environment.
+// ServerUID selects the server the environment runs on, the primary server is used when it is zero.
 type CreateEnvironmentInput struct {
-	Name string `json:"name"`
+	Name      string `json:"name"`
+	ServerUID int64  `json:"server_uid,string,omitempty"`
 }
 
 func (c *Controller) Create(ctx context.Context, session *auth.Session, tenant *types.Tenant, project *types.Project, in *CreateEnvironmentInput) (*types.Environment, error) {
@@ -23,6 +28,11 @@ func (c *Controller) Create(ctx context.Context, session *auth.Session, tenant *
 		return nil, err
 	}
 
+	server, err := c.resolveServer(ctx, in.ServerUID)
+	if err != nil {
+		return nil, err
+	}
+
 	envs, err := c.List(ctx, tenant.ID, project.ID)
 	if err != nil {
 		return nil, err
@@ -33,6 +43,7 @@ func (c *Controller) Create(ctx context.Context, session *auth.Session, tenant *
 		UID:       helpers.GenerateUID(),
 		TenantID:  tenant.ID,
 		ProjectID: project.ID,
+		ServerID:  server.ID,
 		Seq:       int64(len(envs) + 1),
 		Name:      in.Name,
 		Slug:      helpers.Slugify("e-"+strings.TrimPrefix(project.Slug, "p-"), in.Name),
@@ -41,5 +52,12 @@ func (c *Controller) Create(ctx context.Context, session *auth.Session, tenant *
 		Updated:   now,
 	}
 
-	return c.environmentStore.Create(ctx, env)
+	env, err = c.environmentStore.Create(ctx, env)
+	if err != nil {
+		return nil, err
+	}
+
+	c.auditSvc.Log(ctx, auditResource(env), enum.AuditActionCreated,
+		audit.WithTenantID(tenant.ID), audit.WithProjectID(project.ID), audit.WithNewObject(env))
+	return env, nil
 }
@@ -4,7 +4,9 @@ import (
 	"context"
 	"time"
 
+	"github.com/cloudness-io/cloudness/app/services/audit"
 	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
 )
 
 func (c *Controller) SoftDelete(ctx context.Context, environment *types.Environment) error {
@@ -22,6 +24,8 @@ func (c *Controller) SoftDelete(ctx context.Context, environment *types.Environm
 		if err := c.volumeCtrl.SoftDeleteInEnvironment(ctx, environment.ID, now); err != nil {
 			return err
 		}
+
+		c.auditSvc.Log(ctx, auditResource(environment), enum.AuditActionDeleted, audit.WithOldObject(environment))
 		return nil
 	})
 }
@@ -16,3 +16,14 @@ func (c *Controller) List(ctx context.Context, tenantID, projectID int64) ([]*ty
 	}
 	return c.environmentStore.List(ctx, filter)
 }
+
+// ListPaginated lists the environments of the project for the given page.
+func (c *Controller) ListPaginated(ctx context.Context, tenantID, projectID int64, filter types.ListQueryFilter) ([]*types.Environment, error) {
+	return c.environmentStore.List(ctx, &types.EnvironmentFilter{
+		ListQueryFilter: filter,
+		TenantID:        &tenantID,
+		ProjectID:       &projectID,
+		Sort:            enum.EnvironmentAttrSequence,
+		Order:           enum.OrderDesc,
+	})
+}
@@ -0,0 +1,76 @@
+package environment
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cloudness-io/cloudness/app/controller/application"
+	"github.com/cloudness-io/cloudness/helpers"
+	"github.com/cloudness-io/cloudness/types"
+)
+
+// CreatePreview clones the source environment into an ephemeral environment for the pull request.
+func (c *Controller) CreatePreview(
+	ctx context.Context,
+	actor string,
+	tenant *types.Tenant,
+	project *types.Project,
+	source *types.Environment,
+	number int64,
+	in *application.PreviewInput,
+) (*types.Environment, []*types.Application, error) {
+	envs, err := c.List(ctx, tenant.ID, project.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	now := time.Now().UTC().UnixMilli()
+	name := fmt.Sprintf("%s pr-%d", source.Name, number)
+	preview := &types.Environment{
+		UID:             helpers.GenerateUID(),
+		TenantID:        tenant.ID,
+		ProjectID:       project.ID,
+		ServerID:        source.ServerID,
+		Seq:             int64(len(envs) + 1),
+		Name:            name,
+		Slug:            helpers.Slugify("e-"+strings.TrimPrefix(project.Slug, "p-"), name),
+		CreateBy:        source.CreateBy,
+		PreviewSourceID: &source.ID,
+		PreviewRepo:     in.Repo,
+		PreviewNumber:   number,
+		Created:         now,
+		Updated:         now,
+	}
+
+	var apps []*types.Application
+	err = c.tx.WithTx(ctx, func(ctx context.Context) error {
+		preview, err = c.environmentStore.Create(ctx, preview)
+		if err != nil {
+			return err
+		}
+
+		apps, err = c.appCtrl.CloneForPreviewWithoutTx(ctx, actor, tenant, project, source, preview, in)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return preview, apps, nil
+}
+
+// ListPreviews lists the preview environments created for the pull request.
+func (c *Controller) ListPreviews(ctx context.Context, tenantID int64, repo string, number int64) ([]*types.Environment, error) {
+	return c.environmentStore.List(ctx, &types.EnvironmentFilter{
+		TenantID:      &tenantID,
+		PreviewRepo:   &repo,
+		PreviewNumber: &number,
+	})
+}
+
+// FindByID finds the environment by id.
+func (c *Controller) FindByID(ctx context.Context, environmentID int64) (*types.Environment, error) {
+	return c.findEnvironmentByID(ctx, environmentID)
+}
@@ -3,7 +3,9 @@ package environment
 import (
 	"context"
 
+	"github.com/cloudness-io/cloudness/app/services/audit"
 	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
 )
 
 func (c *Controller) Update(ctx context.Context, environmentID int64, in *CreateEnvironmentInput) (*types.Environment, error) {
@@ -16,7 +18,14 @@ func (c *Controller) Update(ctx context.Context, environmentID int64, in *Create
 		return nil, err
 	}
 
+	old := *env
 	env.Name = in.Name
 
-	return c.environmentStore.Update(ctx, env)
+	env, err = c.environmentStore.Update(ctx, env)
+	if err != nil {
+		return nil, err
+	}
+
+	c.auditSvc.Log(ctx, auditResource(env), enum.AuditActionUpdated, audit.WithOldObject(&old), audit.WithNewObject(env))
+	return env, nil
 }
@@ -2,7 +2,9 @@ package environment
 
 import (
 	"github.com/cloudness-io/cloudness/app/controller/application"
+	"github.com/cloudness-io/cloudness/app/controller/server"
 	"github.com/cloudness-io/cloudness/app/controller/volume"
+	"github.com/cloudness-io/cloudness/app/services/audit"
 	"github.com/cloudness-io/cloudness/app/store"
 	"github.com/cloudness-io/cloudness/store/database/dbtx"
 
@@ -18,12 +20,16 @@ func ProvideController(
 	tx dbtx.Transactor,
 	appCtrl *application.Controller,
 	volumeCtrl *volume.Controller,
+	serverCtrl *server.Controller,
 	environmentStore store.EnvironmentStore,
+	auditSvc *audit.Service,
 ) *Controller {
 	return NewController(
 		tx,
 		appCtrl,
 		volumeCtrl,
+		serverCtrl,
 		environmentStore,
+		auditSvc,
 	)
 }
@@ -0,0 +1,159 @@
+package export
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/cloudness-io/cloudness/types/enum"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	namespacesFile = "namespaces.yaml"
+	chartVersion   = "0.1.0"
+)
+
+// Bundle is the exported set of files, written as a gzipped tarball with the files under a directory
+// named after the bundle.
+type Bundle struct {
+	Name  string
+	files []*bundleFile
+}
+
+type bundleFile struct {
+	path    string
+	content []byte
+}
+
+func newBundle(name, description string, apps []*renderedApp, format enum.ExportFormat) (*Bundle, error) {
+	b := &Bundle{Name: name}
+
+	dir := ""
+	if format == enum.ExportFormatHelm {
+		dir = "templates"
+	}
+
+	namespaces := []string{}
+	seen := map[string]bool{}
+	resources := []string{}
+	values := map[string]any{}
+	for _, app := range apps {
+		if app.namespaceDoc != "" && !seen[app.namespace] {
+			seen[app.namespace] = true
+			namespaces = append(namespaces, app.namespaceDoc)
+		}
+
+		file := path.Join(app.namespace, app.identifier+".yaml")
+		resources = append(resources, file)
+		b.add(path.Join(dir, file), []byte(joinDocuments(app.docs)))
+
+		if app.values != nil {
+			nsValues, ok := values[app.namespace].(map[string]any)
+			if !ok {
+				nsValues = map[string]any{}
+				values[app.namespace] = nsValues
+			}
+			nsValues[app.identifier] = app.values
+		}
+	}
+	if len(namespaces) > 0 {
+		resources = append([]string{namespacesFile}, resources...)
+		b.files = append([]*bundleFile{{
+			path:    path.Join(dir, namespacesFile),
+			content: []byte(joinDocuments(namespaces)),
+		}}, b.files...)
+	}
+
+	switch format {
+	case enum.ExportFormatKustomize:
+		kustomization, err := marshalYaml(map[string]any{
+			"apiVersion": "kustomize.config.k8s.io/v1beta1",
+			"kind":       "Kustomization",
+			"resources":  resources,
+		})
+		if err != nil {
+			return nil, err
+		}
+		b.add("kustomization.yaml", kustomization)
+	case enum.ExportFormatHelm:
+		chart, err := marshalYaml(map[string]any{
+			"apiVersion":  "v2",
+			"name":        name,
+			"description": fmt.Sprintf("Applications of the %s, exported from Cloudness", description),
+			"type":        "application",
+			"version":     chartVersion,
+		})
+		if err != nil {
+			return nil, err
+		}
+		valuesYaml, err := marshalYaml(values)
+		if err != nil {
+			return nil, err
+		}
+		b.add("Chart.yaml", chart)
+		b.add("values.yaml", valuesYaml)
+	}
+
+	return b, nil
+}
+
+func (b *Bundle) add(filePath string, content []byte) {
+	b.files = append(b.files, &bundleFile{path: filePath, content: content})
+}
+
+// FileName returns the name of the archive.
+func (b *Bundle) FileName() string {
+	return b.Name + ".tar.gz"
+}
+
+// WriteArchive writes the files of the bundle as a gzipped tarball.
+func (b *Bundle) WriteArchive(w io.Writer) error {
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+
+	now := time.Now().UTC()
+	for _, f := range b.files {
+		err := tw.WriteHeader(&tar.Header{
+			Name:    path.Join(b.Name, f.path),
+			Mode:    0o644,
+			Size:    int64(len(f.content)),
+			ModTime: now,
+		})
+		if err != nil {
+			return err
+		}
+		if _, err := tw.Write(f.content); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gw.Close()
+}
+
+func joinDocuments(docs []string) string {
+	return strings.Join(docs, "---\n")
+}
+
+// marshalYaml marshals with the two space indentation of the manifests.
+func marshalYaml(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(v); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
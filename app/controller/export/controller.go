@@ -0,0 +1,39 @@
+package export
+
+import (
+	"github.com/cloudness-io/cloudness/app/controller/registrycredential"
+	"github.com/cloudness-io/cloudness/app/controller/variable"
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	"github.com/cloudness-io/cloudness/app/services/config"
+	"github.com/cloudness-io/cloudness/app/store"
+)
+
+type Controller struct {
+	applicationStore store.ApplicationStore
+	deploymentStore  store.DeploymentStore
+	volumeStore      store.VolumeStore
+	varCtrl          *variable.Controller
+	regCredCtrl      *registrycredential.Controller
+	configSvc        *config.Service
+	auditSvc         *audit.Service
+}
+
+func NewController(
+	applicationStore store.ApplicationStore,
+	deploymentStore store.DeploymentStore,
+	volumeStore store.VolumeStore,
+	varCtrl *variable.Controller,
+	regCredCtrl *registrycredential.Controller,
+	configSvc *config.Service,
+	auditSvc *audit.Service,
+) *Controller {
+	return &Controller{
+		applicationStore: applicationStore,
+		deploymentStore:  deploymentStore,
+		volumeStore:      volumeStore,
+		varCtrl:          varCtrl,
+		regCredCtrl:      regCredCtrl,
+		configSvc:        configSvc,
+		auditSvc:         auditSvc,
+	}
+}
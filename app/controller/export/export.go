@@ -0,0 +1,130 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	"github.com/cloudness-io/cloudness/errors"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+const listPageSize = 100
+
+// ExportProject renders the applications of every environment of the project into a bundle.
+func (c *Controller) ExportProject(ctx context.Context, project *types.Project, in *types.ExportInput) (*Bundle, error) {
+	apps, err := c.listApplications(ctx, &types.ApplicationFilter{
+		TenantID:  &project.TenantID,
+		ProjectID: &project.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	bundle, err := c.export(ctx, project.Slug, fmt.Sprintf("project %s", project.Name), apps, in)
+	if err != nil {
+		return nil, err
+	}
+
+	c.auditSvc.Log(ctx,
+		audit.NewResource(enum.AuditResourceProject, strconv.FormatInt(project.UID, 10), project.Name),
+		enum.AuditActionExported,
+		audit.WithTenantID(project.TenantID),
+		audit.WithProjectID(project.ID),
+		audit.WithData("format", in.Format),
+		audit.WithData("redact", in.Redact),
+	)
+	return bundle, nil
+}
+
+// ExportEnvironment renders the applications of the environment into a bundle.
+func (c *Controller) ExportEnvironment(
+	ctx context.Context,
+	project *types.Project,
+	env *types.Environment,
+	in *types.ExportInput,
+) (*Bundle, error) {
+	apps, err := c.listApplications(ctx, &types.ApplicationFilter{
+		TenantID:      &env.TenantID,
+		ProjectID:     &env.ProjectID,
+		EnvironmentID: &env.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	name := fmt.Sprintf("%s-%s", project.Slug, env.Slug)
+	bundle, err := c.export(ctx, name, fmt.Sprintf("environment %s of project %s", env.Name, project.Name), apps, in)
+	if err != nil {
+		return nil, err
+	}
+
+	c.auditSvc.Log(ctx,
+		audit.NewResource(enum.AuditResourceEnvironment, strconv.FormatInt(env.UID, 10), env.Name),
+		enum.AuditActionExported,
+		audit.WithTenantID(env.TenantID),
+		audit.WithProjectID(env.ProjectID),
+		audit.WithEnvironmentID(env.ID),
+		audit.WithData("format", in.Format),
+		audit.WithData("redact", in.Redact),
+	)
+	return bundle, nil
+}
+
+func (c *Controller) export(
+	ctx context.Context,
+	name string,
+	description string,
+	apps []*types.Application,
+	in *types.ExportInput,
+) (*Bundle, error) {
+	config, err := c.configSvc.Pipeline(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rendered := make([]*renderedApp, 0, len(apps))
+	for _, app := range apps {
+		r, err := c.renderApplication(ctx, app, config, in)
+		if err != nil {
+			return nil, fmt.Errorf("failed to render application %s: %w", app.Name, err)
+		}
+		if r != nil {
+			rendered = append(rendered, r)
+		}
+	}
+	if len(rendered) == 0 {
+		return nil, errors.BadRequest("No deployable applications to export")
+	}
+
+	sort.Slice(rendered, func(i, j int) bool {
+		if rendered[i].namespace != rendered[j].namespace {
+			return rendered[i].namespace < rendered[j].namespace
+		}
+		return rendered[i].identifier < rendered[j].identifier
+	})
+
+	return newBundle(name, description, rendered, in.Format)
+}
+
+func (c *Controller) listApplications(ctx context.Context, filter *types.ApplicationFilter) ([]*types.Application, error) {
+	filter.Size = listPageSize
+
+	applications := []*types.Application{}
+	for filter.Page = 1; ; filter.Page++ {
+		page, err := c.applicationStore.List(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		applications = append(applications, page...)
+
+		if len(page) < listPageSize {
+			break
+		}
+	}
+
+	return applications, nil
+}
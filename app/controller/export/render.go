@@ -0,0 +1,263 @@
+package export
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/cloudness-io/cloudness/app/pipeline"
+	"github.com/cloudness-io/cloudness/app/pipeline/convert"
+	"github.com/cloudness-io/cloudness/app/pipeline/convert/templates"
+	"github.com/cloudness-io/cloudness/app/services/config"
+	specSvc "github.com/cloudness-io/cloudness/app/services/spec"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+// emptyDockerConfig replaces the registry credential of redacted exports.
+const emptyDockerConfig = `{"auths":{}}`
+
+// renderedApp holds the manifests of an application, split into yaml documents.
+type renderedApp struct {
+	namespace  string
+	identifier string
+	// namespaceDoc is the namespace manifest, shared by the applications of an environment.
+	namespaceDoc string
+	docs         []string
+	// values are the helm values of the application.
+	values map[string]any
+}
+
+// renderApplication renders the manifests the deploy step applies for the current spec of the application.
+// Git applications that were never deployed have no image and are skipped.
+func (c *Controller) renderApplication(
+	ctx context.Context,
+	app *types.Application,
+	config *config.PipelineConfig,
+	in *types.ExportInput,
+) (*renderedApp, error) {
+	deployment := &types.Deployment{}
+	if app.DeploymentID != nil {
+		active, err := c.deploymentStore.Find(ctx, *app.DeploymentID)
+		if err != nil {
+			return nil, err
+		}
+		deployment.UID = active.UID
+		deployment.ImageUID = active.ImageUID
+	} else if app.Spec.IsGit() {
+		return nil, nil
+	}
+	deployment.Spec = app.Spec
+
+	variables, err := c.varCtrl.ListResolved(ctx, app.EnvironmentID, app.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	volumes, err := c.volumeStore.List(ctx, &types.VolumeFilter{
+		TenantID:      &app.TenantID,
+		ProjectID:     &app.ProjectID,
+		EnvironmentID: &app.EnvironmentID,
+		ApplicationID: &app.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	runnerCtxIn := &pipeline.RunnerContextInput{
+		Application: app,
+		Variables:   variables,
+		Deployment:  deployment,
+		Volumes:     volumes,
+		Config:      config,
+	}
+	if credUID := specSvc.GetRegistryCredentialUID(app, deployment); credUID > 0 {
+		cred, err := c.regCredCtrl.Resolve(ctx, app.TenantID, credUID)
+		if err != nil {
+			return nil, err
+		}
+		runnerCtxIn.RegistryCredential = cred
+	}
+
+	tmplIn, err := convert.ToTemplateInput(runnerCtxIn)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &renderedApp{
+		namespace:  tmplIn.Namespace,
+		identifier: tmplIn.Identifier,
+	}
+	refs := out.parameterize(tmplIn, in)
+
+	common, pvc, manifest, route, err := templates.GenerateKubeTemplates(tmplIn)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, doc := range splitDocuments(common, pvc, manifest, route) {
+		if in.Format == enum.ExportFormatHelm {
+			doc = refs.replace(escapeHelm(doc))
+		}
+		if isNamespace(doc) {
+			out.namespaceDoc = doc
+			continue
+		}
+		out.docs = append(out.docs, doc)
+	}
+	return out, nil
+}
+
+// parameterize redacts the secrets of the template input, helm exports move the image and the secrets
+// to the values of the chart and reference them from the manifests.
+func (r *renderedApp) parameterize(tmplIn *templates.TemplateIn, in *types.ExportInput) *helmRefs {
+	refs := &helmRefs{}
+	secrets := make(map[string]string, len(tmplIn.Secrets))
+	for key, value := range tmplIn.Secrets {
+		plain := ""
+		if !in.Redact {
+			plain = decodeBase64(value)
+		}
+		secrets[key] = plain
+
+		switch {
+		case in.Format == enum.ExportFormatHelm:
+			tmplIn.Secrets[key] = refs.add(r.valuePath("secrets", key) + " | b64enc | quote")
+		case in.Redact:
+			tmplIn.Secrets[key] = `""`
+		}
+	}
+
+	// the owner of a postgres cluster is created with the password of the variables
+	if tmplIn.PostgresHA != nil && tmplIn.PostgresHA.Password != "" {
+		switch {
+		case in.Format == enum.ExportFormatHelm:
+			tmplIn.PostgresHA.Password = refs.add(r.valuePath("secrets", "POSTGRES_PASSWORD") + " | b64enc | quote")
+		case in.Redact:
+			tmplIn.PostgresHA.Password = `""`
+		}
+	}
+
+	registry := ""
+	if tmplIn.ImagePullSecret != "" {
+		registry = emptyDockerConfig
+		if !in.Redact {
+			registry = decodeBase64(tmplIn.ImagePullSecret)
+		}
+
+		switch {
+		case in.Format == enum.ExportFormatHelm:
+			tmplIn.ImagePullSecret = refs.add(r.valuePath("registry") + " | b64enc | quote")
+		case in.Redact:
+			tmplIn.ImagePullSecret = base64.StdEncoding.EncodeToString([]byte(emptyDockerConfig))
+		}
+	}
+
+	if in.Format != enum.ExportFormatHelm {
+		return refs
+	}
+
+	r.values = map[string]any{
+		"image": tmplIn.Image,
+	}
+	if len(secrets) > 0 {
+		r.values["secrets"] = secrets
+	}
+	if registry != "" {
+		r.values["registry"] = registry
+	}
+	tmplIn.Image = refs.add(r.valuePath("image") + " | quote")
+	return refs
+}
+
+// valuePath returns the helm expression of a value of the application.
+func (r *renderedApp) valuePath(keys ...string) string {
+	path := fmt.Sprintf("index .Values %q %q", r.namespace, r.identifier)
+	for _, key := range keys {
+		path += fmt.Sprintf(" %q", key)
+	}
+	return path
+}
+
+// helmRefs replaces placeholders in the rendered manifests with helm expressions, the placeholders keep
+// the expressions from being escaped with the rest of the manifest.
+type helmRefs struct {
+	exprs []string
+}
+
+func (h *helmRefs) add(expr string) string {
+	h.exprs = append(h.exprs, expr)
+	return h.placeholder(len(h.exprs) - 1)
+}
+
+func (h *helmRefs) placeholder(i int) string {
+	return fmt.Sprintf("__cloudness_helm_ref_%d__", i)
+}
+
+func (h *helmRefs) replace(doc string) string {
+	for i := range h.exprs {
+		doc = strings.ReplaceAll(doc, h.placeholder(i), "{{ "+h.exprs[i]+" }}")
+	}
+	return doc
+}
+
+// escapeHelm escapes template actions that are part of the manifest, eg. in a start command.
+func escapeHelm(doc string) string {
+	return strings.ReplaceAll(doc, "{{", `{{ "{{" }}`)
+}
+
+// splitDocuments splits the rendered templates into yaml documents, documents without content are dropped.
+func splitDocuments(manifests ...string) []string {
+	docs := []string{}
+	for _, manifest := range manifests {
+		current := []string{}
+		flush := func() {
+			// trailing comments are commented out manifests of the templates
+			for len(current) > 0 && !hasContent(current[len(current)-1]) {
+				current = current[:len(current)-1]
+			}
+			doc := strings.TrimSpace(strings.Join(current, "\n"))
+			if doc != "" {
+				docs = append(docs, doc+"\n")
+			}
+			current = current[:0]
+		}
+		for _, line := range strings.Split(manifest, "\n") {
+			if strings.TrimSpace(line) == "---" {
+				flush()
+				continue
+			}
+			current = append(current, line)
+		}
+		flush()
+	}
+	return docs
+}
+
+func hasContent(doc string) bool {
+	for _, line := range strings.Split(doc, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "#") {
+			return true
+		}
+	}
+	return false
+}
+
+func isNamespace(doc string) bool {
+	for _, line := range strings.Split(doc, "\n") {
+		if strings.TrimSpace(line) == "kind: Namespace" {
+			return true
+		}
+	}
+	return false
+}
+
+func decodeBase64(value string) string {
+	data, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return value
+	}
+	return string(data)
+}
@@ -0,0 +1,36 @@
+package export
+
+import (
+	"github.com/cloudness-io/cloudness/app/controller/registrycredential"
+	"github.com/cloudness-io/cloudness/app/controller/variable"
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	"github.com/cloudness-io/cloudness/app/services/config"
+	"github.com/cloudness-io/cloudness/app/store"
+
+	"github.com/google/wire"
+)
+
+// WireSet provides a wire set for this package.
+var WireSet = wire.NewSet(
+	ProvideController,
+)
+
+func ProvideController(
+	applicationStore store.ApplicationStore,
+	deploymentStore store.DeploymentStore,
+	volumeStore store.VolumeStore,
+	varCtrl *variable.Controller,
+	regCredCtrl *registrycredential.Controller,
+	configSvc *config.Service,
+	auditSvc *audit.Service,
+) *Controller {
+	return NewController(
+		applicationStore,
+		deploymentStore,
+		volumeStore,
+		varCtrl,
+		regCredCtrl,
+		configSvc,
+		auditSvc,
+	)
+}
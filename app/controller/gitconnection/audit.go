@@ -0,0 +1,13 @@
+package gitconnection
+
+import (
+	"strconv"
+
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+func auditResource(conn *types.GitConnection) audit.Resource {
+	return audit.NewResource(enum.AuditResourceGitConnection, strconv.FormatInt(conn.UID, 10), conn.Name)
+}
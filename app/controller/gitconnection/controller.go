@@ -0,0 +1,29 @@
+package gitconnection
+
+import (
+	"github.com/cloudness-io/cloudness/app/pipeline/triggerer"
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	"github.com/cloudness-io/cloudness/app/services/gitconnection"
+	"github.com/cloudness-io/cloudness/app/store"
+)
+
+type Controller struct {
+	gitConnSvc       *gitconnection.Service
+	applicationStore store.ApplicationStore
+	triggerer        triggerer.Triggerer
+	auditSvc         *audit.Service
+}
+
+func NewController(
+	gitConnSvc *gitconnection.Service,
+	applicationStore store.ApplicationStore,
+	triggerer triggerer.Triggerer,
+	auditSvc *audit.Service,
+) *Controller {
+	return &Controller{
+		gitConnSvc:       gitConnSvc,
+		applicationStore: applicationStore,
+		triggerer:        triggerer,
+		auditSvc:         auditSvc,
+	}
+}
@@ -0,0 +1,100 @@
+package gitconnection
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	"github.com/cloudness-io/cloudness/helpers"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/check"
+	"github.com/cloudness-io/cloudness/types/enum"
+
+	"github.com/dchest/uniuri"
+)
+
+const webhookSecretLength = 32
+
+// CreateInput is the input used to create a git connection.
+// ServerURL defaults to the hosted offering of the provider when left empty.
+type CreateInput struct {
+	Name      string           `json:"name"`
+	Provider  enum.GitProvider `json:"provider"`
+	ServerURL string           `json:"serverURL"`
+	Username  string           `json:"username"`
+	Token     string           `json:"token"`
+}
+
+// Create creates a git connection within the project, the access token is encrypted at rest.
+func (c *Controller) Create(
+	ctx context.Context,
+	tenant *types.Tenant,
+	project *types.Project,
+	createdBy *types.Principal,
+	in *CreateInput,
+) (*types.GitConnection, error) {
+	if err := c.sanitizeCreateInput(in); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC().UnixMilli()
+	conn := &types.GitConnection{
+		UID:           helpers.GenerateUID(),
+		TenantID:      tenant.ID,
+		ProjectID:     project.ID,
+		Name:          in.Name,
+		Provider:      in.Provider,
+		ServerURL:     in.ServerURL,
+		Username:      in.Username,
+		WebhookSecret: uniuri.NewLen(webhookSecretLength),
+		CreatedBy:     createdBy.ID,
+		Created:       now,
+		Updated:       now,
+	}
+
+	conn, err := c.gitConnSvc.Create(ctx, conn, in.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	c.auditSvc.Log(ctx, auditResource(conn), enum.AuditActionCreated,
+		audit.WithTenantID(tenant.ID),
+		audit.WithProjectID(project.ID),
+		audit.WithNewObject(conn),
+	)
+	return conn, nil
+}
+
+func (c *Controller) sanitizeCreateInput(in *CreateInput) error {
+	errors := check.NewValidationErrors()
+	if err := check.DisplayName(in.Name); err != nil {
+		errors.AddValidationError("name", err)
+	}
+
+	if enum.GitConnectionProviderFromString(string(in.Provider)) == "" {
+		errors.AddValidationError("provider", check.NewValidationError("Invalid git provider"))
+	}
+
+	// only bitbucket cloud is supported, its api is not served from the server url
+	if in.ServerURL == "" || in.Provider == enum.BitbucketProvider {
+		in.ServerURL = in.Provider.DefaultServer()
+	}
+	in.ServerURL = strings.TrimSuffix(strings.TrimSpace(in.ServerURL), "/")
+	if u, err := url.Parse(in.ServerURL); err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		errors.AddValidationError("serverURL", check.NewValidationError("Server url must be a valid http(s) address"))
+	}
+
+	if in.Provider == enum.BitbucketProvider && in.Username == "" {
+		errors.AddValidationError("username", check.NewValidationError("Username is required for bitbucket app passwords"))
+	}
+	if in.Token == "" {
+		errors.AddValidationError("token", check.NewValidationError("Access token is required"))
+	}
+
+	if errors.HasError() {
+		return errors
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package gitconnection
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	"github.com/cloudness-io/cloudness/errors"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+// Delete deletes the git connection once no application is deployed from it anymore.
+func (c *Controller) Delete(ctx context.Context, conn *types.GitConnection) error {
+	count, err := c.applicationStore.Count(ctx, &types.ApplicationFilter{
+		TenantID:        &conn.TenantID,
+		GitConnectionID: &conn.ID,
+	})
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return errors.PreconditionFailed("Git connection is used by %d application(s)", count)
+	}
+
+	if err := c.gitConnSvc.Delete(ctx, conn); err != nil {
+		return err
+	}
+
+	c.auditSvc.Log(ctx, auditResource(conn), enum.AuditActionDeleted, audit.WithOldObject(conn))
+	return nil
+}
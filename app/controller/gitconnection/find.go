@@ -0,0 +1,15 @@
+package gitconnection
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/types"
+)
+
+func (c *Controller) Find(ctx context.Context, tenantID, projectID, id int64) (*types.GitConnection, error) {
+	return c.gitConnSvc.Find(ctx, tenantID, projectID, id)
+}
+
+func (c *Controller) FindByUID(ctx context.Context, tenantID, projectID, uid int64) (*types.GitConnection, error) {
+	return c.gitConnSvc.FindByUID(ctx, tenantID, projectID, uid)
+}
@@ -0,0 +1,15 @@
+package gitconnection
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/types"
+)
+
+func (c *Controller) ListRepos(ctx context.Context, conn *types.GitConnection) ([]*types.GitRepo, error) {
+	return c.gitConnSvc.ListRepos(ctx, conn)
+}
+
+func (c *Controller) ListBranches(ctx context.Context, conn *types.GitConnection, repo string) ([]*types.GitBranch, error) {
+	return c.gitConnSvc.ListBranches(ctx, conn, repo)
+}
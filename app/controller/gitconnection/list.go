@@ -0,0 +1,11 @@
+package gitconnection
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/types"
+)
+
+func (c *Controller) List(ctx context.Context, tenantID, projectID int64) ([]*types.GitConnection, error) {
+	return c.gitConnSvc.List(ctx, tenantID, projectID)
+}
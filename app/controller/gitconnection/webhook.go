@@ -0,0 +1,142 @@
+package gitconnection
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/cloudness-io/cloudness/app/pipeline/triggerer"
+	"github.com/cloudness-io/cloudness/errors"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	branchRefPrefix = "refs/heads/"
+	emptyCommitSHA  = "0000000000000000000000000000000000000000"
+	webhookPageSize = 100
+)
+
+var ErrInvalidWebhookSignature = errors.New("invalid git webhook signature")
+
+// HandleWebhookEvent verifies the webhook payload against the git connection secret and
+// deploys every application tracking the pushed branch.
+func (c *Controller) HandleWebhookEvent(
+	ctx context.Context,
+	connUID int64,
+	r *http.Request,
+	payload []byte,
+) ([]*types.Deployment, error) {
+	conn, hook, err := c.parseWebhook(ctx, connUID, r, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	push, ok := hook.(*scm.PushHook)
+	if !ok {
+		log.Ctx(ctx).Debug().Msg("git webhook: ignoring event")
+		return nil, nil
+	}
+	if push.After == "" || push.After == emptyCommitSHA || !strings.HasPrefix(push.Ref, branchRefPrefix) {
+		return nil, nil
+	}
+
+	return c.deployPush(ctx, conn, push)
+}
+
+// parseWebhook resolves the connection whose secret verifies the payload, UIDs are not unique across tenants.
+func (c *Controller) parseWebhook(ctx context.Context, connUID int64, r *http.Request, payload []byte) (*types.GitConnection, scm.Webhook, error) {
+	conns, err := c.gitConnSvc.ListByUID(ctx, connUID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, conn := range conns {
+		req := r.Clone(ctx)
+		req.Body = io.NopCloser(bytes.NewReader(payload))
+
+		hook, err := c.gitConnSvc.ParseWebhook(conn, req)
+		switch {
+		case errors.Is(err, scm.ErrSignatureInvalid):
+			continue
+		case errors.Is(err, scm.ErrUnknownEvent):
+			return conn, nil, nil
+		case err != nil:
+			return nil, nil, errors.BadRequest("Invalid git webhook payload")
+		}
+		return conn, hook, nil
+	}
+
+	return nil, nil, ErrInvalidWebhookSignature
+}
+
+func (c *Controller) deployPush(ctx context.Context, conn *types.GitConnection, push *scm.PushHook) ([]*types.Deployment, error) {
+	repoFullName := push.Repo.Namespace + "/" + push.Repo.Name
+	branch := strings.TrimPrefix(push.Ref, branchRefPrefix)
+
+	title := push.Commit.Message
+	if title == "" {
+		title = "Application hook trigger"
+	}
+	triggeredBy := push.Sender.Login
+	if triggeredBy == "" {
+		triggeredBy = push.Sender.Name
+	}
+
+	applications, err := c.listApplications(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+
+	deployments := []*types.Deployment{}
+	for _, application := range applications {
+		// repositories are matched on their full path as self-hosted servers may nest them in groups
+		if !application.TracksBranch(repoFullName, branch, conn.RepoFullName) {
+			continue
+		}
+
+		deployment, err := c.triggerer.Trigger(ctx, &triggerer.TriggerHook{
+			ApplicaitonID: application.ID,
+			Triggerer:     triggeredBy,
+			Title:         title,
+			Action:        enum.TriggerActionHook,
+			Commit:        push.After,
+		})
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Int64("application.id", application.ID).Msg("git webhook: failed to trigger deployment")
+			continue
+		}
+		deployments = append(deployments, deployment)
+	}
+
+	return deployments, nil
+}
+
+// listApplications lists all the applications of the tenant deployed from the git connection.
+func (c *Controller) listApplications(ctx context.Context, conn *types.GitConnection) ([]*types.Application, error) {
+	filter := &types.ApplicationFilter{
+		TenantID:        &conn.TenantID,
+		GitConnectionID: &conn.ID,
+	}
+	filter.Size = webhookPageSize
+
+	applications := []*types.Application{}
+	for filter.Page = 1; ; filter.Page++ {
+		page, err := c.applicationStore.List(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		applications = append(applications, page...)
+
+		if len(page) < webhookPageSize {
+			break
+		}
+	}
+
+	return applications, nil
+}
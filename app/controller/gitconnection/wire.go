@@ -0,0 +1,23 @@
+package gitconnection
+
+import (
+	"github.com/cloudness-io/cloudness/app/pipeline/triggerer"
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	"github.com/cloudness-io/cloudness/app/services/gitconnection"
+	"github.com/cloudness-io/cloudness/app/store"
+
+	"github.com/google/wire"
+)
+
+var WireSet = wire.NewSet(
+	ProvideController,
+)
+
+func ProvideController(
+	gitConnSvc *gitconnection.Service,
+	applicationStore store.ApplicationStore,
+	triggerer triggerer.Triggerer,
+	auditSvc *audit.Service,
+) *Controller {
+	return NewController(gitConnSvc, applicationStore, triggerer, auditSvc)
+}
@@ -0,0 +1,21 @@
+package githubapp
+
+import (
+	"strconv"
+
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+func auditResource(ghApp *types.GithubApp) audit.Resource {
+	return audit.NewResource(enum.AuditResourceGithubApp, strconv.FormatInt(ghApp.UID, 10), ghApp.Name)
+}
+
+func auditScope(ghApp *types.GithubApp) []audit.Option {
+	opts := []audit.Option{audit.WithTenantID(ghApp.TenantID)}
+	if ghApp.ProjectID > 0 {
+		opts = append(opts, audit.WithProjectID(ghApp.ProjectID))
+	}
+	return opts
+}
@@ -1,7 +1,11 @@
 package githubapp
 
 import (
+	"github.com/cloudness-io/cloudness/app/controller/environment"
+	"github.com/cloudness-io/cloudness/app/pipeline/triggerer"
+	"github.com/cloudness-io/cloudness/app/services/audit"
 	"github.com/cloudness-io/cloudness/app/services/githubapp"
+	"github.com/cloudness-io/cloudness/app/store"
 	"github.com/cloudness-io/cloudness/types/check"
 )
 
@@ -13,12 +17,32 @@ const (
 )
 
 type Controller struct {
-	ghAppSvc *githubapp.Service
+	ghAppSvc         *githubapp.Service
+	tenantStore      store.TenantStore
+	projectStore     store.ProjectStore
+	applicationStore store.ApplicationStore
+	envCtrl          *environment.Controller
+	triggerer        triggerer.Triggerer
+	auditSvc         *audit.Service
 }
 
-func NewController(ghAppSvc *githubapp.Service) *Controller {
+func NewController(
+	ghAppSvc *githubapp.Service,
+	tenantStore store.TenantStore,
+	projectStore store.ProjectStore,
+	applicationStore store.ApplicationStore,
+	envCtrl *environment.Controller,
+	triggerer triggerer.Triggerer,
+	auditSvc *audit.Service,
+) *Controller {
 	return &Controller{
-		ghAppSvc: ghAppSvc,
+		ghAppSvc:         ghAppSvc,
+		tenantStore:      tenantStore,
+		projectStore:     projectStore,
+		applicationStore: applicationStore,
+		envCtrl:          envCtrl,
+		triggerer:        triggerer,
+		auditSvc:         auditSvc,
 	}
 }
 
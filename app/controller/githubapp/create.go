@@ -5,8 +5,10 @@ import (
 	"time"
 
 	"github.com/cloudness-io/cloudness/app/auth"
+	"github.com/cloudness-io/cloudness/app/services/audit"
 	"github.com/cloudness-io/cloudness/helpers"
 	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
 
 	"github.com/rs/zerolog/log"
 )
@@ -50,5 +52,12 @@ func (c *Controller) Create(ctx context.Context, session *auth.Session, tenantID
 		ghApp.ProjectID = projectID
 	}
 
-	return c.ghAppSvc.Create(ctx, ghApp)
+	ghApp, err := c.ghAppSvc.Create(ctx, ghApp)
+	if err != nil {
+		return nil, err
+	}
+
+	c.auditSvc.Log(ctx, auditResource(ghApp), enum.AuditActionCreated,
+		append(auditScope(ghApp), audit.WithNewObject(ghApp))...)
+	return ghApp, nil
 }
@@ -3,9 +3,17 @@ package githubapp
 import (
 	"context"
 
+	"github.com/cloudness-io/cloudness/app/services/audit"
 	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
 )
 
 func (c *Controller) Delete(ctx context.Context, ghApp *types.GithubApp) error {
-	return c.ghAppSvc.Delete(ctx, ghApp)
+	if err := c.ghAppSvc.Delete(ctx, ghApp); err != nil {
+		return err
+	}
+
+	c.auditSvc.Log(ctx, auditResource(ghApp), enum.AuditActionDeleted,
+		append(auditScope(ghApp), audit.WithOldObject(ghApp))...)
+	return nil
 }
@@ -0,0 +1,144 @@
+package githubapp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	appCtrl "github.com/cloudness-io/cloudness/app/controller/application"
+	"github.com/cloudness-io/cloudness/helpers"
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	pullRequestActionOpened   = "opened"
+	pullRequestActionReopened = "reopened"
+	pullRequestActionClosed   = "closed"
+)
+
+func (c *Controller) handlePullRequest(ctx context.Context, ghApp *types.GithubApp, event *github.PullRequestEvent) ([]*types.Deployment, error) {
+	switch event.GetAction() {
+	case pullRequestActionOpened, pullRequestActionReopened:
+		return c.openPreviews(ctx, ghApp, event)
+	case pullRequestActionClosed:
+		return nil, c.closePreviews(ctx, ghApp, event)
+	}
+	// new commits on the head branch are deployed by the push event
+	return nil, nil
+}
+
+// openPreviews clones every environment with an application tracking the pull request base branch
+// and posts the preview urls back to the pull request. Pull requests from forks are not previewed.
+func (c *Controller) openPreviews(ctx context.Context, ghApp *types.GithubApp, event *github.PullRequestEvent) ([]*types.Deployment, error) {
+	pr := event.GetPullRequest()
+	repoFullName := event.GetRepo().GetFullName()
+	number := int64(event.GetNumber())
+	// previews are cloned with the variables and secrets of the base environment,
+	// code from a fork must never run with them.
+	if pr.GetHead().GetRepo().GetFullName() != pr.GetBase().GetRepo().GetFullName() {
+		log.Ctx(ctx).Info().Str("repo", repoFullName).Int64("pull_request", number).
+			Msg("github webhook: skipping preview of pull request from a fork")
+		return nil, nil
+	}
+	actor := event.GetSender().GetLogin()
+	in := &appCtrl.PreviewInput{
+		Repo:        repoFullName,
+		BaseBranch:  pr.GetBase().GetRef(),
+		HeadRepoURL: pr.GetHead().GetRepo().GetCloneURL(),
+		HeadBranch:  pr.GetHead().GetRef(),
+		HeadCommit:  pr.GetHead().GetSHA(),
+	}
+
+	existing, err := c.envCtrl.ListPreviews(ctx, ghApp.TenantID, repoFullName, number)
+	if err != nil {
+		return nil, err
+	}
+	previewed := make(map[int64]bool, len(existing))
+	for _, env := range existing {
+		previewed[*env.PreviewSourceID] = true
+	}
+
+	applications, err := c.listApplications(ctx, ghApp)
+	if err != nil {
+		return nil, err
+	}
+
+	deployments := []*types.Deployment{}
+	urls := []string{}
+	for _, application := range applications {
+		if previewed[application.EnvironmentID] || !application.TracksBranch(repoFullName, in.BaseBranch, helpers.GitRepoFullName) {
+			continue
+		}
+		previewed[application.EnvironmentID] = true
+
+		source, err := c.envCtrl.FindByID(ctx, application.EnvironmentID)
+		if err != nil {
+			return nil, err
+		}
+		if source.IsPreview() {
+			continue
+		}
+
+		tenant, err := c.tenantStore.Find(ctx, application.TenantID)
+		if err != nil {
+			return nil, err
+		}
+		project, err := c.projectStore.Find(ctx, application.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+
+		preview, clones, err := c.envCtrl.CreatePreview(ctx, actor, tenant, project, source, number, in)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Int64("environment.id", source.ID).Msg("github webhook: failed to create preview environment")
+			continue
+		}
+
+		for _, clone := range clones {
+			commit := ""
+			if clone.Spec.IsGit() && clone.Spec.Build.Source.Git.Branch == in.HeadBranch {
+				commit = in.HeadCommit
+			}
+			deployment, err := c.triggerHook(ctx, clone, actor, pr.GetTitle(), commit)
+			if err != nil {
+				log.Ctx(ctx).Error().Err(err).Int64("application.id", clone.ID).Msg("github webhook: failed to trigger preview deployment")
+				continue
+			}
+			deployments = append(deployments, deployment)
+
+			if clone.Domain != "" {
+				urls = append(urls, fmt.Sprintf("- **%s** (%s): %s", clone.Name, preview.Name, clone.Domain))
+			}
+		}
+	}
+
+	if len(urls) > 0 {
+		body := "Preview environment deployed on Cloudness:\n\n" + strings.Join(urls, "\n")
+		err := c.ghAppSvc.CreatePullRequestComment(ctx, ghApp,
+			event.GetRepo().GetOwner().GetLogin(), event.GetRepo().GetName(), event.GetNumber(), body)
+		if err != nil {
+			log.Ctx(ctx).Warn().Err(err).Msg("github webhook: failed to comment preview urls on pull request")
+		}
+	}
+
+	return deployments, nil
+}
+
+// closePreviews tears down the preview environments of the pull request, resources are
+// released by the cleanup job once the environments are soft deleted.
+func (c *Controller) closePreviews(ctx context.Context, ghApp *types.GithubApp, event *github.PullRequestEvent) error {
+	envs, err := c.envCtrl.ListPreviews(ctx, ghApp.TenantID, event.GetRepo().GetFullName(), int64(event.GetNumber()))
+	if err != nil {
+		return err
+	}
+
+	for _, env := range envs {
+		if err := c.envCtrl.SoftDelete(ctx, env); err != nil {
+			return err
+		}
+	}
+	return nil
+}
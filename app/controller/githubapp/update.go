@@ -3,13 +3,32 @@ package githubapp
 import (
 	"context"
 
+	"github.com/cloudness-io/cloudness/app/services/audit"
 	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
 )
 
 func (c *Controller) CompleteManifest(ctx context.Context, ghApp *types.GithubApp, code string) error {
-	return c.ghAppSvc.CompleteManifest(ctx, ghApp, code)
+	old := *ghApp
+	if err := c.ghAppSvc.CompleteManifest(ctx, ghApp, code); err != nil {
+		return err
+	}
+
+	c.auditUpdate(ctx, &old, ghApp)
+	return nil
 }
 
 func (c *Controller) CompleteInstallation(ctx context.Context, ghApp *types.GithubApp, installationID int64) error {
-	return c.ghAppSvc.CompleteInstallation(ctx, ghApp, installationID)
+	old := *ghApp
+	if err := c.ghAppSvc.CompleteInstallation(ctx, ghApp, installationID); err != nil {
+		return err
+	}
+
+	c.auditUpdate(ctx, &old, ghApp)
+	return nil
+}
+
+func (c *Controller) auditUpdate(ctx context.Context, old, ghApp *types.GithubApp) {
+	c.auditSvc.Log(ctx, auditResource(ghApp), enum.AuditActionUpdated,
+		append(auditScope(ghApp), audit.WithOldObject(old), audit.WithNewObject(ghApp))...)
 }
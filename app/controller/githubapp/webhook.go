@@ -0,0 +1,163 @@
+package githubapp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cloudness-io/cloudness/app/pipeline/triggerer"
+	"github.com/cloudness-io/cloudness/errors"
+	"github.com/cloudness-io/cloudness/helpers"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	githubEventPush        = "push"
+	githubEventPullRequest = "pull_request"
+	signaturePrefix        = "sha256="
+	branchRefPrefix        = "refs/heads/"
+	emptyCommitSHA         = "0000000000000000000000000000000000000000"
+	webhookPageSize        = 100
+)
+
+var ErrInvalidWebhookSignature = errors.New("invalid github webhook signature")
+
+// HandleWebhookEvent verifies the webhook payload against the github app secret and
+// deploys every application affected by the event.
+func (c *Controller) HandleWebhookEvent(
+	ctx context.Context,
+	githubAppUID int64,
+	event string,
+	signature string,
+	payload []byte,
+) ([]*types.Deployment, error) {
+	ghApp, err := c.verifyWebhook(ctx, githubAppUID, signature, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if event != githubEventPush && event != githubEventPullRequest {
+		log.Ctx(ctx).Debug().Str("event", event).Msg("github webhook: ignoring event")
+		return nil, nil
+	}
+
+	parsed, err := github.ParseWebHook(event, payload)
+	if err != nil {
+		return nil, errors.BadRequest("Invalid github webhook payload")
+	}
+
+	switch e := parsed.(type) {
+	case *github.PushEvent:
+		if e.GetDeleted() || e.GetAfter() == emptyCommitSHA || !strings.HasPrefix(e.GetRef(), branchRefPrefix) {
+			return nil, nil
+		}
+		return c.deployPush(ctx, ghApp, e)
+	case *github.PullRequestEvent:
+		return c.handlePullRequest(ctx, ghApp, e)
+	}
+
+	return nil, errors.BadRequest("Invalid github event")
+}
+
+func (c *Controller) verifyWebhook(ctx context.Context, githubAppUID int64, signature string, payload []byte) (*types.GithubApp, error) {
+	if !strings.HasPrefix(signature, signaturePrefix) {
+		return nil, ErrInvalidWebhookSignature
+	}
+
+	ghApps, err := c.ghAppSvc.ListByUID(ctx, githubAppUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return findSigner(ghApps, signature, payload)
+}
+
+// findSigner returns the github app whose webhook secret signed the payload.
+func findSigner(ghApps []*types.GithubApp, signature string, payload []byte) (*types.GithubApp, error) {
+	if !strings.HasPrefix(signature, signaturePrefix) {
+		return nil, ErrInvalidWebhookSignature
+	}
+
+	for _, ghApp := range ghApps {
+		if ghApp.WebhookSecret == "" {
+			continue
+		}
+		if err := github.ValidateSignature(signature, payload, []byte(ghApp.WebhookSecret)); err == nil {
+			return ghApp, nil
+		}
+	}
+
+	return nil, ErrInvalidWebhookSignature
+}
+
+func (c *Controller) deployPush(ctx context.Context, ghApp *types.GithubApp, push *github.PushEvent) ([]*types.Deployment, error) {
+	repoFullName := push.GetRepo().GetFullName()
+	branch := strings.TrimPrefix(push.GetRef(), branchRefPrefix)
+
+	title := push.GetHeadCommit().GetMessage()
+	if title == "" {
+		title = "Application hook trigger"
+	}
+	triggeredBy := push.GetPusher().GetName()
+	if triggeredBy == "" {
+		triggeredBy = push.GetSender().GetLogin()
+	}
+
+	applications, err := c.listApplications(ctx, ghApp)
+	if err != nil {
+		return nil, err
+	}
+
+	deployments := []*types.Deployment{}
+	for _, application := range applications {
+		if !application.TracksBranch(repoFullName, branch, helpers.GitRepoFullName) {
+			continue
+		}
+
+		deployment, err := c.triggerHook(ctx, application, triggeredBy, title, push.GetAfter())
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Int64("application.id", application.ID).Msg("github webhook: failed to trigger deployment")
+			continue
+		}
+		deployments = append(deployments, deployment)
+	}
+
+	return deployments, nil
+}
+
+// listApplications lists all the applications of the tenant deployed from the github app.
+func (c *Controller) listApplications(ctx context.Context, ghApp *types.GithubApp) ([]*types.Application, error) {
+	filter := &types.ApplicationFilter{
+		TenantID:    &ghApp.TenantID,
+		GithubAppID: &ghApp.ID,
+	}
+	filter.Size = webhookPageSize
+
+	applications := []*types.Application{}
+	for filter.Page = 1; ; filter.Page++ {
+		page, err := c.applicationStore.List(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		applications = append(applications, page...)
+
+		if len(page) < webhookPageSize {
+			break
+		}
+	}
+
+	return applications, nil
+}
+
+func (c *Controller) triggerHook(ctx context.Context, application *types.Application, triggeredBy, title, commit string) (*types.Deployment, error) {
+	return c.triggerer.Trigger(ctx, &triggerer.TriggerHook{
+		ApplicaitonID: application.ID,
+		Triggerer:     triggeredBy,
+		Title:         title,
+		Action:        enum.TriggerActionHook,
+		Commit:        commit,
+	})
+}
@@ -0,0 +1,58 @@
+package githubapp
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/cloudness-io/cloudness/types"
+)
+
+func sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestFindSigner(t *testing.T) {
+	payload := []byte(`{"ref":"refs/heads/main"}`)
+	first := &types.GithubApp{ID: 1, WebhookSecret: "first-secret"}
+	second := &types.GithubApp{ID: 2, WebhookSecret: "second-secret"}
+	unset := &types.GithubApp{ID: 3}
+	ghApps := []*types.GithubApp{unset, first, second}
+
+	tests := []struct {
+		name      string
+		signature string
+		payload   []byte
+		want      *types.GithubApp
+	}{
+		{name: "valid", signature: sign("first-secret", payload), payload: payload, want: first},
+		{name: "valid for second app", signature: sign("second-secret", payload), payload: payload, want: second},
+		{name: "missing", signature: "", payload: payload},
+		{name: "missing prefix", signature: sign("first-secret", payload)[len(signaturePrefix):], payload: payload},
+		{name: "sha1", signature: "sha1=" + sign("first-secret", payload)[len(signaturePrefix):], payload: payload},
+		{name: "wrong secret", signature: sign("other-secret", payload), payload: payload},
+		{name: "empty secret", signature: sign("", payload), payload: payload},
+		{name: "tampered payload", signature: sign("first-secret", payload), payload: []byte(`{"ref":"refs/heads/prod"}`)},
+		{name: "malformed", signature: signaturePrefix + "zz", payload: payload},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := findSigner(ghApps, tt.signature, tt.payload)
+			if tt.want == nil {
+				if err != ErrInvalidWebhookSignature {
+					t.Fatalf("err = %v, want %v", err, ErrInvalidWebhookSignature)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.ID != tt.want.ID {
+				t.Errorf("signed by github app %d, want %d", got.ID, tt.want.ID)
+			}
+		})
+	}
+}
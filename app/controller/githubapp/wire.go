@@ -1,7 +1,11 @@
 package githubapp
 
 import (
+	"github.com/cloudness-io/cloudness/app/controller/environment"
+	"github.com/cloudness-io/cloudness/app/pipeline/triggerer"
+	"github.com/cloudness-io/cloudness/app/services/audit"
 	"github.com/cloudness-io/cloudness/app/services/githubapp"
+	"github.com/cloudness-io/cloudness/app/store"
 
 	"github.com/google/wire"
 )
@@ -10,6 +14,14 @@ var WireSet = wire.NewSet(
 	ProvideController,
 )
 
-func ProvideController(ghAppSvc *githubapp.Service) *Controller {
-	return NewController(ghAppSvc)
+func ProvideController(
+	ghAppSvc *githubapp.Service,
+	tenantStore store.TenantStore,
+	projectStore store.ProjectStore,
+	applicationStore store.ApplicationStore,
+	envCtrl *environment.Controller,
+	triggerer triggerer.Triggerer,
+	auditSvc *audit.Service,
+) *Controller {
+	return NewController(ghAppSvc, tenantStore, projectStore, applicationStore, envCtrl, triggerer, auditSvc)
 }
@@ -255,7 +255,7 @@ func (c *Controller) UpdateRegistry(ctx context.Context, in *InstanceRegistryUpd
 		if _, err := c.instanceStore.Update(ctx, instance); err != nil {
 			return err
 		}
-		server, err := c.serverStore.Find(ctx, 1)
+		server, err := c.serverStore.Find(ctx, types.PrimaryServerID)
 		if err != nil {
 			return err
 		}
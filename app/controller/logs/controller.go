@@ -2,20 +2,24 @@ package logs
 
 import (
 	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/blob"
 	"github.com/cloudness-io/cloudness/logstream"
 )
 
 type Controller struct {
 	logStore  store.LogStore
+	blobStore blob.Store
 	logStream logstream.LogStream
 }
 
 func NewController(
 	logStore store.LogStore,
+	blobStore blob.Store,
 	logStream logstream.LogStream,
 ) *Controller {
 	return &Controller{
 		logStore:  logStore,
+		blobStore: blobStore,
 		logStream: logStream,
 	}
 }
@@ -4,13 +4,18 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 
+	"github.com/cloudness-io/cloudness/app/services/logarchive"
+	"github.com/cloudness-io/cloudness/blob"
+	dbStore "github.com/cloudness-io/cloudness/store"
 	"github.com/cloudness-io/cloudness/types"
 )
 
 func (c *Controller) Find(ctx context.Context, deploymentID int64) ([]*types.LogLine, error) {
-	rc, err := c.logStore.Find(ctx, deploymentID)
+	rc, err := c.open(ctx, deploymentID)
 	if err != nil {
 		return nil, fmt.Errorf("could not find logs: %w", err)
 	}
@@ -30,3 +35,20 @@ func (c *Controller) Find(ctx context.Context, deploymentID int64) ([]*types.Log
 
 	return lines, nil
 }
+
+// open reads the log from the database, falling back to the blob store once the log has been archived.
+func (c *Controller) open(ctx context.Context, deploymentID int64) (io.ReadCloser, error) {
+	rc, err := c.logStore.Find(ctx, deploymentID)
+	if err == nil {
+		return rc, nil
+	}
+	if !errors.Is(err, dbStore.ErrResourceNotFound) {
+		return nil, err
+	}
+
+	rc, err = c.blobStore.Download(ctx, logarchive.BlobPath(deploymentID))
+	if errors.Is(err, blob.ErrNotFound) {
+		return nil, dbStore.ErrResourceNotFound
+	}
+	return rc, err
+}
@@ -2,6 +2,7 @@ package logs
 
 import (
 	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/blob"
 	"github.com/cloudness-io/cloudness/logstream"
 
 	"github.com/google/wire"
@@ -12,6 +13,6 @@ var WireSet = wire.NewSet(
 	ProvideController,
 )
 
-func ProvideController(logStore store.LogStore, logStream logstream.LogStream) *Controller {
-	return NewController(logStore, logStream)
+func ProvideController(logStore store.LogStore, blobStore blob.Store, logStream logstream.LogStream) *Controller {
+	return NewController(logStore, blobStore, logStream)
 }
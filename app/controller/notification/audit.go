@@ -0,0 +1,13 @@
+package notification
+
+import (
+	"strconv"
+
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+func auditResource(channel *types.NotificationChannel) audit.Resource {
+	return audit.NewResource(enum.AuditResourceNotificationChannel, strconv.FormatInt(channel.UID, 10), channel.Name)
+}
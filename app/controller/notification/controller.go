@@ -0,0 +1,29 @@
+package notification
+
+import (
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	"github.com/cloudness-io/cloudness/app/services/notification"
+	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/encrypt"
+)
+
+type Controller struct {
+	channelStore    store.NotificationChannelStore
+	encrypter       encrypt.Encrypter
+	notificationSvc *notification.Service
+	auditSvc        *audit.Service
+}
+
+func NewController(
+	channelStore store.NotificationChannelStore,
+	encrypter encrypt.Encrypter,
+	notificationSvc *notification.Service,
+	auditSvc *audit.Service,
+) *Controller {
+	return &Controller{
+		channelStore:    channelStore,
+		encrypter:       encrypter,
+		notificationSvc: notificationSvc,
+		auditSvc:        auditSvc,
+	}
+}
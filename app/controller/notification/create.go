@@ -0,0 +1,156 @@
+package notification
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	"github.com/cloudness-io/cloudness/app/services/notification"
+	"github.com/cloudness-io/cloudness/helpers"
+	cloudhttp "github.com/cloudness-io/cloudness/http"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/check"
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+// CreateInput is the input used to create a notification channel.
+// Target is the webhook url, or the comma separated recipients of email channels.
+// Events is the comma separated list of subscribed events.
+type CreateInput struct {
+	Name       string                       `json:"name"`
+	Type       enum.NotificationChannelType `json:"type"`
+	Target     string                       `json:"target"`
+	SigningKey string                       `json:"signing_key"`
+	Events     string                       `json:"events"`
+}
+
+// Create creates a notification channel of the tenant, or of the project when it is not nil.
+// The target and the signing key are encrypted at rest.
+func (c *Controller) Create(
+	ctx context.Context,
+	tenant *types.Tenant,
+	project *types.Project,
+	createdBy *types.Principal,
+	in *CreateInput,
+) (*types.NotificationChannel, error) {
+	if err := c.sanitizeCreateInput(in); err != nil {
+		return nil, err
+	}
+
+	destination, err := c.encrypter.Encrypt(in.Target)
+	if err != nil {
+		return nil, err
+	}
+	signingKey := []byte{}
+	if in.SigningKey != "" {
+		signingKey, err = c.encrypter.Encrypt(in.SigningKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var projectID *int64
+	if project != nil {
+		projectID = &project.ID
+	}
+
+	now := time.Now().UTC().UnixMilli()
+	channel, err := c.channelStore.Create(ctx, &types.NotificationChannel{
+		UID:         helpers.GenerateUID(),
+		TenantID:    tenant.ID,
+		ProjectID:   projectID,
+		Name:        in.Name,
+		Type:        in.Type,
+		Events:      in.Events,
+		Destination: destination,
+		SigningKey:  signingKey,
+		CreatedBy:   createdBy.ID,
+		Created:     now,
+		Updated:     now,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []audit.Option{audit.WithTenantID(tenant.ID), audit.WithNewObject(channel)}
+	if project != nil {
+		opts = append(opts, audit.WithProjectID(project.ID))
+	}
+	c.auditSvc.Log(ctx, auditResource(channel), enum.AuditActionCreated, opts...)
+	return channel, nil
+}
+
+func (c *Controller) sanitizeCreateInput(in *CreateInput) error {
+	errors := check.NewValidationErrors()
+	if err := check.DisplayName(in.Name); err != nil {
+		errors.AddValidationError("name", err)
+	}
+
+	in.Target = strings.TrimSpace(in.Target)
+	switch enum.NotificationChannelTypeFromString(string(in.Type)) {
+	case enum.NotificationChannelTypeSlack, enum.NotificationChannelTypeDiscord:
+		if err := checkWebhookURL(in.Target); err != nil {
+			errors.AddValidationError("target", err)
+		}
+		in.SigningKey = ""
+	case enum.NotificationChannelTypeWebhook:
+		if err := checkWebhookURL(in.Target); err != nil {
+			errors.AddValidationError("target", err)
+		}
+	case enum.NotificationChannelTypeEmail:
+		if !c.notificationSvc.SupportsEmail() {
+			errors.AddValidationError("type", check.NewValidationError("Email channels require an SMTP server to be configured"))
+		}
+		recipients := notification.SplitRecipients(in.Target)
+		if len(recipients) == 0 {
+			errors.AddValidationError("target", check.NewValidationError("At least one recipient is required"))
+		}
+		for _, r := range recipients {
+			if err := check.Email(r); err != nil {
+				errors.AddValidationError("target", check.NewValidationErrorf("Invalid recipient %s", r))
+				break
+			}
+		}
+		in.Target = strings.Join(recipients, ",")
+		in.SigningKey = ""
+	default:
+		errors.AddValidationError("type", check.NewValidationError("Invalid channel type"))
+	}
+
+	events := []string{}
+	for _, e := range strings.Split(in.Events, ",") {
+		e = strings.TrimSpace(e)
+		if e == "" {
+			continue
+		}
+		if enum.NotificationEventFromString(e) == "" {
+			errors.AddValidationError("events", check.NewValidationErrorf("Invalid event %s", e))
+			break
+		}
+		events = append(events, e)
+	}
+	if len(events) == 0 {
+		errors.AddValidationError("events", check.NewValidationError("Select at least one event"))
+	}
+	in.Events = strings.Join(events, ",")
+
+	if errors.HasError() {
+		return errors
+	}
+	return nil
+}
+
+// checkWebhookURL rejects internal hosts early, names resolving to internal addresses are refused
+// when the notification is delivered.
+func checkWebhookURL(target string) error {
+	u, err := url.Parse(target)
+	if err != nil || u.Host == "" || (u.Scheme != "https" && u.Scheme != "http") {
+		return check.NewValidationError("A valid http or https url is required")
+	}
+	if !cloudhttp.IsPublicHost(u.Hostname()) {
+		return check.NewValidationError("The url must point to a public host")
+	}
+	return nil
+}
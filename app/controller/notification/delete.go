@@ -0,0 +1,28 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+// Delete deletes the channel of the tenant, or of the project when it is not nil.
+func (c *Controller) Delete(ctx context.Context, tenantID int64, project *types.Project, uid int64) error {
+	channel, err := c.find(ctx, tenantID, project, uid)
+	if err != nil {
+		return err
+	}
+
+	if err := c.channelStore.Delete(ctx, tenantID, channel.ID); err != nil {
+		return err
+	}
+
+	opts := []audit.Option{audit.WithTenantID(tenantID), audit.WithOldObject(channel)}
+	if project != nil {
+		opts = append(opts, audit.WithProjectID(project.ID))
+	}
+	c.auditSvc.Log(ctx, auditResource(channel), enum.AuditActionDeleted, opts...)
+	return nil
+}
@@ -0,0 +1,23 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/errors"
+	"github.com/cloudness-io/cloudness/types"
+)
+
+// find finds the channel by uid, channels of other projects, or project channels looked up
+// from the tenant level, are not found.
+func (c *Controller) find(ctx context.Context, tenantID int64, project *types.Project, uid int64) (*types.NotificationChannel, error) {
+	channel, err := c.channelStore.FindByUID(ctx, tenantID, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	if project == nil && channel.ProjectID != nil ||
+		project != nil && (channel.ProjectID == nil || *channel.ProjectID != project.ID) {
+		return nil, errors.NotFound("Notification channel not found")
+	}
+	return channel, nil
+}
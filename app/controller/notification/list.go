@@ -0,0 +1,39 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/types"
+)
+
+// ListTenantLevel lists the channels of the tenant that receive the events of every project.
+func (c *Controller) ListTenantLevel(ctx context.Context, tenantID int64) ([]*types.NotificationChannel, error) {
+	channels, err := c.channelStore.ListTenantLevel(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return c.resolve(channels)
+}
+
+// ListProjectLevel lists the channels scoped to the project.
+func (c *Controller) ListProjectLevel(ctx context.Context, tenantID, projectID int64) ([]*types.NotificationChannel, error) {
+	channels, err := c.channelStore.ListProjectLevel(ctx, tenantID, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return c.resolve(channels)
+}
+
+// SupportsEmail returns true if email channels can be created.
+func (c *Controller) SupportsEmail() bool {
+	return c.notificationSvc.SupportsEmail()
+}
+
+func (c *Controller) resolve(channels []*types.NotificationChannel) ([]*types.NotificationChannel, error) {
+	for _, channel := range channels {
+		if err := c.notificationSvc.Resolve(channel); err != nil {
+			return nil, err
+		}
+	}
+	return channels, nil
+}
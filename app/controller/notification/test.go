@@ -0,0 +1,24 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/app/usererror"
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/rs/zerolog/log"
+)
+
+// SendTest delivers a test message to the channel of the tenant, or of the project when it is not nil.
+// Delivery errors are only logged, they may describe hosts the channel target can reach.
+func (c *Controller) SendTest(ctx context.Context, tenantID int64, project *types.Project, uid int64) error {
+	channel, err := c.find(ctx, tenantID, project, uid)
+	if err != nil {
+		return err
+	}
+	if err := c.notificationSvc.SendTest(ctx, channel); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Int64("channel", channel.ID).Msg("notification: test delivery failed")
+		return usererror.BadRequest("Test notification failed, check the channel target and try again")
+	}
+	return nil
+}
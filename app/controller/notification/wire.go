@@ -0,0 +1,29 @@
+package notification
+
+import (
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	"github.com/cloudness-io/cloudness/app/services/notification"
+	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/encrypt"
+
+	"github.com/google/wire"
+)
+
+// WireSet provides a wire set for this package.
+var WireSet = wire.NewSet(
+	ProvideController,
+)
+
+func ProvideController(
+	channelStore store.NotificationChannelStore,
+	encrypter encrypt.Encrypter,
+	notificationSvc *notification.Service,
+	auditSvc *audit.Service,
+) *Controller {
+	return NewController(
+		channelStore,
+		encrypter,
+		notificationSvc,
+		auditSvc,
+	)
+}
@@ -0,0 +1,22 @@
+package project
+
+import (
+	"strconv"
+
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+func auditResource(project *types.Project) audit.Resource {
+	return audit.NewResource(enum.AuditResourceProject, strconv.FormatInt(project.UID, 10), project.Name)
+}
+
+func auditMemberResource(user *types.User) audit.Resource {
+	return audit.NewResource(enum.AuditResourceProjectMember, strconv.FormatInt(user.ID, 10), user.Email)
+}
+
+// auditMember is the audited state of a project membership.
+type auditMember struct {
+	Role enum.ProjectRole `json:"role"`
+}
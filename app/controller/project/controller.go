@@ -6,6 +6,7 @@ import (
 
 	"github.com/cloudness-io/cloudness/app/controller/environment"
 	"github.com/cloudness-io/cloudness/app/controller/user"
+	"github.com/cloudness-io/cloudness/app/services/audit"
 	"github.com/cloudness-io/cloudness/app/services/config"
 	"github.com/cloudness-io/cloudness/app/sse"
 	"github.com/cloudness-io/cloudness/app/store"
@@ -23,6 +24,7 @@ type Controller struct {
 	projectMembershipStore store.ProjectMembershipStore
 	tenantMembershipStore  store.TenantMembershipStore
 	sseStremer             sse.Streamer
+	auditSvc               *audit.Service
 }
 
 func NewController(tx dbtx.Transactor,
@@ -33,6 +35,7 @@ func NewController(tx dbtx.Transactor,
 	projectMembershipStore store.ProjectMembershipStore,
 	tenantMembershipStore store.TenantMembershipStore,
 	sseStremer sse.Streamer,
+	auditSvc *audit.Service,
 ) *Controller {
 	return &Controller{
 		tx:                     tx,
@@ -43,6 +46,7 @@ func NewController(tx dbtx.Transactor,
 		projectMembershipStore: projectMembershipStore,
 		tenantMembershipStore:  tenantMembershipStore,
 		sseStremer:             sseStremer,
+		auditSvc:               auditSvc,
 	}
 }
 
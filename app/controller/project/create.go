@@ -6,6 +6,7 @@ import (
 
 	"github.com/cloudness-io/cloudness/app/auth"
 	"github.com/cloudness-io/cloudness/app/controller/environment"
+	"github.com/cloudness-io/cloudness/app/services/audit"
 	"github.com/cloudness-io/cloudness/errors"
 	"github.com/cloudness-io/cloudness/helpers"
 	"github.com/cloudness-io/cloudness/types"
@@ -75,6 +76,8 @@ func (c *Controller) Create(ctx context.Context, session *auth.Session, tenant *
 		return nil, err
 	}
 
+	c.auditSvc.Log(ctx, auditResource(project), enum.AuditActionCreated,
+		audit.WithProjectID(project.ID), audit.WithNewObject(project))
 	return project, err
 }
 
@@ -4,7 +4,9 @@ import (
 	"context"
 	"time"
 
+	"github.com/cloudness-io/cloudness/app/services/audit"
 	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
 )
 
 func (c *Controller) SoftDelete(ctx context.Context, project *types.Project) error {
@@ -16,7 +18,13 @@ func (c *Controller) SoftDelete(ctx context.Context, project *types.Project) err
 			return nil
 		}
 
-		return c.envCtrl.SoftDeleteInProject(ctx, project.ID, now)
+		if err := c.envCtrl.SoftDeleteInProject(ctx, project.ID, now); err != nil {
+			return err
+		}
+
+		c.auditSvc.Log(ctx, auditResource(project), enum.AuditActionDeleted,
+			audit.WithProjectID(project.ID), audit.WithOldObject(project))
+		return nil
 	})
 }
 
@@ -12,3 +12,24 @@ func (c *Controller) List(ctx context.Context, tenantID int64, principalID int64
 		PrincipalID: &principalID,
 	})
 }
+
+// ListPaginated lists the projects of the principal in the tenant along with the total count.
+func (c *Controller) ListPaginated(ctx context.Context, tenantID int64, principalID int64, filter types.ListQueryFilter) ([]*types.Project, int64, error) {
+	opts := &types.ProjectFilter{
+		ListQueryFilter: filter,
+		TenantID:        &tenantID,
+		PrincipalID:     &principalID,
+	}
+
+	projects, err := c.projectStore.List(ctx, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := c.projectStore.Count(ctx, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return projects, count, nil
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"github.com/cloudness-io/cloudness/app/auth"
+	"github.com/cloudness-io/cloudness/app/services/audit"
 	"github.com/cloudness-io/cloudness/store"
 	"github.com/cloudness-io/cloudness/types"
 	"github.com/cloudness-io/cloudness/types/check"
@@ -50,7 +51,13 @@ func (c *Controller) AddMember(ctx context.Context, session *auth.Session, tenan
 		},
 		CreatedBy: session.Principal.ID,
 	}
-	return c.projectMembershipStore.Create(ctx, membership)
+	if err := c.projectMembershipStore.Create(ctx, membership); err != nil {
+		return err
+	}
+
+	c.auditSvc.Log(ctx, auditMemberResource(user), enum.AuditActionCreated,
+		audit.WithProjectID(projectID), audit.WithNewObject(&auditMember{Role: in.Role}))
+	return nil
 }
 
 func (c *Controller) UpdateMember(ctx context.Context, tenantID, projetID int64, in *ProjectMembershipUpdateModel) error {
@@ -63,7 +70,21 @@ func (c *Controller) UpdateMember(ctx context.Context, tenantID, projetID int64,
 		return err
 	}
 
-	return c.projectMembershipStore.Update(ctx, tenantID, projetID, user.ID, in.Role)
+	membership, err := c.projectMembershipStore.Find(ctx, tenantID, projetID, user.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := c.projectMembershipStore.Update(ctx, tenantID, projetID, user.ID, in.Role); err != nil {
+		return err
+	}
+
+	c.auditSvc.Log(ctx, auditMemberResource(user), enum.AuditActionRoleChanged,
+		audit.WithProjectID(projetID),
+		audit.WithOldObject(&auditMember{Role: membership.Role}),
+		audit.WithNewObject(&auditMember{Role: in.Role}),
+	)
+	return nil
 }
 
 func (c *Controller) RemoveMember(ctx context.Context, tenantID, projectID int64, in *ProjectMembershipRemoveModel) error {
@@ -76,7 +97,18 @@ func (c *Controller) RemoveMember(ctx context.Context, tenantID, projectID int64
 		return err
 	}
 
-	return c.projectMembershipStore.Delete(ctx, tenantID, projectID, user.ID)
+	membership, err := c.projectMembershipStore.Find(ctx, tenantID, projectID, user.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := c.projectMembershipStore.Delete(ctx, tenantID, projectID, user.ID); err != nil {
+		return err
+	}
+
+	c.auditSvc.Log(ctx, auditMemberResource(user), enum.AuditActionDeleted,
+		audit.WithProjectID(projectID), audit.WithOldObject(&auditMember{Role: membership.Role}))
+	return nil
 }
 
 func (c *Controller) FindMembership(ctx context.Context, tenantID, projectID int64, principalID int64) (*types.ProjectMembership, error) {
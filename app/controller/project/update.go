@@ -3,7 +3,9 @@ package project
 import (
 	"context"
 
+	"github.com/cloudness-io/cloudness/app/services/audit"
 	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
 )
 
 func (c *Controller) Update(ctx context.Context, projectID int64, in *CreateProjectInput) (*types.Project, error) {
@@ -16,8 +18,16 @@ func (c *Controller) Update(ctx context.Context, projectID int64, in *CreateProj
 		return nil, err
 	}
 
+	old := *project
 	project.Name = in.Name
 	project.Description = in.Description
 
-	return c.projectStore.Update(ctx, project)
+	project, err = c.projectStore.Update(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+
+	c.auditSvc.Log(ctx, auditResource(project), enum.AuditActionUpdated,
+		audit.WithOldObject(&old), audit.WithNewObject(project))
+	return project, nil
 }
@@ -3,6 +3,7 @@ package project
 import (
 	"github.com/cloudness-io/cloudness/app/controller/environment"
 	"github.com/cloudness-io/cloudness/app/controller/user"
+	"github.com/cloudness-io/cloudness/app/services/audit"
 	"github.com/cloudness-io/cloudness/app/services/config"
 	"github.com/cloudness-io/cloudness/app/sse"
 	"github.com/cloudness-io/cloudness/app/store"
@@ -24,11 +25,13 @@ func ProviderController(
 	projectMembershipStore store.ProjectMembershipStore,
 	tenantMembershipStore store.TenantMembershipStore,
 	sseStremer sse.Streamer,
+	auditSvc *audit.Service,
 ) *Controller {
 	return NewController(tx,
 		configSvc, userCtrl, envCtrl,
 		projectStore, projectMembershipStore,
 		tenantMembershipStore,
 		sseStremer,
+		auditSvc,
 	)
 }
@@ -0,0 +1,13 @@
+package registrycredential
+
+import (
+	"strconv"
+
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+func auditResource(cred *types.RegistryCredential) audit.Resource {
+	return audit.NewResource(enum.AuditResourceRegistryCredential, strconv.FormatInt(cred.UID, 10), cred.Name)
+}
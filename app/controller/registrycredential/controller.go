@@ -0,0 +1,25 @@
+package registrycredential
+
+import (
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/encrypt"
+)
+
+type Controller struct {
+	registryCredentialStore store.RegistryCredentialStore
+	encrypter               encrypt.Encrypter
+	auditSvc                *audit.Service
+}
+
+func NewController(
+	registryCredentialStore store.RegistryCredentialStore,
+	encrypter encrypt.Encrypter,
+	auditSvc *audit.Service,
+) *Controller {
+	return &Controller{
+		registryCredentialStore: registryCredentialStore,
+		encrypter:               encrypter,
+		auditSvc:                auditSvc,
+	}
+}
@@ -0,0 +1,92 @@
+package registrycredential
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	"github.com/cloudness-io/cloudness/helpers"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/check"
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+// CreateInput is the input used to create a registry credential.
+// Server and Username default to the well known values of the provider when left empty.
+type CreateInput struct {
+	Name     string                `json:"name"`
+	Provider enum.RegistryProvider `json:"provider"`
+	Server   string                `json:"server"`
+	Username string                `json:"username"`
+	Password string                `json:"password"`
+}
+
+// Create creates a registry credential within the tenant, the password is encrypted at rest.
+func (c *Controller) Create(
+	ctx context.Context,
+	tenant *types.Tenant,
+	createdBy *types.Principal,
+	in *CreateInput,
+) (*types.RegistryCredential, error) {
+	if err := c.sanitizeCreateInput(in); err != nil {
+		return nil, err
+	}
+
+	secret, err := c.encrypter.Encrypt(in.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC().UnixMilli()
+	cred, err := c.registryCredentialStore.Create(ctx, &types.RegistryCredential{
+		UID:       helpers.GenerateUID(),
+		TenantID:  tenant.ID,
+		Name:      in.Name,
+		Provider:  in.Provider,
+		Server:    in.Server,
+		Username:  in.Username,
+		Secret:    secret,
+		CreatedBy: createdBy.ID,
+		Created:   now,
+		Updated:   now,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.auditSvc.Log(ctx, auditResource(cred), enum.AuditActionCreated,
+		audit.WithTenantID(tenant.ID), audit.WithNewObject(cred))
+	return cred, nil
+}
+
+func (c *Controller) sanitizeCreateInput(in *CreateInput) error {
+	errors := check.NewValidationErrors()
+	if err := check.DisplayName(in.Name); err != nil {
+		errors.AddValidationError("name", err)
+	}
+
+	if enum.RegistryProviderFromString(string(in.Provider)) == "" {
+		errors.AddValidationError("provider", check.NewValidationError("Invalid registry provider"))
+	}
+	if in.Server == "" {
+		in.Server = in.Provider.DefaultServer()
+	}
+	if in.Username == "" {
+		in.Username = in.Provider.DefaultUsername()
+	}
+
+	if in.Server == "" {
+		errors.AddValidationError("server", check.NewValidationError("Registry server is required"))
+	}
+	if in.Username == "" {
+		errors.AddValidationError("username", check.NewValidationError("Username is required"))
+	}
+	if in.Password == "" {
+		errors.AddValidationError("password", check.NewValidationError("Password or token is required"))
+	}
+
+	if errors.HasError() {
+		return errors
+	}
+	return nil
+}
@@ -0,0 +1,24 @@
+package registrycredential
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+// Delete deletes the registry credential of the tenant.
+func (c *Controller) Delete(ctx context.Context, tenantID int64, uid int64) error {
+	cred, err := c.registryCredentialStore.FindByUID(ctx, tenantID, uid)
+	if err != nil {
+		return err
+	}
+
+	if err := c.registryCredentialStore.Delete(ctx, tenantID, cred.ID); err != nil {
+		return err
+	}
+
+	c.auditSvc.Log(ctx, auditResource(cred), enum.AuditActionDeleted,
+		audit.WithTenantID(tenantID), audit.WithOldObject(cred))
+	return nil
+}
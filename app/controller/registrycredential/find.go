@@ -0,0 +1,26 @@
+package registrycredential
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/types"
+)
+
+// FindByUID finds the registry credential of the tenant by uid.
+func (c *Controller) FindByUID(ctx context.Context, tenantID int64, uid int64) (*types.RegistryCredential, error) {
+	return c.registryCredentialStore.FindByUID(ctx, tenantID, uid)
+}
+
+// Resolve finds the registry credential of the tenant by uid and decrypts its password.
+func (c *Controller) Resolve(ctx context.Context, tenantID int64, uid int64) (*types.RegistryCredential, error) {
+	cred, err := c.registryCredentialStore.FindByUID(ctx, tenantID, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	cred.Password, err = c.encrypter.Decrypt(cred.Secret)
+	if err != nil {
+		return nil, err
+	}
+	return cred, nil
+}
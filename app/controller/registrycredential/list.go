@@ -0,0 +1,12 @@
+package registrycredential
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/types"
+)
+
+// List lists the registry credentials of the tenant.
+func (c *Controller) List(ctx context.Context, tenantID int64) ([]*types.RegistryCredential, error) {
+	return c.registryCredentialStore.List(ctx, tenantID)
+}
@@ -0,0 +1,26 @@
+package registrycredential
+
+import (
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/encrypt"
+
+	"github.com/google/wire"
+)
+
+// WireSet provides a wire set for this package.
+var WireSet = wire.NewSet(
+	ProvideController,
+)
+
+func ProvideController(
+	registryCredentialStore store.RegistryCredentialStore,
+	encrypter encrypt.Encrypter,
+	auditSvc *audit.Service,
+) *Controller {
+	return NewController(
+		registryCredentialStore,
+		encrypter,
+		auditSvc,
+	)
+}
@@ -0,0 +1,32 @@
+package server
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/app/token"
+	"github.com/cloudness-io/cloudness/types"
+)
+
+// Bootstrap queues the installation of the cloudness components on the cluster of the server.
+func (c *Controller) Bootstrap(ctx context.Context, server *types.Server) error {
+	return c.clusterSvc.Bootstrap(ctx, server)
+}
+
+// CheckHealth checks the health of the cluster of the server and returns the updated server.
+func (c *Controller) CheckHealth(ctx context.Context, server *types.Server) (*types.Server, error) {
+	if err := c.clusterSvc.CheckHealth(ctx, server); err != nil {
+		return nil, err
+	}
+	return server, nil
+}
+
+// IssueRunnerToken replaces the runner token of the server and returns it, agents using the
+// previous token are rejected from now on.
+func (c *Controller) IssueRunnerToken(ctx context.Context, server *types.Server) (string, error) {
+	runnerToken, hash := token.GenerateRunnerToken()
+	server.RunnerTokenHash = hash
+	if err := c.serverStore.UpdateRunnerTokenHash(ctx, server); err != nil {
+		return "", err
+	}
+	return runnerToken, nil
+}
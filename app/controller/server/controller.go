@@ -4,11 +4,13 @@ import (
 	"context"
 	"errors"
 
+	"github.com/cloudness-io/cloudness/app/services/cluster"
 	"github.com/cloudness-io/cloudness/app/services/config"
 	"github.com/cloudness-io/cloudness/app/services/dns"
 	"github.com/cloudness-io/cloudness/app/services/manager"
 	"github.com/cloudness-io/cloudness/app/services/proxy"
 	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/encrypt"
 	"github.com/cloudness-io/cloudness/helpers"
 	dbStore "github.com/cloudness-io/cloudness/store"
 	"github.com/cloudness-io/cloudness/store/database/dbtx"
@@ -23,8 +25,11 @@ type Controller struct {
 	configSvc     *config.Service
 	dnsSvc        *dns.Service
 	proxySvc      *proxy.Service
+	clusterSvc    *cluster.Service
 	serverStore   store.ServerStore
 	instanceStore store.InstanceStore
+	envStore      store.EnvironmentStore
+	encrypter     encrypt.Encrypter
 	factory       manager.ManagerFactory
 }
 
@@ -33,8 +38,11 @@ func NewController(
 	configSvc *config.Service,
 	dnsSvc *dns.Service,
 	proxySvc *proxy.Service,
+	clusterSvc *cluster.Service,
 	serverStore store.ServerStore,
 	instanceStore store.InstanceStore,
+	envStore store.EnvironmentStore,
+	encrypter encrypt.Encrypter,
 	factory manager.ManagerFactory,
 ) *Controller {
 	return &Controller{
@@ -42,8 +50,11 @@ func NewController(
 		configSvc:     configSvc,
 		dnsSvc:        dnsSvc,
 		proxySvc:      proxySvc,
+		clusterSvc:    clusterSvc,
 		serverStore:   serverStore,
 		instanceStore: instanceStore,
+		envStore:      envStore,
+		encrypter:     encrypter,
 		factory:       factory,
 	}
 }
@@ -53,13 +64,13 @@ func (c *Controller) resolveServerManager(server *types.Server) (manager.ServerM
 }
 
 func (c *Controller) Init(ctx context.Context) (*types.Server, error) {
-	server, err := c.serverStore.Find(ctx, 1)
+	server, err := c.serverStore.Find(ctx, types.PrimaryServerID)
 	if err != nil && !errors.Is(err, dbStore.ErrResourceNotFound) {
 		return nil, err
 	}
 
 	if server != nil {
-		return server, nil
+		return c.detectMissingSnapshotClass(ctx, server)
 	}
 
 	defaults := c.configSvc.GetKubeServerDefaults()
@@ -77,6 +88,8 @@ func (c *Controller) Init(ctx context.Context) (*types.Server, error) {
 		MaxCPUPerBuild:                1,
 		MaxMemoryPerBuild:             2,
 		VolumeMinSize:                 1024,
+		VolumeSnapshotClass:           defaults.DefaultVolumeSnapshotClass,
+		HealthStatus:                  enum.ServerHealthStatusUnknown,
 	}
 
 	manager, err := c.resolveServerManager(server)
@@ -91,9 +104,31 @@ func (c *Controller) Init(ctx context.Context) (*types.Server, error) {
 
 	server.IPV4 = ip
 
+	if server.VolumeSnapshotClass == "" {
+		server.VolumeSnapshotClass = cluster.DetectVolumeSnapshotClass(ctx, server, manager)
+	}
+
 	return c.serverStore.Create(ctx, server)
 }
 
+// detectMissingSnapshotClass detects the snapshot class of servers created before it was detected
+// on create, the server is returned unchanged when the cluster has none.
+func (c *Controller) detectMissingSnapshotClass(ctx context.Context, server *types.Server) (*types.Server, error) {
+	if server.VolumeSnapshotClass != "" || server.Type != enum.ServerTypeK8s {
+		return server, nil
+	}
+	manager, err := c.resolveServerManager(server)
+	if err != nil {
+		return nil, err
+	}
+	class := cluster.DetectVolumeSnapshotClass(ctx, server, manager)
+	if class == "" {
+		return server, nil
+	}
+	server.VolumeSnapshotClass = class
+	return c.serverStore.Update(ctx, server)
+}
+
 func (c *Controller) findByID(ctx context.Context, serverID int64) (*types.Server, error) {
 	return c.serverStore.Find(ctx, serverID)
 }
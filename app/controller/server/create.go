@@ -0,0 +1,157 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cloudness-io/cloudness/app/services/cluster"
+	"github.com/cloudness-io/cloudness/app/services/manager/kube"
+	"github.com/cloudness-io/cloudness/app/usererror"
+	"github.com/cloudness-io/cloudness/helpers"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/check"
+	"github.com/cloudness-io/cloudness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Create registers an additional cluster or docker host and queues its bootstrap. The kubeconfig is encrypted
+// at rest, token credentials are converted to a kubeconfig first.
+func (c *Controller) Create(ctx context.Context, in *types.ServerCreateInput) (*types.Server, error) {
+	kubeconfig, err := c.sanitizeCreateInput(in)
+	if err != nil {
+		return nil, err
+	}
+
+	var encrypted []byte
+	if kubeconfig != nil {
+		encrypted, err = c.encrypter.Encrypt(string(kubeconfig))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	primary, err := c.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	server, err := c.serverStore.Create(ctx, &types.Server{
+		UID:                           helpers.GenerateUID(),
+		Name:                          in.Name,
+		Description:                   in.Description,
+		Type:                          in.Type,
+		DNSProvider:                   enum.DNSProviderNone,
+		VolumeSupportsOnlineExpansion: primary.VolumeSupportsOnlineExpansion,
+		BuildEnabled:                  true,
+		IsBuildServer:                 false,
+		PollingInterval:               primary.PollingInterval,
+		MaxConcurrentBuilds:           primary.MaxConcurrentBuilds,
+		MaxCPUPerBuild:                primary.MaxCPUPerBuild,
+		MaxMemoryPerBuild:             primary.MaxMemoryPerBuild,
+		VolumeMinSize:                 primary.VolumeMinSize,
+		KubeConfig:                    encrypted,
+		DockerHost:                    in.DockerHost,
+		HealthStatus:                  enum.ServerHealthStatusUnknown,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the ip and the snapshot class are best effort, the cluster may not be reachable yet
+	manager, err := c.resolveServerManager(server)
+	if err != nil {
+		return nil, err
+	}
+	ip, err := manager.GetIP(ctx, server)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Str("server", server.Name).Msg("Unable to find ip address, skipping....")
+	}
+	server.IPV4 = ip
+	server.VolumeSnapshotClass = cluster.DetectVolumeSnapshotClass(ctx, server, manager)
+	if server.IPV4 != "" || server.VolumeSnapshotClass != "" {
+		server, err = c.serverStore.Update(ctx, server)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := c.clusterSvc.Bootstrap(ctx, server); err != nil {
+		return nil, err
+	}
+
+	return server, nil
+}
+
+// sanitizeCreateInput validates the input and returns the kubeconfig of the cluster, docker servers have none.
+func (c *Controller) sanitizeCreateInput(in *types.ServerCreateInput) ([]byte, error) {
+	errors := check.NewValidationErrors()
+	if err := check.DisplayName(in.Name); err != nil {
+		errors.AddValidationError("name", err)
+	}
+	if err := check.Description(in.Description); err != nil {
+		errors.AddValidationError("description", err)
+	}
+
+	if in.Type == "" {
+		in.Type = enum.ServerTypeK8s
+	}
+	switch in.Type {
+	case enum.ServerTypeK8s:
+	case enum.ServerTypeDocker:
+		in.DockerHost = strings.TrimSpace(in.DockerHost)
+		if in.DockerHost != "" && !strings.HasPrefix(in.DockerHost, "tcp://") && !strings.HasPrefix(in.DockerHost, "unix://") {
+			errors.AddValidationError("docker_host", usererror.BadRequest("Docker host must start with tcp:// or unix://"))
+		}
+		if errors.HasError() {
+			return nil, errors
+		}
+		return nil, nil
+	default:
+		errors.AddValidationError("type", usererror.BadRequest("Server type is not supported"))
+		return nil, errors
+	}
+
+	var kubeconfig []byte
+	switch in.CredentialType {
+	case enum.ServerCredentialTypeKubeconfig:
+		if strings.TrimSpace(in.KubeConfig) == "" {
+			errors.AddValidationError("kubeconfig", usererror.BadRequest("Kubeconfig is required"))
+			break
+		}
+		kubeconfig = []byte(in.KubeConfig)
+	case enum.ServerCredentialTypeToken:
+		in.APIServerURL = strings.TrimSpace(in.APIServerURL)
+		if !strings.HasPrefix(in.APIServerURL, "https://") {
+			errors.AddValidationError("api_server_url", usererror.BadRequest("API server url must start with https://"))
+		}
+		if strings.TrimSpace(in.Token) == "" {
+			errors.AddValidationError("token", usererror.BadRequest("Token is required"))
+		}
+		if errors.HasError() {
+			break
+		}
+		var err error
+		kubeconfig, err = kube.TokenKubeConfig(in.Name, in.APIServerURL, in.CACertificate, strings.TrimSpace(in.Token))
+		if err != nil {
+			errors.AddValidationError("token", usererror.BadRequestf("Invalid credentials: %s", err))
+		}
+	default:
+		errors.AddValidationError("credential_type", usererror.BadRequest("Credential type is not supported"))
+	}
+
+	if kubeconfig != nil {
+		if _, err := kube.ParseKubeConfig(kubeconfig); err != nil {
+			field := "kubeconfig"
+			if in.CredentialType == enum.ServerCredentialTypeToken {
+				field = "ca_certificate"
+			}
+			errors.AddValidationError(field, usererror.BadRequestf("Invalid kubeconfig: %s", err))
+		}
+	}
+
+	if errors.HasError() {
+		return nil, errors
+	}
+	return kubeconfig, nil
+}
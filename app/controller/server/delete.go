@@ -0,0 +1,43 @@
+package server
+
+import (
+	"context"
+	"math"
+
+	"github.com/cloudness-io/cloudness/app/usererror"
+	"github.com/cloudness-io/cloudness/types"
+)
+
+// Delete removes an additional cluster from cloudness, the resources on the cluster are left as is.
+// Servers with environments, including environments still being cleaned up, can not be deleted.
+func (c *Controller) Delete(ctx context.Context, server *types.Server) error {
+	if server.IsPrimary() {
+		return usererror.BadRequest("The primary server can not be deleted")
+	}
+
+	active, err := c.envStore.List(ctx, &types.EnvironmentFilter{
+		ListQueryFilter: types.ListQueryFilter{Pagination: types.Pagination{Size: 1}},
+		ServerID:        &server.ID,
+	})
+	if err != nil {
+		return err
+	}
+	if len(active) > 0 {
+		return usererror.Conflict("Delete the environments running on the server first")
+	}
+
+	deletedBefore := int64(math.MaxInt64)
+	deleted, err := c.envStore.List(ctx, &types.EnvironmentFilter{
+		ListQueryFilter:   types.ListQueryFilter{Pagination: types.Pagination{Size: 1}},
+		ServerID:          &server.ID,
+		DeletedBeforeOrAt: &deletedBefore,
+	})
+	if err != nil {
+		return err
+	}
+	if len(deleted) > 0 {
+		return usererror.Conflict("Environments of the server are still being cleaned up, try again later")
+	}
+
+	return c.serverStore.Delete(ctx, server.ID)
+}
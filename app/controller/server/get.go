@@ -6,11 +6,20 @@ import (
 	"github.com/cloudness-io/cloudness/types"
 )
 
-// TODO: end goal is to remove this when we start supporting multiple servers
+// Get returns the primary server, which holds the instance wide settings.
 func (c *Controller) Get(ctx context.Context) (*types.Server, error) {
-	return c.findByID(ctx, 1)
+	return c.findByID(ctx, types.PrimaryServerID)
 }
 
 func (c *Controller) FindByID(ctx context.Context, serverID int64) (*types.Server, error) {
 	return c.findByID(ctx, serverID)
 }
+
+func (c *Controller) FindByUID(ctx context.Context, serverUID int64) (*types.Server, error) {
+	return c.serverStore.FindByUID(ctx, serverUID)
+}
+
+// List lists all servers.
+func (c *Controller) List(ctx context.Context) ([]*types.Server, error) {
+	return c.serverStore.List(ctx)
+}
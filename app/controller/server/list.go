@@ -6,12 +6,7 @@ import (
 	"github.com/cloudness-io/cloudness/types"
 )
 
-func (c *Controller) ListCertificates(ctx context.Context) ([]*types.Certificate, error) {
-	server, err := c.Get(ctx)
-	if err != nil {
-		return nil, err
-	}
-
+func (c *Controller) ListCertificates(ctx context.Context, server *types.Server) ([]*types.Certificate, error) {
 	manageer, err := c.factory.GetServerManager(server)
 	if err != nil {
 		return nil, err
@@ -37,26 +37,22 @@ type ServerBuilderUpdateModel struct {
 }
 
 type ServerLimitsUpdateModel struct {
-	SupportsOnlineExpansion bool  `json:"supports_online_expansion,string"`
-	MinVolumeSize           int64 `json:"min_volume_size,string"`
+	SupportsOnlineExpansion bool   `json:"supports_online_expansion,string"`
+	MinVolumeSize           int64  `json:"min_volume_size,string"`
+	VolumeSnapshotClass     string `json:"volume_snapshot_class"`
 }
 
-func (c *Controller) UpdateGeneral(ctx context.Context, in *ServerGeneralUpdateModel) (*types.Server, error) {
+func (c *Controller) UpdateGeneral(ctx context.Context, server *types.Server, in *ServerGeneralUpdateModel) (*types.Server, error) {
 	if err := c.sanitizeGeneralUpdateModel(in); err != nil {
 		return nil, err
 	}
 
-	server, err := c.Get(ctx)
-	if err != nil {
-		return nil, err
-	}
-
 	server.Name = in.Name
 	server.Description = in.Description
 	return c.serverStore.Update(ctx, server)
 }
 
-func (c *Controller) UpdateNetwork(ctx context.Context, in *ServerNetworkUpdateModel) (*types.Server, error) {
+func (c *Controller) UpdateNetwork(ctx context.Context, server *types.Server, in *ServerNetworkUpdateModel) (*types.Server, error) {
 	if err := c.sanitizeNetworkUpdateModel(in); err != nil {
 		return nil, err
 	}
@@ -64,11 +60,6 @@ func (c *Controller) UpdateNetwork(ctx context.Context, in *ServerNetworkUpdateM
 	//flags
 	doProvisionSSL := false
 
-	server, err := c.Get(ctx)
-	if err != nil {
-		return nil, err
-	}
-
 	instance, err := c.instanceStore.Get(ctx)
 	if err != nil {
 		return nil, err
@@ -139,12 +130,7 @@ func (c *Controller) UpdateNetwork(ctx context.Context, in *ServerNetworkUpdateM
 	return server, nil
 }
 
-func (c *Controller) UpdateBuilder(ctx context.Context, in *ServerBuilderUpdateModel) (*types.Server, error) {
-	server, err := c.Get(ctx)
-	if err != nil {
-		return nil, err
-	}
-
+func (c *Controller) UpdateBuilder(ctx context.Context, server *types.Server, in *ServerBuilderUpdateModel) (*types.Server, error) {
 	if in.Enabled {
 		if err := c.sanitizeBuilderUpdateModel(in); err != nil {
 			return nil, err
@@ -162,24 +148,46 @@ func (c *Controller) UpdateBuilder(ctx context.Context, in *ServerBuilderUpdateM
 	return c.serverStore.Update(ctx, server)
 }
 
-func (c *Controller) UpdateLimits(ctx context.Context, in *ServerLimitsUpdateModel) (*types.Server, error) {
+func (c *Controller) UpdateLimits(ctx context.Context, server *types.Server, in *ServerLimitsUpdateModel) (*types.Server, error) {
 	if in.MinVolumeSize <= 0 {
 		errors := check.NewValidationErrors()
 		errors.AddValidationError("min_volume_size", check.NewValidationError("minimum volume size must be greater than 0"))
 		return nil, errors
 	}
 
-	server, err := c.Get(ctx)
-	if err != nil {
-		return nil, err
+	if in.VolumeSnapshotClass != "" && in.VolumeSnapshotClass != server.VolumeSnapshotClass {
+		if err := c.validateVolumeSnapshotClass(ctx, server, in.VolumeSnapshotClass); err != nil {
+			return nil, err
+		}
 	}
 
 	server.VolumeMinSize = in.MinVolumeSize
 	server.VolumeSupportsOnlineExpansion = in.SupportsOnlineExpansion
+	server.VolumeSnapshotClass = in.VolumeSnapshotClass
 
 	return c.serverStore.Update(ctx, server)
 }
 
+func (c *Controller) validateVolumeSnapshotClass(ctx context.Context, server *types.Server, name string) error {
+	manager, err := c.resolveServerManager(server)
+	if err != nil {
+		return err
+	}
+	classes, err := manager.ListVolumeSnapshotClasses(ctx, server)
+	if err != nil {
+		return err
+	}
+	for _, class := range classes {
+		if class.Name == name {
+			return nil
+		}
+	}
+
+	errors := check.NewValidationErrors()
+	errors.AddValidationError("volume_snapshot_class", check.NewValidationErrorf("VolumeSnapshotClass %s does not exist on the server", name))
+	return errors
+}
+
 func (c *Controller) sanitizeGeneralUpdateModel(in *ServerGeneralUpdateModel) error {
 	errors := check.NewValidationErrors()
 	if err := check.DisplayName(in.Name); err != nil {
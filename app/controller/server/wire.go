@@ -1,11 +1,13 @@
 package server
 
 import (
+	"github.com/cloudness-io/cloudness/app/services/cluster"
 	"github.com/cloudness-io/cloudness/app/services/config"
 	"github.com/cloudness-io/cloudness/app/services/dns"
 	"github.com/cloudness-io/cloudness/app/services/manager"
 	"github.com/cloudness-io/cloudness/app/services/proxy"
 	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/encrypt"
 	"github.com/cloudness-io/cloudness/store/database/dbtx"
 
 	"github.com/google/wire"
@@ -20,8 +22,11 @@ func ProvideController(
 	configSvc *config.Service,
 	dnsSvc *dns.Service,
 	proxySvc *proxy.Service,
+	clusterSvc *cluster.Service,
 	serverStore store.ServerStore,
 	instanceStore store.InstanceStore,
+	envStore store.EnvironmentStore,
+	encrypter encrypt.Encrypter,
 	factory manager.ManagerFactory,
 ) *Controller {
 	return NewController(
@@ -29,8 +34,11 @@ func ProvideController(
 		configSvc,
 		dnsSvc,
 		proxySvc,
+		clusterSvc,
 		serverStore,
 		instanceStore,
+		envStore,
+		encrypter,
 		factory,
 	)
 }
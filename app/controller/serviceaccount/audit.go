@@ -0,0 +1,15 @@
+package serviceaccount
+
+import (
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+func auditResource(sa *types.ServiceAccount) audit.Resource {
+	return audit.NewResource(enum.AuditResourceServiceAccount, sa.UID, sa.DisplayName)
+}
+
+func auditTokenResource(sa *types.ServiceAccount, tkn *types.Token) audit.Resource {
+	return audit.NewResource(enum.AuditResourceToken, tkn.Identifier, sa.UID+"/"+tkn.Identifier)
+}
@@ -0,0 +1,37 @@
+package serviceaccount
+
+import (
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/store/database/dbtx"
+)
+
+type Controller struct {
+	tx                     dbtx.Transactor
+	principalStore         store.PrincipalStore
+	tokenStore             store.TokenStore
+	projectStore           store.ProjectStore
+	tenantMembershipStore  store.TenantMembershipStore
+	projectMembershipStore store.ProjectMembershipStore
+	auditSvc               *audit.Service
+}
+
+func NewController(
+	tx dbtx.Transactor,
+	principalStore store.PrincipalStore,
+	tokenStore store.TokenStore,
+	projectStore store.ProjectStore,
+	tenantMembershipStore store.TenantMembershipStore,
+	projectMembershipStore store.ProjectMembershipStore,
+	auditSvc *audit.Service,
+) *Controller {
+	return &Controller{
+		tx:                     tx,
+		principalStore:         principalStore,
+		tokenStore:             tokenStore,
+		projectStore:           projectStore,
+		tenantMembershipStore:  tenantMembershipStore,
+		projectMembershipStore: projectMembershipStore,
+		auditSvc:               auditSvc,
+	}
+}
@@ -0,0 +1,133 @@
+package serviceaccount
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	"github.com/cloudness-io/cloudness/helpers"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/check"
+	"github.com/cloudness-io/cloudness/types/enum"
+
+	"github.com/dchest/uniuri"
+)
+
+// serviceAccountEmailDomain is used to generate the unique, non routable email of a service account.
+const serviceAccountEmailDomain = "serviceaccount.cloudness.local"
+
+// CreateInput is the input used to create a service account.
+// A service account is scoped to the tenant with TenantRole, or to a single
+// project with ProjectRole when ProjectUID is provided.
+type CreateInput struct {
+	DisplayName string           `json:"name"`
+	TenantRole  enum.TenantRole  `json:"tenant_role"`
+	ProjectUID  int64            `json:"project_uid,string"`
+	ProjectRole enum.ProjectRole `json:"project_role"`
+}
+
+// Create creates a service account within the tenant.
+func (c *Controller) Create(
+	ctx context.Context,
+	tenant *types.Tenant,
+	createdBy *types.Principal,
+	in *CreateInput,
+) (*types.ServiceAccount, error) {
+	if err := c.sanitizeCreateInput(in); err != nil {
+		return nil, err
+	}
+
+	var project *types.Project
+	if in.ProjectUID != 0 {
+		var err error
+		project, err = c.projectStore.FindByUID(ctx, tenant.ID, in.ProjectUID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now().UTC().UnixMilli()
+	uid := helpers.Slugify("sa", in.DisplayName)
+	sa := &types.ServiceAccount{
+		UID:         uid,
+		Email:       fmt.Sprintf("%s@%s", uid, serviceAccountEmailDomain),
+		DisplayName: in.DisplayName,
+		Salt:        uniuri.NewLen(uniuri.UUIDLen),
+		Created:     now,
+		Updated:     now,
+		TenantRole:  in.TenantRole,
+	}
+
+	err := c.tx.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		sa, err = c.principalStore.CreateServiceAccount(ctx, sa)
+		if err != nil {
+			return err
+		}
+
+		err = c.tenantMembershipStore.Create(ctx, &types.TenantMembership{
+			TenantMembershipKey: &types.TenantMembershipKey{
+				TenantID:    tenant.ID,
+				PrincipalID: sa.ID,
+				Role:        in.TenantRole,
+			},
+			CreatedBy: createdBy.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if project == nil {
+			return nil
+		}
+
+		tenantMembership, err := c.tenantMembershipStore.Find(ctx, tenant.ID, sa.ID)
+		if err != nil {
+			return err
+		}
+
+		return c.projectMembershipStore.Create(ctx, &types.ProjectMembership{
+			ProjectMembershipKey: &types.ProjectMembershipKey{
+				TenantID:           tenant.ID,
+				TenantMembershipID: tenantMembership.ID,
+				ProjectID:          project.ID,
+				PrincipalID:        sa.ID,
+				Role:               in.ProjectRole,
+			},
+			CreatedBy: createdBy.ID,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []audit.Option{audit.WithTenantID(tenant.ID), audit.WithNewObject(sa)}
+	if project != nil {
+		opts = append(opts, audit.WithProjectID(project.ID), audit.WithData("project_role", in.ProjectRole))
+	}
+	c.auditSvc.Log(ctx, auditResource(sa), enum.AuditActionCreated, opts...)
+	return sa, nil
+}
+
+func (c *Controller) sanitizeCreateInput(in *CreateInput) error {
+	errors := check.NewValidationErrors()
+	if err := check.DisplayName(in.DisplayName); err != nil {
+		errors.AddValidationError("name", err)
+	}
+
+	if in.ProjectUID != 0 {
+		// project scoped service accounts are plain members of the tenant.
+		in.TenantRole = enum.TenantRoleMember
+		if enum.ProjectRoleFromString(string(in.ProjectRole)) == "" {
+			errors.AddValidationError("project_role", check.NewValidationError("Invalid project role"))
+		}
+	} else if enum.TenantRoleFromString(string(in.TenantRole)) == "" {
+		errors.AddValidationError("tenant_role", check.NewValidationError("Invalid tenant role"))
+	}
+
+	if errors.HasError() {
+		return errors
+	}
+	return nil
+}
@@ -0,0 +1,24 @@
+package serviceaccount
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+// Delete deletes the service account of the tenant along with its memberships and tokens.
+func (c *Controller) Delete(ctx context.Context, tenantID int64, uid string) error {
+	sa, err := c.principalStore.FindServiceAccountByUID(ctx, tenantID, uid)
+	if err != nil {
+		return err
+	}
+
+	if err := c.principalStore.DeleteServiceAccount(ctx, sa.ID); err != nil {
+		return err
+	}
+
+	c.auditSvc.Log(ctx, auditResource(sa), enum.AuditActionDeleted,
+		audit.WithTenantID(tenantID), audit.WithOldObject(sa))
+	return nil
+}
@@ -0,0 +1,12 @@
+package serviceaccount
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/types"
+)
+
+// FindByUID finds the service account of the tenant by uid.
+func (c *Controller) FindByUID(ctx context.Context, tenantID int64, uid string) (*types.ServiceAccount, error) {
+	return c.principalStore.FindServiceAccountByUID(ctx, tenantID, uid)
+}
@@ -0,0 +1,12 @@
+package serviceaccount
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/types"
+)
+
+// List lists the service accounts of the tenant.
+func (c *Controller) List(ctx context.Context, tenantID int64) ([]*types.ServiceAccount, error) {
+	return c.principalStore.ListServiceAccounts(ctx, tenantID)
+}
@@ -0,0 +1,76 @@
+package serviceaccount
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	"github.com/cloudness-io/cloudness/app/token"
+	"github.com/cloudness-io/cloudness/store"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+// CreateTokenInput is the input used to mint a service account token.
+type CreateTokenInput = token.CreateInput
+
+// CreateToken mints a new token for the service account.
+func (c *Controller) CreateToken(
+	ctx context.Context,
+	tenantID int64,
+	createdBy *types.Principal,
+	uid string,
+	in *CreateTokenInput,
+) (*types.TokenResponse, error) {
+	if err := token.SanitizeCreateInput(in); err != nil {
+		return nil, err
+	}
+
+	sa, err := c.principalStore.FindServiceAccountByUID(ctx, tenantID, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	tkn, jwt, err := token.CreateSAT(ctx, c.tokenStore, createdBy, sa, in.Identifier, in.Lifetime())
+	if err != nil {
+		return nil, err
+	}
+
+	c.auditSvc.Log(ctx, auditTokenResource(sa, tkn), enum.AuditActionCreated,
+		audit.WithTenantID(tenantID), audit.WithNewObject(tkn))
+	return &types.TokenResponse{Token: *tkn, AccessToken: jwt}, nil
+}
+
+// ListTokens lists the tokens of the service account.
+func (c *Controller) ListTokens(ctx context.Context, tenantID int64, uid string) ([]*types.Token, error) {
+	sa, err := c.principalStore.FindServiceAccountByUID(ctx, tenantID, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.tokenStore.List(ctx, sa.ID, enum.TokenTypeSAT)
+}
+
+// DeleteToken revokes the token of the service account with the given identifier.
+func (c *Controller) DeleteToken(ctx context.Context, tenantID int64, uid string, identifier string) error {
+	sa, err := c.principalStore.FindServiceAccountByUID(ctx, tenantID, uid)
+	if err != nil {
+		return err
+	}
+
+	tkn, err := c.tokenStore.FindByIdentifier(ctx, sa.ID, identifier)
+	if err != nil {
+		return err
+	}
+
+	if tkn.Type != enum.TokenTypeSAT {
+		return store.ErrResourceNotFound
+	}
+
+	if err := c.tokenStore.Delete(ctx, tkn.ID); err != nil {
+		return err
+	}
+
+	c.auditSvc.Log(ctx, auditTokenResource(sa, tkn), enum.AuditActionDeleted,
+		audit.WithTenantID(tenantID), audit.WithOldObject(tkn))
+	return nil
+}
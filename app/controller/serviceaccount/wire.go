@@ -0,0 +1,34 @@
+package serviceaccount
+
+import (
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/store/database/dbtx"
+
+	"github.com/google/wire"
+)
+
+// WireSet provides a wire set for this package.
+var WireSet = wire.NewSet(
+	ProvideController,
+)
+
+func ProvideController(
+	tx dbtx.Transactor,
+	principalStore store.PrincipalStore,
+	tokenStore store.TokenStore,
+	projectStore store.ProjectStore,
+	tenantMembershipStore store.TenantMembershipStore,
+	projectMembershipStore store.ProjectMembershipStore,
+	auditSvc *audit.Service,
+) *Controller {
+	return NewController(
+		tx,
+		principalStore,
+		tokenStore,
+		projectStore,
+		tenantMembershipStore,
+		projectMembershipStore,
+		auditSvc,
+	)
+}
@@ -0,0 +1,17 @@
+package snapshot
+
+import (
+	"strconv"
+
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+func auditResource(snapshot *types.VolumeSnapshot) audit.Resource {
+	return audit.NewResource(enum.AuditResourceVolumeSnapshot, strconv.FormatInt(snapshot.UID, 10), snapshot.Name)
+}
+
+func auditPolicyResource(volume *types.Volume) audit.Resource {
+	return audit.NewResource(enum.AuditResourceSnapshotPolicy, strconv.FormatInt(volume.UID, 10), volume.Name)
+}
@@ -0,0 +1,47 @@
+package snapshot
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/app/controller/server"
+	"github.com/cloudness-io/cloudness/app/controller/volume"
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	snapshotSvc "github.com/cloudness-io/cloudness/app/services/snapshot"
+	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/store/database/dbtx"
+	"github.com/cloudness-io/cloudness/types"
+)
+
+type Controller struct {
+	tx            dbtx.Transactor
+	snapshotStore store.VolumeSnapshotStore
+	policyStore   store.VolumeSnapshotPolicyStore
+	snapshotSvc   *snapshotSvc.Service
+	volumeCtrl    *volume.Controller
+	serverCtrl    *server.Controller
+	auditSvc      *audit.Service
+}
+
+func NewController(
+	tx dbtx.Transactor,
+	snapshotStore store.VolumeSnapshotStore,
+	policyStore store.VolumeSnapshotPolicyStore,
+	snapshotSvc *snapshotSvc.Service,
+	volumeCtrl *volume.Controller,
+	serverCtrl *server.Controller,
+	auditSvc *audit.Service,
+) *Controller {
+	return &Controller{
+		tx:            tx,
+		snapshotStore: snapshotStore,
+		policyStore:   policyStore,
+		snapshotSvc:   snapshotSvc,
+		volumeCtrl:    volumeCtrl,
+		serverCtrl:    serverCtrl,
+		auditSvc:      auditSvc,
+	}
+}
+
+func (c *Controller) findByUID(ctx context.Context, volumeID, snapshotUID int64) (*types.VolumeSnapshot, error) {
+	return c.snapshotStore.FindByUID(ctx, volumeID, snapshotUID)
+}
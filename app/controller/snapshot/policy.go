@@ -0,0 +1,62 @@
+package snapshot
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	snapshotSvc "github.com/cloudness-io/cloudness/app/services/snapshot"
+	baseStore "github.com/cloudness-io/cloudness/store"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+const (
+	defaultSchedule  = "0 3 * * *" // every day at 03:00
+	defaultRetention = 7
+)
+
+// GetPolicy returns the snapshot policy of the volume, a disabled default when none was saved.
+func (c *Controller) GetPolicy(ctx context.Context, volume *types.Volume) (*types.VolumeSnapshotPolicy, error) {
+	policy, err := c.policyStore.Find(ctx, volume.ID)
+	if baseStore.IsNotFound(err) {
+		return &types.VolumeSnapshotPolicy{
+			VolumeID:  volume.ID,
+			Schedule:  defaultSchedule,
+			Retention: defaultRetention,
+		}, nil
+	}
+	return policy, err
+}
+
+// UpdatePolicy saves the snapshot policy of the volume.
+func (c *Controller) UpdatePolicy(ctx context.Context, volume *types.Volume, in *types.VolumeSnapshotPolicyInput) (*types.VolumeSnapshotPolicy, error) {
+	if err := snapshotSvc.ValidatePolicy(in); err != nil {
+		return nil, err
+	}
+
+	old, err := c.GetPolicy(ctx, volume)
+	if err != nil {
+		return nil, err
+	}
+
+	nextRun, err := snapshotSvc.NextRun(in.Schedule, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	policy := *old
+	policy.Enabled = in.Enabled
+	policy.Schedule = in.Schedule
+	policy.Retention = in.Retention
+	policy.NextRun = nextRun
+
+	updated, err := c.policyStore.Upsert(ctx, &policy)
+	if err != nil {
+		return nil, err
+	}
+
+	c.auditSvc.Log(ctx, auditPolicyResource(volume), enum.AuditActionUpdated,
+		audit.WithOldObject(old.ToInput()), audit.WithNewObject(updated.ToInput()))
+	return updated, nil
+}
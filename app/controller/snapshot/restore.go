@@ -0,0 +1,53 @@
+package snapshot
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+const mib = 1024 * 1024
+
+// Restore creates a new unattached volume in the environment from the snapshot, the volume can
+// then be attached to any application of the environment.
+func (c *Controller) Restore(
+	ctx context.Context,
+	tenant *types.Tenant,
+	project *types.Project,
+	environment *types.Environment,
+	src *types.Volume,
+	snapshot *types.VolumeSnapshot,
+	in *types.VolumeSnapshotRestoreInput,
+) (*types.Volume, error) {
+	server, err := c.serverCtrl.FindByID(ctx, src.ServerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the claim can not be smaller than the snapshot
+	size := max(src.Size, (snapshot.Size+mib-1)/mib)
+
+	var volume *types.Volume
+	err = c.tx.WithTx(ctx, func(ctx context.Context) error {
+		volume, err = c.volumeCtrl.Create(ctx, tenant, project, environment, nil, &types.VolumeCreateInput{
+			Name:      in.Name,
+			MountPath: src.MountPath,
+			Size:      size,
+			Server:    server,
+		})
+		if err != nil {
+			return err
+		}
+
+		return c.snapshotSvc.Restore(ctx, snapshot, volume)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.auditSvc.Log(ctx, auditResource(snapshot), enum.AuditActionRestored,
+		audit.WithData("volume", volume.Name))
+	return volume, nil
+}
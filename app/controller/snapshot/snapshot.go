@@ -0,0 +1,54 @@
+package snapshot
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	"github.com/cloudness-io/cloudness/errors"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+// IsEnabled returns true if the server of the volume has a VolumeSnapshotClass to take snapshots with.
+func (c *Controller) IsEnabled(ctx context.Context, volume *types.Volume) (bool, error) {
+	server, err := c.serverCtrl.FindByID(ctx, volume.ServerID)
+	if err != nil {
+		return false, err
+	}
+	return server.SupportsVolumeSnapshots(), nil
+}
+
+// List lists the snapshots of the volume, newest first.
+func (c *Controller) List(ctx context.Context, volume *types.Volume) ([]*types.VolumeSnapshot, error) {
+	return c.snapshotStore.List(ctx, volume.ID)
+}
+
+// Get finds the snapshot of the volume by uid.
+func (c *Controller) Get(ctx context.Context, volume *types.Volume, snapshotUID int64) (*types.VolumeSnapshot, error) {
+	return c.findByUID(ctx, volume.ID, snapshotUID)
+}
+
+// Start takes a manual snapshot of the volume.
+func (c *Controller) Start(ctx context.Context, actor string, volume *types.Volume) (*types.VolumeSnapshot, error) {
+	snapshot, err := c.snapshotSvc.Start(ctx, volume, enum.VolumeSnapshotTriggerManual, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	c.auditSvc.Log(ctx, auditResource(snapshot), enum.AuditActionCreated, audit.WithNewObject(snapshot))
+	return snapshot, nil
+}
+
+// Delete deletes a snapshot that is no longer being taken.
+func (c *Controller) Delete(ctx context.Context, snapshot *types.VolumeSnapshot) error {
+	if !snapshot.Status.IsDone() {
+		return errors.PreconditionFailed("The snapshot is still being taken")
+	}
+
+	if err := c.snapshotSvc.Delete(ctx, snapshot); err != nil {
+		return err
+	}
+
+	c.auditSvc.Log(ctx, auditResource(snapshot), enum.AuditActionDeleted, audit.WithOldObject(snapshot))
+	return nil
+}
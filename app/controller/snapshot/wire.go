@@ -0,0 +1,28 @@
+package snapshot
+
+import (
+	"github.com/cloudness-io/cloudness/app/controller/server"
+	"github.com/cloudness-io/cloudness/app/controller/volume"
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	snapshotSvc "github.com/cloudness-io/cloudness/app/services/snapshot"
+	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/store/database/dbtx"
+
+	"github.com/google/wire"
+)
+
+var WireSet = wire.NewSet(
+	ProvideController,
+)
+
+func ProvideController(
+	tx dbtx.Transactor,
+	snapshotStore store.VolumeSnapshotStore,
+	policyStore store.VolumeSnapshotPolicyStore,
+	snapshotSvc *snapshotSvc.Service,
+	volumeCtrl *volume.Controller,
+	serverCtrl *server.Controller,
+	auditSvc *audit.Service,
+) *Controller {
+	return NewController(tx, snapshotStore, policyStore, snapshotSvc, volumeCtrl, serverCtrl, auditSvc)
+}
@@ -6,6 +6,6 @@ import (
 	"github.com/cloudness-io/cloudness/types"
 )
 
-func (c *Controller) FindByID(ctx context.Context, id int64) (*types.Template, error) {
-	return c.templateStore.Find(ctx, id)
+func (c *Controller) FindByID(ctx context.Context, tenantID int64, id int64) (*types.Template, error) {
+	return c.templateStore.Find(ctx, tenantID, id)
 }
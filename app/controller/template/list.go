@@ -6,16 +6,16 @@ import (
 	"github.com/cloudness-io/cloudness/types"
 )
 
-var databaseSlugs = []string{"postgres", "mysql", "valkey", "redis"}
+var databaseSlugs = []string{"postgres", "postgres-ha", "mysql", "valkey", "redis"}
 
-func (c *Controller) List(ctx context.Context) ([]*types.Template, error) {
-	return c.templateStore.List(ctx)
+func (c *Controller) List(ctx context.Context, tenantID int64) ([]*types.Template, error) {
+	return c.templateStore.List(ctx, tenantID)
 }
 
-func (c *Controller) ListDatabase(ctx context.Context) ([]*types.Template, error) {
-	return c.templateStore.ListByTag(ctx, "database")
+func (c *Controller) ListDatabase(ctx context.Context, tenantID int64) ([]*types.Template, error) {
+	return c.templateStore.ListByTag(ctx, tenantID, "database")
 }
 
-func (c *Controller) ListTemplates(ctx context.Context) ([]*types.Template, error) {
-	return c.templateStore.ListNotInSlugs(ctx, databaseSlugs)
+func (c *Controller) ListTemplates(ctx context.Context, tenantID int64) ([]*types.Template, error) {
+	return c.templateStore.ListNotInSlugs(ctx, tenantID, databaseSlugs)
 }
@@ -0,0 +1,23 @@
+package templatesource
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+func auditResource(source *types.TemplateSource) audit.Resource {
+	return audit.NewResource(enum.AuditResourceTemplateSource, strconv.FormatInt(source.UID, 10), source.Name)
+}
+
+// auditLog records the event of a template source of a tenant, the audit log has no instance scope.
+func (c *Controller) auditLog(ctx context.Context, source *types.TemplateSource, action enum.AuditAction, opts ...audit.Option) {
+	if source.IsInstanceLevel() {
+		return
+	}
+	opts = append(opts, audit.WithTenantID(*source.TenantID))
+	c.auditSvc.Log(ctx, auditResource(source), action, opts...)
+}
@@ -0,0 +1,36 @@
+package templatesource
+
+import (
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	"github.com/cloudness-io/cloudness/app/services/gitconnection"
+	"github.com/cloudness-io/cloudness/app/services/templatesource"
+	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/encrypt"
+)
+
+type Controller struct {
+	sourceStore       store.TemplateSourceStore
+	templateStore     store.TemplateStore
+	encrypter         encrypt.Encrypter
+	templateSourceSvc *templatesource.Service
+	gitConnectionSvc  *gitconnection.Service
+	auditSvc          *audit.Service
+}
+
+func NewController(
+	sourceStore store.TemplateSourceStore,
+	templateStore store.TemplateStore,
+	encrypter encrypt.Encrypter,
+	templateSourceSvc *templatesource.Service,
+	gitConnectionSvc *gitconnection.Service,
+	auditSvc *audit.Service,
+) *Controller {
+	return &Controller{
+		sourceStore:       sourceStore,
+		templateStore:     templateStore,
+		encrypter:         encrypter,
+		templateSourceSvc: templateSourceSvc,
+		gitConnectionSvc:  gitConnectionSvc,
+		auditSvc:          auditSvc,
+	}
+}
@@ -0,0 +1,166 @@
+package templatesource
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	"github.com/cloudness-io/cloudness/helpers"
+	cloudhttp "github.com/cloudness-io/cloudness/http"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/check"
+	"github.com/cloudness-io/cloudness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+const defaultBranch = "main"
+
+// CreateInput is the input used to create a template source.
+// URL is the repository of git sources, or the url of the index of https sources.
+// GitConnectionUID is the optional git connection reading the repository, a public github repository
+// is required without one.
+// Token is the optional bearer token sent with the requests of https sources.
+type CreateInput struct {
+	Name             string                  `json:"name"`
+	Type             enum.TemplateSourceType `json:"type"`
+	URL              string                  `json:"url"`
+	GitConnectionUID int64                   `json:"git_connection_uid,string"`
+	Branch           string                  `json:"branch"`
+	Path             string                  `json:"path"`
+	Token            string                  `json:"token"`
+}
+
+// Create creates a template source of the tenant, or of the instance when the tenant is nil,
+// and queues its first sync. The token is encrypted at rest.
+// Team sources only use the git connections of the team and only reach public hosts, instance sources
+// may use the git connection of any team and reach private hosts.
+func (c *Controller) Create(
+	ctx context.Context,
+	tenant *types.Tenant,
+	createdBy *types.Principal,
+	in *CreateInput,
+) (*types.TemplateSource, error) {
+	conn, err := c.sanitizeCreateInput(ctx, tenant, in)
+	if err != nil {
+		return nil, err
+	}
+
+	token := []byte{}
+	if in.Token != "" {
+		token, err = c.encrypter.Encrypt(in.Token)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var tenantID *int64
+	if tenant != nil {
+		tenantID = &tenant.ID
+	}
+	var connID *int64
+	if conn != nil {
+		connID = &conn.ID
+	}
+
+	now := time.Now().UTC().UnixMilli()
+	source, err := c.sourceStore.Create(ctx, &types.TemplateSource{
+		UID:             helpers.GenerateUID(),
+		TenantID:        tenantID,
+		Name:            in.Name,
+		Type:            in.Type,
+		URL:             in.URL,
+		Branch:          in.Branch,
+		Path:            in.Path,
+		Token:           token,
+		GitConnectionID: connID,
+		CreatedBy:       createdBy.ID,
+		Created:         now,
+		Updated:         now,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.templateSourceSvc.Sync(ctx, source); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Str("template_source", source.Name).Msg("failed to queue the first sync of the template source")
+	}
+
+	c.auditLog(ctx, source, enum.AuditActionCreated, audit.WithNewObject(source))
+	return source, nil
+}
+
+func (c *Controller) sanitizeCreateInput(ctx context.Context, tenant *types.Tenant, in *CreateInput) (*types.GitConnection, error) {
+	var conn *types.GitConnection
+	errors := check.NewValidationErrors()
+	if err := check.DisplayName(in.Name); err != nil {
+		errors.AddValidationError("name", err)
+	}
+
+	in.URL = strings.TrimSpace(in.URL)
+	in.Token = strings.TrimSpace(in.Token)
+	switch enum.TemplateSourceTypeFromString(string(in.Type)) {
+	case enum.TemplateSourceTypeGit:
+		in.URL = helpers.SanitizeGitUrl(in.URL)
+		if in.GitConnectionUID != 0 {
+			var err error
+			conn, err = c.findGitConnection(ctx, tenant, in.GitConnectionUID)
+			if err != nil {
+				return nil, err
+			}
+			if conn == nil {
+				errors.AddValidationError("git_connection_uid", check.NewValidationError("Git connection not found"))
+			} else if conn.RepoFullName(in.URL) == "" {
+				errors.AddValidationError("url", check.NewValidationError("A repository url of the git connection is required"))
+			}
+		} else if _, _, err := helpers.SplitGitRepoUrl(in.URL); err != nil || !strings.HasPrefix(in.URL, "https://github.com/") {
+			errors.AddValidationError("url", check.NewValidationError("A public github repository url is required"))
+		}
+		in.Branch = strings.TrimSpace(in.Branch)
+		if in.Branch == "" {
+			in.Branch = defaultBranch
+		}
+		in.Path = strings.Trim(strings.TrimSpace(in.Path), "/")
+		in.Token = ""
+	case enum.TemplateSourceTypeHTTPS:
+		u, err := url.Parse(in.URL)
+		if err != nil || u.Host == "" || u.Scheme != "https" {
+			errors.AddValidationError("url", check.NewValidationError("A valid https url is required"))
+		} else if tenant != nil && !cloudhttp.IsPublicHost(u.Hostname()) {
+			errors.AddValidationError("url", check.NewValidationError("The url must point to a public host"))
+		}
+		in.Branch = ""
+		in.Path = ""
+		in.GitConnectionUID = 0
+	default:
+		errors.AddValidationError("type", check.NewValidationError("Invalid source type"))
+	}
+
+	if errors.HasError() {
+		return nil, errors
+	}
+	return conn, nil
+}
+
+// findGitConnection finds the git connection of the team by uid, or of any team for instance sources,
+// nil is returned when there is no single match.
+func (c *Controller) findGitConnection(ctx context.Context, tenant *types.Tenant, uid int64) (*types.GitConnection, error) {
+	conns, err := c.gitConnectionSvc.ListByUID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *types.GitConnection
+	for _, conn := range conns {
+		if tenant != nil && conn.TenantID != tenant.ID {
+			continue
+		}
+		if found != nil {
+			return nil, nil
+		}
+		found = conn
+	}
+	return found, nil
+}
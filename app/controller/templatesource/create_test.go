@@ -0,0 +1,108 @@
+package templatesource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudness-io/cloudness/app/services/gitconnection"
+	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+// fakeGitConnectionStore serves a gitlab connection of tenant 1 and a gitea connection of tenant 2.
+type fakeGitConnectionStore struct {
+	store.GitConnectionStore
+}
+
+func (s *fakeGitConnectionStore) ListByUID(_ context.Context, uid int64) ([]*types.GitConnection, error) {
+	conns := []*types.GitConnection{
+		{ID: 11, UID: 100, TenantID: 1, Provider: enum.GitLabProvider, ServerURL: "https://gitlab.acme.internal"},
+		{ID: 12, UID: 200, TenantID: 2, Provider: enum.GiteaProvider, ServerURL: "https://gitea.acme.internal"},
+	}
+	matches := []*types.GitConnection{}
+	for _, conn := range conns {
+		if conn.UID == uid {
+			matches = append(matches, conn)
+		}
+	}
+	return matches, nil
+}
+
+func TestSanitizeCreateInput(t *testing.T) {
+	c := NewController(nil, nil, nil, nil, gitconnection.New(&fakeGitConnectionStore{}, nil), nil)
+	team := &types.Tenant{ID: 1}
+
+	tests := []struct {
+		name     string
+		tenant   *types.Tenant
+		in       *CreateInput
+		wantConn int64
+		wantErr  bool
+	}{
+		{
+			name:   "public github repository",
+			tenant: team,
+			in:     &CreateInput{Name: "catalog", Type: enum.TemplateSourceTypeGit, URL: "https://github.com/acme/templates"},
+		},
+		{
+			name:    "private repository without connection",
+			tenant:  team,
+			in:      &CreateInput{Name: "catalog", Type: enum.TemplateSourceTypeGit, URL: "https://gitlab.acme.internal/platform/templates"},
+			wantErr: true,
+		},
+		{
+			name:     "repository of a connection of the team",
+			tenant:   team,
+			in:       &CreateInput{Name: "catalog", Type: enum.TemplateSourceTypeGit, URL: "https://gitlab.acme.internal/platform/stack/templates", GitConnectionUID: 100},
+			wantConn: 11,
+		},
+		{
+			name:    "repository of another server",
+			tenant:  team,
+			in:      &CreateInput{Name: "catalog", Type: enum.TemplateSourceTypeGit, URL: "https://github.com/acme/templates", GitConnectionUID: 100},
+			wantErr: true,
+		},
+		{
+			name:    "connection of another team",
+			tenant:  team,
+			in:      &CreateInput{Name: "catalog", Type: enum.TemplateSourceTypeGit, URL: "https://gitea.acme.internal/platform/templates", GitConnectionUID: 200},
+			wantErr: true,
+		},
+		{
+			name:     "instance source with a connection of any team",
+			in:       &CreateInput{Name: "catalog", Type: enum.TemplateSourceTypeGit, URL: "https://gitea.acme.internal/platform/templates", GitConnectionUID: 200},
+			wantConn: 12,
+		},
+		{
+			name:    "private index of a team source",
+			tenant:  team,
+			in:      &CreateInput{Name: "catalog", Type: enum.TemplateSourceTypeHTTPS, URL: "https://10.0.0.5/index.yaml"},
+			wantErr: true,
+		},
+		{
+			name: "private index of an instance source",
+			in:   &CreateInput{Name: "catalog", Type: enum.TemplateSourceTypeHTTPS, URL: "https://10.0.0.5/index.yaml"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			conn, err := c.sanitizeCreateInput(context.Background(), tc.tenant, tc.in)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected the input to be rejected")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("sanitize: %v", err)
+			}
+			if tc.wantConn == 0 && conn != nil {
+				t.Errorf("connection = %d, want none", conn.ID)
+			}
+			if tc.wantConn != 0 && (conn == nil || conn.ID != tc.wantConn) {
+				t.Errorf("connection = %v, want %d", conn, tc.wantConn)
+			}
+		})
+	}
+}
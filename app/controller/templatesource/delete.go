@@ -0,0 +1,25 @@
+package templatesource
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+// Delete deletes the template source of the tenant, or of the instance when the tenant is nil,
+// with the templates synced from it.
+func (c *Controller) Delete(ctx context.Context, tenant *types.Tenant, uid int64) error {
+	source, err := c.Find(ctx, tenant, uid)
+	if err != nil {
+		return err
+	}
+
+	if err := c.sourceStore.Delete(ctx, source.ID); err != nil {
+		return err
+	}
+
+	c.auditLog(ctx, source, enum.AuditActionDeleted, audit.WithOldObject(source))
+	return nil
+}
@@ -0,0 +1,23 @@
+package templatesource
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/errors"
+	"github.com/cloudness-io/cloudness/types"
+)
+
+// Find finds the template source of the tenant by uid, or the instance level source when the tenant
+// is nil. Sources of other tenants, or tenant sources looked up from the instance level, are not found.
+func (c *Controller) Find(ctx context.Context, tenant *types.Tenant, uid int64) (*types.TemplateSource, error) {
+	source, err := c.sourceStore.FindByUID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	if tenant == nil && source.TenantID != nil ||
+		tenant != nil && (source.TenantID == nil || *source.TenantID != tenant.ID) {
+		return nil, errors.NotFound("Template source not found")
+	}
+	return source, nil
+}
@@ -0,0 +1,44 @@
+package templatesource
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/types"
+)
+
+// ListInstanceLevel lists the template sources available to every tenant.
+func (c *Controller) ListInstanceLevel(ctx context.Context) ([]*types.TemplateSource, error) {
+	return c.sourceStore.ListInstanceLevel(ctx)
+}
+
+// ListTenantLevel lists the template sources of the tenant.
+func (c *Controller) ListTenantLevel(ctx context.Context, tenantID int64) ([]*types.TemplateSource, error) {
+	return c.sourceStore.ListTenantLevel(ctx, tenantID)
+}
+
+// ListTemplates lists the templates synced from the template source.
+func (c *Controller) ListTemplates(ctx context.Context, source *types.TemplateSource) ([]*types.Template, error) {
+	return c.templateStore.ListBySource(ctx, source.ID)
+}
+
+// ListTemplatesBySource lists the templates synced from each of the template sources, keyed by source id.
+func (c *Controller) ListTemplatesBySource(ctx context.Context, sources []*types.TemplateSource) (map[int64][]*types.Template, error) {
+	templates := make(map[int64][]*types.Template, len(sources))
+	for _, source := range sources {
+		tmpls, err := c.templateStore.ListBySource(ctx, source.ID)
+		if err != nil {
+			return nil, err
+		}
+		templates[source.ID] = tmpls
+	}
+	return templates, nil
+}
+
+// ListGitConnections lists the git connections a source of the tenant can read its repository with,
+// the git connections of every tenant for instance sources when the tenant is nil.
+func (c *Controller) ListGitConnections(ctx context.Context, tenant *types.Tenant) ([]*types.GitConnection, error) {
+	if tenant == nil {
+		return c.gitConnectionSvc.ListAll(ctx)
+	}
+	return c.gitConnectionSvc.ListByTenant(ctx, tenant.ID)
+}
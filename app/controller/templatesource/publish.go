@@ -0,0 +1,88 @@
+package templatesource
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	"github.com/cloudness-io/cloudness/app/services/spec"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+// VersionPreview is a version of a template with its diff from the published version.
+type VersionPreview struct {
+	Version *types.TemplateVersion
+	Diff    []*spec.DiffLine
+}
+
+// Preview finds the template of the template source with its versions, newest first, each diffed
+// from the published version.
+func (c *Controller) Preview(
+	ctx context.Context,
+	source *types.TemplateSource,
+	templateID int64,
+) (*types.Template, []*VersionPreview, error) {
+	tmpl, err := c.templateStore.FindBySource(ctx, source.ID, templateID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	versions, err := c.templateStore.ListVersions(ctx, tmpl.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	published := new(types.TemplateSpec)
+	if err := json.Unmarshal([]byte(tmpl.SpecJson), published); err != nil {
+		return nil, nil, err
+	}
+
+	previews := make([]*VersionPreview, 0, len(versions))
+	for _, version := range versions {
+		versionSpec := new(types.TemplateSpec)
+		if err := json.Unmarshal([]byte(version.SpecJson), versionSpec); err != nil {
+			return nil, nil, err
+		}
+		diff, err := spec.Diff(published, versionSpec)
+		if err != nil {
+			return nil, nil, err
+		}
+		previews = append(previews, &VersionPreview{Version: version, Diff: diff})
+	}
+	return tmpl, previews, nil
+}
+
+// Publish makes the version of the template of the template source the one new applications are created from.
+func (c *Controller) Publish(ctx context.Context, source *types.TemplateSource, templateID int64, number int64) (*types.Template, error) {
+	tmpl, err := c.templateStore.FindBySource(ctx, source.ID, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := c.templateStore.FindVersion(ctx, tmpl.ID, number)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := new(types.TemplateSpec)
+	if err := json.Unmarshal([]byte(version.SpecJson), spec); err != nil {
+		return nil, err
+	}
+	published, err := spec.ToTemplate()
+	if err != nil {
+		return nil, err
+	}
+	published.ID = tmpl.ID
+	published.Slug = tmpl.Slug
+	published.SourceID = tmpl.SourceID
+	published.Version = version.Number
+	published.LatestVersion = tmpl.LatestVersion
+
+	if err := c.templateStore.Publish(ctx, published); err != nil {
+		return nil, err
+	}
+
+	c.auditLog(ctx, source, enum.AuditActionUpdated, audit.WithOldObject(tmpl), audit.WithNewObject(published))
+	return published, nil
+}
@@ -0,0 +1,104 @@
+package templatesource
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/cloudness-io/cloudness/app/store"
+	baseStore "github.com/cloudness-io/cloudness/store"
+	"github.com/cloudness-io/cloudness/types"
+)
+
+// fakeTemplateStore serves one template of source 7 published at version 1 with a pending version 2.
+type fakeTemplateStore struct {
+	store.TemplateStore
+
+	template  *types.Template
+	versions  map[int64]*types.TemplateVersion
+	published *types.Template
+}
+
+func newFakeTemplateStore(t *testing.T) *fakeTemplateStore {
+	sourceID := int64(7)
+	versions := map[int64]*types.TemplateVersion{}
+	for number, image := range map[int64]string{1: "traefik/whoami:v1.10", 2: "traefik/whoami:v1.11"} {
+		spec, err := json.Marshal(&types.TemplateSpec{
+			Name:     "Whoami",
+			Services: []*types.TemplateService{{Name: "whoami", Build: &types.BuildConfiguration{Source: &types.Source{Registry: &types.RegistrySource{Image: image}}}}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		versions[number] = &types.TemplateVersion{TemplateID: 3, Number: number, SpecJson: string(spec)}
+	}
+	return &fakeTemplateStore{
+		template: &types.Template{
+			ID:            3,
+			Slug:          "whoami-7",
+			Name:          "Whoami",
+			SpecJson:      versions[1].SpecJson,
+			SourceID:      &sourceID,
+			Version:       1,
+			LatestVersion: 2,
+		},
+		versions: versions,
+	}
+}
+
+func (s *fakeTemplateStore) FindBySource(_ context.Context, sourceID int64, id int64) (*types.Template, error) {
+	if sourceID != *s.template.SourceID || id != s.template.ID {
+		return nil, baseStore.ErrResourceNotFound
+	}
+	return s.template, nil
+}
+
+func (s *fakeTemplateStore) FindVersion(_ context.Context, templateID int64, number int64) (*types.TemplateVersion, error) {
+	if version, ok := s.versions[number]; ok && templateID == s.template.ID {
+		return version, nil
+	}
+	return nil, baseStore.ErrResourceNotFound
+}
+
+func (s *fakeTemplateStore) Publish(_ context.Context, tmpl *types.Template) error {
+	s.published = tmpl
+	return nil
+}
+
+func TestPublish(t *testing.T) {
+	templateStore := newFakeTemplateStore(t)
+	c := NewController(nil, templateStore, nil, nil, nil, nil)
+	source := &types.TemplateSource{ID: 7, Name: "catalog"}
+
+	published, err := c.Publish(context.Background(), source, 3, 2)
+	if err != nil {
+		t.Fatalf("publish: %v", err)
+	}
+	if templateStore.published != published {
+		t.Fatal("the published template was not saved")
+	}
+	if published.ID != 3 || published.Slug != "whoami-7" || published.SourceID == nil || *published.SourceID != 7 {
+		t.Errorf("published template %d %s of source %v, want the template 3 whoami-7 of source 7", published.ID, published.Slug, published.SourceID)
+	}
+	if published.Version != 2 || published.HasPendingVersion() {
+		t.Errorf("published version %d of %d, want 2 with no pending version", published.Version, published.LatestVersion)
+	}
+	if published.Spec.Services[0].Build.Source.Registry.Image != "traefik/whoami:v1.11" {
+		t.Errorf("image = %s, want the image of version 2", published.Spec.Services[0].Build.Source.Registry.Image)
+	}
+}
+
+func TestPublishOtherSource(t *testing.T) {
+	templateStore := newFakeTemplateStore(t)
+	c := NewController(nil, templateStore, nil, nil, nil, nil)
+
+	if _, err := c.Publish(context.Background(), &types.TemplateSource{ID: 8, Name: "other"}, 3, 2); !baseStore.IsNotFound(err) {
+		t.Fatalf("expected not found for the template of another source, got %v", err)
+	}
+	if _, err := c.Publish(context.Background(), &types.TemplateSource{ID: 7, Name: "catalog"}, 3, 5); !baseStore.IsNotFound(err) {
+		t.Fatalf("expected not found for an unknown version, got %v", err)
+	}
+	if templateStore.published != nil {
+		t.Error("nothing must be published")
+	}
+}
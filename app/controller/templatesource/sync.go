@@ -0,0 +1,16 @@
+package templatesource
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/types"
+)
+
+// Sync queues the sync of the template source of the tenant, or of the instance when the tenant is nil.
+func (c *Controller) Sync(ctx context.Context, tenant *types.Tenant, uid int64) error {
+	source, err := c.Find(ctx, tenant, uid)
+	if err != nil {
+		return err
+	}
+	return c.templateSourceSvc.Sync(ctx, source)
+}
@@ -0,0 +1,34 @@
+package templatesource
+
+import (
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	"github.com/cloudness-io/cloudness/app/services/gitconnection"
+	"github.com/cloudness-io/cloudness/app/services/templatesource"
+	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/encrypt"
+
+	"github.com/google/wire"
+)
+
+// WireSet provides a wire set for this package.
+var WireSet = wire.NewSet(
+	ProvideController,
+)
+
+func ProvideController(
+	sourceStore store.TemplateSourceStore,
+	templateStore store.TemplateStore,
+	encrypter encrypt.Encrypter,
+	templateSourceSvc *templatesource.Service,
+	gitConnectionSvc *gitconnection.Service,
+	auditSvc *audit.Service,
+) *Controller {
+	return NewController(
+		sourceStore,
+		templateStore,
+		encrypter,
+		templateSourceSvc,
+		gitConnectionSvc,
+		auditSvc,
+	)
+}
@@ -0,0 +1,22 @@
+package tenant
+
+import (
+	"strconv"
+
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+func auditResource(tenant *types.Tenant) audit.Resource {
+	return audit.NewResource(enum.AuditResourceTenant, strconv.FormatInt(tenant.UID, 10), tenant.Name)
+}
+
+func auditMemberResource(principalID int64, email string) audit.Resource {
+	return audit.NewResource(enum.AuditResourceTenantMember, strconv.FormatInt(principalID, 10), email)
+}
+
+// auditMember is the audited state of a tenant membership.
+type auditMember struct {
+	Role enum.TenantRole `json:"role"`
+}
@@ -0,0 +1,90 @@
+package tenant
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+
+	"github.com/cloudness-io/cloudness/app/usererror"
+	"github.com/cloudness-io/cloudness/types"
+)
+
+// auditExportBatchSize is the number of audit events read from the store at once while exporting.
+const auditExportBatchSize = 500
+
+// ListAuditEvents lists the audit events of the tenant along with the total count.
+// The events are narrowed down to a single project when projectUID is set.
+func (c *Controller) ListAuditEvents(
+	ctx context.Context,
+	tenant *types.Tenant,
+	projectUID int64,
+	filter *types.AuditFilter,
+) ([]*types.AuditEvent, int64, error) {
+	if err := c.scopeAuditFilter(ctx, tenant, projectUID, filter); err != nil {
+		return nil, 0, err
+	}
+
+	events, err := c.auditSvc.List(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := c.auditSvc.Count(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return events, count, nil
+}
+
+// ExportAuditEvents writes all audit events matching the filter as json lines, newest first.
+// Pagination of the filter is ignored.
+func (c *Controller) ExportAuditEvents(
+	ctx context.Context,
+	tenant *types.Tenant,
+	projectUID int64,
+	filter *types.AuditFilter,
+	w io.Writer,
+) error {
+	if err := c.scopeAuditFilter(ctx, tenant, projectUID, filter); err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(w)
+	filter.Size = auditExportBatchSize
+	for page := 1; ; page++ {
+		filter.Page = page
+		events, err := c.auditSvc.List(ctx, filter)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			if err := enc.Encode(event.ToDTO()); err != nil {
+				return err
+			}
+		}
+
+		if len(events) < auditExportBatchSize {
+			return nil
+		}
+	}
+}
+
+func (c *Controller) scopeAuditFilter(ctx context.Context, tenant *types.Tenant, projectUID int64, filter *types.AuditFilter) error {
+	filter.TenantID = tenant.ID
+	filter.ProjectID = 0
+	if projectUID == 0 {
+		return nil
+	}
+
+	project, err := c.projectCtrl.FindByUID(ctx, tenant.ID, projectUID)
+	if err != nil {
+		return err
+	}
+	if project == nil {
+		return usererror.ErrNotFound
+	}
+	filter.ProjectID = project.ID
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/cloudness-io/cloudness/app/controller/project"
 	"github.com/cloudness-io/cloudness/app/controller/user"
+	"github.com/cloudness-io/cloudness/app/services/audit"
 	"github.com/cloudness-io/cloudness/app/services/config"
 	"github.com/cloudness-io/cloudness/app/store"
 	dbStore "github.com/cloudness-io/cloudness/store"
@@ -21,6 +22,7 @@ type Controller struct {
 	tenantMembershipStore store.TenantMembershipStore
 	userCtrl              *user.Controller
 	projectCtrl           *project.Controller
+	auditSvc              *audit.Service
 }
 
 func NewController(tx dbtx.Transactor,
@@ -29,6 +31,7 @@ func NewController(tx dbtx.Transactor,
 	tenantMembershipStore store.TenantMembershipStore,
 	userCtrl *user.Controller,
 	projectCtrl *project.Controller,
+	auditSvc *audit.Service,
 ) *Controller {
 	return &Controller{
 		tx:                    tx,
@@ -37,6 +40,7 @@ func NewController(tx dbtx.Transactor,
 		tenantMembershipStore: tenantMembershipStore,
 		userCtrl:              userCtrl,
 		projectCtrl:           projectCtrl,
+		auditSvc:              auditSvc,
 	}
 }
 
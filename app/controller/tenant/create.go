@@ -5,6 +5,7 @@ import (
 	"errors"
 	"time"
 
+	"github.com/cloudness-io/cloudness/app/services/audit"
 	"github.com/cloudness-io/cloudness/helpers"
 	"github.com/cloudness-io/cloudness/store"
 	"github.com/cloudness-io/cloudness/types"
@@ -69,6 +70,8 @@ func (c *Controller) CreateNoAuth(ctx context.Context, userID int64, in *CreateT
 		return nil, err
 	}
 
+	c.auditSvc.Log(ctx, auditResource(tenant), enum.AuditActionCreated,
+		audit.WithTenantID(tenant.ID), audit.WithNewObject(tenant))
 	return tenant, nil
 }
 
@@ -4,7 +4,9 @@ import (
 	"context"
 	"time"
 
+	"github.com/cloudness-io/cloudness/app/services/audit"
 	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
 )
 
 func (c *Controller) SoftDelete(ctx context.Context, tenant *types.Tenant) error {
@@ -19,6 +21,11 @@ func (c *Controller) SoftDelete(ctx context.Context, tenant *types.Tenant) error
 			return err
 		}
 
-		return c.projectCtrl.SoftDeleteInTenant(ctx, tenant.ID, now)
+		if err := c.projectCtrl.SoftDeleteInTenant(ctx, tenant.ID, now); err != nil {
+			return err
+		}
+
+		c.auditSvc.Log(ctx, auditResource(tenant), enum.AuditActionDeleted, audit.WithOldObject(tenant))
+		return nil
 	})
 }
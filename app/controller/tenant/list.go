@@ -19,3 +19,8 @@ func (c *Controller) ListMembership(ctx context.Context, userID int64) ([]*types
 func (c *Controller) ListAllMembers(ctx context.Context, tenantID int64) ([]*types.TenantMembershipUser, error) {
 	return c.tenantMembershipStore.ListByTenant(ctx, tenantID)
 }
+
+// ListAll lists all the tenants of the instance.
+func (c *Controller) ListAll(ctx context.Context) ([]*types.Tenant, error) {
+	return c.tenantStore.List(ctx, &types.TenantFilter{})
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/cloudness-io/cloudness/app/auth"
 	"github.com/cloudness-io/cloudness/app/controller/user"
+	"github.com/cloudness-io/cloudness/app/services/audit"
 	"github.com/cloudness-io/cloudness/errors"
 	"github.com/cloudness-io/cloudness/store"
 	"github.com/cloudness-io/cloudness/types"
@@ -58,7 +59,36 @@ func (c *Controller) CreateTenantMembership(ctx context.Context, tenant *types.T
 		CreatedBy: session.Principal.ID,
 	}
 
-	return c.tenantMembershipStore.Create(ctx, membership)
+	if err := c.tenantMembershipStore.Create(ctx, membership); err != nil {
+		return err
+	}
+
+	c.auditSvc.Log(ctx, auditMemberResource(principal.ID, principal.Email), enum.AuditActionCreated,
+		audit.WithNewObject(&auditMember{Role: in.Role}))
+	return nil
+}
+
+// JoinTenant adds the principal to the tenant on its own behalf, used to auto join users on login.
+func (c *Controller) JoinTenant(ctx context.Context, tenant *types.Tenant, principalID int64, role enum.TenantRole) error {
+	membership := &types.TenantMembership{
+		TenantMembershipKey: &types.TenantMembershipKey{
+			TenantID:    tenant.ID,
+			PrincipalID: principalID,
+			Role:        role,
+		},
+		CreatedBy: principalID,
+	}
+
+	if err := c.tenantMembershipStore.Create(ctx, membership); err != nil {
+		return err
+	}
+
+	c.auditSvc.Log(ctx, auditMemberResource(principalID, ""), enum.AuditActionCreated,
+		audit.WithTenantID(tenant.ID),
+		audit.WithNewObject(&auditMember{Role: role}),
+		audit.WithData("auto_join", true),
+	)
+	return nil
 }
 
 func (c *Controller) sanitizeCreateMembershipInput(in *TenantMembershipModel) error {
@@ -89,7 +119,20 @@ func (c *Controller) UpdateMembership(ctx context.Context, tenantID int64, in *T
 		return errors.BadRequest("Invalid role")
 	}
 
-	return c.tenantMembershipStore.Update(ctx, tenantID, user.ID, role)
+	membership, err := c.tenantMembershipStore.Find(ctx, tenantID, user.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := c.tenantMembershipStore.Update(ctx, tenantID, user.ID, role); err != nil {
+		return err
+	}
+
+	c.auditSvc.Log(ctx, auditMemberResource(user.ID, user.Email), enum.AuditActionRoleChanged,
+		audit.WithOldObject(&auditMember{Role: membership.Role}),
+		audit.WithNewObject(&auditMember{Role: role}),
+	)
+	return nil
 }
 
 func (c *Controller) DeleteMembership(ctx context.Context, tenantID int64, in *TenantMembershipModel) error {
@@ -98,5 +141,16 @@ func (c *Controller) DeleteMembership(ctx context.Context, tenantID int64, in *T
 		return nil
 	}
 
-	return c.tenantMembershipStore.Delete(ctx, tenantID, user.ID)
+	membership, err := c.tenantMembershipStore.Find(ctx, tenantID, user.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := c.tenantMembershipStore.Delete(ctx, tenantID, user.ID); err != nil {
+		return err
+	}
+
+	c.auditSvc.Log(ctx, auditMemberResource(user.ID, user.Email), enum.AuditActionDeleted,
+		audit.WithOldObject(&auditMember{Role: membership.Role}))
+	return nil
 }
@@ -4,9 +4,11 @@ import (
 	"context"
 
 	"github.com/cloudness-io/cloudness/app/request"
+	"github.com/cloudness-io/cloudness/app/services/audit"
 	"github.com/cloudness-io/cloudness/app/usererror"
 	"github.com/cloudness-io/cloudness/types"
 	"github.com/cloudness-io/cloudness/types/check"
+	"github.com/cloudness-io/cloudness/types/enum"
 
 	"github.com/rs/zerolog/log"
 )
@@ -22,10 +24,18 @@ func (c *Controller) UpdateGeneral(ctx context.Context, tenant *types.Tenant, in
 		return nil, err
 	}
 
+	old := *tenant
 	tenant.Name = in.Name
 	tenant.Description = in.Description
 
-	return c.tenantStore.Update(ctx, tenant)
+	tenant, err := c.tenantStore.Update(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	c.auditSvc.Log(ctx, auditResource(tenant), enum.AuditActionUpdated,
+		audit.WithOldObject(&old), audit.WithNewObject(tenant))
+	return tenant, nil
 }
 
 func (c *Controller) UpdateRestrictions(ctx context.Context, tenant *types.Tenant, in *types.TenantRestrictions) (*types.TenantRestrictions, error) {
@@ -58,7 +68,11 @@ func (c *Controller) UpdateRestrictions(ctx context.Context, tenant *types.Tenan
 	if err != nil {
 		return nil, err
 	}
-	return c.GetRestrctions(ctx, tenant), nil
+
+	updated := c.GetRestrctions(ctx, tenant)
+	c.auditSvc.Log(ctx, auditResource(tenant), enum.AuditActionUpdated,
+		audit.WithOldObject(restrictions), audit.WithNewObject(updated))
+	return updated, nil
 }
 
 func (c *Controller) sanitizeUpdateInput(in *TenantGeneralUpdateModel) error {
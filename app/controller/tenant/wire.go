@@ -3,6 +3,7 @@ package tenant
 import (
 	"github.com/cloudness-io/cloudness/app/controller/project"
 	"github.com/cloudness-io/cloudness/app/controller/user"
+	"github.com/cloudness-io/cloudness/app/services/audit"
 	"github.com/cloudness-io/cloudness/app/services/config"
 	"github.com/cloudness-io/cloudness/app/store"
 	"github.com/cloudness-io/cloudness/store/database/dbtx"
@@ -22,6 +23,7 @@ func ProviderController(
 	tenantMembershipStore store.TenantMembershipStore,
 	userCtrl *user.Controller,
 	projectCtrl *project.Controller,
+	auditSvc *audit.Service,
 ) *Controller {
-	return NewController(tx, configSvc, tenantStore, tenantMembershipStore, userCtrl, projectCtrl)
+	return NewController(tx, configSvc, tenantStore, tenantMembershipStore, userCtrl, projectCtrl, auditSvc)
 }
@@ -11,15 +11,18 @@ import (
 type Controller struct {
 	tx             dbtx.Transactor
 	principalStore store.PrincipalStore
+	tokenStore     store.TokenStore
 }
 
 func NewController(
 	tx dbtx.Transactor,
 	userStore store.PrincipalStore,
+	tokenStore store.TokenStore,
 ) *Controller {
 	return &Controller{
 		tx:             tx,
 		principalStore: userStore,
+		tokenStore:     tokenStore,
 	}
 }
 
@@ -0,0 +1,52 @@
+package user
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/app/token"
+	"github.com/cloudness-io/cloudness/store"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+// CreateTokenInput is the input used to mint a personal access token.
+type CreateTokenInput = token.CreateInput
+
+// CreateToken mints a new personal access token for the user.
+func (c *Controller) CreateToken(ctx context.Context, userID int64, in *CreateTokenInput) (*types.TokenResponse, error) {
+	if err := token.SanitizeCreateInput(in); err != nil {
+		return nil, err
+	}
+
+	user, err := findUserFromID(ctx, c.principalStore, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	tkn, jwt, err := token.CreatePAT(ctx, c.tokenStore, user, in.Identifier, in.Lifetime())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.TokenResponse{Token: *tkn, AccessToken: jwt}, nil
+}
+
+// ListTokens lists the personal access tokens of the user.
+func (c *Controller) ListTokens(ctx context.Context, userID int64) ([]*types.Token, error) {
+	return c.tokenStore.List(ctx, userID, enum.TokenTypePAT)
+}
+
+// DeleteToken revokes the personal access token of the user with the given identifier.
+func (c *Controller) DeleteToken(ctx context.Context, userID int64, identifier string) error {
+	tkn, err := c.tokenStore.FindByIdentifier(ctx, userID, identifier)
+	if err != nil {
+		return err
+	}
+
+	// session tokens are managed by login / logout only.
+	if tkn.Type != enum.TokenTypePAT {
+		return store.ErrResourceNotFound
+	}
+
+	return c.tokenStore.Delete(ctx, tkn.ID)
+}
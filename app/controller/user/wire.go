@@ -15,9 +15,11 @@ var WireSet = wire.NewSet(
 func ProvideController(
 	tx dbtx.Transactor,
 	userStore store.PrincipalStore,
+	tokenStore store.TokenStore,
 ) *Controller {
 	return NewController(
 		tx,
 		userStore,
+		tokenStore,
 	)
 }
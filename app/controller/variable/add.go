@@ -3,6 +3,7 @@ package variable
 import (
 	"context"
 
+	"github.com/cloudness-io/cloudness/app/services/audit"
 	"github.com/cloudness-io/cloudness/types/check"
 	"github.com/cloudness-io/cloudness/types/enum"
 )
@@ -22,7 +23,12 @@ func (c *Controller) Add(ctx context.Context, envID, appID int64, in *AddVariabl
 	if err := c.updateTextFromValue(ctx, envID, newVar, allVars); err != nil {
 		return err
 	}
-	return c.upsert(ctx, newVar)
+	if err := c.upsert(ctx, newVar); err != nil {
+		return err
+	}
+
+	c.auditSvc.Log(ctx, auditResource(newVar), enum.AuditActionCreated, audit.WithNewObject(newVar))
+	return nil
 }
 
 func (c *Controller) AddSystem(ctx context.Context, envID, appID int64, key, value string) error {
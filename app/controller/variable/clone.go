@@ -0,0 +1,64 @@
+package variable
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/cloudness-io/cloudness/types"
+)
+
+// CloneEnvironment copies the user variables of the source environment into the target environment.
+// appIDMap maps source application ids to their clones, references between variables are rewritten
+// to point at the cloned variables.
+func (c *Controller) CloneEnvironment(ctx context.Context, srcEnvID, dstEnvID int64, appIDMap map[int64]int64) error {
+	srcVars, err := c.ListInEnvironment(ctx, srcEnvID)
+	if err != nil {
+		return err
+	}
+
+	// system variables are already generated for the cloned applications
+	dstVars, err := c.ListInEnvironment(ctx, dstEnvID)
+	if err != nil {
+		return err
+	}
+	systemVars := make(map[string]*types.Variable, len(dstVars))
+	for _, v := range dstVars {
+		systemVars[fmt.Sprintf("%d.%s", v.ApplicationID, v.Key)] = v
+	}
+
+	uidMap := make(map[string]string, len(srcVars))
+	newVars := make([]*types.Variable, 0, len(srcVars))
+	for _, v := range srcVars {
+		appID, ok := appIDMap[v.ApplicationID]
+		if !ok {
+			continue
+		}
+
+		if IsSystemVar(v.Key) {
+			if sv, ok := systemVars[fmt.Sprintf("%d.%s", appID, v.Key)]; ok {
+				uidMap[strconv.FormatInt(v.UID, 10)] = getIDRefKey(sv)
+			}
+			continue
+		}
+
+		nv := newVariable(dstEnvID, appID, v.Key, v.Value, v.Type)
+		nv.TextValue = v.TextValue
+		uidMap[strconv.FormatInt(v.UID, 10)] = getIDRefKey(nv)
+		newVars = append(newVars, nv)
+	}
+
+	_, idToValueMap := getNameAndIDRefMap(append(dstVars, newVars...), 0)
+	for _, v := range newVars {
+		if isSecret(v.Value) || len(getRef(v.Value)) == 0 {
+			continue
+		}
+		v.Value = replaceWithMap(v.Value, uidMap)
+		v.TextValue = replaceWithMap(v.Value, idToValueMap)
+	}
+
+	if len(newVars) == 0 {
+		return nil
+	}
+	return c.UpsertMany(ctx, newVars)
+}
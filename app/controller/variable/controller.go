@@ -1,17 +1,20 @@
 package variable
 
 import (
+	"github.com/cloudness-io/cloudness/app/services/audit"
 	"github.com/cloudness-io/cloudness/app/store"
 	"github.com/cloudness-io/cloudness/types/enum"
 )
 
 type Controller struct {
 	variableStore store.VariableStore
+	auditSvc      *audit.Service
 }
 
-func NewController(variableStore store.VariableStore) *Controller {
+func NewController(variableStore store.VariableStore, auditSvc *audit.Service) *Controller {
 	return &Controller{
 		variableStore: variableStore,
+		auditSvc:      auditSvc,
 	}
 }
 
@@ -4,11 +4,23 @@ import (
 	"context"
 	"errors"
 
+	"github.com/cloudness-io/cloudness/app/services/audit"
 	"github.com/cloudness-io/cloudness/store"
+	"github.com/cloudness-io/cloudness/types/enum"
 )
 
 func (c *Controller) Delete(ctx context.Context, appID, varUID int64) error {
-	return c.variableStore.Delete(ctx, appID, varUID)
+	variable, err := c.variableStore.Find(ctx, appID, varUID)
+	if err != nil {
+		return err
+	}
+
+	if err := c.variableStore.Delete(ctx, appID, varUID); err != nil {
+		return err
+	}
+
+	c.auditSvc.Log(ctx, auditResource(variable), enum.AuditActionDeleted, audit.WithOldObject(variable))
+	return nil
 }
 
 func (c *Controller) DeleteByKey(ctx context.Context, appID int64, key string) error {
@@ -28,12 +28,19 @@ func (c *Controller) UpdateDefaultVariables(
 	}
 
 	if app.PrivateDomain != "" {
-		if server.Type == enum.ServerTypeK8s {
+		if server.Type == enum.ServerTypeK8s || server.Type == enum.ServerTypeDocker {
 			vars = append(vars, newVariable(env.ID, app.ID, SystemVarAppPrivateDomain, app.PrivateDomain, enum.VariableTypeBuildAndRun))
 		}
 		vars = append(vars, newVariable(env.ID, app.ID, SystemVarServiceName, app.PrivateDomain, enum.VariableTypeBuildAndRun))
 	}
 
+	// the replicas of a postgres cluster are reached on their own service
+	if app.PrivateDomain != "" && app.IsPostgresHA() && server.Type == enum.ServerTypeK8s {
+		vars = append(vars, newVariable(env.ID, app.ID, SystemVarAppPrivateDomainReadonly, app.PrivateDomain+"-ro", enum.VariableTypeBuildAndRun))
+	} else {
+		varsToDelete = append(varsToDelete, SystemVarAppPrivateDomainReadonly)
+	}
+
 	if app.Spec.Networking.TCPProxies != nil {
 		vars = append(vars, newVariable(env.ID, app.ID, SystemVarAppTCPPort, strconv.Itoa(app.Spec.Networking.TCPProxies.TCPPort), enum.VariableTypeBuildAndRun))
 	} else {
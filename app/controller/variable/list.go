@@ -33,3 +33,15 @@ func (c *Controller) ListWithUpdate(ctx context.Context, envID, appID int64) (ma
 
 	return appVars, nil
 }
+
+// ListResolved returns the variables of the application with the references to other variables resolved,
+// unlike ListWithUpdate the resolved values are not stored.
+func (c *Controller) ListResolved(ctx context.Context, envID, appID int64) (map[string]*types.Variable, error) {
+	allVars, err := c.ListInEnvironment(ctx, envID)
+	if err != nil {
+		return nil, err
+	}
+
+	appVars, _ := c.toListUpdate(allVars, appID)
+	return appVars, nil
+}
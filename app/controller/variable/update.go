@@ -3,6 +3,7 @@ package variable
 import (
 	"context"
 
+	"github.com/cloudness-io/cloudness/app/services/audit"
 	"github.com/cloudness-io/cloudness/types"
 	"github.com/cloudness-io/cloudness/types/enum"
 )
@@ -21,6 +22,7 @@ func (c *Controller) Update(ctx context.Context, envID, appID int64, varUID int6
 	if err != nil {
 		return err
 	}
+	old := *variable
 
 	variable.Value = in.Value
 	variable.Type = in.Type
@@ -33,7 +35,13 @@ func (c *Controller) Update(ctx context.Context, envID, appID int64, varUID int6
 		return err
 	}
 
-	return c.upsert(ctx, variable)
+	if err := c.upsert(ctx, variable); err != nil {
+		return err
+	}
+
+	c.auditSvc.Log(ctx, auditResource(variable), enum.AuditActionUpdated,
+		audit.WithOldObject(&old), audit.WithNewObject(variable))
+	return nil
 }
 
 func (c *Controller) UpdateGenerate(ctx context.Context, envID, appID int64, varUID int64, in *GenerateVariableInput) error {
@@ -42,10 +50,18 @@ func (c *Controller) UpdateGenerate(ctx context.Context, envID, appID int64, var
 		return err
 	}
 
+	old := *variable
+
 	value, ref := c.generateSecret(in.Length)
 	variable.TextValue = value
 	variable.Value = ref
-	return c.upsert(ctx, variable)
+	if err := c.upsert(ctx, variable); err != nil {
+		return err
+	}
+
+	c.auditSvc.Log(ctx, auditResource(variable), enum.AuditActionUpdated,
+		audit.WithOldObject(&old), audit.WithNewObject(variable))
+	return nil
 }
 
 func (c *Controller) updateTextFromValue(ctx context.Context, envID int64, v *types.Variable, allVars []*types.Variable) error {
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/cloudness-io/cloudness/app/services/audit"
 	"github.com/cloudness-io/cloudness/helpers"
 	"github.com/cloudness-io/cloudness/types"
 	"github.com/cloudness-io/cloudness/types/enum"
@@ -32,6 +33,10 @@ func newVariable(envID int64, appID int64, key, value string, varType enum.Varia
 	}
 }
 
+func auditResource(v *types.Variable) audit.Resource {
+	return audit.NewResource(enum.AuditResourceVariable, strconv.FormatInt(v.UID, 10), v.Key)
+}
+
 func isSecret(v string) bool {
 	return varSecretRegex.MatchString(v)
 }
@@ -1,6 +1,7 @@
 package variable
 
 import (
+	"github.com/cloudness-io/cloudness/app/services/audit"
 	"github.com/cloudness-io/cloudness/app/store"
 
 	"github.com/google/wire"
@@ -10,6 +11,6 @@ var WireSet = wire.NewSet(
 	ProvideController,
 )
 
-func ProvideController(variableStore store.VariableStore) *Controller {
-	return NewController(variableStore)
+func ProvideController(variableStore store.VariableStore, auditSvc *audit.Service) *Controller {
+	return NewController(variableStore, auditSvc)
 }
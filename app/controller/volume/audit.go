@@ -0,0 +1,13 @@
+package volume
+
+import (
+	"strconv"
+
+	"github.com/cloudness-io/cloudness/app/services/audit"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+func auditResource(volume *types.Volume) audit.Resource {
+	return audit.NewResource(enum.AuditResourceVolume, strconv.FormatInt(volume.UID, 10), volume.Name)
+}
@@ -3,6 +3,7 @@ package volume
 import (
 	"context"
 
+	"github.com/cloudness-io/cloudness/app/services/audit"
 	"github.com/cloudness-io/cloudness/app/services/config"
 	"github.com/cloudness-io/cloudness/app/store"
 	"github.com/cloudness-io/cloudness/types"
@@ -11,12 +12,14 @@ import (
 type Controller struct {
 	configSvc   *config.Service
 	volumeStore store.VolumeStore
+	auditSvc    *audit.Service
 }
 
-func NewController(configSvc *config.Service, volumeStore store.VolumeStore) *Controller {
+func NewController(configSvc *config.Service, volumeStore store.VolumeStore, auditSvc *audit.Service) *Controller {
 	return &Controller{
 		configSvc:   configSvc,
 		volumeStore: volumeStore,
+		auditSvc:    auditSvc,
 	}
 }
 
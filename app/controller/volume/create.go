@@ -4,11 +4,14 @@ import (
 	"context"
 	"time"
 
+	"github.com/cloudness-io/cloudness/app/services/audit"
 	"github.com/cloudness-io/cloudness/helpers"
 	"github.com/cloudness-io/cloudness/types"
 	"github.com/cloudness-io/cloudness/types/check"
+	"github.com/cloudness-io/cloudness/types/enum"
 )
 
+// Create creates the volume attached to the application, the volume is left unattached when app is nil.
 func (c *Controller) Create(ctx context.Context, tenant *types.Tenant, project *types.Project, env *types.Environment, app *types.Application, in *types.VolumeCreateInput) (*types.Volume, error) {
 	err := c.sanitizeCreateInput(in)
 	if err != nil {
@@ -33,14 +36,27 @@ func (c *Controller) Create(ctx context.Context, tenant *types.Tenant, project *
 		EnvironmentID:  env.ID,
 		EnvironmentUID: env.UID,
 		ServerID:       in.Server.ID,
-		ApplicaitonID:  &app.ID,
 		MountPath:      in.MountPath,
 		Size:           in.Size,
 		Created:        now,
 		Updated:        now,
 	}
+	if app != nil {
+		volume.ApplicaitonID = &app.ID
+	}
+
+	volume, err = c.volumeStore.Create(ctx, volume)
+	if err != nil {
+		return nil, err
+	}
 
-	return c.volumeStore.Create(ctx, volume)
+	c.auditSvc.Log(ctx, auditResource(volume), enum.AuditActionCreated,
+		audit.WithTenantID(tenant.ID),
+		audit.WithProjectID(project.ID),
+		audit.WithEnvironmentID(env.ID),
+		audit.WithNewObject(volume),
+	)
+	return volume, nil
 }
 
 func (c *Controller) sanitizeCreateInput(in *types.VolumeCreateInput) error {
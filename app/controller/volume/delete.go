@@ -4,12 +4,19 @@ import (
 	"context"
 	"time"
 
+	"github.com/cloudness-io/cloudness/app/services/audit"
 	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
 )
 
 func (c *Controller) SoftDelete(ctx context.Context, volume *types.Volume) error {
 	now := time.Now().UTC().UnixMilli()
-	return c.volumeStore.SoftDelete(ctx, volume, now)
+	if err := c.volumeStore.SoftDelete(ctx, volume, now); err != nil {
+		return err
+	}
+
+	c.auditSvc.Log(ctx, auditResource(volume), enum.AuditActionDeleted, audit.WithOldObject(volume))
+	return nil
 }
 
 func (c *Controller) SoftDeleteInApplication(ctx context.Context, appID, now int64) error {
@@ -3,17 +3,35 @@ package volume
 import (
 	"context"
 
+	"github.com/cloudness-io/cloudness/app/services/audit"
 	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
 )
 
 func (c *Controller) Update(ctx context.Context, volume *types.Volume) (*types.Volume, error) {
 	if err := c.sanitizeCreateInput(volume.ToInput()); err != nil {
 		return nil, err
 	}
-	return c.volumeStore.Update(ctx, volume)
+	return c.update(ctx, volume)
 }
 
 func (c *Controller) Detach(ctx context.Context, volume *types.Volume) (*types.Volume, error) {
 	volume.ApplicaitonID = nil
-	return c.volumeStore.Update(ctx, volume)
+	return c.update(ctx, volume)
+}
+
+func (c *Controller) update(ctx context.Context, volume *types.Volume) (*types.Volume, error) {
+	old, err := c.volumeStore.Find(ctx, volume.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	volume, err = c.volumeStore.Update(ctx, volume)
+	if err != nil {
+		return nil, err
+	}
+
+	c.auditSvc.Log(ctx, auditResource(volume), enum.AuditActionUpdated,
+		audit.WithOldObject(old), audit.WithNewObject(volume))
+	return volume, nil
 }
@@ -1,6 +1,7 @@
 package volume
 
 import (
+	"github.com/cloudness-io/cloudness/app/services/audit"
 	"github.com/cloudness-io/cloudness/app/services/config"
 	"github.com/cloudness-io/cloudness/app/store"
 
@@ -11,6 +12,6 @@ var WireSet = wire.NewSet(
 	ProvideController,
 )
 
-func ProvideController(configSvc *config.Service, volumeStore store.VolumeStore) *Controller {
-	return NewController(configSvc, volumeStore)
+func ProvideController(configSvc *config.Service, volumeStore store.VolumeStore, auditSvc *audit.Service) *Controller {
+	return NewController(configSvc, volumeStore, auditSvc)
 }
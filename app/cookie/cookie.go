@@ -1,6 +1,7 @@
 package cookie
 
 import (
+	"crypto/subtle"
 	"errors"
 	"net/http"
 	"time"
@@ -47,3 +48,32 @@ func newEmptyTokenCookie(r *http.Request, cookieName string) *http.Cookie {
 		Secure:   r.URL.Scheme == "https",
 	}
 }
+
+const (
+	oauthStateCookieName = "cloudness_oauth_state"
+	oauthStateMaxAge     = 10 * time.Minute
+)
+
+// IncludeOAuthStateCookie stores the state of an oauth login, so the callback can verify it was
+// initiated by the same browser.
+func IncludeOAuthStateCookie(r *http.Request, w http.ResponseWriter, state string) {
+	cookie := newEmptyTokenCookie(r, oauthStateCookieName)
+	cookie.Value = state
+	cookie.Expires = time.Now().Add(oauthStateMaxAge)
+
+	http.SetCookie(w, cookie)
+}
+
+// VerifyOAuthStateCookie reports whether the state matches the one stored on redirect, the cookie is removed either way.
+func VerifyOAuthStateCookie(r *http.Request, w http.ResponseWriter, state string) bool {
+	stored, err := r.Cookie(oauthStateCookieName)
+	if err != nil {
+		return false
+	}
+
+	cookie := newEmptyTokenCookie(r, oauthStateCookieName)
+	cookie.Expires = time.UnixMilli(0)
+	http.SetCookie(w, cookie)
+
+	return state != "" && subtle.ConstantTimeCompare([]byte(stored.Value), []byte(state)) == 1
+}
@@ -0,0 +1,43 @@
+package inject
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/controller/server"
+	"github.com/cloudness-io/cloudness/app/request"
+	"github.com/cloudness-io/cloudness/app/web/render"
+	"github.com/cloudness-io/cloudness/store"
+
+	"github.com/rs/zerolog/log"
+)
+
+func InjectServer(serverCtrl *server.Controller) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			serverUID, err := request.GetServerUIDFromPath(r)
+			if err != nil {
+				log.Ctx(ctx).Error().Err(err).Msg("Error getting server uid from path")
+				render.Error500(w, r)
+				return
+			}
+
+			server, err := serverCtrl.FindByUID(ctx, serverUID)
+			if err != nil && !errors.Is(err, store.ErrResourceNotFound) {
+				log.Ctx(ctx).Error().Err(err).Msg("Error fetching server")
+				render.Error500(w, r)
+				return
+			}
+			if server == nil {
+				render.NotFound(w, r)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(
+				request.WithServer(ctx, server),
+			))
+		})
+	}
+}
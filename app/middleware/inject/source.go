@@ -3,6 +3,7 @@ package inject
 import (
 	"net/http"
 
+	"github.com/cloudness-io/cloudness/app/controller/gitconnection"
 	"github.com/cloudness-io/cloudness/app/controller/githubapp"
 	"github.com/cloudness-io/cloudness/app/request"
 	"github.com/cloudness-io/cloudness/app/web/render"
@@ -43,3 +44,36 @@ func InjectGithubAppSource(ghCtrl *githubapp.Controller) func(http.Handler) http
 		})
 	}
 }
+
+func InjectGitConnection(gitConnCtrl *gitconnection.Controller) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			connUID, err := request.GetSourceUIDFromPath(r)
+			if err != nil {
+				log.Ctx(ctx).Error().Err(err).Msg("Error getting git connection uid from path")
+				render.Error500(w, r)
+				return
+			}
+
+			tenant, _ := request.TenantFrom(ctx)
+			project, _ := request.ProjectFrom(ctx)
+
+			conn, err := gitConnCtrl.FindByUID(ctx, tenant.ID, project.ID, connUID)
+			if err != nil {
+				log.Ctx(ctx).Error().Err(err).Msg("Error fetching git connection")
+				render.Error500(w, r)
+				return
+			}
+			if conn == nil {
+				render.NotFound(w, r)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(
+				request.WithGitConnection(ctx, conn),
+			))
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/cloudness-io/cloudness/app/pipeline/manager/client"
 	"github.com/cloudness-io/cloudness/app/pipeline/runner/engine"
+	"github.com/cloudness-io/cloudness/app/pipeline/runner/engine/docker"
 	"github.com/cloudness-io/cloudness/app/pipeline/runner/engine/kubernetes"
 	"github.com/cloudness-io/cloudness/app/services/manager"
 	"github.com/cloudness-io/cloudness/types"
@@ -18,30 +19,38 @@ import (
 
 var engines = []engine.Engine{
 	kubernetes.New(),
+	docker.New(),
 }
 
 type Agent struct {
 	serverUID     int64
 	client        client.RunnerClient
-	serverManager manager.ServerManager
+	factory       manager.ManagerFactory
 	stopChan      <-chan bool
 	configUpdated int64
 }
 
+// New returns an agent running the deployments of the server of the client.
 func New(client client.RunnerClient, factory manager.ManagerFactory) *Agent {
-	serverManager, err := factory.GetServerManagerByType(enum.ServerTypeK8s)
-	if err != nil {
-		panic(err)
+	return &Agent{
+		client:  client,
+		factory: factory,
 	}
+}
 
-	return &Agent{
-		client:        client,
-		serverManager: serverManager,
+// serverManager returns the manager of the type of the server the agent runs on, it reads the
+// statuses and metrics of the local cluster or docker host.
+func (a *Agent) serverManager(config types.RunnerConfig) (manager.ServerManager, error) {
+	serverType := config.ServerType
+	if serverType == "" {
+		// control planes without docker servers do not send the server type
+		serverType = enum.ServerTypeK8s
 	}
+	return a.factory.GetServerManagerByType(serverType)
 }
 
 func (a *Agent) Start(ctx context.Context) error {
-	serverUID, err := a.client.GetPrimaryServerUID(ctx) //NOTE: temporary untill agent is split, serverUID will be passed an env or config to independent agent
+	serverUID, err := a.client.GetServerUID(ctx)
 	if err != nil {
 		return err
 	}
@@ -122,8 +131,9 @@ func (a *Agent) checkConfigChange(ctx context.Context, configChan chan<- *types.
 		case <-ticker.C:
 			config, err := a.client.Config(ctx, a.serverUID)
 			if err != nil {
+				// remote agents lose the control plane on network failures, retry on the next tick
 				log.Ctx(ctx).Error().Err(err).Msg("agent: error fetching runner config")
-				return err
+				continue
 			}
 			if a.configUpdated < config.UpdatedAt || a.configUpdated == 0 {
 				log.Ctx(ctx).Trace().Msg("agent: config updated, sending to channel for restart")
@@ -10,13 +10,18 @@ import (
 )
 
 func (a *Agent) runStatusMonitor(ctx context.Context, config types.RunnerConfig) error {
+	mgr, err := a.serverManager(config)
+	if err != nil {
+		return err
+	}
+
 	ticker := time.NewTicker(time.Duration(5) * time.Second)
 	for {
 		select {
 		case <-ctx.Done():
 			return nil
 		case <-ticker.C:
-			status, err := a.serverManager.ListApplicationStatuses(ctx, nil)
+			status, err := mgr.ListApplicationStatuses(ctx, nil)
 			if err != nil {
 				continue
 			}
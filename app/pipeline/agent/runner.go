@@ -116,7 +116,7 @@ func (a *Agent) startPolling(ctx context.Context, config types.RunnerConfig, job
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
-			deployment, err := a.client.Request(ctx)
+			deployment, err := a.client.Request(ctx, a.serverUID)
 			if err == context.Canceled || err == context.DeadlineExceeded {
 				log.Trace().Err(err).Msg("agent: no deployment received")
 				continue
@@ -10,13 +10,18 @@ import (
 )
 
 func (a *Agent) runMetricsScrapper(ctx context.Context, config types.RunnerConfig) error {
+	mgr, err := a.serverManager(config)
+	if err != nil {
+		return err
+	}
+
 	ticker := time.NewTicker(time.Duration(5) * time.Second)
 	for {
 		select {
 		case <-ctx.Done():
 			return nil
 		case <-ticker.C:
-			metrics, err := a.serverManager.ListMetrics(ctx, nil)
+			metrics, err := mgr.ListMetrics(ctx, nil)
 			if err != nil {
 				continue
 			}
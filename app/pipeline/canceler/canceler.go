@@ -7,8 +7,10 @@ import (
 	"time"
 
 	"github.com/cloudness-io/cloudness/app/pipeline/scheduler"
+	"github.com/cloudness-io/cloudness/app/services/notification"
 	"github.com/cloudness-io/cloudness/app/sse"
 	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/types"
 	"github.com/cloudness-io/cloudness/types/enum"
 
 	"github.com/rs/zerolog/log"
@@ -19,20 +21,26 @@ type Canceler interface {
 }
 
 type canceler struct {
-	deploymentStore store.DeploymentStore
-	sseStreamer     sse.Streamer
-	scheduler       scheduler.Scheduler
+	deploymentStore  store.DeploymentStore
+	applicationStore store.ApplicationStore
+	sseStreamer      sse.Streamer
+	scheduler        scheduler.Scheduler
+	notificationSvc  *notification.Service
 }
 
 func New(
 	deploymentStore store.DeploymentStore,
+	applicationStore store.ApplicationStore,
 	sseStreamer sse.Streamer,
 	scheduler scheduler.Scheduler,
+	notificationSvc *notification.Service,
 ) Canceler {
 	return &canceler{
-		deploymentStore: deploymentStore,
-		sseStreamer:     sseStreamer,
-		scheduler:       scheduler,
+		deploymentStore:  deploymentStore,
+		applicationStore: applicationStore,
+		sseStreamer:      sseStreamer,
+		scheduler:        scheduler,
+		notificationSvc:  notificationSvc,
 	}
 }
 
@@ -68,7 +76,20 @@ func (c *canceler) CancelIncompleteBuilds(ctx context.Context, tenantID, project
 			log.Debug().Err(err).Msg("Canceler: failed to cancel scheduler")
 		}
 
+		c.notify(ctx, applicationID, deployment)
 	}
 
 	return nil
 }
+
+func (c *canceler) notify(ctx context.Context, applicationID int64, deployment *types.Deployment) {
+	app, err := c.applicationStore.Find(ctx, applicationID)
+	if err != nil {
+		log.Debug().Err(err).Msg("canceler: failed to find application of cancelled deployment")
+		return
+	}
+
+	if err := c.notificationSvc.NotifyDeployment(ctx, app, deployment); err != nil {
+		log.Warn().Err(err).Msg("canceler: failed to notify cancelled deployment")
+	}
+}
@@ -2,6 +2,7 @@ package canceler
 
 import (
 	"github.com/cloudness-io/cloudness/app/pipeline/scheduler"
+	"github.com/cloudness-io/cloudness/app/services/notification"
 	"github.com/cloudness-io/cloudness/app/sse"
 	"github.com/cloudness-io/cloudness/app/store"
 
@@ -14,8 +15,10 @@ var WireSet = wire.NewSet(
 
 func ProvideCanceler(
 	deploymentStore store.DeploymentStore,
+	applicationStore store.ApplicationStore,
 	sseStreamer sse.Streamer,
 	scheduler scheduler.Scheduler,
+	notificationSvc *notification.Service,
 ) Canceler {
-	return New(deploymentStore, sseStreamer, scheduler)
+	return New(deploymentStore, applicationStore, sseStreamer, scheduler, notificationSvc)
 }
@@ -73,3 +73,54 @@ func buildCommandNew(
 
 	return nil
 }
+
+// buildpacksStep runs the cloud native buildpacks lifecycle in the builder image, the creator
+// detects, builds and exports the image straight to the registry.
+func buildpacksStep(
+	in *pipeline.RunnerContextInput,
+	pCtx *pipeline.RunnerContext,
+	spec *types.ApplicationSpec,
+	buildVars map[string]string,
+) *pipeline.Step {
+	step := &pipeline.Step{
+		Name:           "build",
+		Image:          specSvc.GetBuilderImage(spec),
+		Command:        []string{"/bin/sh", "-c"},
+		ScriptCommands: []string{},
+		VolumeMounts:   []*pipeline.VolumeMount{getBuildVolumeMount(pCtx)},
+		Envs: map[string]string{
+			"CNB_PLATFORM_API": "0.12",
+		},
+	}
+
+	gitSource := spec.Build.Source.Git
+	sourcePath := wsBuildVolumePath
+	if gitSource.BasePath != "" && gitSource.BasePath != "/" {
+		sourcePath = wsBuildVolumePath + gitSource.BasePath
+	}
+
+	image, _, cacheImage := specSvc.GetImage(in.Application, in.Deployment, in.Config)
+	registry := strings.Split(in.Config.PushRegistryURL, "/")[0]
+
+	addSecret(pCtx, step, "CLOUDNESS_BUILD_SOURCE_PATH", sourcePath)
+	addSecret(pCtx, step, "CLOUDNESS_BUILD_IMAGE", image)
+	addSecret(pCtx, step, "CLOUDNESS_BUILD_CACHE_IMAGE", cacheImage+":buildpacks")
+
+	step.AddStripCmds("#!/bin/sh\n\n", "set -e")
+
+	// build variables are handed to the buildpacks through the platform env directory
+	step.AddScriptCmd(fmt.Sprintf("mkdir -p %s/env", cnbPlatformPath))
+	addSecrets(pCtx, step, buildVars)
+	for key := range buildVars {
+		step.AddScriptCmd(fmt.Sprintf(`printf '%%s' "${%s}" > %s/env/%s`, key, cnbPlatformPath, key))
+	}
+
+	step.AddScriptCmd(fmt.Sprintf(`echo "Building with Buildpacks using %s"`, step.Image))
+	step.AddScriptCmd(fmt.Sprintf(
+		`%s -app="$CLOUDNESS_BUILD_SOURCE_PATH" -platform=%s -cache-image="$CLOUDNESS_BUILD_CACHE_IMAGE" -insecure-registry=%s "$CLOUDNESS_BUILD_IMAGE"`,
+		cnbCreator, cnbPlatformPath, registry,
+	))
+	step.Args = []string{step.GenerateShellScript()}
+
+	return step
+}
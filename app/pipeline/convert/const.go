@@ -12,6 +12,22 @@ const (
 
 	//deployment workspace volume
 	wsDeployVolumePath = "/cloudness/workspace/deploy"
+
+	// cloud native buildpacks lifecycle, shipped in every builder and app image
+	cnbCreator  = "/cnb/lifecycle/creator"
+	cnbLauncher = "/cnb/lifecycle/launcher"
+	// platform directory for build time environment, must be writable by the builder user
+	cnbPlatformPath = "/tmp/cnb-platform"
+
+	// autoscaling fallbacks for specs saved before the settings existed
+	defaultHPAUtilization            = 75
+	defaultHPAScaleDownStabilization = 300
+	defaultSleepAfterMinutes         = 15
+
+	// postgres cluster fallbacks when the template defines no volume or credentials
+	defaultPostgresStorage  = "1Gi"
+	defaultPostgresDatabase = "app"
+	defaultPostgresOwner    = "app"
 )
 
 var (
@@ -0,0 +1,100 @@
+package convert
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/cloudness-io/cloudness/app/pipeline"
+	specSvc "github.com/cloudness-io/cloudness/app/services/spec"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+
+	shlex "github.com/kballard/go-shellquote"
+)
+
+var (
+	errCronNotSupported       = errors.New("scheduled jobs are not supported on docker servers")
+	errPostgresHANotSupported = errors.New("postgres clusters are not supported on docker servers")
+)
+
+// containerDeployStep returns the step deploying the application on a docker server, the engine starts
+// the containers itself so the step has no image.
+func containerDeployStep(in *pipeline.RunnerContextInput, spec *types.ApplicationSpec, vars map[string]string) (*pipeline.Step, error) {
+	if specSvc.IsCron(spec) {
+		return nil, errCronNotSupported
+	}
+	if specSvc.IsPostgresHA(spec) {
+		return nil, errPostgresHANotSupported
+	}
+
+	_, pullImage, _ := specSvc.GetImage(in.Application, in.Deployment, in.Config)
+	deploy := &pipeline.ContainerDeploy{
+		Namespace:      in.Application.ParentSlug,
+		Identifier:     in.Application.GetIdentifierStr(),
+		ApplicationUID: in.Application.UID,
+		ProjectID:      in.Application.ProjectID,
+		Image:          pullImage,
+		Env:            vars,
+		Replicas:       max(spec.Deploy.MinReplicas, 1),
+		Stateful:       in.Application.Type == enum.ApplicationTypeStateful,
+		PrivateDomain:  in.Application.PrivateDomain,
+		Volumes:        make([]*pipeline.ContainerVolume, 0, len(in.Volumes)),
+		UpdatedAt:      strconv.FormatInt(time.Now().UTC().UnixMilli(), 10),
+	}
+	// containers of stateful apps share their volumes, a single replica writes to them
+	if deploy.Stateful {
+		deploy.Replicas = 1
+	}
+
+	if spec.ShouldAddStartCommand() {
+		parts, err := shlex.Split(spec.Deploy.StartCommand)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case specSvc.GetBuilder(spec) == enum.BuilderTypeBuildpacks:
+			// buildpack images need the launcher to set up the process environment
+			deploy.Command = []string{cnbLauncher}
+			deploy.Args = parts
+		case len(parts) > 0:
+			deploy.Command = parts[:1]
+			deploy.Args = parts[1:]
+		}
+	}
+
+	if in.RegistryCredential != nil {
+		auth, err := in.RegistryCredential.EngineAuth()
+		if err != nil {
+			return nil, err
+		}
+		deploy.RegistryAuth = auth
+	}
+
+	if spec.Networking.ServiceDomain != nil {
+		u, err := url.Parse(spec.Networking.ServiceDomain.Domain)
+		if err != nil {
+			return nil, err
+		}
+		deploy.Route = &pipeline.ContainerRoute{
+			Host:      u.Hostname(),
+			Port:      spec.Networking.ServiceDomain.Port,
+			Websecure: u.Scheme == "https",
+		}
+	}
+
+	for _, v := range in.Volumes {
+		deploy.Volumes = append(deploy.Volumes, &pipeline.ContainerVolume{
+			Name:      v.GetIdentifierStr(),
+			MountPath: v.MountPath,
+			Size:      v.Size * 1024 * 1024,
+		})
+	}
+
+	return &pipeline.Step{
+		Name:   "deploy",
+		Deploy: deploy,
+	}, nil
+}
@@ -1,7 +1,11 @@
 package convert
 
 import (
+	"fmt"
+
 	"github.com/cloudness-io/cloudness/app/pipeline"
+	specSvc "github.com/cloudness-io/cloudness/app/services/spec"
+	"github.com/cloudness-io/cloudness/types"
 	"github.com/cloudness-io/cloudness/types/enum"
 )
 
@@ -36,9 +40,64 @@ func ToRunnerContext(in *pipeline.RunnerContextInput) (*pipeline.RunnerContext,
 	}
 
 	// args
-	buildVars := map[string]string{}
-	runVars := map[string]string{}
-	for _, v := range in.Variables {
+	buildVars, runVars := splitVariables(in.Variables)
+
+	step := newHelperStep("deploy")
+
+	// rollbacks reuse the image built by the source deployment
+	if in.Deployment.NeedsBuild {
+		if err := initCommand(step, in, pCtx, spec); err != nil {
+			return nil, err
+		}
+
+		switch specSvc.GetBuilder(spec) {
+		case enum.BuilderTypeBuildpacks:
+			// the lifecycle runs inside the builder image, clone and deploy run in their own helper steps
+			step.Name = "clone"
+			step.AddScriptCmd(fmt.Sprintf("chmod -R a+rwX %s", wsBuildVolumePath))
+			step.Args = []string{step.GenerateShellScript()}
+			pCtx.Steps = append(pCtx.Steps, step, buildpacksStep(in, pCtx, spec, buildVars))
+
+			step = newHelperStep("deploy")
+		default:
+			if err := buildCommandNew(step, in, pCtx, spec, buildVars); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if in.ServerType == enum.ServerTypeDocker {
+		// the docker engine starts the containers itself, the helper step only clones and builds
+		if in.Deployment.NeedsBuild && specSvc.GetBuilder(spec) != enum.BuilderTypeBuildpacks &&
+			(specSvc.NeedsInit(spec) || specSvc.NeedsBuild(spec)) {
+			step.Name = "build"
+			step.Args = []string{step.GenerateShellScript()}
+			pCtx.Steps = append(pCtx.Steps, step)
+		}
+
+		deployStep, err := containerDeployStep(in, spec, runVars)
+		if err != nil {
+			return nil, err
+		}
+		pCtx.Steps = append(pCtx.Steps, deployStep)
+		return pCtx, nil
+	}
+
+	if err := deployCommand(step, in, pCtx, spec, runVars); err != nil {
+		return nil, err
+	}
+	step.Args = []string{step.GenerateShellScript()}
+
+	pCtx.Steps = append(pCtx.Steps, step)
+
+	return pCtx, nil
+}
+
+// splitVariables returns the values of the build and run variables.
+func splitVariables(variables map[string]*types.Variable) (buildVars, runVars map[string]string) {
+	buildVars = map[string]string{}
+	runVars = map[string]string{}
+	for _, v := range variables {
 		switch v.Type {
 		case enum.VariableTypeBuild:
 			buildVars[v.Key] = v.TextValue
@@ -49,9 +108,12 @@ func ToRunnerContext(in *pipeline.RunnerContextInput) (*pipeline.RunnerContext,
 			runVars[v.Key] = v.TextValue
 		}
 	}
+	return buildVars, runVars
+}
 
+func newHelperStep(name string) *pipeline.Step {
 	step := &pipeline.Step{
-		Name:           "deploy",
+		Name:           name,
 		Image:          baseImage,
 		Command:        []string{"/bin/sh", "-c"},
 		ScriptCommands: []string{},
@@ -61,21 +123,5 @@ func ToRunnerContext(in *pipeline.RunnerContextInput) (*pipeline.RunnerContext,
 	}
 
 	step.AddStripCmds("#!/bin/sh\n\n", "set -e", ". /usr/local/lib/cloudness-utils.sh")
-
-	if err := initCommand(step, in, pCtx, spec); err != nil {
-		return nil, err
-	}
-
-	if err := buildCommandNew(step, in, pCtx, spec, buildVars); err != nil {
-		return nil, err
-	}
-
-	if err := deployCommand(step, in, pCtx, spec, runVars); err != nil {
-		return nil, err
-	}
-	step.Args = []string{step.GenerateShellScript()}
-
-	pCtx.Steps = append(pCtx.Steps, step)
-
-	return pCtx, nil
+	return step
 }
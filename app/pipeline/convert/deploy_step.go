@@ -1,6 +1,7 @@
 package convert
 
 import (
+	"cmp"
 	"encoding/base64"
 	"fmt"
 	"net/url"
@@ -110,12 +111,25 @@ func getTemplateInput(image string, input *pipeline.RunnerContextInput, spec *ty
 			return nil, err
 		}
 
-		if len(parts) > 0 {
+		switch {
+		case specSvc.GetBuilder(spec) == enum.BuilderTypeBuildpacks:
+			// buildpack images need the launcher to set up the process environment
+			in.Command = []string{cnbLauncher}
+			in.Args = parts
+		case len(parts) > 0:
 			in.Command = parts[:1]
 			in.Args = parts[1:]
 		}
 	}
 
+	if input.RegistryCredential != nil {
+		dockerConfig, err := input.RegistryCredential.DockerConfigJSON()
+		if err != nil {
+			return nil, err
+		}
+		in.ImagePullSecret = dockerConfig
+	}
+
 	for key, value := range vars {
 		//NOTE: hack to avoid deployment failure due to empty value in secrets
 		if value != "" {
@@ -125,6 +139,12 @@ func getTemplateInput(image string, input *pipeline.RunnerContextInput, spec *ty
 		}
 	}
 
+	if specSvc.IsCron(spec) {
+		in.Cron = toCronJob(spec.Deploy)
+		// scheduled jobs are not reachable, networking is skipped
+		return appendVolumes(in, input), nil
+	}
+
 	containerPorts := make(map[int]bool, 0)
 	//allcontainer ports
 	for _, port := range spec.Networking.ContainerPorts {
@@ -156,6 +176,26 @@ func getTemplateInput(image string, input *pipeline.RunnerContextInput, spec *ty
 		}
 	}
 
+	if specSvc.IsPostgresHA(spec) {
+		// the operator runs the instances and claims their volumes, there is nothing to scale
+		in.PostgresHA = toPostgresHA(spec.Deploy, input.Volumes, vars)
+		return in, nil
+	}
+
+	in.Autoscaling = toAutoscaling(spec.Deploy, in.ServiceDomain != nil)
+	// only apps reached through the gateway of the primary cluster, where the activator runs, can be woken up
+	if spec.Deploy.SleepApplication && !in.HasState && in.ServiceDomain != nil &&
+		input.Application.ServerID == types.PrimaryServerID {
+		in.Sleep = &templates.Sleep{
+			AfterSeconds: cmp.Or(spec.Deploy.SleepAfterMinutes, defaultSleepAfterMinutes) * 60,
+			Port:         in.ServiceDomain.Port,
+		}
+	}
+
+	return appendVolumes(in, input), nil
+}
+
+func appendVolumes(in *templates.TemplateIn, input *pipeline.RunnerContextInput) *templates.TemplateIn {
 	for _, v := range input.Volumes {
 		in.Volumes = append(in.Volumes, &templates.Volume{
 			VolumeName: v.GetIdentifierStr(),
@@ -163,8 +203,59 @@ func getTemplateInput(image string, input *pipeline.RunnerContextInput, spec *ty
 			MountPath:  v.MountPath,
 		})
 	}
+	return in
+}
 
-	return in, nil
+// toPostgresHA returns the postgres cluster of the application, the first volume sizes the volume of every
+// instance and the database is created from the variables of the postgres image.
+func toPostgresHA(deploy *types.DeployConfiguration, volumes []*types.Volume, vars map[string]string) *templates.PostgresHA {
+	cluster := &templates.PostgresHA{
+		Instances: deploy.PostgresInstances,
+		Storage:   defaultPostgresStorage,
+		Database:  cmp.Or(vars["POSTGRES_DB"], defaultPostgresDatabase),
+		Owner:     cmp.Or(vars["POSTGRES_USER"], defaultPostgresOwner),
+	}
+	if len(volumes) > 0 {
+		cluster.Storage = fmt.Sprintf("%dMi", volumes[0].Size)
+	}
+	if password := vars["POSTGRES_PASSWORD"]; password != "" {
+		cluster.Password = base64.StdEncoding.EncodeToString([]byte(password))
+	}
+	return cluster
+}
+
+func toAutoscaling(deploy *types.DeployConfiguration, hasRoute bool) *templates.Autoscaling {
+	autoscaling := &templates.Autoscaling{
+		MinReplicas:                   max(deploy.MinReplicas, 1),
+		MaxReplicas:                   deploy.MaxReplicas,
+		TargetCPUUtilization:          cmp.Or(deploy.TargetCPUUtilization, defaultHPAUtilization),
+		TargetMemoryUtilization:       cmp.Or(deploy.TargetMemoryUtilization, defaultHPAUtilization),
+		ScaleUpStabilizationSeconds:   deploy.ScaleUpStabilizationSeconds,
+		ScaleDownStabilizationSeconds: cmp.Or(deploy.ScaleDownStabilizationSeconds, defaultHPAScaleDownStabilization),
+	}
+	autoscaling.MaxReplicas = max(autoscaling.MaxReplicas, autoscaling.MinReplicas)
+	// the request rate is only known for traffic passing through the gateway
+	if hasRoute && deploy.TargetRequestsPerReplica > 0 {
+		autoscaling.TargetRequestsPerReplica = deploy.TargetRequestsPerReplica
+		autoscaling.RequestsMetricName = types.GatewayRequestsMetric
+	}
+	return autoscaling
+}
+
+func toCronJob(deploy *types.DeployConfiguration) *templates.CronJob {
+	restartPolicy := "OnFailure"
+	if deploy.RestartPolicyType == enum.RestartPolicyTypeNever {
+		restartPolicy = "Never"
+	}
+
+	return &templates.CronJob{
+		Schedule:                   deploy.Schedule,
+		ConcurrencyPolicy:          string(deploy.ConcurrencyPolicy),
+		SuccessfulJobsHistoryLimit: deploy.SuccessfulJobsHistoryLimit,
+		FailedJobsHistoryLimit:     deploy.FailedJobsHistoryLimit,
+		RestartPolicy:              restartPolicy,
+		BackoffLimit:               deploy.RestartPolicyMaxRetries,
+	}
 }
 
 func needsVolumeRemount(currDeployment *types.Deployment, prevDeployment *types.Deployment) bool {
@@ -0,0 +1,20 @@
+package convert
+
+import (
+	"github.com/cloudness-io/cloudness/app/pipeline"
+	"github.com/cloudness-io/cloudness/app/pipeline/convert/templates"
+	specSvc "github.com/cloudness-io/cloudness/app/services/spec"
+)
+
+// ToTemplateInput returns the input of the kubernetes templates the deploy step renders for the spec of the
+// deployment, it is used to export the manifests outside of a deployment.
+func ToTemplateInput(in *pipeline.RunnerContextInput) (*templates.TemplateIn, error) {
+	spec := in.Deployment.Spec
+	if spec.HasStartCommand() {
+		spec.Deploy.StartCommand = replaceEnvVars(spec.Deploy.StartCommand, in.Variables)
+	}
+
+	_, runVars := splitVariables(in.Variables)
+	_, pullImage, _ := specSvc.GetImage(in.Application, in.Deployment, in.Config)
+	return getTemplateInput(pullImage, in, spec, runVars)
+}
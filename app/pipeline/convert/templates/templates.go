@@ -73,6 +73,11 @@ type (
 		Memory                 float64
 		Variables              map[string]string
 		Secrets                map[string]string
+		ImagePullSecret        string // base64 encoded dockerconfigjson of the private registry
+		Autoscaling            *Autoscaling
+		Sleep                  *Sleep
+		Cron                   *CronJob
+		PostgresHA             *PostgresHA
 		UpdatedAt              string
 	}
 
@@ -82,6 +87,41 @@ type (
 		Port      int
 	}
 
+	Autoscaling struct {
+		MinReplicas                   int64
+		MaxReplicas                   int64
+		TargetCPUUtilization          int
+		TargetMemoryUtilization       int
+		TargetRequestsPerReplica      int64 // 0 when request based scaling is disabled
+		RequestsMetricName            string
+		ScaleUpStabilizationSeconds   int
+		ScaleDownStabilizationSeconds int
+	}
+
+	// Sleep scales the application to zero when idle, the activator wakes it on the next request
+	Sleep struct {
+		AfterSeconds int
+		Port         int
+	}
+
+	CronJob struct {
+		Schedule                   string
+		ConcurrencyPolicy          string
+		SuccessfulJobsHistoryLimit int
+		FailedJobsHistoryLimit     int
+		RestartPolicy              string
+		BackoffLimit               int
+	}
+
+	// PostgresHA is the postgres cluster of the operator, the operator runs the pods and claims the volumes
+	PostgresHA struct {
+		Instances int64
+		Storage   string
+		Database  string
+		Owner     string
+		Password  string // base64 encoded, the database and owner are created only when set
+	}
+
 	Volume struct {
 		VolumeName string
 		Storage    string
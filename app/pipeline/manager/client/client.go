@@ -8,14 +8,15 @@ import (
 )
 
 type RunnerClient interface {
-	// GetPrimaryServerUID returns the primary server UID.
-	GetPrimaryServerUID(ctx context.Context) (int64, error) //NOTE: temporary
+	// GetServerUID returns the uid of the server the agent runs deployments for, the primary server
+	// for the embedded agent and the server of the runner token for remote agents.
+	GetServerUID(ctx context.Context) (int64, error)
 
 	// Config returns the runner config for the server.
 	Config(ctx context.Context, serverUID int64) (*types.RunnerConfig, error)
 
-	// Request requests the next available deployment for execution.
-	Request(ctx context.Context) (*types.Deployment, error)
+	// Request requests the next available deployment of the server for execution.
+	Request(ctx context.Context, serverUID int64) (*types.Deployment, error)
 
 	// Accept accepts a deployment for execution.
 	Accept(ctx context.Context, deployment *types.Deployment) error
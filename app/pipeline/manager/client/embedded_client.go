@@ -22,7 +22,7 @@ func NewEmbeddedClient(manager manager.RunnerManager) *embeddedClient {
 	}
 }
 
-func (e *embeddedClient) GetPrimaryServerUID(ctx context.Context) (int64, error) {
+func (e *embeddedClient) GetServerUID(ctx context.Context) (int64, error) {
 	return e.manager.GetPrimaryServerUID(ctx)
 }
 
@@ -30,8 +30,8 @@ func (e *embeddedClient) Config(ctx context.Context, serverUID int64) (*types.Ru
 	return e.manager.Config(ctx, serverUID)
 }
 
-func (e *embeddedClient) Request(ctx context.Context) (*types.Deployment, error) {
-	return e.manager.Request(ctx)
+func (e *embeddedClient) Request(ctx context.Context, serverUID int64) (*types.Deployment, error) {
+	return e.manager.Request(ctx, serverUID)
 }
 
 func (e *embeddedClient) Accept(ctx context.Context, deployment *types.Deployment) error {
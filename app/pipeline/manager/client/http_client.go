@@ -0,0 +1,178 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cloudness-io/cloudness/app/pipeline"
+	"github.com/cloudness-io/cloudness/app/usererror"
+	"github.com/cloudness-io/cloudness/types"
+)
+
+// requestTimeout bounds the requests to the control plane, it is above the long poll timeout of the server.
+const requestTimeout = 60 * time.Second
+
+type httpClient struct {
+	endpoint string
+	token    string
+	client   *http.Client
+}
+
+var _ RunnerClient = (*httpClient)(nil)
+
+// NewHTTPClient returns a runner client for remote agents talking to the runner api of the control plane.
+func NewHTTPClient(endpoint, token string) *httpClient {
+	return &httpClient{
+		endpoint: strings.TrimSuffix(endpoint, "/") + "/api/v1/runner",
+		token:    token,
+		client:   &http.Client{Timeout: requestTimeout},
+	}
+}
+
+func (c *httpClient) GetServerUID(ctx context.Context) (int64, error) {
+	out := new(types.RunnerServer)
+	if err := c.do(ctx, http.MethodGet, "/server", nil, out); err != nil {
+		return 0, err
+	}
+	return out.UID, nil
+}
+
+func (c *httpClient) Config(ctx context.Context, serverUID int64) (*types.RunnerConfig, error) {
+	out := new(types.RunnerConfig)
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("/servers/%d/config", serverUID), nil, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *httpClient) Request(ctx context.Context, serverUID int64) (*types.Deployment, error) {
+	out := new(types.RunnerDeployment)
+	found, err := c.doOptional(ctx, http.MethodPost, fmt.Sprintf("/servers/%d/request", serverUID), nil, out)
+	if err != nil || !found {
+		return nil, err
+	}
+	return toDeployment(out), nil
+}
+
+func (c *httpClient) Accept(ctx context.Context, deployment *types.Deployment) error {
+	in := &types.RunnerAcceptInput{Machine: deployment.Machine}
+	out := new(types.RunnerDeployment)
+	if err := c.do(ctx, http.MethodPost, fmt.Sprintf("/deployments/%d/accept", deployment.ID), in, out); err != nil {
+		return err
+	}
+	*deployment = *toDeployment(out)
+	return nil
+}
+
+func (c *httpClient) Init(ctx context.Context, deploymentID int64) (*pipeline.RunnerContext, error) {
+	out := new(pipeline.RunnerContext)
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("/deployments/%d/details", deploymentID), nil, out); err != nil {
+		return nil, err
+	}
+	// the id of the deployment is not part of its json representation
+	if out.Deployment != nil {
+		out.Deployment.ID = deploymentID
+	}
+	return out, nil
+}
+
+func (c *httpClient) Update(ctx context.Context, deployment *types.Deployment) error {
+	in := &types.RunnerDeployment{ID: deployment.ID, Deployment: *deployment}
+	out := new(types.RunnerDeployment)
+	if err := c.do(ctx, http.MethodPut, fmt.Sprintf("/deployments/%d", deployment.ID), in, out); err != nil {
+		return err
+	}
+	*deployment = *toDeployment(out)
+	return nil
+}
+
+func (c *httpClient) Watch(ctx context.Context, deploymentID int64) (bool, error) {
+	for {
+		out := new(types.RunnerWatchResult)
+		if err := c.do(ctx, http.MethodPost, fmt.Sprintf("/deployments/%d/watch", deploymentID), nil, out); err != nil {
+			return false, err
+		}
+		if !out.Retry {
+			return out.Done, nil
+		}
+	}
+}
+
+func (c *httpClient) Stream(ctx context.Context, deploymentID int64, logs []*types.LogLine) error {
+	return c.do(ctx, http.MethodPost, fmt.Sprintf("/deployments/%d/logs/stream", deploymentID), logs, nil)
+}
+
+func (c *httpClient) Upload(ctx context.Context, deploymentID int64, logs []*types.LogLine) error {
+	return c.do(ctx, http.MethodPost, fmt.Sprintf("/deployments/%d/logs/upload", deploymentID), logs, nil)
+}
+
+func (c *httpClient) UploadMetrics(ctx context.Context, metrics []*types.AppMetrics) error {
+	return c.do(ctx, http.MethodPost, "/metrics", metrics, nil)
+}
+
+func (c *httpClient) UploadAppStatus(ctx context.Context, status []*types.AppStatus) error {
+	return c.do(ctx, http.MethodPost, "/status", status, nil)
+}
+
+// do sends the json encoded input and decodes the response into out when set.
+func (c *httpClient) do(ctx context.Context, method, path string, in, out any) error {
+	_, err := c.doOptional(ctx, method, path, in, out)
+	return err
+}
+
+// doOptional is like do, it returns false when the server answers without content.
+func (c *httpClient) doOptional(ctx context.Context, method, path string, in, out any) (bool, error) {
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return false, err
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.endpoint+path, body)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		// surface the cancellation of the agent rather than the wrapped transport error
+		if ctx.Err() != nil {
+			return false, ctx.Err()
+		}
+		return false, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= http.StatusBadRequest {
+		uerr := &usererror.Error{Status: res.StatusCode}
+		if err := json.NewDecoder(res.Body).Decode(uerr); err != nil || uerr.Message == "" {
+			uerr.Message = http.StatusText(res.StatusCode)
+		}
+		return false, fmt.Errorf("runner api %s %s: %w", method, path, uerr)
+	}
+	if res.StatusCode == http.StatusNoContent || out == nil {
+		return res.StatusCode != http.StatusNoContent, nil
+	}
+
+	return true, json.NewDecoder(res.Body).Decode(out)
+}
+
+// toDeployment restores the id of the deployment, it is not part of the json representation of deployments.
+func toDeployment(in *types.RunnerDeployment) *types.Deployment {
+	deployment := in.Deployment
+	deployment.ID = in.ID
+	return &deployment
+}
@@ -43,5 +43,9 @@ func (m *runnerManager) down(ctx context.Context, deployment *types.Deployment)
 		log.Warn().Err(err).Msg("manager: could not publish application updated event")
 	}
 
+	if err := m.notificationSvc.NotifyDeployment(ctx, app, deployment); err != nil {
+		log.Warn().Err(err).Msg("manager: could not notify deployment")
+	}
+
 	return nil
 }
@@ -6,15 +6,19 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/cloudness-io/cloudness/app/controller/registrycredential"
 	"github.com/cloudness-io/cloudness/app/controller/variable"
 	"github.com/cloudness-io/cloudness/app/pipeline"
 	"github.com/cloudness-io/cloudness/app/pipeline/convert"
 	"github.com/cloudness-io/cloudness/app/pipeline/scheduler"
 	"github.com/cloudness-io/cloudness/app/services/config"
+	"github.com/cloudness-io/cloudness/app/services/gitconnection"
 	"github.com/cloudness-io/cloudness/app/services/githubapp"
+	"github.com/cloudness-io/cloudness/app/services/notification"
 	specSvc "github.com/cloudness-io/cloudness/app/services/spec"
 	"github.com/cloudness-io/cloudness/app/sse"
 	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/app/token"
 	"github.com/cloudness-io/cloudness/logstream"
 	basestore "github.com/cloudness-io/cloudness/store"
 	"github.com/cloudness-io/cloudness/types"
@@ -30,11 +34,20 @@ type (
 		// GetPrimaryServerUID returns the primary server UID.
 		GetPrimaryServerUID(ctx context.Context) (int64, error) //NOTE: temporary
 
+		// FindServerByRunnerToken returns the server the runner token of a remote agent was issued for.
+		FindServerByRunnerToken(ctx context.Context, runnerToken string) (*types.Server, error)
+
+		// FindDeploymentServerID returns the id of the server the deployment runs on.
+		FindDeploymentServerID(ctx context.Context, deploymentID int64) (int64, error)
+
+		// ListServerApplications returns the applications of the server by uid.
+		ListServerApplications(ctx context.Context, serverID int64) (map[int64]*types.Application, error)
+
 		// Config returns the runner config for the server.
 		Config(ctx context.Context, serverUID int64) (*types.RunnerConfig, error)
 
-		// Request requests the next available deployment for execution.
-		Request(ctx context.Context) (*types.Deployment, error)
+		// Request requests the next available deployment of the server for execution.
+		Request(ctx context.Context, serverUID int64) (*types.Deployment, error)
 
 		// Accept accepts a deployment for execution.
 		Accept(ctx context.Context, deploymentID int64, machine string) (*types.Deployment, error)
@@ -72,8 +85,11 @@ type runnerManager struct {
 	logStore         store.LogStore
 	metricsStore     store.MetricsStore
 	varCtrl          *variable.Controller
+	regCredCtrl      *registrycredential.Controller
 	configSvc        *config.Service
 	ghAppSvc         *githubapp.Service
+	gitConnSvc       *gitconnection.Service
+	notificationSvc  *notification.Service
 	sseStreamer      sse.Streamer
 	logz             logstream.LogStream
 }
@@ -86,8 +102,11 @@ func New(scheduler scheduler.Scheduler,
 	logStore store.LogStore,
 	metrcisStore store.MetricsStore,
 	varCtrl *variable.Controller,
+	regCredCtrl *registrycredential.Controller,
 	configSvc *config.Service,
 	ghAppSvc *githubapp.Service,
+	gitConnSvc *gitconnection.Service,
+	notificationSvc *notification.Service,
 	sseStreamer sse.Streamer,
 	logStream logstream.LogStream,
 ) RunnerManager {
@@ -100,15 +119,18 @@ func New(scheduler scheduler.Scheduler,
 		logStore:         logStore,
 		metricsStore:     metrcisStore,
 		varCtrl:          varCtrl,
+		regCredCtrl:      regCredCtrl,
 		configSvc:        configSvc,
 		ghAppSvc:         ghAppSvc,
+		gitConnSvc:       gitConnSvc,
+		notificationSvc:  notificationSvc,
 		sseStreamer:      sseStreamer,
 		logz:             logStream,
 	}
 }
 
 func (m *runnerManager) GetPrimaryServerUID(ctx context.Context) (int64, error) {
-	server, err := m.serverStore.Find(ctx, 1)
+	server, err := m.serverStore.Find(ctx, types.PrimaryServerID)
 	if err != nil {
 		return 0, err
 	}
@@ -116,6 +138,42 @@ func (m *runnerManager) GetPrimaryServerUID(ctx context.Context) (int64, error)
 	return server.UID, nil
 }
 
+func (m *runnerManager) FindServerByRunnerToken(ctx context.Context, runnerToken string) (*types.Server, error) {
+	return m.serverStore.FindByRunnerTokenHash(ctx, token.HashRunnerToken(runnerToken))
+}
+
+func (m *runnerManager) FindDeploymentServerID(ctx context.Context, deploymentID int64) (int64, error) {
+	deployment, err := m.deploymentStore.Find(ctx, deploymentID)
+	if err != nil {
+		return 0, err
+	}
+	app, err := m.applicationStore.Find(ctx, deployment.ApplicationID)
+	if err != nil {
+		return 0, err
+	}
+	return app.ServerID, nil
+}
+
+func (m *runnerManager) ListServerApplications(ctx context.Context, serverID int64) (map[int64]*types.Application, error) {
+	const pageSize = 100
+	apps := map[int64]*types.Application{}
+	for page := 1; ; page++ {
+		list, err := m.applicationStore.List(ctx, &types.ApplicationFilter{
+			ListQueryFilter: types.ListQueryFilter{Pagination: types.Pagination{Page: page, Size: pageSize}},
+			ServerID:        &serverID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, app := range list {
+			apps[app.UID] = app
+		}
+		if len(list) < pageSize {
+			return apps, nil
+		}
+	}
+}
+
 func (m *runnerManager) Config(ctx context.Context, serverUID int64) (*types.RunnerConfig, error) {
 	server, err := m.serverStore.FindByUID(ctx, serverUID)
 	if err != nil {
@@ -129,21 +187,30 @@ func (m *runnerManager) Config(ctx context.Context, serverUID int64) (*types.Run
 		MaxCPU:          server.MaxCPUPerBuild,
 		MaxMemory:       server.MaxMemoryPerBuild,
 		UpdatedAt:       server.Updated,
+		ServerType:      server.Type,
 	}
 	switch server.Type {
 	case enum.ServerTypeK8s:
 		config.Engine = "kubernetes"
 		config.KubeNameSpace = "cloudness"
+	case enum.ServerTypeDocker:
+		config.Engine = "docker"
 	}
 	return config, nil
 }
 
-func (m *runnerManager) Request(ctx context.Context) (*types.Deployment, error) {
-	log := log.Ctx(ctx).With().Logger()
+func (m *runnerManager) Request(ctx context.Context, serverUID int64) (*types.Deployment, error) {
+	log := log.Ctx(ctx).With().Int64("server.uid", serverUID).Logger()
 
 	log.Trace().Msg("manager: request deployment from queue")
 
-	deployment, err := m.scheduler.Request(ctx, scheduler.Filter{})
+	server, err := m.serverStore.FindByUID(ctx, serverUID)
+	if err != nil {
+		log.Warn().Err(err).Msg("manager: error finding server")
+		return nil, err
+	}
+
+	deployment, err := m.scheduler.Request(ctx, scheduler.Filter{ServerID: server.ID})
 	if err != nil && ctx.Err() != nil {
 		log.Trace().Err(err).Msg("manager: context canceled")
 		return nil, err
@@ -240,6 +307,13 @@ func (m *runnerManager) Details(ctx context.Context, deploymentID int64) (*pipel
 		log.Ctx(ctx).Error().Err(err).Msg("manager: error getting volumes")
 		return nil, err
 	}
+	// the repository config can move and grow the volumes, a claim can not shrink so the growth is kept
+	for _, volume := range specSvc.ApplyVolumeMounts(volumes, deployment.Spec) {
+		if _, err := m.volumeStore.Update(ctx, volume); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("manager: error updating volume size")
+			return nil, err
+		}
+	}
 
 	server, err := m.serverStore.Find(ctx, app.ServerID)
 	if err != nil {
@@ -247,13 +321,14 @@ func (m *runnerManager) Details(ctx context.Context, deploymentID int64) (*pipel
 		return nil, err
 	}
 
-	config, err := m.configSvc.Pipeline(ctx)
+	config, err := m.configSvc.ServerPipeline(ctx, server)
 	if err != nil {
 		log.Ctx(ctx).Error().Err(err).Msg("manager: error getting pipeline config")
 	}
 	serverRestrictions := m.configSvc.GetServerRestrictions(server)
 
 	runnerCtxIn := &pipeline.RunnerContextInput{
+		ServerType:         server.Type,
 		Application:        app,
 		Variables:          variables,
 		Deployment:         deployment,
@@ -272,6 +347,24 @@ func (m *runnerManager) Details(ctx context.Context, deploymentID int64) (*pipel
 		runnerCtxIn.Netrc = netrc
 	}
 
+	if app.GetGitConnectionID() > 0 {
+		netrc, err := m.gitConnSvc.Netrc(ctx, app.TenantID, app.ProjectID, app.GetGitConnectionID(), specSvc.GetGitRepoUrl(app.Spec))
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("manager: error getting netrc for git connection")
+			return nil, err
+		}
+		runnerCtxIn.Netrc = netrc
+	}
+
+	if credUID := specSvc.GetRegistryCredentialUID(app, deployment); credUID > 0 {
+		cred, err := m.regCredCtrl.Resolve(ctx, app.TenantID, credUID)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("manager: error resolving registry credential")
+			return nil, err
+		}
+		runnerCtxIn.RegistryCredential = cred
+	}
+
 	runnerCtx, err := convert.ToRunnerContext(runnerCtxIn)
 	if err != nil {
 		deployment.Fail(err)
@@ -353,14 +446,38 @@ func (m *runnerManager) UploadMetrics(ctx context.Context, metrics []*types.AppM
 
 func (m *runnerManager) UploadAppStatus(ctx context.Context, statuses []*types.AppStatus) error {
 	for _, status := range statuses {
-		if _, err := m.applicationStore.UpdateStatus(ctx, status.ApplicationUID, status.Status); err != nil {
+		changed, err := m.applicationStore.UpdateStatus(ctx, status.ApplicationUID, status.Status)
+		if err != nil {
 			log.Ctx(ctx).Error().Err(err).Msg("manager: error updating application status")
 			continue
 		}
 
+		if changed && status.Status == enum.ApplicationStatusError {
+			m.notifyCrashed(ctx, status)
+		}
+
 		if err := m.sseStreamer.Publish(ctx, status.ProjectID, enum.SSETypeApplicationStatusUpdated, status.ToEvent()); err != nil {
 			log.Warn().Err(err).Msg("manager: could not publish application updated event")
 		}
 	}
 	return nil
 }
+
+// notifyCrashed notifies the subscribers of the project that the application went into the error status.
+func (m *runnerManager) notifyCrashed(ctx context.Context, status *types.AppStatus) {
+	apps, err := m.applicationStore.List(ctx, &types.ApplicationFilter{ProjectID: &status.ProjectID})
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msg("manager: could not list applications of crashed application")
+		return
+	}
+
+	for _, app := range apps {
+		if app.UID != status.ApplicationUID {
+			continue
+		}
+		if err := m.notificationSvc.NotifyApplicationCrashed(ctx, app, status.Reason); err != nil {
+			log.Ctx(ctx).Warn().Err(err).Msg("manager: could not notify crashed application")
+		}
+		return
+	}
+}
@@ -1,10 +1,13 @@
 package manager
 
 import (
+	"github.com/cloudness-io/cloudness/app/controller/registrycredential"
 	"github.com/cloudness-io/cloudness/app/controller/variable"
 	"github.com/cloudness-io/cloudness/app/pipeline/scheduler"
 	"github.com/cloudness-io/cloudness/app/services/config"
+	"github.com/cloudness-io/cloudness/app/services/gitconnection"
 	"github.com/cloudness-io/cloudness/app/services/githubapp"
+	"github.com/cloudness-io/cloudness/app/services/notification"
 	"github.com/cloudness-io/cloudness/app/sse"
 	"github.com/cloudness-io/cloudness/app/store"
 	"github.com/cloudness-io/cloudness/logstream"
@@ -25,8 +28,11 @@ func ProvideRunnerManager(
 	logStore store.LogStore,
 	metricsStore store.MetricsStore,
 	varCtrl *variable.Controller,
+	regCredCtrl *registrycredential.Controller,
 	configSvc *config.Service,
 	ghAppSvc *githubapp.Service,
+	gitConnSvc *gitconnection.Service,
+	notificationSvc *notification.Service,
 	sseStreamer sse.Streamer,
 	logStream logstream.LogStream,
 ) RunnerManager {
@@ -38,8 +44,11 @@ func ProvideRunnerManager(
 		logStore,
 		metricsStore,
 		varCtrl,
+		regCredCtrl,
 		configSvc,
 		ghAppSvc,
+		gitConnSvc,
+		notificationSvc,
 		sseStreamer,
 		logStream,
 	)
@@ -0,0 +1,224 @@
+package docker
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudness-io/cloudness/app/pipeline"
+	dockermanager "github.com/cloudness-io/cloudness/app/services/manager/docker"
+
+	cerrdefs "github.com/containerd/errdefs"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/stdcopy"
+)
+
+const (
+	// time given to the new containers to crash before the old ones are removed
+	deployStartupGrace = 5 * time.Second
+	deployLogTail      = "20"
+)
+
+// deploy starts the containers of the application and removes the containers of the previous deployment,
+// progress is written to w.
+func deploy(ctx context.Context, cli *client.Client, in *pipeline.ContainerDeploy, w io.Writer) error {
+	networkName := dockermanager.NamespaceNetwork(in.Namespace)
+	if err := dockermanager.EnsureNetwork(ctx, cli, networkName); err != nil {
+		return err
+	}
+	if in.Route != nil {
+		if err := dockermanager.ConnectNetwork(ctx, cli, networkName, dockermanager.TraefikContainer); err != nil {
+			if cerrdefs.IsNotFound(err) {
+				return fmt.Errorf("traefik is not running on the server, bootstrap the server first")
+			}
+			return err
+		}
+	}
+
+	fmt.Fprintf(w, "Pulling image %s\n", in.Image)
+	if err := dockermanager.PullImage(ctx, cli, in.Image, in.RegistryAuth, io.Discard); err != nil {
+		return fmt.Errorf("could not pull image: %w", err)
+	}
+
+	mounts := make([]mount.Mount, 0, len(in.Volumes))
+	for _, v := range in.Volumes {
+		name := dockermanager.VolumeName(in.Namespace, v.Name)
+		err := dockermanager.EnsureVolume(ctx, cli, name, map[string]string{
+			dockermanager.LabelManagedBy: dockermanager.ManagedBy,
+			dockermanager.LabelNamespace: in.Namespace,
+			dockermanager.LabelVolume:    v.Name,
+			dockermanager.LabelSize:      strconv.FormatInt(v.Size, 10),
+		})
+		if err != nil {
+			return err
+		}
+		mounts = append(mounts, mount.Mount{Type: mount.TypeVolume, Source: name, Target: v.MountPath})
+	}
+
+	previous, err := dockermanager.ListAppContainers(ctx, cli, in.Namespace, in.Identifier)
+	if err != nil {
+		return err
+	}
+
+	// a stateful application never runs two containers on the same volumes
+	if in.Stateful {
+		if err := removeContainers(ctx, cli, previous, w); err != nil {
+			return err
+		}
+		previous = nil
+	}
+
+	config := &container.Config{
+		Image:      in.Image,
+		Entrypoint: in.Command,
+		Cmd:        in.Args,
+		Env:        toDeployEnv(in.Env),
+		Labels:     toDeployLabels(in, networkName),
+	}
+	hostConfig := &container.HostConfig{
+		Mounts:        mounts,
+		RestartPolicy: container.RestartPolicy{Name: container.RestartPolicyUnlessStopped},
+	}
+	endpoint := &network.EndpointSettings{Aliases: []string{in.Identifier}}
+	if in.PrivateDomain != "" {
+		endpoint.Aliases = append(endpoint.Aliases, in.PrivateDomain)
+	}
+	networking := &network.NetworkingConfig{
+		EndpointsConfig: map[string]*network.EndpointSettings{networkName: endpoint},
+	}
+
+	started := make([]string, 0, in.Replicas)
+	for i := range in.Replicas {
+		name := fmt.Sprintf("%s-%s-%s-%d", in.Namespace, in.Identifier, in.UpdatedAt, i)
+		fmt.Fprintf(w, "Starting container %s\n", name)
+
+		if _, err := cli.ContainerCreate(ctx, config, hostConfig, networking, nil, name); err != nil {
+			removeNames(ctx, cli, started)
+			return err
+		}
+		started = append(started, name)
+		if err := cli.ContainerStart(ctx, name, container.StartOptions{}); err != nil {
+			removeNames(ctx, cli, started)
+			return err
+		}
+	}
+
+	select {
+	case <-ctx.Done():
+		removeNames(ctx, cli, started)
+		return ctx.Err()
+	case <-time.After(deployStartupGrace):
+	}
+
+	for _, name := range started {
+		inspect, err := cli.ContainerInspect(ctx, name)
+		if err != nil {
+			removeNames(ctx, cli, started)
+			return err
+		}
+		if inspect.State == nil || !inspect.State.Running || inspect.State.Restarting {
+			tail := containerLogTail(ctx, cli, name)
+			removeNames(ctx, cli, started)
+			return fmt.Errorf("container %s did not start: %s", name, tail)
+		}
+	}
+
+	if err := removeContainers(ctx, cli, previous, w); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "Deployed %d container(s)\n", len(started))
+	return nil
+}
+
+// toDeployLabels returns the labels the server manager finds the containers by, and the traefik labels
+// routing the domain of the application to them.
+func toDeployLabels(in *pipeline.ContainerDeploy, networkName string) map[string]string {
+	labels := map[string]string{
+		dockermanager.LabelManagedBy:   dockermanager.ManagedBy,
+		dockermanager.LabelNamespace:   in.Namespace,
+		dockermanager.LabelInstance:    in.Identifier,
+		dockermanager.LabelName:        in.Identifier,
+		dockermanager.LabelApplication: strconv.FormatInt(in.ApplicationUID, 10),
+		dockermanager.LabelProject:     strconv.FormatInt(in.ProjectID, 10),
+		dockermanager.LabelUpdatedAt:   in.UpdatedAt,
+		dockermanager.LabelComponent:   dockermanager.ComponentApp,
+	}
+	if in.Route == nil {
+		return labels
+	}
+
+	router := in.Namespace + "-" + in.Identifier
+	labels["traefik.enable"] = "true"
+	labels["traefik.docker.network"] = networkName
+	labels["traefik.http.routers."+router+".rule"] = "Host(`" + in.Route.Host + "`)"
+	labels["traefik.http.routers."+router+".service"] = router
+	labels["traefik.http.services."+router+".loadbalancer.server.port"] = strconv.Itoa(in.Route.Port)
+	if in.Route.Websecure {
+		labels["traefik.http.routers."+router+".entrypoints"] = "websecure"
+		labels["traefik.http.routers."+router+".tls.certresolver"] = dockermanager.CertResolver
+
+		// plain http requests are redirected to https
+		labels["traefik.http.routers."+router+"-http.rule"] = "Host(`" + in.Route.Host + "`)"
+		labels["traefik.http.routers."+router+"-http.entrypoints"] = "web"
+		labels["traefik.http.routers."+router+"-http.middlewares"] = router + "-https"
+		labels["traefik.http.middlewares."+router+"-https.redirectscheme.scheme"] = "https"
+	} else {
+		labels["traefik.http.routers."+router+".entrypoints"] = "web"
+	}
+	return labels
+}
+
+func toDeployEnv(vars map[string]string) []string {
+	env := make([]string, 0, len(vars))
+	for key, value := range vars {
+		env = append(env, key+"="+value)
+	}
+	sort.Strings(env)
+	return env
+}
+
+func removeContainers(ctx context.Context, cli *client.Client, containers []container.Summary, w io.Writer) error {
+	for _, c := range containers {
+		name := c.ID
+		if len(c.Names) > 0 {
+			name = strings.TrimPrefix(c.Names[0], "/")
+		}
+		fmt.Fprintf(w, "Removing container %s\n", name)
+		err := cli.ContainerRemove(ctx, c.ID, container.RemoveOptions{Force: true})
+		if err != nil && !cerrdefs.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeNames removes the containers of a failed deployment, errors are ignored as the deployment already failed.
+func removeNames(ctx context.Context, cli *client.Client, names []string) {
+	for _, name := range names {
+		_ = cli.ContainerRemove(context.WithoutCancel(ctx), name, container.RemoveOptions{Force: true})
+	}
+}
+
+// containerLogTail returns the last lines logged by the container.
+func containerLogTail(ctx context.Context, cli *client.Client, name string) string {
+	rc, err := cli.ContainerLogs(ctx, name, container.LogsOptions{ShowStdout: true, ShowStderr: true, Tail: deployLogTail})
+	if err != nil {
+		return err.Error()
+	}
+	defer rc.Close()
+
+	var buf bytes.Buffer
+	if _, err := stdcopy.StdCopy(&buf, &buf, rc); err != nil {
+		return err.Error()
+	}
+	return strings.TrimSpace(buf.String())
+}
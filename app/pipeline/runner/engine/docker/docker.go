@@ -0,0 +1,354 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/cloudness-io/cloudness/app/pipeline"
+	"github.com/cloudness-io/cloudness/app/pipeline/runner/engine"
+	dockermanager "github.com/cloudness-io/cloudness/app/services/manager/docker"
+	"github.com/cloudness-io/cloudness/types"
+
+	cerrdefs "github.com/containerd/errdefs"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/api/types/volume"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	pipelineIDLabel             = "pipeline.id"
+	pipelineDeploymentUIDLabel  = "deployment.uid"
+	pipelineApplicationUIDLabel = "application.uid"
+	pipelineStepLabel           = "pipeline.step"
+	pipelineRunnerLabel         = "runner.type"
+	pipelineRunnerValue         = "docker"
+
+	dockerSocket = "/var/run/docker.sock"
+)
+
+type dockerEngine struct {
+	client *client.Client
+	config types.RunnerConfig
+
+	// deploys are the deploy steps run by the engine itself, by step container name
+	deploysMu sync.Mutex
+	deploys   map[string]*deployRun
+}
+
+// deployRun is a deploy step in progress, its log is read from the pipe.
+type deployRun struct {
+	logs *io.PipeReader
+	done chan struct{}
+	err  error
+}
+
+func New() engine.Engine {
+	return &dockerEngine{
+		deploys: map[string]*deployRun{},
+	}
+}
+
+func (e *dockerEngine) Type() string {
+	return "docker"
+}
+
+func (e *dockerEngine) IsAvailable(_ types.RunnerConfig) bool {
+	if len(os.Getenv("DOCKER_HOST")) > 0 {
+		return true
+	}
+	_, err := os.Stat(dockerSocket)
+	return err == nil
+}
+
+func (e *dockerEngine) Load(ctx context.Context, config types.RunnerConfig) (*engine.EngineInfo, error) {
+	e.config = config
+
+	cli, err := dockermanager.NewClient("")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := cli.Ping(ctx); err != nil {
+		return nil, err
+	}
+	e.client = cli
+
+	// steps push to the registry over the cloudness network
+	if err := dockermanager.EnsureNetwork(ctx, e.client, dockermanager.Network); err != nil {
+		return nil, err
+	}
+
+	return &engine.EngineInfo{}, nil
+}
+
+func (e *dockerEngine) ListIncomplete(ctx context.Context) ([]int64, error) {
+	containers, err := e.client.ContainerList(ctx, container.ListOptions{
+		All:     true,
+		Filters: filters.NewArgs(filters.Arg("label", fmt.Sprintf("%s=%s", pipelineRunnerLabel, pipelineRunnerValue))),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	dst := make([]int64, 0, len(containers))
+	seen := map[int64]bool{}
+	for _, c := range containers {
+		uidStr := c.Labels[pipelineDeploymentUIDLabel]
+		uid, err := strconv.ParseInt(uidStr, 10, 64)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Str(pipelineDeploymentUIDLabel, uidStr).Msg("engine: unable up parse deployment uid in resume phase")
+			continue
+		}
+		// every step of a deployment runs in its own container
+		if !seen[uid] {
+			seen[uid] = true
+			dst = append(dst, uid)
+		}
+	}
+
+	return dst, nil
+}
+
+// Setup creates the workspace volumes shared by the step containers.
+func (e *dockerEngine) Setup(ctx context.Context, pCtx *pipeline.RunnerContext) error {
+	for _, ws := range pCtx.Workspaces {
+		_, err := e.client.VolumeCreate(ctx, volume.CreateOptions{
+			Name:   ws.ID,
+			Labels: toLabels(pCtx),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *dockerEngine) StartStep(ctx context.Context, pCtx *pipeline.RunnerContext, step *pipeline.Step) error {
+	log := zerolog.Ctx(ctx)
+	log.Trace().Msg("engine: starting step")
+
+	name := stepContainerName(pCtx, step)
+	if step.Deploy != nil {
+		pr, pw := io.Pipe()
+		run := &deployRun{logs: pr, done: make(chan struct{})}
+		e.deploysMu.Lock()
+		e.deploys[name] = run
+		e.deploysMu.Unlock()
+
+		go func() {
+			defer close(run.done)
+			run.err = deploy(ctx, e.client, step.Deploy, pw)
+			if run.err != nil {
+				fmt.Fprintf(pw, "Deployment failed: %s\n", run.err)
+			}
+			pw.Close()
+		}()
+		return nil
+	}
+
+	if _, err := e.client.ImageInspect(ctx, step.Image); cerrdefs.IsNotFound(err) {
+		if err := dockermanager.PullImage(ctx, e.client, step.Image, "", io.Discard); err != nil {
+			return fmt.Errorf("could not pull image for step %s: %w", step.Name, err)
+		}
+	}
+
+	labels := toLabels(pCtx)
+	labels[pipelineStepLabel] = step.Name
+	_, err := e.client.ContainerCreate(ctx, &container.Config{
+		Image:      step.Image,
+		Entrypoint: step.Command,
+		Cmd:        step.Args,
+		WorkingDir: step.WorkingDir,
+		Env:        toEnv(step, pCtx),
+		Labels:     labels,
+	}, &container.HostConfig{
+		Privileged: step.Privileged,
+		Mounts:     toMounts(step),
+		Resources:  toResources(pCtx),
+	}, &network.NetworkingConfig{
+		EndpointsConfig: map[string]*network.EndpointSettings{dockermanager.Network: {}},
+	}, nil, name)
+	if err != nil {
+		return err
+	}
+
+	return e.client.ContainerStart(ctx, name, container.StartOptions{})
+}
+
+func (e *dockerEngine) TailStep(ctx context.Context, pCtx *pipeline.RunnerContext, step *pipeline.Step) (io.ReadCloser, error) {
+	name := stepContainerName(pCtx, step)
+	if step.Deploy != nil {
+		run, err := e.deployRun(name)
+		if err != nil {
+			return nil, err
+		}
+		return run.logs, nil
+	}
+
+	logs, err := e.client.ContainerLogs(ctx, name, container.LogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Follow:     true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rc, wc := io.Pipe()
+
+	go func() {
+		defer logs.Close()
+		// steps run without a tty, stdout and stderr are multiplexed on the stream
+		_, err := stdcopy.StdCopy(wc, wc, logs)
+		wc.CloseWithError(err)
+	}()
+
+	return rc, nil
+}
+
+func (e *dockerEngine) WaitStep(ctx context.Context, pCtx *pipeline.RunnerContext, step *pipeline.Step) (*engine.State, error) {
+	name := stepContainerName(pCtx, step)
+	if step.Deploy != nil {
+		run, err := e.deployRun(name)
+		if err != nil {
+			return nil, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-run.done:
+		}
+
+		e.deploysMu.Lock()
+		delete(e.deploys, name)
+		e.deploysMu.Unlock()
+
+		if run.err != nil {
+			return nil, run.err
+		}
+		return &engine.State{ExitCode: 0, Exited: true}, nil
+	}
+
+	waitc, errc := e.client.ContainerWait(ctx, name, container.WaitConditionNotRunning)
+	select {
+	case err := <-errc:
+		return nil, err
+	case res := <-waitc:
+		state := &engine.State{
+			ExitCode: int(res.StatusCode),
+			Exited:   true,
+		}
+		if inspect, err := e.client.ContainerInspect(ctx, name); err == nil && inspect.State != nil {
+			state.OOMKilled = inspect.State.OOMKilled
+		}
+		return state, nil
+	}
+}
+
+func (e *dockerEngine) Destroy(ctx context.Context, pCtx *pipeline.RunnerContext) error {
+	args := filters.NewArgs(filters.Arg("label", fmt.Sprintf("%s=%s", pipelineIDLabel, pCtx.RunnerName)))
+
+	containers, err := e.client.ContainerList(ctx, container.ListOptions{All: true, Filters: args})
+	if err != nil {
+		return err
+	}
+	for _, c := range containers {
+		err := e.client.ContainerRemove(ctx, c.ID, container.RemoveOptions{Force: true})
+		if err != nil && !cerrdefs.IsNotFound(err) {
+			return err
+		}
+	}
+
+	for _, ws := range pCtx.Workspaces {
+		err := e.client.VolumeRemove(ctx, ws.ID, true)
+		if err != nil && !cerrdefs.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *dockerEngine) deployRun(name string) (*deployRun, error) {
+	e.deploysMu.Lock()
+	defer e.deploysMu.Unlock()
+
+	run, ok := e.deploys[name]
+	if !ok {
+		return nil, fmt.Errorf("engine: deploy %s not started", name)
+	}
+	return run, nil
+}
+
+func stepContainerName(pCtx *pipeline.RunnerContext, step *pipeline.Step) string {
+	return pCtx.RunnerName + "-" + step.Name
+}
+
+func toLabels(pCtx *pipeline.RunnerContext) map[string]string {
+	return map[string]string{
+		pipelineIDLabel:             pCtx.RunnerName,
+		pipelineRunnerLabel:         pipelineRunnerValue,
+		pipelineDeploymentUIDLabel:  strconv.FormatInt(pCtx.Deployment.UID, 10),
+		pipelineApplicationUIDLabel: strconv.FormatInt(pCtx.ApplicationUID, 10),
+	}
+}
+
+func toEnv(step *pipeline.Step, pCtx *pipeline.RunnerContext) []string {
+	env := make([]string, 0, len(step.Envs)+len(step.Secrets)+len(step.Variables))
+	for key, value := range step.Envs {
+		env = append(env, key+"="+value)
+	}
+
+	secrets := make(map[string]string, len(pCtx.Secrets))
+	for _, s := range pCtx.Secrets {
+		secrets[s.Name] = s.Data
+	}
+	for _, s := range step.Secrets {
+		env = append(env, s.Key+"="+secrets[s.Key])
+	}
+
+	variables := make(map[string]string, len(pCtx.Variables))
+	for _, v := range pCtx.Variables {
+		variables[v.Name] = v.Value
+	}
+	for _, v := range step.Variables {
+		env = append(env, v.Key+"="+variables[v.Key])
+	}
+	return env
+}
+
+func toMounts(step *pipeline.Step) []mount.Mount {
+	mounts := make([]mount.Mount, 0, len(step.VolumeMounts))
+	for _, vm := range step.VolumeMounts {
+		mounts = append(mounts, mount.Mount{
+			Type:     mount.TypeVolume,
+			Source:   vm.ID,
+			Target:   vm.Path,
+			ReadOnly: vm.Readonly,
+		})
+	}
+	return mounts
+}
+
+// toResources limits the step containers to the build limits of the server, cpu in cores and memory in GB.
+func toResources(pCtx *pipeline.RunnerContext) container.Resources {
+	var dst container.Resources
+	if pCtx.ResourcesLimit == nil {
+		return dst
+	}
+	if pCtx.ResourcesLimit.CPU > 0 {
+		dst.NanoCPUs = int64(pCtx.ResourcesLimit.CPU * 1e9)
+	}
+	if pCtx.ResourcesLimit.Memory > 0 {
+		dst.Memory = int64(pCtx.ResourcesLimit.Memory * 1024 * 1024 * 1024)
+	}
+	return dst
+}
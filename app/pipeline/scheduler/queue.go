@@ -21,12 +21,13 @@ type queue struct {
 	paused   bool
 	interval time.Duration
 	store    store.DeploymentStore
+	appStore store.ApplicationStore
 	managers map[*manager]struct{}
 	ctx      context.Context
 }
 
 // newQueue returns a new Queue backed by the build datastore.
-func newQueue(store store.DeploymentStore, lock lock.MutexManager) (*queue, error) {
+func newQueue(store store.DeploymentStore, appStore store.ApplicationStore, lock lock.MutexManager) (*queue, error) {
 	const lockKey = "build_queue"
 	mx, err := lock.NewMutex(lockKey)
 	if err != nil {
@@ -34,6 +35,7 @@ func newQueue(store store.DeploymentStore, lock lock.MutexManager) (*queue, erro
 	}
 	q := &queue{
 		store:    store,
+		appStore: appStore,
 		globMx:   mx,
 		ready:    make(chan struct{}, 1),
 		managers: map[*manager]struct{}{},
@@ -66,8 +68,9 @@ func (q *queue) Pause(_ context.Context) error {
 
 func (q *queue) Request(ctx context.Context, params Filter) (*types.Deployment, error) {
 	w := &manager{
-		channel: make(chan *types.Deployment),
-		done:    ctx.Done(),
+		serverID: params.ServerID,
+		channel:  make(chan *types.Deployment),
+		done:     ctx.Done(),
 	}
 	q.Lock()
 	q.managers[w] = struct{}{}
@@ -114,6 +117,9 @@ func (q *queue) signal(ctx context.Context) error {
 		return err
 	}
 
+	// server of the application of each deployment, looked up once per signal
+	servers := map[int64]int64{}
+
 	q.Lock()
 	defer q.Unlock()
 	for _, item := range items {
@@ -121,8 +127,24 @@ func (q *queue) signal(ctx context.Context) error {
 			continue
 		}
 
+		serverID, ok := servers[item.ApplicationID]
+		if !ok {
+			app, err := q.appStore.Find(ctx, item.ApplicationID)
+			if err != nil {
+				log.Ctx(ctx).Warn().Err(err).Int64("application_id", item.ApplicationID).
+					Msg("scheduler: failed to find application of deployment")
+				continue
+			}
+			serverID = app.ServerID
+			servers[item.ApplicationID] = serverID
+		}
+
 	loop:
 		for m := range q.managers {
+			if m.serverID != 0 && m.serverID != serverID {
+				continue
+			}
+
 			select {
 			case m.channel <- item:
 			case <-m.done:
@@ -156,7 +178,8 @@ func (q *queue) start() error {
 }
 
 type manager struct {
-	labels  map[string]string
-	channel chan *types.Deployment
-	done    <-chan struct{}
+	labels   map[string]string
+	serverID int64
+	channel  chan *types.Deployment
+	done     <-chan struct{}
 }
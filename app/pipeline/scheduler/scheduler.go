@@ -12,6 +12,8 @@ import (
 // from the scheduler.
 type Filter struct {
 	Labels map[string]string
+	// ServerID limits the deployments to applications running on the server.
+	ServerID int64
 }
 
 // Scheduler schedules deployments
@@ -37,8 +39,8 @@ type scheduler struct {
 }
 
 // newScheduler provides an instance of a scheduler with cancel abilities.
-func newScheduler(deploymentStore store.DeploymentStore, lock lock.MutexManager) (Scheduler, error) {
-	q, err := newQueue(deploymentStore, lock)
+func newScheduler(deploymentStore store.DeploymentStore, applicationStore store.ApplicationStore, lock lock.MutexManager) (Scheduler, error) {
+	q, err := newQueue(deploymentStore, applicationStore, lock)
 	if err != nil {
 		return nil, err
 	}
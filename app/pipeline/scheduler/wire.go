@@ -15,7 +15,8 @@ var WireSet = wire.NewSet(
 // ProvideScheduler provides a scheduler which can be used to schedule and request builds.
 func ProvideScheduler(
 	store store.DeploymentStore,
+	applicationStore store.ApplicationStore,
 	lock lock.MutexManager,
 ) (Scheduler, error) {
-	return newScheduler(store, lock)
+	return newScheduler(store, applicationStore, lock)
 }
@@ -0,0 +1,55 @@
+package triggerer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cloudness-io/cloudness/app/services/spec"
+	"github.com/cloudness-io/cloudness/types"
+)
+
+// applyRepoConfig merges the repository config of git applications over the spec of the deployment,
+// the spec of the application settings is kept on the deployment to show the drift.
+func (t *triggerer) applyRepoConfig(ctx context.Context, application *types.Application, deployment *types.Deployment) error {
+	if !application.Spec.IsGit() {
+		return nil
+	}
+	configPath := spec.GetConfigPath(application.Spec)
+
+	data, err := t.specSvc.FindRepoConfig(ctx, application)
+	if err != nil {
+		return fmt.Errorf("failed to fetch %s: %w", configPath, err)
+	}
+	if data == nil {
+		return nil
+	}
+
+	merged, err := spec.MergeRepoConfig(application.Spec, data)
+	if err != nil {
+		return fmt.Errorf("invalid %s: %w", configPath, err)
+	}
+	if err := t.schemaSvc.ValidateApplication(ctx, merged); err != nil {
+		return fmt.Errorf("invalid %s: %w", configPath, err)
+	}
+
+	tenant, err := t.tenantStore.Find(ctx, application.TenantID)
+	if err != nil {
+		return err
+	}
+	restrictions := t.configSvc.GetTenantRestrictions(tenant)
+	if err := spec.ValidateRestrictions(merged.Deploy, restrictions); err != nil {
+		return fmt.Errorf("invalid %s: %w", configPath, err)
+	}
+	if err := spec.ValidateVolumeRestrictions(merged.Volumes, restrictions); err != nil {
+		return fmt.Errorf("invalid %s: %w", configPath, err)
+	}
+
+	specJson, err := json.Marshal(merged)
+	if err != nil {
+		return err
+	}
+	deployment.BaseSpecJson = deployment.SpecJson
+	deployment.SpecJson = string(specJson)
+	return nil
+}
@@ -7,6 +7,9 @@ import (
 
 	"github.com/cloudness-io/cloudness/app/pipeline/canceler"
 	"github.com/cloudness-io/cloudness/app/pipeline/scheduler"
+	"github.com/cloudness-io/cloudness/app/services/config"
+	"github.com/cloudness-io/cloudness/app/services/schema"
+	"github.com/cloudness-io/cloudness/app/services/spec"
 	"github.com/cloudness-io/cloudness/app/store"
 	"github.com/cloudness-io/cloudness/helpers"
 	"github.com/cloudness-io/cloudness/store/database/dbtx"
@@ -21,6 +24,10 @@ type TriggerHook struct {
 	Triggerer     string             `json:"triggerer"`
 	Title         string             `json:"title"`
 	Action        enum.TriggerAction `josn:"action"`
+	// Commit pins the deployment to a git commit, defaults to branch head when empty.
+	Commit string `json:"commit,omitempty"`
+	// Source redeploys the spec and image of a previous deployment without building.
+	Source *types.Deployment `json:"-"`
 }
 
 type Triggerer interface {
@@ -31,6 +38,10 @@ type triggerer struct {
 	tx              dbtx.Transactor
 	appStore        store.ApplicationStore
 	deploymentStore store.DeploymentStore
+	tenantStore     store.TenantStore
+	configSvc       *config.Service
+	schemaSvc       *schema.Service
+	specSvc         *spec.Service
 	scheduler       scheduler.Scheduler
 	canceler        canceler.Canceler
 }
@@ -39,6 +50,10 @@ func New(
 	tx dbtx.Transactor,
 	appStore store.ApplicationStore,
 	deploymentStore store.DeploymentStore,
+	tenantStore store.TenantStore,
+	configSvc *config.Service,
+	schemaSvc *schema.Service,
+	specSvc *spec.Service,
 	scheduler scheduler.Scheduler,
 	canceler canceler.Canceler,
 ) Triggerer {
@@ -46,6 +61,10 @@ func New(
 		tx:              tx,
 		appStore:        appStore,
 		deploymentStore: deploymentStore,
+		tenantStore:     tenantStore,
+		configSvc:       configSvc,
+		schemaSvc:       schemaSvc,
+		specSvc:         specSvc,
 		scheduler:       scheduler,
 		canceler:        canceler,
 	}
@@ -73,6 +92,13 @@ func (t *triggerer) Trigger(ctx context.Context, hook *TriggerHook) (*types.Depl
 		return nil, err
 	}
 
+	if hook.Commit != "" && application.Spec.IsGit() {
+		application.Spec.Build.Source.Git.Commit = hook.Commit
+		if err := application.UpdateSpecJSON(); err != nil {
+			return nil, err
+		}
+	}
+
 	deployment := &types.Deployment{
 		UID:           helpers.GenerateUID(),
 		ApplicationID: application.ID,
@@ -89,6 +115,18 @@ func (t *triggerer) Trigger(ctx context.Context, hook *TriggerHook) (*types.Depl
 		Updated:    now,
 	}
 
+	if hook.Source != nil {
+		imageUID := hook.Source.GetImageUID()
+		deployment.SpecJson = hook.Source.SpecJson
+		deployment.BaseSpecJson = hook.Source.BaseSpecJson
+		deployment.NeedsBuild = false
+		deployment.SourceUID = &hook.Source.UID
+		deployment.ImageUID = &imageUID
+	} else if err := t.applyRepoConfig(ctx, application, deployment); err != nil {
+		// the deployment is recorded as failed so a config that is invalid or can not be fetched shows up on the deployment page
+		deployment.Fail(err)
+	}
+
 	err = t.tx.WithTx(ctx, func(ctx context.Context) error {
 		// cancelling other deployments before creating new
 		if err := t.canceler.CancelIncompleteBuilds(ctx, application.TenantID, application.ProjectID, application.ID); err != nil {
@@ -110,6 +148,10 @@ func (t *triggerer) Trigger(ctx context.Context, hook *TriggerHook) (*types.Depl
 			return err
 		}
 
+		if deployment.IsDone() {
+			return nil
+		}
+
 		err = t.scheduler.Schedule(ctx, deployment)
 		if err != nil {
 			//TODO: should we error this out?
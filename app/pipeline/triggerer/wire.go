@@ -3,6 +3,9 @@ package triggerer
 import (
 	"github.com/cloudness-io/cloudness/app/pipeline/canceler"
 	"github.com/cloudness-io/cloudness/app/pipeline/scheduler"
+	"github.com/cloudness-io/cloudness/app/services/config"
+	"github.com/cloudness-io/cloudness/app/services/schema"
+	"github.com/cloudness-io/cloudness/app/services/spec"
 	"github.com/cloudness-io/cloudness/app/store"
 	"github.com/cloudness-io/cloudness/store/database/dbtx"
 
@@ -19,8 +22,12 @@ func ProvideTriggerer(
 	tx dbtx.Transactor,
 	appStore store.ApplicationStore,
 	deploymentStore store.DeploymentStore,
+	tenantStore store.TenantStore,
+	configSvc *config.Service,
+	schemaSvc *schema.Service,
+	specSvc *spec.Service,
 	scheduler scheduler.Scheduler,
 	canceler canceler.Canceler,
 ) Triggerer {
-	return New(tx, appStore, deploymentStore, scheduler, canceler)
+	return New(tx, appStore, deploymentStore, tenantStore, configSvc, schemaSvc, specSvc, scheduler, canceler)
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/cloudness-io/cloudness/app/services/config"
 	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
 )
 
 type RestartPolicy string
@@ -17,12 +18,14 @@ const (
 type (
 	// RunnerContextInput contains the input params to generate runner context.
 	RunnerContextInput struct {
+		ServerType         enum.ServerType
 		Application        *types.Application
 		Variables          map[string]*types.Variable
 		Volumes            []*types.Volume
 		Deployment         *types.Deployment
 		PreviousDeployment *types.Deployment
 		Netrc              *types.Netrc
+		RegistryCredential *types.RegistryCredential
 		Config             *config.PipelineConfig
 		ServerRestctions   *types.ServerRestrictions
 	}
@@ -57,6 +60,9 @@ type (
 		Privileged       bool
 		RestartPolicy    RestartPolicy
 
+		// Deploy is run by engines starting the application containers themselves, the step has no image
+		Deploy *ContainerDeploy
+
 		//housekeeping
 		Liveness *Liveness
 	}
@@ -117,6 +123,37 @@ type (
 		Memory float64
 		CPU    float64
 	}
+
+	// ContainerDeploy is the application deployed as plain containers on a docker server.
+	ContainerDeploy struct {
+		Namespace      string
+		Identifier     string
+		ApplicationUID int64
+		ProjectID      int64
+		Image          string
+		RegistryAuth   string // base64 encoded credentials of the private registry
+		Command        []string
+		Args           []string
+		Env            map[string]string
+		Replicas       int64
+		Stateful       bool
+		PrivateDomain  string
+		Route          *ContainerRoute
+		Volumes        []*ContainerVolume
+		UpdatedAt      string
+	}
+
+	ContainerRoute struct {
+		Host      string
+		Port      int
+		Websecure bool
+	}
+
+	ContainerVolume struct {
+		Name      string
+		MountPath string
+		Size      int64 // in bytes
+	}
 )
 
 func (s *Step) AppendArgs(args []string) {
@@ -0,0 +1,79 @@
+package request
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/cloudness-io/cloudness/app/usererror"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+const (
+	QueryParamProject      = "project"
+	QueryParamActor        = "actor"
+	QueryParamAction       = "action"
+	QueryParamResourceType = "resource_type"
+	QueryParamFrom         = "from"
+	QueryParamTo           = "to"
+
+	// auditDateLayout is the layout of the from and to dates, as sent by html date inputs.
+	auditDateLayout = "2006-01-02"
+)
+
+// ParseAuditFilterFromRequest parses the audit event filters from the url.
+// The project is returned as a uid and has to be resolved by the caller.
+func ParseAuditFilterFromRequest(r *http.Request) (*types.AuditFilter, int64, error) {
+	listFilter, err := ParseListQueryFilterFromRequest(r)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	projectUID, err := QueryParamAsPositiveInt64(r, QueryParamProject)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	actorID, err := QueryParamAsPositiveInt64(r, QueryParamActor)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	filter := &types.AuditFilter{
+		ListQueryFilter: listFilter,
+		ActorID:         actorID,
+	}
+
+	if action := QueryParamOrDefault(r, QueryParamAction, ""); action != "" {
+		filter.Action = enum.AuditActionFromString(action)
+		if filter.Action == "" {
+			return nil, 0, usererror.BadRequestf("Invalid audit action '%s'.", action)
+		}
+	}
+
+	if resourceType := QueryParamOrDefault(r, QueryParamResourceType, ""); resourceType != "" {
+		filter.ResourceType = enum.AuditResourceTypeFromString(resourceType)
+		if filter.ResourceType == "" {
+			return nil, 0, usererror.BadRequestf("Invalid audit resource type '%s'.", resourceType)
+		}
+	}
+
+	if from := QueryParamOrDefault(r, QueryParamFrom, ""); from != "" {
+		t, err := time.Parse(auditDateLayout, from)
+		if err != nil {
+			return nil, 0, usererror.BadRequestf("Parameter '%s' must be a date (yyyy-mm-dd).", QueryParamFrom)
+		}
+		filter.CreatedGt = t.UnixMilli() - 1
+	}
+
+	if to := QueryParamOrDefault(r, QueryParamTo, ""); to != "" {
+		t, err := time.Parse(auditDateLayout, to)
+		if err != nil {
+			return nil, 0, usererror.BadRequestf("Parameter '%s' must be a date (yyyy-mm-dd).", QueryParamTo)
+		}
+		// the to date is inclusive
+		filter.CreatedLt = t.AddDate(0, 0, 1).UnixMilli()
+	}
+
+	return filter, projectUID, nil
+}
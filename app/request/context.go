@@ -25,9 +25,11 @@ const (
 	projectMembershipKey
 	environmentKey
 	githubAppKey
+	gitConnectionKey
 	applicationKey
 	deploymentKey
 	volumeKey
+	serverKey
 	authSettingKey
 	navItemsKey
 )
@@ -174,6 +176,17 @@ func GithubAppFrom(ctx context.Context) (*types.GithubApp, bool) {
 	return g, ok && g != nil
 }
 
+// WithGitConnection function    returns a copy of parent in which the git connection
+func WithGitConnection(parent context.Context, conn *types.GitConnection) context.Context {
+	return context.WithValue(parent, gitConnectionKey, conn)
+}
+
+// GitConnectionFrom function    returns the value of the git connection
+func GitConnectionFrom(ctx context.Context) (*types.GitConnection, bool) {
+	g, ok := ctx.Value(gitConnectionKey).(*types.GitConnection)
+	return g, ok && g != nil
+}
+
 // WithEnvironment function    returns a copy of parent in which the environment
 func WithEnvironment(parent context.Context, e *types.Environment) context.Context {
 	return context.WithValue(parent, environmentKey, e)
@@ -218,6 +231,17 @@ func VolumeFrom(ctx context.Context) (*types.Volume, bool) {
 	return c, ok && c != nil
 }
 
+// WithServer function returns a copy of parent in which the server
+func WithServer(parent context.Context, s *types.Server) context.Context {
+	return context.WithValue(parent, serverKey, s)
+}
+
+// ServerFrom function    returns the value of the server
+func ServerFrom(ctx context.Context) (*types.Server, bool) {
+	s, ok := ctx.Value(serverKey).(*types.Server)
+	return s, ok && s != nil
+}
+
 // WithAuthSetting function returns a copy of parent in which the authsetting
 func WithAuthSetting(parent context.Context, c *types.AuthSetting) context.Context {
 	return context.WithValue(parent, authSettingKey, c)
@@ -0,0 +1,34 @@
+package request
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/usererror"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+const (
+	QueryParamFormat = "format"
+	QueryParamRedact = "redact"
+)
+
+// ParseExportInputFromRequest parses the export format and redaction from the url,
+// plain manifests are exported by default.
+func ParseExportInputFromRequest(r *http.Request) (*types.ExportInput, error) {
+	format := QueryParamOrDefault(r, QueryParamFormat, string(enum.ExportFormatManifests))
+	in := &types.ExportInput{
+		Format: enum.ExportFormatFromString(format),
+	}
+	if in.Format == "" {
+		return nil, usererror.BadRequestf("Invalid export format '%s'.", format)
+	}
+
+	redact, err := QueryParamAsBoolOrDefault(r, QueryParamRedact, false)
+	if err != nil {
+		return nil, err
+	}
+	in.Redact = redact
+
+	return in, nil
+}
@@ -0,0 +1,60 @@
+package request
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/types"
+)
+
+const (
+	QueryParamPage  = "page"
+	QueryParamLimit = "limit"
+	QueryParamQuery = "query"
+
+	// PerPageDefault defines the default page size when none is provided.
+	PerPageDefault = 30
+	// PerPageMax defines the maximum page size a client can request.
+	PerPageMax = 100
+)
+
+// ParsePage extracts the page parameter from the url.
+func ParsePage(r *http.Request) (int, error) {
+	page, err := QueryParamAsPositiveInt64OrDefault(r, QueryParamPage, 1)
+	if err != nil {
+		return 0, err
+	}
+	return int(page), nil
+}
+
+// ParseLimit extracts the limit parameter from the url.
+func ParseLimit(r *http.Request) (int, error) {
+	limit, err := QueryParamAsPositiveInt64OrDefault(r, QueryParamLimit, PerPageDefault)
+	if err != nil {
+		return 0, err
+	}
+	if limit > PerPageMax {
+		limit = PerPageMax
+	}
+	return int(limit), nil
+}
+
+// ParseListQueryFilterFromRequest parses pagination and query related info from the url.
+func ParseListQueryFilterFromRequest(r *http.Request) (types.ListQueryFilter, error) {
+	page, err := ParsePage(r)
+	if err != nil {
+		return types.ListQueryFilter{}, err
+	}
+
+	limit, err := ParseLimit(r)
+	if err != nil {
+		return types.ListQueryFilter{}, err
+	}
+
+	return types.ListQueryFilter{
+		Pagination: types.Pagination{
+			Page: page,
+			Size: limit,
+		},
+		Query: QueryParamOrDefault(r, QueryParamQuery, ""),
+	}, nil
+}
@@ -19,6 +19,16 @@ const (
 	PathParamTemplateID     = "template_id"
 	PathParamMetricsSpan    = "metrics_span"
 	PathParamSelectedUID    = "selected"
+	PathParamServiceAccount = "service_account_uid"
+	PathParamToken          = "token_identifier"
+	PathParamRegistryCred   = "registry_credential_uid"
+	PathParamBackupUID      = "backup_uid"
+	PathParamSnapshotUID    = "snapshot_uid"
+	PathParamNotifyChannel  = "notification_channel_uid"
+	PathParamServerUID      = "server_uid"
+	PathParamDeploymentID   = "deployment_id"
+	PathParamTemplateSource = "template_source_uid"
+	PathParamVersion        = "version"
 )
 
 func GetTenantUIDFromPath(r *http.Request) (int64, error) {
@@ -113,3 +123,76 @@ func GetSelectedUIDFromPath(r *http.Request) (int64, error) {
 	}
 	return strconv.ParseInt(id, 10, 64)
 }
+
+func GetServiceAccountUIDFromPath(r *http.Request) (string, error) {
+	return PathParamOrError(r, PathParamServiceAccount)
+}
+
+func GetTokenIdentifierFromPath(r *http.Request) (string, error) {
+	return PathParamOrError(r, PathParamToken)
+}
+
+func GetRegistryCredentialUIDFromPath(r *http.Request) (int64, error) {
+	id, err := PathParamOrError(r, PathParamRegistryCred)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(id, 10, 64)
+}
+
+func GetNotificationChannelUIDFromPath(r *http.Request) (int64, error) {
+	id, err := PathParamOrError(r, PathParamNotifyChannel)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(id, 10, 64)
+}
+
+func GetVersionFromPath(r *http.Request) (int64, error) {
+	number, err := PathParamOrError(r, PathParamVersion)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(number, 10, 64)
+}
+
+func GetTemplateSourceUIDFromPath(r *http.Request) (int64, error) {
+	id, err := PathParamOrError(r, PathParamTemplateSource)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(id, 10, 64)
+}
+
+func GetBackupUIDFromPath(r *http.Request) (int64, error) {
+	id, err := PathParamOrError(r, PathParamBackupUID)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(id, 10, 64)
+}
+
+func GetSnapshotUIDFromPath(r *http.Request) (int64, error) {
+	id, err := PathParamOrError(r, PathParamSnapshotUID)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(id, 10, 64)
+}
+
+func GetServerUIDFromPath(r *http.Request) (int64, error) {
+	id, err := PathParamOrError(r, PathParamServerUID)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(id, 10, 64)
+}
+
+// GetDeploymentIDFromPath returns the internal id of the deployment, only used by the runner api.
+func GetDeploymentIDFromPath(r *http.Request) (int64, error) {
+	id, err := PathParamOrError(r, PathParamDeploymentID)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(id, 10, 64)
+}
@@ -0,0 +1,303 @@
+package router
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/api/handler/application"
+	"github.com/cloudness-io/cloudness/app/api/handler/deployment"
+	"github.com/cloudness-io/cloudness/app/api/handler/environment"
+	"github.com/cloudness-io/cloudness/app/api/handler/project"
+	"github.com/cloudness-io/cloudness/app/api/handler/registrycredential"
+	"github.com/cloudness-io/cloudness/app/api/handler/runner"
+	"github.com/cloudness-io/cloudness/app/api/handler/serviceaccount"
+	"github.com/cloudness-io/cloudness/app/api/handler/tenant"
+	"github.com/cloudness-io/cloudness/app/api/handler/user"
+	"github.com/cloudness-io/cloudness/app/api/handler/variable"
+	"github.com/cloudness-io/cloudness/app/api/handler/volume"
+	middlewareauthn "github.com/cloudness-io/cloudness/app/api/middleware/authn"
+	middlewareinject "github.com/cloudness-io/cloudness/app/api/middleware/inject"
+	middlewarerestrict "github.com/cloudness-io/cloudness/app/api/middleware/restrict"
+	"github.com/cloudness-io/cloudness/app/api/openapi"
+	"github.com/cloudness-io/cloudness/app/api/render"
+	"github.com/cloudness-io/cloudness/app/auth/authn"
+	controllerapplication "github.com/cloudness-io/cloudness/app/controller/application"
+	controllerdeployment "github.com/cloudness-io/cloudness/app/controller/deployment"
+	controllerenvironment "github.com/cloudness-io/cloudness/app/controller/environment"
+	controllerproject "github.com/cloudness-io/cloudness/app/controller/project"
+	controllerregistrycredential "github.com/cloudness-io/cloudness/app/controller/registrycredential"
+	controllerserviceaccount "github.com/cloudness-io/cloudness/app/controller/serviceaccount"
+	controllertenant "github.com/cloudness-io/cloudness/app/controller/tenant"
+	controlleruser "github.com/cloudness-io/cloudness/app/controller/user"
+	controllervariable "github.com/cloudness-io/cloudness/app/controller/variable"
+	controllervolume "github.com/cloudness-io/cloudness/app/controller/volume"
+	"github.com/cloudness-io/cloudness/app/middleware/address"
+	"github.com/cloudness-io/cloudness/app/middleware/audit"
+	"github.com/cloudness-io/cloudness/app/middleware/logging"
+	"github.com/cloudness-io/cloudness/app/middleware/nocache"
+	"github.com/cloudness-io/cloudness/app/pipeline/manager"
+	"github.com/cloudness-io/cloudness/app/request"
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/rs/zerolog/hlog"
+)
+
+// APIHandler is an abstraction of a http handler that handles API calls.
+type APIHandler interface {
+	http.Handler
+}
+
+// NewAPIHandler returns a new APIHandler.
+func NewAPIHandler(
+	appCtx context.Context,
+	config *types.Config,
+	authenticator authn.Authenticator,
+	openapiSvc openapi.Service,
+	userCtrl *controlleruser.Controller,
+	tenantCtrl *controllertenant.Controller,
+	saCtrl *controllerserviceaccount.Controller,
+	regCredCtrl *controllerregistrycredential.Controller,
+	projectCtrl *controllerproject.Controller,
+	envCtrl *controllerenvironment.Controller,
+	appCtrl *controllerapplication.Controller,
+	varCtrl *controllervariable.Controller,
+	volumeCtrl *controllervolume.Controller,
+	deploymentCtrl *controllerdeployment.Controller,
+	runnerManager manager.RunnerManager,
+) APIHandler {
+	// Use go-chi router for inner routing.
+	r := chi.NewRouter()
+
+	// Apply common api middleware.
+	r.Use(nocache.NoCache)
+	r.Use(middleware.Recoverer)
+
+	// configure logging middleware.
+	r.Use(hlog.URLHandler("http.url"))
+	r.Use(hlog.MethodHandler("http.method"))
+	r.Use(logging.HLogRequestIDHandler())
+	r.Use(logging.HLogAccessLogHandler())
+	r.Use(address.Handler("", ""))
+
+	r.Use(audit.Middleware())
+
+	r.Route("/v1", func(r chi.Router) {
+		r.Get("/openapi.yaml", handleOpenAPI(openapiSvc))
+		setupAPIRunner(r, runnerManager)
+
+		r.Group(func(r chi.Router) {
+			r.Use(middlewareauthn.Required(authenticator))
+			setupAPIUser(r, userCtrl)
+			setupAPITenants(r, tenantCtrl, saCtrl, regCredCtrl, projectCtrl, envCtrl, appCtrl, varCtrl, volumeCtrl, deploymentCtrl)
+		})
+	})
+
+	r.NotFound(func(w http.ResponseWriter, r *http.Request) {
+		render.NotFound(r.Context(), w)
+	})
+
+	return r
+}
+
+// setupAPIRunner sets up the routes used by remote runner agents, they authenticate with the runner
+// token of their server and only reach its deployments and applications.
+func setupAPIRunner(r chi.Router, runnerManager manager.RunnerManager) {
+	r.Route("/runner", func(r chi.Router) {
+		r.Use(middlewareauthn.Runner(runnerManager))
+		r.Get("/server", runner.HandleServer())
+		r.Route(fmt.Sprintf("/servers/{%s}", request.PathParamServerUID), func(r chi.Router) {
+			r.Use(runner.RequireServer())
+			r.Get("/config", runner.HandleConfig(runnerManager))
+			r.Post("/request", runner.HandleRequest(runnerManager))
+		})
+		r.Post("/metrics", runner.HandleUploadMetrics(runnerManager))
+		r.Post("/status", runner.HandleUploadAppStatus(runnerManager))
+		r.Route(fmt.Sprintf("/deployments/{%s}", request.PathParamDeploymentID), func(r chi.Router) {
+			r.Use(runner.RequireDeployment(runnerManager))
+			r.Put("/", runner.HandleUpdate(runnerManager))
+			r.Post("/accept", runner.HandleAccept(runnerManager))
+			r.Get("/details", runner.HandleDetails(runnerManager))
+			r.Post("/watch", runner.HandleWatch(runnerManager))
+			r.Post("/logs/stream", runner.HandleStream(runnerManager))
+			r.Post("/logs/upload", runner.HandleUpload(runnerManager))
+		})
+	})
+}
+
+func setupAPIUser(r chi.Router, userCtrl *controlleruser.Controller) {
+	r.Route("/user", func(r chi.Router) {
+		r.Route("/tokens", func(r chi.Router) {
+			r.Get("/", user.HandleListTokens(userCtrl))
+			r.Post("/", user.HandleCreateToken(userCtrl))
+			r.Delete(fmt.Sprintf("/{%s}", request.PathParamToken), user.HandleDeleteToken(userCtrl))
+		})
+	})
+}
+
+func setupAPITenants(
+	r chi.Router,
+	tenantCtrl *controllertenant.Controller,
+	saCtrl *controllerserviceaccount.Controller,
+	regCredCtrl *controllerregistrycredential.Controller,
+	projectCtrl *controllerproject.Controller,
+	envCtrl *controllerenvironment.Controller,
+	appCtrl *controllerapplication.Controller,
+	varCtrl *controllervariable.Controller,
+	volumeCtrl *controllervolume.Controller,
+	deploymentCtrl *controllerdeployment.Controller,
+) {
+	r.Route("/tenants", func(r chi.Router) {
+		r.Get("/", tenant.HandleList(tenantCtrl))
+		r.Route(fmt.Sprintf("/{%s}", request.PathParamTenantUID), func(r chi.Router) {
+			r.Use(middlewareinject.InjectTenant(tenantCtrl))
+			r.Get("/", tenant.HandleFind())
+			setupAPIServiceAccounts(r, saCtrl)
+			setupAPIRegistryCredentials(r, regCredCtrl)
+			r.Route("/audit-events", func(r chi.Router) {
+				r.Use(middlewarerestrict.ToTeamAdmin())
+				r.Get("/", tenant.HandleListAuditEvents(tenantCtrl))
+				r.Get("/export", tenant.HandleExportAuditEvents(tenantCtrl))
+			})
+			setupAPIProjects(r, projectCtrl, envCtrl, appCtrl, varCtrl, volumeCtrl, deploymentCtrl)
+		})
+	})
+}
+
+func setupAPIServiceAccounts(r chi.Router, saCtrl *controllerserviceaccount.Controller) {
+	r.Route("/service-accounts", func(r chi.Router) {
+		r.Use(middlewarerestrict.ToTeamAdmin())
+		r.Get("/", serviceaccount.HandleList(saCtrl))
+		r.Post("/", serviceaccount.HandleCreate(saCtrl))
+		r.Route(fmt.Sprintf("/{%s}", request.PathParamServiceAccount), func(r chi.Router) {
+			r.Get("/", serviceaccount.HandleFind(saCtrl))
+			r.Delete("/", serviceaccount.HandleDelete(saCtrl))
+			r.Route("/tokens", func(r chi.Router) {
+				r.Get("/", serviceaccount.HandleListTokens(saCtrl))
+				r.Post("/", serviceaccount.HandleCreateToken(saCtrl))
+				r.Delete(fmt.Sprintf("/{%s}", request.PathParamToken), serviceaccount.HandleDeleteToken(saCtrl))
+			})
+		})
+	})
+}
+
+func setupAPIRegistryCredentials(r chi.Router, regCredCtrl *controllerregistrycredential.Controller) {
+	r.Route("/registry-credentials", func(r chi.Router) {
+		r.Use(middlewarerestrict.ToTeamAdmin())
+		r.Get("/", registrycredential.HandleList(regCredCtrl))
+		r.Post("/", registrycredential.HandleCreate(regCredCtrl))
+		r.Route(fmt.Sprintf("/{%s}", request.PathParamRegistryCred), func(r chi.Router) {
+			r.Get("/", registrycredential.HandleFind(regCredCtrl))
+			r.Delete("/", registrycredential.HandleDelete(regCredCtrl))
+		})
+	})
+}
+
+func setupAPIProjects(
+	r chi.Router,
+	projectCtrl *controllerproject.Controller,
+	envCtrl *controllerenvironment.Controller,
+	appCtrl *controllerapplication.Controller,
+	varCtrl *controllervariable.Controller,
+	volumeCtrl *controllervolume.Controller,
+	deploymentCtrl *controllerdeployment.Controller,
+) {
+	r.Route("/projects", func(r chi.Router) {
+		r.Get("/", project.HandleList(projectCtrl))
+		r.With(middlewarerestrict.ToTeamAdmin()).Post("/", project.HandleCreate(projectCtrl))
+		r.Route(fmt.Sprintf("/{%s}", request.PathParamProjectUID), func(r chi.Router) {
+			r.Use(middlewareinject.InjectProject(projectCtrl))
+			r.Get("/", project.HandleFind())
+			r.With(middlewarerestrict.ToProjectOwner()).Patch("/", project.HandleUpdate(projectCtrl))
+			r.With(middlewarerestrict.ToProjectOwner()).Delete("/", project.HandleDelete(projectCtrl))
+			setupAPIEnvironments(r, envCtrl, appCtrl, varCtrl, volumeCtrl, deploymentCtrl)
+		})
+	})
+}
+
+func setupAPIEnvironments(
+	r chi.Router,
+	envCtrl *controllerenvironment.Controller,
+	appCtrl *controllerapplication.Controller,
+	varCtrl *controllervariable.Controller,
+	volumeCtrl *controllervolume.Controller,
+	deploymentCtrl *controllerdeployment.Controller,
+) {
+	r.Route("/environments", func(r chi.Router) {
+		r.Get("/", environment.HandleList(envCtrl))
+		r.With(middlewarerestrict.ToProjectOwner()).Post("/", environment.HandleCreate(envCtrl))
+		r.Route(fmt.Sprintf("/{%s}", request.PathParamEnvironmentUID), func(r chi.Router) {
+			r.Use(middlewareinject.InjectEnvironment(envCtrl))
+			r.Get("/", environment.HandleFind())
+			r.With(middlewarerestrict.ToProjectOwner()).Patch("/", environment.HandleUpdate(envCtrl))
+			r.With(middlewarerestrict.ToProjectOwner()).Delete("/", environment.HandleDelete(envCtrl))
+			setupAPIApplications(r, appCtrl, varCtrl, volumeCtrl, deploymentCtrl)
+		})
+	})
+}
+
+func setupAPIApplications(
+	r chi.Router,
+	appCtrl *controllerapplication.Controller,
+	varCtrl *controllervariable.Controller,
+	volumeCtrl *controllervolume.Controller,
+	deploymentCtrl *controllerdeployment.Controller,
+) {
+	r.Route("/applications", func(r chi.Router) {
+		r.Use(middlewarerestrict.ToProjectRole())
+		r.Get("/", application.HandleList(appCtrl))
+		r.Post("/", application.HandleCreate(appCtrl))
+		r.Post("/registry", application.HandleCreateRegistry(appCtrl))
+		r.Route(fmt.Sprintf("/{%s}", request.PathParamApplicationUID), func(r chi.Router) {
+			r.Use(middlewareinject.InjectApplication(appCtrl))
+			r.Get("/", application.HandleFind())
+			r.Patch("/", application.HandleUpdate(appCtrl))
+			r.Delete("/", application.HandleDelete(appCtrl))
+			r.Post("/deploy", application.HandleDeploy(appCtrl))
+			r.Post("/redeploy", application.HandleRedeploy(appCtrl))
+
+			r.Route("/variables", func(r chi.Router) {
+				r.Get("/", variable.HandleList(varCtrl))
+				r.Post("/", variable.HandleCreate(appCtrl, varCtrl))
+				r.Patch(fmt.Sprintf("/{%s}", request.PathParamVariableUID), variable.HandleUpdate(appCtrl, varCtrl))
+				r.Delete(fmt.Sprintf("/{%s}", request.PathParamVariableUID), variable.HandleDelete(appCtrl, varCtrl))
+			})
+
+			r.Route("/volumes", func(r chi.Router) {
+				r.Get("/", volume.HandleList(volumeCtrl))
+				r.Post("/", volume.HandleCreate(appCtrl, volumeCtrl))
+				r.Route(fmt.Sprintf("/{%s}", request.PathParamVolumeUID), func(r chi.Router) {
+					r.Use(middlewareinject.InjectVolume(volumeCtrl))
+					r.Post("/detach", volume.HandleDetach(appCtrl))
+					r.Delete("/", volume.HandleDelete(volumeCtrl))
+				})
+			})
+
+			r.Route("/deployments", func(r chi.Router) {
+				r.Get("/", deployment.HandleList(deploymentCtrl))
+				r.Route(fmt.Sprintf("/{%s}", request.PathParamDeploymentUID), func(r chi.Router) {
+					r.Use(middlewareinject.InjectDeployment(deploymentCtrl))
+					r.Get("/", deployment.HandleFind())
+					r.Post("/rollback", deployment.HandleRollback(deploymentCtrl))
+				})
+			})
+		})
+	})
+}
+
+func handleOpenAPI(openapiSvc openapi.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		spec := openapiSvc.Generate()
+		data, err := spec.MarshalYAML()
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.Reader(ctx, w, http.StatusOK, "application/yaml", data)
+	}
+}
@@ -0,0 +1,45 @@
+package router
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/cloudness-io/cloudness/app/api/openapi"
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// TestAPIRoutesDocumented fails when a route of the api is missing from the hand written openapi spec.
+// The runner routes are internal to the agents and are not documented.
+func TestAPIRoutesDocumented(t *testing.T) {
+	spec := openapi.NewOpenAPIService().Generate()
+	handler := NewAPIHandler(context.Background(), &types.Config{}, nil, openapi.NewOpenAPIService(),
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	routes, ok := handler.(chi.Routes)
+	if !ok {
+		t.Fatalf("api handler is not a chi router")
+	}
+
+	walk := func(method string, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+		path := strings.TrimSuffix(strings.TrimPrefix(route, "/v1"), "/")
+		if path == "/openapi.yaml" || strings.HasPrefix(path, "/runner") {
+			return nil
+		}
+		item, ok := spec.Paths.MapOfPathItemValues[path]
+		if !ok {
+			t.Errorf("route %s %s is missing from the openapi spec", method, path)
+			return nil
+		}
+		if _, ok := item.MapOfOperationValues[strings.ToLower(method)]; !ok {
+			t.Errorf("operation %s %s is missing from the openapi spec", method, path)
+		}
+		return nil
+	}
+	if err := chi.Walk(routes, walk); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/cloudness-io/cloudness/app/api/render"
 	"github.com/cloudness-io/cloudness/app/request"
+	"github.com/cloudness-io/cloudness/app/services/sleep"
 
 	"github.com/go-logr/logr"
 	"github.com/go-logr/zerologr"
@@ -17,24 +19,27 @@ const (
 )
 
 type Router struct {
-	// api APIHandler
-	web WebHandler
+	api       APIHandler
+	web       WebHandler
+	activator *sleep.Activator
 }
 
 // NewRouter returns a new http.Handler that routes traffic
 // to the appropriate handlers.
 func NewRouter(
-	// api APIHandler,
+	api APIHandler,
 	web WebHandler,
+	activator *sleep.Activator,
 ) *Router {
 	return &Router{
-		// api: api,
-		web: web,
+		api:       api,
+		web:       web,
+		activator: activator,
 	}
 }
 
 func (r *Router) ServeHTTP(w http.ResponseWriter, req *http.Request) {
-	// var err error
+	var err error
 	// setup logger for request
 	log := log.Logger.With().Logger()
 	ctx := log.WithContext(req.Context())
@@ -46,26 +51,41 @@ func (r *Router) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 			Str("http.original_url", req.URL.String())
 	})
 
+	/*
+	 * 0. ACTIVATOR
+	 *
+	 * Requests of sleeping applications, the gateway routes them here with the activator header set.
+	 */
+	if sleep.IsActivatorTraffic(req) {
+		log.UpdateContext(func(c zerolog.Context) zerolog.Context {
+			return c.Str("http.handler", "activator")
+		})
+
+		r.activator.ServeHTTP(w, req)
+		return
+	}
+	sleep.StripActivatorHeaders(req)
+
 	/*
 	 * 1. REST API
 	 *
 	 * All Rest API calls start with "/api/", and thus can be uniquely identified.
 	 */
-	// if r.isAPITraffic(req) {
-	// 	log.UpdateContext(func(c zerolog.Context) zerolog.Context {
-	// 		return c.Str("http.handler", "api")
-	// 	})
-
-	// 	// remove matched prefix to simplify API handlers
-	// 	if err = stripPrefix(APIMount, req); err != nil {
-	// 		log.Err(err).Msgf("Failed striping of prefix for api request.")
-	// 		render.InternalError(ctx, w)
-	// 		return
-	// 	}
-
-	// 	r.api.ServeHTTP(w, req)
-	// 	return
-	// }
+	if r.isAPITraffic(req) {
+		log.UpdateContext(func(c zerolog.Context) zerolog.Context {
+			return c.Str("http.handler", "api")
+		})
+
+		// remove matched prefix to simplify API handlers
+		if err = stripPrefix(APIMount, req); err != nil {
+			log.Err(err).Msgf("Failed striping of prefix for api request.")
+			render.InternalError(ctx, w)
+			return
+		}
+
+		r.api.ServeHTTP(w, req)
+		return
+	}
 
 	/*
 	 * 2. WEB
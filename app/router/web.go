@@ -8,17 +8,25 @@ import (
 
 	"github.com/cloudness-io/cloudness/app/auth/authn"
 	"github.com/cloudness-io/cloudness/app/controller/application"
+	"github.com/cloudness-io/cloudness/app/controller/backup"
 	"github.com/cloudness-io/cloudness/app/controller/auth"
 	"github.com/cloudness-io/cloudness/app/controller/deployment"
 	"github.com/cloudness-io/cloudness/app/controller/environment"
+	"github.com/cloudness-io/cloudness/app/controller/export"
 	"github.com/cloudness-io/cloudness/app/controller/favorite"
+	"github.com/cloudness-io/cloudness/app/controller/gitconnection"
 	"github.com/cloudness-io/cloudness/app/controller/githubapp"
 	"github.com/cloudness-io/cloudness/app/controller/gitpublic"
 	"github.com/cloudness-io/cloudness/app/controller/instance"
 	"github.com/cloudness-io/cloudness/app/controller/logs"
+	"github.com/cloudness-io/cloudness/app/controller/notification"
 	"github.com/cloudness-io/cloudness/app/controller/project"
 	"github.com/cloudness-io/cloudness/app/controller/server"
+	"github.com/cloudness-io/cloudness/app/controller/registrycredential"
+	"github.com/cloudness-io/cloudness/app/controller/serviceaccount"
+	"github.com/cloudness-io/cloudness/app/controller/snapshot"
 	"github.com/cloudness-io/cloudness/app/controller/template"
+	"github.com/cloudness-io/cloudness/app/controller/templatesource"
 	"github.com/cloudness-io/cloudness/app/controller/tenant"
 	"github.com/cloudness-io/cloudness/app/controller/user"
 	"github.com/cloudness-io/cloudness/app/controller/variable"
@@ -37,6 +45,7 @@ import (
 	accounthandler "github.com/cloudness-io/cloudness/app/web/handler/account"
 	handlerapplication "github.com/cloudness-io/cloudness/app/web/handler/application"
 	authhandler "github.com/cloudness-io/cloudness/app/web/handler/auth"
+	handlerbackup "github.com/cloudness-io/cloudness/app/web/handler/backup"
 	handlerConn "github.com/cloudness-io/cloudness/app/web/handler/connections"
 	handlercreate "github.com/cloudness-io/cloudness/app/web/handler/create"
 	handlerdeployment "github.com/cloudness-io/cloudness/app/web/handler/deployment"
@@ -46,6 +55,7 @@ import (
 	handlerLogs "github.com/cloudness-io/cloudness/app/web/handler/logs"
 	handlerproject "github.com/cloudness-io/cloudness/app/web/handler/project"
 	handlerserver "github.com/cloudness-io/cloudness/app/web/handler/server"
+	handlersnapshot "github.com/cloudness-io/cloudness/app/web/handler/snapshot"
 	handlertenant "github.com/cloudness-io/cloudness/app/web/handler/tenant"
 	handlervariable "github.com/cloudness-io/cloudness/app/web/handler/variable"
 	handlervolume "github.com/cloudness-io/cloudness/app/web/handler/volume"
@@ -78,13 +88,21 @@ func NewWebHandler(
 	authCtrl *auth.Controller,
 	ghAppCtrl *githubapp.Controller,
 	gitPublicCtrl *gitpublic.Controller,
+	gitConnCtrl *gitconnection.Controller,
 	appCtrl *application.Controller,
 	varCtrl *variable.Controller,
 	deploymentCtrl *deployment.Controller,
 	logsCtrl *logs.Controller,
 	volumeCtrl *volume.Controller,
+	backupCtrl *backup.Controller,
+	snapshotCtrl *snapshot.Controller,
 	templCtrl *template.Controller,
 	favCtrl *favorite.Controller,
+	saCtrl *serviceaccount.Controller,
+	regCredCtrl *registrycredential.Controller,
+	notifyCtrl *notification.Controller,
+	exportCtrl *export.Controller,
+	tsCtrl *templatesource.Controller,
 ) WebHandler {
 	// Use go-chi router for inner routing.
 	r := chi.NewRouter()
@@ -122,7 +140,7 @@ func NewWebHandler(
 		r.Use(middlewareinject.InjectInstance(instanceCtrl))
 		//special methods that don't require authentication
 		setupAccountWithoutAuth(r, config, instanceCtrl, authCtrl)
-		setupWebhooks(r, tenantCtrl, projectCtrl, ghAppCtrl)
+		setupWebhooks(r, tenantCtrl, projectCtrl, ghAppCtrl, gitConnCtrl)
 		r.Group(func(r chi.Router) {
 			r.Use(middlewareauthn.AttemptWeb(authenticator, instanceCtrl, authCtrl, config.Token.CookieName))
 			setupRoutesV1WithAuth(r,
@@ -130,11 +148,11 @@ func NewWebHandler(
 				instanceCtrl, serverCtrl,
 				userCtrl, tenantCtrl, projectCtrl,
 				envCtrl, authCtrl,
-				ghAppCtrl, gitPublicCtrl,
+				ghAppCtrl, gitPublicCtrl, gitConnCtrl,
 				appCtrl, varCtrl,
 				deploymentCtrl, logsCtrl,
-				volumeCtrl, templCtrl,
-				favCtrl,
+				volumeCtrl, backupCtrl, snapshotCtrl, templCtrl,
+				favCtrl, saCtrl, regCredCtrl, notifyCtrl, exportCtrl, tsCtrl,
 			)
 		})
 
@@ -159,13 +177,21 @@ func setupRoutesV1WithAuth(r chi.Router,
 	authCtrl *auth.Controller,
 	ghAppCtrl *githubapp.Controller,
 	gitPublicCtrl *gitpublic.Controller,
+	gitConnCtrl *gitconnection.Controller,
 	appCtrl *application.Controller,
 	varCtrl *variable.Controller,
 	deploymentCtrl *deployment.Controller,
 	logsCtrl *logs.Controller,
 	volumeCtrl *volume.Controller,
+	backupCtrl *backup.Controller,
+	snapshotCtrl *snapshot.Controller,
 	templCtrl *template.Controller,
 	favCtrl *favorite.Controller,
+	saCtrl *serviceaccount.Controller,
+	regCredCtrl *registrycredential.Controller,
+	notifyCtrl *notification.Controller,
+	exportCtrl *export.Controller,
+	tsCtrl *templatesource.Controller,
 ) {
 
 	setupAccount(r, config, authCtrl, userCtrl, tenantCtrl)
@@ -173,19 +199,22 @@ func setupRoutesV1WithAuth(r chi.Router,
 	//TODO: multi tenant level routes goes here
 
 	//Personal tenant routes
-	setupInstance(r, instanceCtrl, serverCtrl, authCtrl)
-	setupTenant(r, appCtx, tenantCtrl, projectCtrl, envCtrl, ghAppCtrl, gitPublicCtrl, appCtrl, varCtrl, deploymentCtrl, logsCtrl, volumeCtrl, templCtrl, favCtrl)
+	setupInstance(r, instanceCtrl, serverCtrl, authCtrl, tenantCtrl, tsCtrl)
+	setupTenant(r, appCtx, tenantCtrl, projectCtrl, envCtrl, ghAppCtrl, gitPublicCtrl, gitConnCtrl, appCtrl, varCtrl, deploymentCtrl, logsCtrl, volumeCtrl, backupCtrl, snapshotCtrl, templCtrl, favCtrl, saCtrl, regCredCtrl, notifyCtrl, exportCtrl, tsCtrl)
 }
 
-func setupWebhooks(r chi.Router, tenantCtrl *tenant.Controller, projectCtrl *project.Controller, ghAppCtrl *githubapp.Controller) {
+func setupWebhooks(r chi.Router, tenantCtrl *tenant.Controller, projectCtrl *project.Controller, ghAppCtrl *githubapp.Controller, gitConnCtrl *gitconnection.Controller) {
 	r.Route("/webhooks", func(r chi.Router) {
 		r.Route("/github", func(r chi.Router) {
-			// r.Post("/events", handlerConn.HandleGithubEvent())
+			r.Post(fmt.Sprintf("/{%s}/events", request.PathParamSourceUID), handlerConn.HandleGithubEvent(ghAppCtrl))
+		})
+		r.Route("/git", func(r chi.Router) {
+			r.Post(fmt.Sprintf("/{%s}/events", request.PathParamSourceUID), handlerConn.HandleGitConnectionEvent(gitConnCtrl))
 		})
 	})
 }
 
-func setupInstance(r chi.Router, instanceCtrl *instance.Controller, serverCtrl *server.Controller, authCtrl *auth.Controller) {
+func setupInstance(r chi.Router, instanceCtrl *instance.Controller, serverCtrl *server.Controller, authCtrl *auth.Controller, tenantCtrl *tenant.Controller, tsCtrl *templatesource.Controller) {
 	r.Route("/settings", func(r chi.Router) {
 		r.Use(middlewarerestrict.ToSuperAdmin())
 		r.Use(middlewarenav.PopulateNavItemKey("Instance Settings"))
@@ -194,15 +223,30 @@ func setupInstance(r chi.Router, instanceCtrl *instance.Controller, serverCtrl *
 		r.Patch("/dns", handlerinstance.HandlePatchDNS(instanceCtrl, serverCtrl))
 		r.Patch("/scripts", handlerinstance.HandlePatchScripts(instanceCtrl, serverCtrl))
 		r.Route("/auth", func(r chi.Router) {
-			r.Get("/", handlerinstance.HandleGetAuth(instanceCtrl, authCtrl))
-			r.Patch("/password", handlerinstance.HandlePatchPassword(instanceCtrl, authCtrl))
-			r.Patch("/demo", handlerinstance.HandlePatchDemoUser(instanceCtrl, authCtrl))
-			r.Patch("/github", handlerinstance.HandlePatchOauthProvider(instanceCtrl, authCtrl, enum.AuthProviderGithub))
+			r.Get("/", handlerinstance.HandleGetAuth(instanceCtrl, authCtrl, tenantCtrl))
+			r.Patch("/password", handlerinstance.HandlePatchPassword(instanceCtrl, authCtrl, tenantCtrl))
+			r.Patch("/demo", handlerinstance.HandlePatchDemoUser(instanceCtrl, authCtrl, tenantCtrl))
+			r.Patch("/github", handlerinstance.HandlePatchOauthProvider(instanceCtrl, authCtrl, tenantCtrl, enum.AuthProviderGithub))
+			r.Patch("/gitlab", handlerinstance.HandlePatchOauthProvider(instanceCtrl, authCtrl, tenantCtrl, enum.AuthProviderGitlab))
+			r.Patch("/google", handlerinstance.HandlePatchOauthProvider(instanceCtrl, authCtrl, tenantCtrl, enum.AuthProviderGoogle))
+			r.Patch("/oidc", handlerinstance.HandlePatchOauthProvider(instanceCtrl, authCtrl, tenantCtrl, enum.AuthProviderOIDC))
 		})
 		r.Route("/registry", func(r chi.Router) {
 			r.Get("/", handlerinstance.HandleGetRegistry(instanceCtrl))
 			r.Patch("/", handlerinstance.HandlePatchRegistry(instanceCtrl))
 		})
+		r.Route("/templates", func(r chi.Router) {
+			r.Get("/", handlerinstance.HandleListTemplateSources(tsCtrl))
+			r.Post("/", handlerinstance.HandleAddTemplateSource(tsCtrl))
+			r.Route(fmt.Sprintf("/{%s}", request.PathParamTemplateSource), func(r chi.Router) {
+				r.Delete("/", handlerinstance.HandleDeleteTemplateSource(tsCtrl))
+				r.Post("/sync", handlerinstance.HandleSyncTemplateSource(tsCtrl))
+				r.Route(fmt.Sprintf("/template/{%s}", request.PathParamTemplateID), func(r chi.Router) {
+					r.Get("/", handlerinstance.HandleGetSourceTemplate(tsCtrl))
+					r.Post(fmt.Sprintf("/publish/{%s}", request.PathParamVersion), handlerinstance.HandlePublishSourceTemplate(tsCtrl))
+				})
+			})
+		})
 
 		setupServer(r, serverCtrl)
 	})
@@ -211,12 +255,21 @@ func setupInstance(r chi.Router, instanceCtrl *instance.Controller, serverCtrl *
 func setupServer(r chi.Router, serverCtrl *server.Controller) {
 	r.Route("/server", func(r chi.Router) {
 		r.Use(middlewarerestrict.ToSuperAdmin())
-		r.Get("/", handlerserver.HandleGet(serverCtrl))
-		r.Patch("/", handlerserver.HandlePatchGeneral(serverCtrl))
-		r.Patch("/network", handlerserver.HandlePatchNetwork(serverCtrl))
-		r.Patch("/builder", handlerserver.HandlePatchBuilder(serverCtrl))
-		r.Patch("/limits", handlerserver.HandlePatchLimits(serverCtrl))
-		r.Get("/certificates", handlerserver.HandleListCertificates(serverCtrl))
+		r.Get("/", handlerserver.HandleList(serverCtrl))
+		r.Post("/", handlerserver.HandleCreate(serverCtrl))
+		r.Route(fmt.Sprintf("/{%s}", request.PathParamServerUID), func(r chi.Router) {
+			r.Use(middlewareinject.InjectServer(serverCtrl))
+			r.Get("/", handlerserver.HandleGet())
+			r.Patch("/", handlerserver.HandlePatchGeneral(serverCtrl))
+			r.Delete("/", handlerserver.HandleDelete(serverCtrl))
+			r.Patch("/network", handlerserver.HandlePatchNetwork(serverCtrl))
+			r.Patch("/builder", handlerserver.HandlePatchBuilder(serverCtrl))
+			r.Patch("/limits", handlerserver.HandlePatchLimits(serverCtrl))
+			r.Post("/health", handlerserver.HandleCheckHealth(serverCtrl))
+			r.Post("/bootstrap", handlerserver.HandleBootstrap(serverCtrl))
+			r.Post("/runner-token", handlerserver.HandleIssueRunnerToken(serverCtrl))
+			r.Get("/certificates", handlerserver.HandleListCertificates(serverCtrl))
+		})
 	})
 }
 
@@ -227,13 +280,21 @@ func setupTenant(r chi.Router,
 	envCtrl *environment.Controller,
 	ghAppCtrl *githubapp.Controller,
 	gitPublicCtrl *gitpublic.Controller,
+	gitConnCtrl *gitconnection.Controller,
 	appCtrl *application.Controller,
 	varCtrl *variable.Controller,
 	deploymentCtrl *deployment.Controller,
 	logsCtrl *logs.Controller,
 	volumeCtrl *volume.Controller,
+	backupCtrl *backup.Controller,
+	snapshotCtrl *snapshot.Controller,
 	templCtrl *template.Controller,
 	favCtrl *favorite.Controller,
+	saCtrl *serviceaccount.Controller,
+	regCredCtrl *registrycredential.Controller,
+	notifyCtrl *notification.Controller,
+	exportCtrl *export.Controller,
+	tsCtrl *templatesource.Controller,
 ) {
 	r.Route("/", func(r chi.Router) {
 		r.Get("/", func(w http.ResponseWriter, r *http.Request) { render.RedirectWithRefresh(w, "/team") })
@@ -252,7 +313,7 @@ func setupTenant(r chi.Router,
 				r.Use(middlewarenav.PopulateNavTeam())
 				r.Get("/", handlertenant.HandleGet(tenantCtrl, projectCtrl))
 				r.Get("/favorites", handlerfavorite.HandleListFavorites(favCtrl))
-				setupProject(r, appCtx, tenantCtrl, projectCtrl, envCtrl, ghAppCtrl, gitPublicCtrl, appCtrl, varCtrl, deploymentCtrl, logsCtrl, volumeCtrl, templCtrl, favCtrl)
+				setupProject(r, appCtx, tenantCtrl, projectCtrl, envCtrl, ghAppCtrl, gitPublicCtrl, gitConnCtrl, appCtrl, varCtrl, deploymentCtrl, logsCtrl, volumeCtrl, backupCtrl, snapshotCtrl, templCtrl, favCtrl, notifyCtrl, exportCtrl)
 
 				// Admin routes
 				r.Route("/", func(r chi.Router) {
@@ -267,6 +328,43 @@ func setupTenant(r chi.Router,
 						r.Patch("/", handlertenant.HandlePatchMember(tenantCtrl))
 						r.Delete("/", handlertenant.HandleDeleteMember(tenantCtrl))
 					})
+					r.Route("/service-accounts", func(r chi.Router) {
+						r.Get("/", handlertenant.HandleListServiceAccounts(tenantCtrl, projectCtrl, saCtrl))
+						r.Post("/", handlertenant.HandleAddServiceAccount(tenantCtrl, projectCtrl, saCtrl))
+						r.Route(fmt.Sprintf("/{%s}", request.PathParamServiceAccount), func(r chi.Router) {
+							r.Get("/", handlertenant.HandleGetServiceAccount(tenantCtrl, saCtrl))
+							r.Delete("/", handlertenant.HandleDeleteServiceAccount(saCtrl))
+							r.Post("/tokens", handlertenant.HandleCreateServiceAccountToken(tenantCtrl, saCtrl))
+							r.Delete(fmt.Sprintf("/tokens/{%s}", request.PathParamToken), handlertenant.HandleDeleteServiceAccountToken(tenantCtrl, saCtrl))
+						})
+					})
+					r.Route("/registry-credentials", func(r chi.Router) {
+						r.Get("/", handlertenant.HandleListRegistryCredentials(tenantCtrl, regCredCtrl))
+						r.Post("/", handlertenant.HandleAddRegistryCredential(tenantCtrl, regCredCtrl))
+						r.Delete(fmt.Sprintf("/{%s}", request.PathParamRegistryCred), handlertenant.HandleDeleteRegistryCredential(tenantCtrl, regCredCtrl))
+					})
+					r.Route("/notifications", func(r chi.Router) {
+						r.Get("/", handlertenant.HandleListNotificationChannels(tenantCtrl, notifyCtrl))
+						r.Post("/", handlertenant.HandleAddNotificationChannel(tenantCtrl, notifyCtrl))
+						r.Route(fmt.Sprintf("/{%s}", request.PathParamNotifyChannel), func(r chi.Router) {
+							r.Post("/test", handlertenant.HandleTestNotificationChannel(notifyCtrl))
+							r.Delete("/", handlertenant.HandleDeleteNotificationChannel(tenantCtrl, notifyCtrl))
+						})
+					})
+					r.Route("/templates", func(r chi.Router) {
+						r.Get("/", handlertenant.HandleListTemplateSources(tenantCtrl, tsCtrl))
+						r.Post("/", handlertenant.HandleAddTemplateSource(tenantCtrl, tsCtrl))
+						r.Route(fmt.Sprintf("/{%s}", request.PathParamTemplateSource), func(r chi.Router) {
+							r.Delete("/", handlertenant.HandleDeleteTemplateSource(tenantCtrl, tsCtrl))
+							r.Post("/sync", handlertenant.HandleSyncTemplateSource(tsCtrl))
+							r.Route(fmt.Sprintf("/template/{%s}", request.PathParamTemplateID), func(r chi.Router) {
+								r.Get("/", handlertenant.HandleGetSourceTemplate(tenantCtrl, tsCtrl))
+								r.Post(fmt.Sprintf("/publish/{%s}", request.PathParamVersion), handlertenant.HandlePublishSourceTemplate(tenantCtrl, tsCtrl))
+							})
+						})
+					})
+					r.Get("/audit", handlertenant.HandleListAuditEvents(tenantCtrl, projectCtrl))
+					r.Get("/audit/export", handlertenant.HandleExportAuditEvents(tenantCtrl))
 					r.Delete("/delete", handlertenant.HandleDeleteTeam(tenantCtrl))
 				})
 			})
@@ -281,13 +379,18 @@ func setupProject(r chi.Router,
 	envCtrl *environment.Controller,
 	ghAppCtrl *githubapp.Controller,
 	gitPublicCtrl *gitpublic.Controller,
+	gitConnCtrl *gitconnection.Controller,
 	appCtrl *application.Controller,
 	varCtrl *variable.Controller,
 	deploymentCtrl *deployment.Controller,
 	logsCtrl *logs.Controller,
 	volumeCtrl *volume.Controller,
+	backupCtrl *backup.Controller,
+	snapshotCtrl *snapshot.Controller,
 	templCtrl *template.Controller,
 	favCtrl *favorite.Controller,
+	notifyCtrl *notification.Controller,
+	exportCtrl *export.Controller,
 ) {
 	r.Route("/project", func(r chi.Router) {
 		r.Route("/new", func(r chi.Router) {
@@ -310,8 +413,8 @@ func setupProject(r chi.Router,
 				r.Delete("/delete", handlerproject.HandleDelete(projectCtrl))
 			})
 			r.Get("/events", handlerproject.HandleEvents(appCtx, projectCtrl))
-			setupProjectConnections(r, ghAppCtrl, gitPublicCtrl)
-			setupEnvionment(r, appCtx, envCtrl, ghAppCtrl, gitPublicCtrl, appCtrl, varCtrl, deploymentCtrl, logsCtrl, volumeCtrl, templCtrl, favCtrl)
+			setupProjectConnections(r, ghAppCtrl, gitPublicCtrl, gitConnCtrl)
+			setupEnvionment(r, appCtx, envCtrl, ghAppCtrl, gitPublicCtrl, gitConnCtrl, appCtrl, varCtrl, deploymentCtrl, logsCtrl, volumeCtrl, backupCtrl, snapshotCtrl, templCtrl, favCtrl, exportCtrl)
 
 			// Admin/Owner routes
 			r.Route("/members", func(r chi.Router) {
@@ -322,6 +425,16 @@ func setupProject(r chi.Router,
 				r.Delete("/", handlerproject.HandleDeleteMember(projectCtrl))
 				r.Get("/list-nonmembers", handlerproject.HandleListAllMembers(tenantCtrl))
 			})
+			r.With(middlewarerestrict.ToProjectOwner()).Get("/export", handlerproject.HandleExport(exportCtrl))
+			r.Route("/notifications", func(r chi.Router) {
+				r.Use(middlewarerestrict.ToProjectOwner())
+				r.Get("/", handlerproject.HandleListNotificationChannels(notifyCtrl))
+				r.Post("/", handlerproject.HandleAddNotificationChannel(notifyCtrl))
+				r.Route(fmt.Sprintf("/{%s}", request.PathParamNotifyChannel), func(r chi.Router) {
+					r.Post("/test", handlerproject.HandleTestNotificationChannel(notifyCtrl))
+					r.Delete("/", handlerproject.HandleDeleteNotificationChannel(notifyCtrl))
+				})
+			})
 		})
 	})
 }
@@ -329,16 +442,17 @@ func setupProject(r chi.Router,
 func setupEnvionment(r chi.Router,
 	appCtx context.Context,
 	envCtrl *environment.Controller, ghAppCtrl *githubapp.Controller, gitPublicCtrl *gitpublic.Controller,
+	gitConnCtrl *gitconnection.Controller,
 	appCtrl *application.Controller, varCtrl *variable.Controller,
 	deploymentCtrl *deployment.Controller,
-	logsCtrl *logs.Controller, volumeCtrl *volume.Controller,
-	templCtrl *template.Controller, favCtrl *favorite.Controller,
+	logsCtrl *logs.Controller, volumeCtrl *volume.Controller, backupCtrl *backup.Controller, snapshotCtrl *snapshot.Controller,
+	templCtrl *template.Controller, favCtrl *favorite.Controller, exportCtrl *export.Controller,
 ) {
 	r.Route("/environment", func(r chi.Router) {
 		r.Route("/new", func(r chi.Router) {
 			r.Use(middlewarerestrict.ToProjectOwner())
 			r.Use(middlewarenav.PopulateNavItemKey("New Environment"))
-			r.Get("/", handlerenvironment.HandleNew())
+			r.Get("/", handlerenvironment.HandleNew(envCtrl))
 			r.Post("/", handlerenvironment.HandleAdd(envCtrl))
 		})
 		r.Get("/", handlerenvironment.HandleList(envCtrl))
@@ -359,12 +473,13 @@ func setupEnvionment(r chi.Router,
 					})
 				})
 			})
-			setupApplication(r, appCtx, envCtrl, appCtrl, varCtrl, ghAppCtrl, gitPublicCtrl, deploymentCtrl, logsCtrl, volumeCtrl, templCtrl, favCtrl)
+			r.With(middlewarerestrict.ToProjectOwner()).Get("/export", handlerenvironment.HandleExport(exportCtrl))
+			setupApplication(r, appCtx, envCtrl, appCtrl, varCtrl, ghAppCtrl, gitPublicCtrl, gitConnCtrl, deploymentCtrl, logsCtrl, volumeCtrl, backupCtrl, snapshotCtrl, templCtrl, favCtrl)
 		})
 	})
 }
 
-func setupApplication(r chi.Router, appCtx context.Context, envCtrl *environment.Controller, appCtrl *application.Controller, varCtrl *variable.Controller, ghAppCtrl *githubapp.Controller, gitPublicCtrl *gitpublic.Controller, deploymentCtrl *deployment.Controller, logsCtrl *logs.Controller, volumeCtrl *volume.Controller, templCtrl *template.Controller, favCtrl *favorite.Controller) {
+func setupApplication(r chi.Router, appCtx context.Context, envCtrl *environment.Controller, appCtrl *application.Controller, varCtrl *variable.Controller, ghAppCtrl *githubapp.Controller, gitPublicCtrl *gitpublic.Controller, gitConnCtrl *gitconnection.Controller, deploymentCtrl *deployment.Controller, logsCtrl *logs.Controller, volumeCtrl *volume.Controller, backupCtrl *backup.Controller, snapshotCtrl *snapshot.Controller, templCtrl *template.Controller, favCtrl *favorite.Controller) {
 	r.Route("/application", func(r chi.Router) {
 		r.Get("/", handlerapplication.HandleList(envCtrl, appCtrl))
 		r.Get(fmt.Sprintf("/nav/{%s}", request.PathParamSelectedUID), handlerapplication.HandleListNavigation(appCtrl))
@@ -378,9 +493,11 @@ func setupApplication(r chi.Router, appCtx context.Context, envCtrl *environment
 			r.Get("/deployments", handlerapplication.HandleListDeployments(appCtrl, deploymentCtrl))
 			r.Get("/settings", handlerapplication.HandleGetSettings(appCtrl, ghAppCtrl))
 			r.Patch("/settings", handlerapplication.HandleUpdateSettings(appCtrl, ghAppCtrl))
+			r.Get("/runs", handlerapplication.HandleGetRuns(appCtrl))
 			r.Get("/logs", handlerapplication.HandleGetLogs(appCtrl))
 			r.Get("/logs/stream", handlerapplication.HandleTailLogs(appCtx, appCtrl))
-			r.Get("/terminal", handlerapplication.HandleGetTerminal())
+			r.Get("/terminal", handlerapplication.HandleGetTerminal(appCtrl))
+			r.With(middlewarerestrict.ToProjectContributor()).Get("/terminal/ws", handlerapplication.HandleTerminalSession(appCtx, appCtrl))
 			r.Get(fmt.Sprintf("/metrics/{%s}", request.PathParamMetricsSpan), handlerapplication.HandleGetMetrics(appCtrl))
 			r.Route("/favorite", func(r chi.Router) {
 				r.Get("/", handlerfavorite.HandleGetFavorite(favCtrl))
@@ -416,6 +533,24 @@ func setupApplication(r chi.Router, appCtx context.Context, envCtrl *environment
 					r.Use(middlewareinject.InjectVolume(volumeCtrl))
 					r.Patch("/", handlervolume.HandleUpdateAttached(appCtrl, volumeCtrl))
 					r.Patch("/detach", handlervolume.HandleUpdateDetach(appCtrl, volumeCtrl))
+					r.Route("/snapshots", func(r chi.Router) {
+						r.Get("/", handlersnapshot.HandleList(snapshotCtrl))
+						r.Post("/", handlersnapshot.HandleCreate(snapshotCtrl))
+						r.Patch("/policy", handlersnapshot.HandleUpdatePolicy(snapshotCtrl))
+						r.Route(fmt.Sprintf("/{%s}", request.PathParamSnapshotUID), func(r chi.Router) {
+							r.Post("/restore", handlersnapshot.HandleRestore(snapshotCtrl))
+							r.Delete("/", handlersnapshot.HandleDelete(snapshotCtrl))
+						})
+					})
+				})
+			})
+			r.Route("/backups", func(r chi.Router) {
+				r.Get("/", handlerbackup.HandleList(backupCtrl))
+				r.Post("/", handlerbackup.HandleCreate(backupCtrl))
+				r.Patch("/policy", handlerbackup.HandleUpdatePolicy(backupCtrl))
+				r.Route(fmt.Sprintf("/{%s}", request.PathParamBackupUID), func(r chi.Router) {
+					r.Post("/restore", handlerbackup.HandleRestore(backupCtrl))
+					r.Delete("/", handlerbackup.HandleDelete(backupCtrl))
 				})
 			})
 			r.Patch("/deploy", handlerapplication.HandleDeploy(appCtrl))
@@ -424,11 +559,11 @@ func setupApplication(r chi.Router, appCtx context.Context, envCtrl *environment
 			r.Delete("/delete", handlerapplication.HandleDeleteApplication(appCtrl))
 			setupDeployment(r, appCtx, appCtrl, deploymentCtrl, logsCtrl)
 		})
-		setupApplicationNew(r, appCtrl, ghAppCtrl, gitPublicCtrl, templCtrl)
+		setupApplicationNew(r, appCtrl, ghAppCtrl, gitPublicCtrl, gitConnCtrl, templCtrl)
 	})
 }
 
-func setupApplicationNew(r chi.Router, appCtrl *application.Controller, ghAppCtrl *githubapp.Controller, gitPublicCtrl *gitpublic.Controller, templCtrl *template.Controller) {
+func setupApplicationNew(r chi.Router, appCtrl *application.Controller, ghAppCtrl *githubapp.Controller, gitPublicCtrl *gitpublic.Controller, gitConnCtrl *gitconnection.Controller, templCtrl *template.Controller) {
 	r.Route("/new", func(r chi.Router) {
 		r.Use(middlewarenav.PopulateNavItemKey("New Application"))
 		r.Use(middlewarerestrict.ModificationToProjectOwner()) // only owners can modify, others can view
@@ -444,8 +579,16 @@ func setupApplicationNew(r chi.Router, appCtrl *application.Controller, ghAppCtr
 				r.Post("/", handlercreate.HandleCreateGithub(appCtrl))
 			})
 		})
+		r.Route("/git", func(r chi.Router) {
+			r.Get("/", handlercreate.HandleListGitConnections(gitConnCtrl))
+			r.Route(fmt.Sprintf("/{%s}", request.PathParamSourceUID), func(r chi.Router) {
+				r.Use(middlewareinject.InjectGitConnection(gitConnCtrl))
+				r.Get("/", handlercreate.HandleGetGitConnectionView(appCtrl))
+				r.Post("/", handlercreate.HandleCreateGitConnection(appCtrl))
+			})
+		})
 		r.Route("/registry", func(r chi.Router) {
-			r.Get("/", handlercreate.HandleGetRegistryView())
+			r.Get("/", handlercreate.HandleGetRegistryView(appCtrl))
 			r.Post("/", handlercreate.HandleCreateWithRegistry(appCtrl))
 		})
 		r.Route("/database", func(r chi.Router) {
@@ -471,15 +614,16 @@ func setupDeployment(r chi.Router, appCtx context.Context, appCtrl *application.
 			//Inject deployment here
 			r.Use(middlewareinject.InjectDeployment(deploymentCtrl))
 			r.Get("/", handlerdeployment.HandleGetDeployment(deploymentCtrl))
+			r.Patch("/rollback", handlerdeployment.HandleRollback(deploymentCtrl))
 			r.Get("/logs", handlerLogs.HandleGetLogs(logsCtrl))
 			r.Get("/logs/stream", handlerLogs.HandleTailLogs(appCtx, logsCtrl))
 		})
 	})
 }
 
-func setupProjectConnections(r chi.Router, ghAppCtrl *githubapp.Controller, gitPublicCtrl *gitpublic.Controller) {
+func setupProjectConnections(r chi.Router, ghAppCtrl *githubapp.Controller, gitPublicCtrl *gitpublic.Controller, gitConnCtrl *gitconnection.Controller) {
 	r.Route("/connections", func(r chi.Router) {
-		r.Get("/", handlerConn.HandleListForProject(ghAppCtrl))
+		r.Get("/", handlerConn.HandleListForProject(ghAppCtrl, gitConnCtrl))
 		r.Route("/github", func(r chi.Router) {
 			r.Route("/new", func(r chi.Router) {
 				r.Use(middlewarenav.PopulateNavItemKey("New Github App"))
@@ -500,6 +644,24 @@ func setupProjectConnections(r chi.Router, ghAppCtrl *githubapp.Controller, gitP
 				r.Get("/list-branches", handlerConn.HandleListGithubBranches(ghAppCtrl))
 			})
 		})
+		r.Route("/git", func(r chi.Router) {
+			r.Route("/new", func(r chi.Router) {
+				r.Use(middlewarenav.PopulateNavItemKey("New Git Connection"))
+				r.Use(middlewarerestrict.ToProjectOwner())
+				r.Get("/", handlerConn.HandleNewGitConnection())
+				r.Post("/", handlerConn.HandleAddGitConnection(gitConnCtrl))
+			})
+			r.Route(fmt.Sprintf("/{%s}", request.PathParamSourceUID), func(r chi.Router) {
+				r.Use(middlewareinject.InjectGitConnection(gitConnCtrl))
+				r.Route("/", func(r chi.Router) {
+					r.Use(middlewarerestrict.ToProjectOwner())
+					r.Get("/", handlerConn.HandleGetGitConnection())
+					r.Delete("/", handlerConn.HandleDeleteGitConnection(gitConnCtrl))
+				})
+				r.Get("/list-repos", handlerConn.HandleListGitConnectionRepos(gitConnCtrl))
+				r.Get("/list-branches", handlerConn.HandleListGitConnectionBranches(gitConnCtrl))
+			})
+		})
 		r.Route("/git-public", func(r chi.Router) {
 			r.Get("/list-branches", handlerConn.HandleListGitpublicBranches(gitPublicCtrl))
 		})
@@ -529,6 +691,11 @@ func setupAccount(r chi.Router, config *types.Config, authCtrl *auth.Controller,
 		r.Get("/", accounthandler.HandleGetProfile(userCtrl))
 		r.Patch("/", accounthandler.HandlePatchProfile(userCtrl))
 		r.Get("/session", accounthandler.HandleGetSession(userCtrl))
+		r.Route("/tokens", func(r chi.Router) {
+			r.Get("/", accounthandler.HandleListTokens(userCtrl))
+			r.Post("/", accounthandler.HandleCreateToken(userCtrl))
+			r.Delete(fmt.Sprintf("/{%s}", request.PathParamToken), accounthandler.HandleDeleteToken(userCtrl))
+		})
 		r.Get("/delete", accounthandler.HandleGetDelete(userCtrl))
 	})
 }
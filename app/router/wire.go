@@ -3,23 +3,34 @@ package router
 import (
 	"context"
 
+	"github.com/cloudness-io/cloudness/app/api/openapi"
 	"github.com/cloudness-io/cloudness/app/auth/authn"
 	"github.com/cloudness-io/cloudness/app/controller/application"
+	"github.com/cloudness-io/cloudness/app/controller/backup"
 	"github.com/cloudness-io/cloudness/app/controller/auth"
 	"github.com/cloudness-io/cloudness/app/controller/deployment"
 	"github.com/cloudness-io/cloudness/app/controller/environment"
+	"github.com/cloudness-io/cloudness/app/controller/export"
 	"github.com/cloudness-io/cloudness/app/controller/favorite"
+	"github.com/cloudness-io/cloudness/app/controller/gitconnection"
 	"github.com/cloudness-io/cloudness/app/controller/githubapp"
 	"github.com/cloudness-io/cloudness/app/controller/gitpublic"
 	"github.com/cloudness-io/cloudness/app/controller/instance"
 	"github.com/cloudness-io/cloudness/app/controller/logs"
+	"github.com/cloudness-io/cloudness/app/controller/notification"
 	"github.com/cloudness-io/cloudness/app/controller/project"
 	"github.com/cloudness-io/cloudness/app/controller/server"
+	"github.com/cloudness-io/cloudness/app/controller/registrycredential"
+	"github.com/cloudness-io/cloudness/app/controller/serviceaccount"
+	"github.com/cloudness-io/cloudness/app/controller/snapshot"
 	"github.com/cloudness-io/cloudness/app/controller/template"
+	"github.com/cloudness-io/cloudness/app/controller/templatesource"
 	"github.com/cloudness-io/cloudness/app/controller/tenant"
 	"github.com/cloudness-io/cloudness/app/controller/user"
 	"github.com/cloudness-io/cloudness/app/controller/variable"
 	"github.com/cloudness-io/cloudness/app/controller/volume"
+	"github.com/cloudness-io/cloudness/app/pipeline/manager"
+	"github.com/cloudness-io/cloudness/app/services/sleep"
 	"github.com/cloudness-io/cloudness/types"
 
 	"github.com/google/wire"
@@ -28,33 +39,42 @@ import (
 // WireSet provides a wire set for this package.
 var WireSet = wire.NewSet(
 	ProvideRouter,
-	// ProvideAPIHandler,
+	ProvideAPIHandler,
 	ProvideWebHandler,
 )
 
 func ProvideRouter(
-	// api APIHandler,
+	api APIHandler,
 	web WebHandler,
+	activator *sleep.Activator,
 ) *Router {
-	// return NewRouter(api, web)
-	return NewRouter(web)
+	return NewRouter(api, web, activator)
 }
 
-// func ProvideAPIHandler(
-// 	appCtx context.Context,
-// 	config *types.Config,
-// 	authenticator authn.Authenticator,
-// 	userCtrl *user.Controller,
-// 	tenatCtrl *tenant.Controller,
-// 	projectCtrl *project.Controller,
-// 	appCtrl *app.Controller,
-// 	spCtrl *sourceprovider.Controller,
-// ) APIHandler {
-// 	return NewAPIHandler(appCtx, config,
-// 		authenticator, userCtrl,
-// 		tenatCtrl, projectCtrl,
-// 		appCtrl, spCtrl)
-// }
+func ProvideAPIHandler(
+	appCtx context.Context,
+	config *types.Config,
+	authenticator authn.Authenticator,
+	openapiSvc openapi.Service,
+	userCtrl *user.Controller,
+	tenatCtrl *tenant.Controller,
+	saCtrl *serviceaccount.Controller,
+	regCredCtrl *registrycredential.Controller,
+	projectCtrl *project.Controller,
+	environmentCtrl *environment.Controller,
+	appCtrl *application.Controller,
+	varCtrl *variable.Controller,
+	volumeCtrl *volume.Controller,
+	deploymentCtrl *deployment.Controller,
+	runnerManager manager.RunnerManager,
+) APIHandler {
+	return NewAPIHandler(appCtx, config,
+		authenticator, openapiSvc,
+		userCtrl, tenatCtrl, saCtrl, regCredCtrl, projectCtrl,
+		environmentCtrl, appCtrl,
+		varCtrl, volumeCtrl, deploymentCtrl, runnerManager,
+	)
+}
 
 func ProvideWebHandler(
 	appCtx context.Context,
@@ -69,22 +89,30 @@ func ProvideWebHandler(
 	authCtrl *auth.Controller,
 	ghAppCtrl *githubapp.Controller,
 	gitPublicCtrl *gitpublic.Controller,
+	gitConnCtrl *gitconnection.Controller,
 	appCtrl *application.Controller,
 	varCtrl *variable.Controller,
 	deploymentCtrl *deployment.Controller,
 	logsCtrl *logs.Controller,
 	volumeCtrl *volume.Controller,
+	backupCtrl *backup.Controller,
+	snapshotCtrl *snapshot.Controller,
 	templCtrl *template.Controller,
 	favCtrl *favorite.Controller,
+	saCtrl *serviceaccount.Controller,
+	regCredCtrl *registrycredential.Controller,
+	notifyCtrl *notification.Controller,
+	exportCtrl *export.Controller,
+	tsCtrl *templatesource.Controller,
 ) WebHandler {
 	return NewWebHandler(appCtx, config,
 		authenticator,
 		instanceCtrl, serverCtrl, userCtrl,
 		tenatCtrl, projectCtrl,
 		environmentCtrl, authCtrl,
-		ghAppCtrl, gitPublicCtrl,
+		ghAppCtrl, gitPublicCtrl, gitConnCtrl,
 		appCtrl, varCtrl, deploymentCtrl,
-		logsCtrl, volumeCtrl,
-		templCtrl, favCtrl,
+		logsCtrl, volumeCtrl, backupCtrl, snapshotCtrl,
+		templCtrl, favCtrl, saCtrl, regCredCtrl, notifyCtrl, exportCtrl, tsCtrl,
 	)
 }
@@ -0,0 +1,133 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/cloudness-io/cloudness/app/middleware/audit"
+	"github.com/cloudness-io/cloudness/app/request"
+	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Service records audit events of the mutations done through the controllers.
+type Service struct {
+	auditStore store.AuditEventStore
+}
+
+func New(auditStore store.AuditEventStore) *Service {
+	return &Service{
+		auditStore: auditStore,
+	}
+}
+
+// Resource identifies the resource an audit event is recorded for.
+type Resource struct {
+	Type enum.AuditResourceType
+	ID   string
+	Name string
+}
+
+func NewResource(resourceType enum.AuditResourceType, id string, name string) Resource {
+	return Resource{Type: resourceType, ID: id, Name: name}
+}
+
+// Log records the action on the resource. The actor, scope and request metadata are taken from
+// the request context, failures are only logged so they never fail the audited mutation.
+func (s *Service) Log(ctx context.Context, resource Resource, action enum.AuditAction, opts ...Option) {
+	event := &types.AuditEvent{
+		Action:        action,
+		ResourceType:  resource.Type,
+		ResourceID:    resource.ID,
+		ResourceName:  resource.Name,
+		ClientIP:      audit.GetRealIP(ctx),
+		RequestID:     audit.GetRequestID(ctx),
+		RequestMethod: audit.GetRequestMethod(ctx),
+		Created:       time.Now().UTC().UnixMilli(),
+	}
+	applyContextScope(ctx, event)
+
+	if principal, ok := request.PrincipalFrom(ctx); ok && principal != nil {
+		event.ActorID = principal.ID
+		event.ActorName = principal.DisplayName
+		event.ActorEmail = principal.Email
+	}
+
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.tenantID != nil {
+		event.TenantID = *o.tenantID
+	}
+	if o.projectID != nil {
+		event.ProjectID = *o.projectID
+	}
+	if o.environmentID != nil {
+		event.EnvironmentID = *o.environmentID
+	}
+
+	if o.oldObject != nil || o.newObject != nil || len(o.data) > 0 {
+		changes, err := diffObjects(o.oldObject, o.newObject)
+		if err != nil {
+			log.Ctx(ctx).Warn().Err(err).Msg("audit: failed to diff objects")
+		}
+		for k, v := range o.data {
+			changes[k] = &types.AuditDiffEntry{New: redactValue(k, v)}
+		}
+		if len(changes) > 0 {
+			raw, err := json.Marshal(changes)
+			if err != nil {
+				log.Ctx(ctx).Warn().Err(err).Msg("audit: failed to marshal diff")
+			}
+			event.Diff = string(raw)
+		}
+	}
+
+	if event.TenantID == 0 {
+		log.Ctx(ctx).Warn().Str("resource", string(resource.Type)).Msg("audit: event without tenant scope skipped")
+		return
+	}
+
+	if err := s.auditStore.Create(ctx, event); err != nil {
+		log.Ctx(ctx).Error().Err(err).
+			Str("resource", string(resource.Type)).
+			Str("action", string(action)).
+			Msg("audit: failed to record event")
+	}
+}
+
+func applyContextScope(ctx context.Context, event *types.AuditEvent) {
+	var scope []string
+	if tenant, ok := request.TenantFrom(ctx); ok && tenant != nil {
+		event.TenantID = tenant.ID
+	}
+	if project, ok := request.ProjectFrom(ctx); ok && project != nil {
+		event.ProjectID = project.ID
+		scope = append(scope, project.Name)
+	}
+	if env, ok := request.EnvironmentFrom(ctx); ok && env != nil {
+		event.EnvironmentID = env.ID
+		scope = append(scope, env.Name)
+	}
+	if app, ok := request.ApplicationFrom(ctx); ok && app != nil {
+		event.ApplicationID = app.ID
+		scope = append(scope, app.Name)
+	}
+	event.Scope = strings.Join(scope, " / ")
+}
+
+// List lists the audit events of the tenant matching the filter.
+func (s *Service) List(ctx context.Context, filter *types.AuditFilter) ([]*types.AuditEvent, error) {
+	return s.auditStore.List(ctx, filter)
+}
+
+// Count counts the audit events of the tenant matching the filter.
+func (s *Service) Count(ctx context.Context, filter *types.AuditFilter) (int64, error) {
+	return s.auditStore.Count(ctx, filter)
+}
@@ -0,0 +1,96 @@
+package audit
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/cloudness-io/cloudness/types"
+)
+
+const redacted = "[redacted]"
+
+// redactedFields are matched against the last segment of the field path, variable values
+// are always redacted as they usually hold credentials.
+var redactedFields = []string{"password", "secret", "token", "private", "value", "text_value", "auth", "dockerconfig"}
+
+// diffObjects returns the changed fields between the json representations of old and new,
+// nested objects are flattened to dot separated paths.
+func diffObjects(oldObj, newObj any) (map[string]*types.AuditDiffEntry, error) {
+	changes := map[string]*types.AuditDiffEntry{}
+
+	oldMap, err := flatten(oldObj)
+	if err != nil {
+		return changes, err
+	}
+	newMap, err := flatten(newObj)
+	if err != nil {
+		return changes, err
+	}
+
+	for k, ov := range oldMap {
+		nv, ok := newMap[k]
+		if ok && reflect.DeepEqual(ov, nv) {
+			continue
+		}
+		entry := &types.AuditDiffEntry{Old: redactValue(k, ov)}
+		if ok {
+			entry.New = redactValue(k, nv)
+		}
+		changes[k] = entry
+	}
+	for k, nv := range newMap {
+		if _, ok := oldMap[k]; ok {
+			continue
+		}
+		changes[k] = &types.AuditDiffEntry{New: redactValue(k, nv)}
+	}
+	return changes, nil
+}
+
+func flatten(obj any) (map[string]any, error) {
+	out := map[string]any{}
+	if obj == nil || (reflect.ValueOf(obj).Kind() == reflect.Ptr && reflect.ValueOf(obj).IsNil()) {
+		return out, nil
+	}
+
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		return out, err
+	}
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return out, err
+	}
+
+	flattenInto(out, "", v)
+	return out, nil
+}
+
+func flattenInto(out map[string]any, prefix string, v any) {
+	m, ok := v.(map[string]any)
+	if !ok {
+		out[prefix] = v
+		return
+	}
+	for k, child := range m {
+		key := k
+		if prefix != "" {
+			key = prefix + "." + k
+		}
+		flattenInto(out, key, child)
+	}
+}
+
+func redactValue(key string, v any) any {
+	if v == nil || v == "" {
+		return v
+	}
+	field := strings.ToLower(key[strings.LastIndex(key, ".")+1:])
+	for _, r := range redactedFields {
+		if strings.Contains(field, r) {
+			return redacted
+		}
+	}
+	return v
+}
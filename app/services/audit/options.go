@@ -0,0 +1,59 @@
+package audit
+
+type options struct {
+	tenantID      *int64
+	projectID     *int64
+	environmentID *int64
+	oldObject     any
+	newObject     any
+	data          map[string]any
+}
+
+// Option configures an audit event.
+type Option func(*options)
+
+// WithOldObject records the state of the resource before the change.
+func WithOldObject(obj any) Option {
+	return func(o *options) {
+		o.oldObject = obj
+	}
+}
+
+// WithNewObject records the state of the resource after the change.
+func WithNewObject(obj any) Option {
+	return func(o *options) {
+		o.newObject = obj
+	}
+}
+
+// WithData records an additional field on the event.
+func WithData(key string, value any) Option {
+	return func(o *options) {
+		if o.data == nil {
+			o.data = map[string]any{}
+		}
+		o.data[key] = value
+	}
+}
+
+// WithTenantID sets the tenant scope when the request context does not carry it,
+// eg. while the tenant itself is being created.
+func WithTenantID(id int64) Option {
+	return func(o *options) {
+		o.tenantID = &id
+	}
+}
+
+// WithProjectID sets the project scope when the request context does not carry it.
+func WithProjectID(id int64) Option {
+	return func(o *options) {
+		o.projectID = &id
+	}
+}
+
+// WithEnvironmentID sets the environment scope when the request context does not carry it.
+func WithEnvironmentID(id int64) Option {
+	return func(o *options) {
+		o.environmentID = &id
+	}
+}
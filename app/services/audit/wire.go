@@ -0,0 +1,15 @@
+package audit
+
+import (
+	"github.com/cloudness-io/cloudness/app/store"
+
+	"github.com/google/wire"
+)
+
+var WireSet = wire.NewSet(
+	ProvideService,
+)
+
+func ProvideService(auditStore store.AuditEventStore) *Service {
+	return New(auditStore)
+}
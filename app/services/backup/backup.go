@@ -0,0 +1,232 @@
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudness-io/cloudness/app/controller/server"
+	"github.com/cloudness-io/cloudness/app/services/manager"
+	specSvc "github.com/cloudness-io/cloudness/app/services/spec"
+	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/blob"
+	"github.com/cloudness-io/cloudness/errors"
+	"github.com/cloudness-io/cloudness/helpers"
+	"github.com/cloudness-io/cloudness/job"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/check"
+	"github.com/cloudness-io/cloudness/types/enum"
+
+	"github.com/gorhill/cronexpr"
+)
+
+const (
+	jobTypeSchedule        = "cloudness:backups:schedule"
+	jobCronSchedule        = "* * * * *" // Every minute
+	jobMaxDurationSchedule = time.Minute
+
+	jobTypeBackup        = "cloudness:backups:backup"
+	jobMaxDurationBackup = 7 * time.Hour
+
+	jobTypeRestore        = "cloudness:backups:restore"
+	jobMaxDurationRestore = 7 * time.Hour
+
+	scheduleFields = 5
+	maxRetention   = 100
+)
+
+// BlobPath returns the path of the backup in the blob store.
+func BlobPath(backup *types.Backup) string {
+	return fmt.Sprintf("backups/%d/%d.dump", backup.ApplicationID, backup.UID)
+}
+
+type Service struct {
+	//job related
+	scheduler *job.Scheduler
+	executor  *job.Executor
+
+	//stores
+	backupStore store.BackupStore
+	policyStore store.BackupPolicyStore
+	appStore    store.ApplicationStore
+	blobStore   blob.Store
+
+	serverCtrl *server.Controller
+	factory    manager.ManagerFactory
+}
+
+func New(
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	backupStore store.BackupStore,
+	policyStore store.BackupPolicyStore,
+	appStore store.ApplicationStore,
+	blobStore blob.Store,
+	serverCtrl *server.Controller,
+	factory manager.ManagerFactory,
+) *Service {
+	return &Service{
+		scheduler:   scheduler,
+		executor:    executor,
+		backupStore: backupStore,
+		policyStore: policyStore,
+		appStore:    appStore,
+		blobStore:   blobStore,
+		serverCtrl:  serverCtrl,
+		factory:     factory,
+	}
+}
+
+func (s *Service) Register(ctx context.Context) error {
+	if err := s.executor.Register(jobTypeSchedule, newScheduleJob(s)); err != nil {
+		return fmt.Errorf("failed to register job handler for backup schedules: %w", err)
+	}
+	if err := s.executor.Register(jobTypeBackup, newBackupRunJob(s)); err != nil {
+		return fmt.Errorf("failed to register job handler for backups: %w", err)
+	}
+	if err := s.executor.Register(jobTypeRestore, newRestoreRunJob(s)); err != nil {
+		return fmt.Errorf("failed to register job handler for restores: %w", err)
+	}
+
+	if err := s.scheduler.AddRecurring(
+		ctx,
+		jobTypeSchedule,
+		jobTypeSchedule,
+		jobCronSchedule,
+		jobMaxDurationSchedule,
+	); err != nil {
+		return fmt.Errorf("failed to schedule backup schedule job: %w", err)
+	}
+
+	return nil
+}
+
+// ValidatePolicy validates the five field cron schedule and the retention of a backup policy.
+func ValidatePolicy(in *types.BackupPolicyInput) error {
+	errs := check.NewValidationErrors()
+
+	if strings.HasPrefix(in.Schedule, "@") || len(strings.Fields(in.Schedule)) != scheduleFields {
+		errs.AddValidationError("schedule", check.NewValidationError("Schedule must have five fields, e.g. 0 3 * * *"))
+	} else if _, err := cronexpr.Parse(in.Schedule); err != nil {
+		errs.AddValidationError("schedule", check.NewValidationErrorf("Invalid schedule: %s", err))
+	}
+	if in.Retention < 1 || in.Retention > maxRetention {
+		errs.AddValidationError("retention", check.NewValidationErrorf("Retention must be between 1 and %d backups", maxRetention))
+	}
+
+	if errs.HasError() {
+		return errs
+	}
+	return nil
+}
+
+// NextRun returns the next time the schedule is due after the given time.
+func NextRun(schedule string, after time.Time) (int64, error) {
+	expr, err := cronexpr.Parse(schedule)
+	if err != nil {
+		return 0, err
+	}
+	return expr.Next(after).UnixMilli(), nil
+}
+
+// Start records a pending backup of the database application and queues its run.
+func (s *Service) Start(ctx context.Context, app *types.Application, trigger enum.BackupTrigger, createdBy string) (*types.Backup, error) {
+	kind := specSvc.GetBackupKind(app.Spec)
+	if kind == "" {
+		return nil, errors.PreconditionFailed("Backups are only supported for database applications")
+	}
+
+	backup := &types.Backup{
+		UID:           helpers.GenerateUID(),
+		TenantID:      app.TenantID,
+		ProjectID:     app.ProjectID,
+		EnvironmentID: app.EnvironmentID,
+		ApplicationID: app.ID,
+		Kind:          kind,
+		Trigger:       trigger,
+		Status:        enum.BackupStatusPending,
+		CreatedBy:     createdBy,
+	}
+	backup.BlobPath = BlobPath(backup)
+
+	backup, err := s.backupStore.Create(ctx, backup)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.scheduler.RunJob(ctx, job.Definition{
+		UID:     fmt.Sprintf("backup-%d", backup.ID),
+		Type:    jobTypeBackup,
+		Timeout: jobMaxDurationBackup,
+		Data:    strconv.FormatInt(backup.ID, 10),
+	}); err != nil {
+		return nil, fmt.Errorf("failed to queue backup: %w", err)
+	}
+
+	return backup, nil
+}
+
+type restoreData struct {
+	BackupID      int64 `json:"backup_id"`
+	ApplicationID int64 `json:"application_id"`
+}
+
+// Restore queues the restore of the backup into the database application, the application may be
+// a new one that is still deploying.
+func (s *Service) Restore(ctx context.Context, backup *types.Backup, target *types.Application) error {
+	if backup.Status != enum.BackupStatusSucceeded {
+		return errors.PreconditionFailed("Only successful backups can be restored")
+	}
+	if backup.IsRestoring() {
+		return errors.PreconditionFailed("The backup is already being restored")
+	}
+	if kind := specSvc.GetBackupKind(target.Spec); kind != backup.Kind {
+		return errors.PreconditionFailed("A %s backup cannot be restored into this application", backup.Kind)
+	}
+
+	data, err := json.Marshal(&restoreData{BackupID: backup.ID, ApplicationID: target.ID})
+	if err != nil {
+		return err
+	}
+
+	backup.RestoreStatus = enum.BackupStatusPending
+	backup.RestoreError = ""
+	if _, err := s.backupStore.Update(ctx, backup); err != nil {
+		return err
+	}
+
+	if err := s.scheduler.RunJob(ctx, job.Definition{
+		UID:     fmt.Sprintf("backup-restore-%d-%d", backup.ID, time.Now().UnixMilli()),
+		Type:    jobTypeRestore,
+		Timeout: jobMaxDurationRestore,
+		Data:    string(data),
+	}); err != nil {
+		return fmt.Errorf("failed to queue restore: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes the backup and its dump from the blob store.
+func (s *Service) Delete(ctx context.Context, backup *types.Backup) error {
+	if err := s.blobStore.Delete(ctx, backup.BlobPath); err != nil {
+		return err
+	}
+	return s.backupStore.Delete(ctx, backup.ID)
+}
+
+func (s *Service) getManager(ctx context.Context, serverID int64) (*types.Server, manager.ServerManager, error) {
+	server, err := s.serverCtrl.FindByID(ctx, serverID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mgr, err := s.factory.GetServerManager(server)
+	if err != nil {
+		return nil, nil, err
+	}
+	return server, mgr, nil
+}
@@ -0,0 +1,122 @@
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/cloudness-io/cloudness/job"
+	baseStore "github.com/cloudness-io/cloudness/store"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+const (
+	// a new application restored into is deployed first
+	restoreDeployTimeout      = 15 * time.Minute
+	restoreDeployPollInterval = 10 * time.Second
+)
+
+type restoreRunJob struct {
+	svc *Service
+}
+
+func newRestoreRunJob(svc *Service) *restoreRunJob {
+	return &restoreRunJob{
+		svc: svc,
+	}
+}
+
+// Handle streams the dump from the blob store into the database of the target application.
+func (j *restoreRunJob) Handle(ctx context.Context, data string, _ job.ProgressReporter) (string, error) {
+	in := new(restoreData)
+	if err := json.Unmarshal([]byte(data), in); err != nil {
+		return "", fmt.Errorf("invalid restore data: %w", err)
+	}
+
+	backup, err := j.svc.backupStore.Find(ctx, in.BackupID)
+	if err != nil {
+		return "", fmt.Errorf("failed to find backup %d: %w", in.BackupID, err)
+	}
+
+	backup.RestoreStatus = enum.BackupStatusRunning
+	if backup, err = j.svc.backupStore.Update(ctx, backup); err != nil {
+		return "", err
+	}
+
+	runErr := j.run(ctx, backup, in.ApplicationID)
+
+	// the outcome is recorded even when the job timed out
+	ctx = context.WithoutCancel(ctx)
+	backup.Restored = time.Now().UnixMilli()
+	backup.RestoreStatus = enum.BackupStatusSucceeded
+	backup.RestoreError = ""
+	if runErr != nil {
+		backup.RestoreStatus = enum.BackupStatusFailed
+		backup.RestoreError = runErr.Error()
+	}
+	if _, err := j.svc.backupStore.Update(ctx, backup); err != nil {
+		return "", err
+	}
+	if runErr != nil {
+		return "", runErr
+	}
+
+	return fmt.Sprintf("restored backup %d into application %d", backup.ID, in.ApplicationID), nil
+}
+
+func (j *restoreRunJob) run(ctx context.Context, backup *types.Backup, applicationID int64) error {
+	app, err := j.waitForDeployment(ctx, applicationID)
+	if err != nil {
+		return err
+	}
+
+	restoreJob, err := newRestoreJob(app, backup)
+	if err != nil {
+		return err
+	}
+
+	server, mgr, err := j.svc.getManager(ctx, app.ServerID)
+	if err != nil {
+		return err
+	}
+
+	rc, err := j.svc.blobStore.Download(ctx, backup.BlobPath)
+	if err != nil {
+		return fmt.Errorf("failed to download dump: %w", err)
+	}
+	defer rc.Close()
+
+	return mgr.RunBackupJob(ctx, server, app, restoreJob, rc, io.Discard)
+}
+
+// waitForDeployment waits for the application to be deployed successfully.
+func (j *restoreRunJob) waitForDeployment(ctx context.Context, applicationID int64) (*types.Application, error) {
+	timeout := time.After(restoreDeployTimeout)
+	for {
+		app, err := j.svc.appStore.Find(ctx, applicationID)
+		if baseStore.IsNotFound(err) {
+			return nil, fmt.Errorf("application was deleted")
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch app.DeploymentStatus {
+		case enum.ApplicationDeploymentStatusSuccess:
+			return app, nil
+		case enum.ApplicationDeploymentStatusFailed:
+			return nil, fmt.Errorf("deployment of application %s failed", app.Name)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timeout:
+			return nil, fmt.Errorf("application %s was not deployed within %s", app.Name, restoreDeployTimeout)
+		case <-time.After(restoreDeployPollInterval):
+		}
+	}
+}
@@ -0,0 +1,171 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/cloudness-io/cloudness/job"
+	baseStore "github.com/cloudness-io/cloudness/store"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+type backupRunJob struct {
+	svc *Service
+}
+
+func newBackupRunJob(svc *Service) *backupRunJob {
+	return &backupRunJob{
+		svc: svc,
+	}
+}
+
+// Handle dumps the database of the application straight into the blob store and prunes the backups
+// beyond the retention of the policy.
+func (j *backupRunJob) Handle(ctx context.Context, data string, _ job.ProgressReporter) (string, error) {
+	id, err := strconv.ParseInt(data, 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid backup id %q: %w", data, err)
+	}
+
+	backup, err := j.svc.backupStore.Find(ctx, id)
+	if err != nil {
+		return "", fmt.Errorf("failed to find backup %d: %w", id, err)
+	}
+	if backup.Status.IsDone() {
+		return "backup already finished", nil
+	}
+
+	backup.Status = enum.BackupStatusRunning
+	backup.Started = time.Now().UnixMilli()
+	if backup, err = j.svc.backupStore.Update(ctx, backup); err != nil {
+		return "", err
+	}
+
+	size, runErr := j.run(ctx, backup)
+
+	// the outcome is recorded even when the job timed out
+	ctx = context.WithoutCancel(ctx)
+	backup.Finished = time.Now().UnixMilli()
+	if runErr != nil {
+		if err := j.svc.blobStore.Delete(ctx, backup.BlobPath); err != nil {
+			log.Ctx(ctx).Warn().Err(err).Int64("backup_id", backup.ID).Msg("backup: failed to delete partial dump")
+		}
+		backup.Status = enum.BackupStatusFailed
+		backup.Error = runErr.Error()
+	} else {
+		backup.Status = enum.BackupStatusSucceeded
+		backup.Size = size
+	}
+	if _, err := j.svc.backupStore.Update(ctx, backup); err != nil {
+		return "", err
+	}
+	if runErr != nil {
+		return "", runErr
+	}
+
+	if err := j.svc.prune(ctx, backup.ApplicationID); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Int64("application_id", backup.ApplicationID).Msg("backup: failed to prune backups")
+	}
+
+	return fmt.Sprintf("backed up %d bytes", size), nil
+}
+
+func (j *backupRunJob) run(ctx context.Context, backup *types.Backup) (int64, error) {
+	app, err := j.svc.appStore.Find(ctx, backup.ApplicationID)
+	if baseStore.IsNotFound(err) {
+		return 0, fmt.Errorf("application was deleted")
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	backupJob, err := newBackupJob(app, backup)
+	if err != nil {
+		return 0, err
+	}
+
+	server, mgr, err := j.svc.getManager(ctx, app.ServerID)
+	if err != nil {
+		return 0, err
+	}
+
+	pr, pw := io.Pipe()
+	runErr := make(chan error, 1)
+	go func() {
+		err := mgr.RunBackupJob(ctx, server, app, backupJob, nil, pw)
+		pw.CloseWithError(err)
+		runErr <- err
+	}()
+
+	counter := &countingReader{r: pr}
+	uploadErr := j.svc.blobStore.Upload(ctx, counter, backup.BlobPath)
+	// unblocks the job when the upload stopped reading early
+	pr.CloseWithError(uploadErr)
+
+	if err := <-runErr; err != nil {
+		return 0, err
+	}
+	if uploadErr != nil {
+		return 0, fmt.Errorf("failed to upload dump: %w", uploadErr)
+	}
+	if counter.n.Load() == 0 {
+		return 0, fmt.Errorf("the dump is empty")
+	}
+	return counter.n.Load(), nil
+}
+
+// prune deletes the successful backups beyond the retention of the policy, failed backups are kept
+// as long as they are newer than the oldest backup kept. Backups being restored are never deleted.
+func (s *Service) prune(ctx context.Context, applicationID int64) error {
+	policy, err := s.policyStore.Find(ctx, applicationID)
+	if baseStore.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if policy.Retention < 1 {
+		return nil
+	}
+
+	backups, err := s.backupStore.List(ctx, applicationID)
+	if err != nil {
+		return err
+	}
+
+	kept := 0
+	for _, backup := range backups {
+		if !backup.Status.IsDone() || backup.IsRestoring() {
+			continue
+		}
+		if kept < policy.Retention {
+			if backup.Status == enum.BackupStatusSucceeded {
+				kept++
+			}
+			continue
+		}
+		if err := s.Delete(ctx, backup); err != nil {
+			return fmt.Errorf("failed to delete backup %d: %w", backup.ID, err)
+		}
+	}
+	return nil
+}
+
+// countingReader counts the bytes read through it.
+type countingReader struct {
+	r io.Reader
+	n atomic.Int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n.Add(int64(n))
+	return n, err
+}
@@ -0,0 +1,78 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	specSvc "github.com/cloudness-io/cloudness/app/services/spec"
+	"github.com/cloudness-io/cloudness/job"
+	baseStore "github.com/cloudness-io/cloudness/store"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+type scheduleJob struct {
+	svc *Service
+}
+
+func newScheduleJob(svc *Service) *scheduleJob {
+	return &scheduleJob{
+		svc: svc,
+	}
+}
+
+// Handle starts the backups of the policies that are due.
+func (j *scheduleJob) Handle(ctx context.Context, _ string, _ job.ProgressReporter) (string, error) {
+	now := time.Now()
+	policies, err := j.svc.policyStore.ListDue(ctx, now.UnixMilli())
+	if err != nil {
+		return "", fmt.Errorf("failed to list due backup policies: %w", err)
+	}
+
+	total := 0
+	for _, policy := range policies {
+		started, err := j.start(ctx, policy)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Int64("application_id", policy.ApplicationID).Msg("backup: failed to start scheduled backup")
+		}
+		if started {
+			total++
+		}
+
+		// the next run moves on even when the backup could not start, a failing policy is retried on schedule
+		nextRun, err := NextRun(policy.Schedule, now)
+		if err != nil {
+			return "", fmt.Errorf("failed to compute next run of backup policy %d: %w", policy.ID, err)
+		}
+		if err := j.svc.policyStore.UpdateNextRun(ctx, policy.ID, nextRun); err != nil {
+			return "", fmt.Errorf("failed to update next run of backup policy %d: %w", policy.ID, err)
+		}
+	}
+
+	result := fmt.Sprintf("started %d scheduled backups", total)
+	if total > 0 {
+		log.Ctx(ctx).Info().Msg(result)
+	}
+	return result, nil
+}
+
+func (j *scheduleJob) start(ctx context.Context, policy *types.BackupPolicy) (bool, error) {
+	app, err := j.svc.appStore.Find(ctx, policy.ApplicationID)
+	if baseStore.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if !specSvc.SupportsBackup(app.Spec) {
+		return false, nil
+	}
+
+	if _, err := j.svc.Start(ctx, app, enum.BackupTriggerSchedule, string(enum.BackupTriggerSchedule)); err != nil {
+		return false, err
+	}
+	return true, nil
+}
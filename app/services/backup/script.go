@@ -0,0 +1,165 @@
+package backup
+
+import (
+	"fmt"
+	"strconv"
+
+	specSvc "github.com/cloudness-io/cloudness/app/services/spec"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+const (
+	// environment of the backup jobs, the variables of the application are available as well
+	envHost = "CLOUDNESS_BACKUP_HOST"
+	envPort = "CLOUDNESS_BACKUP_PORT"
+
+	// readyAttempts bounds how long a restore waits for the database, two seconds apart
+	readyAttempts = 150
+)
+
+var defaultPorts = map[enum.BackupKind]int{
+	enum.BackupKindPostgres: 5432,
+	enum.BackupKindMySQL:    3306,
+	enum.BackupKindRedis:    6379,
+	enum.BackupKindValkey:   6379,
+}
+
+// newBackupJob returns the job dumping the database of the application to stdout.
+func newBackupJob(app *types.Application, backup *types.Backup) (*types.BackupJob, error) {
+	script, err := backupScript(backup.Kind)
+	if err != nil {
+		return nil, err
+	}
+	return newJob(app, fmt.Sprintf("%s-backup-%d", app.GetIdentifierStr(), backup.UID), script), nil
+}
+
+// newRestoreJob returns the job loading the dump on stdin into the database of the application.
+func newRestoreJob(app *types.Application, backup *types.Backup) (*types.BackupJob, error) {
+	script, err := restoreScript(backup.Kind)
+	if err != nil {
+		return nil, err
+	}
+	return newJob(app, fmt.Sprintf("%s-restore-%d", app.GetIdentifierStr(), backup.UID), script), nil
+}
+
+// newJob runs the script with the image of the application, it ships the clients of the database.
+func newJob(app *types.Application, name, script string) *types.BackupJob {
+	port := defaultPorts[specSvc.GetBackupKind(app.Spec)]
+	if app.Spec.Networking != nil && len(app.Spec.Networking.ContainerPorts) > 0 {
+		port = app.Spec.Networking.ContainerPorts[0]
+	}
+
+	return &types.BackupJob{
+		Name:   name,
+		Image:  app.Spec.Build.Source.Registry.Image,
+		Script: script,
+		Env: map[string]string{
+			envHost: app.PrivateDomain,
+			envPort: strconv.Itoa(port),
+		},
+	}
+}
+
+func backupScript(kind enum.BackupKind) (string, error) {
+	switch kind {
+	case enum.BackupKindPostgres:
+		return postgresEnv + `
+exec pg_dump --host="$CLOUDNESS_BACKUP_HOST" --port="$CLOUDNESS_BACKUP_PORT" --username="$user" --no-password \
+  --format=custom "$db"
+`, nil
+	case enum.BackupKindMySQL:
+		return mysqlEnv + `
+dbs="$("$client" "$@" --batch --skip-column-names -e 'SHOW DATABASES' | grep -Ev '^(information_schema|performance_schema|mysql|sys)$' || true)"
+if [ -z "$dbs" ]; then
+  echo "no databases to back up" >&2
+  exit 1
+fi
+exec "$dump" "$@" --single-transaction --routines --triggers --events --databases $dbs
+`, nil
+	case enum.BackupKindRedis, enum.BackupKindValkey:
+		// --rdb makes the server fork a background save, as BGSAVE does, and streams the snapshot to the client
+		return redisEnv + `
+"$cli" -h "$CLOUDNESS_BACKUP_HOST" -p "$CLOUDNESS_BACKUP_PORT" --rdb /tmp/backup.rdb >&2
+exec cat /tmp/backup.rdb
+`, nil
+	default:
+		return "", fmt.Errorf("backups are not supported for %q", kind)
+	}
+}
+
+func restoreScript(kind enum.BackupKind) (string, error) {
+	switch kind {
+	case enum.BackupKindPostgres:
+		return postgresEnv +
+			waitFor(`pg_isready --host="$CLOUDNESS_BACKUP_HOST" --port="$CLOUDNESS_BACKUP_PORT" --username="$user"`) + `
+exec pg_restore --host="$CLOUDNESS_BACKUP_HOST" --port="$CLOUDNESS_BACKUP_PORT" --username="$user" --no-password \
+  --dbname="$db" --clean --if-exists --no-owner --exit-on-error
+`, nil
+	case enum.BackupKindMySQL:
+		return mysqlEnv +
+			waitFor(`"$client" "$@" -e 'SELECT 1'`) + `
+exec "$client" "$@"
+`, nil
+	case enum.BackupKindRedis, enum.BackupKindValkey:
+		// the snapshot is loaded into a local server and its keys are migrated into the running database,
+		// that way the data files of the database are never touched
+		return redisEnv + `
+server="$(command -v valkey-server || command -v redis-server)"
+cat > /tmp/restore.rdb
+"$server" --port 6390 --bind 127.0.0.1 --dir /tmp --dbfilename restore.rdb --save "" --appendonly no \
+  ${pass:+--requirepass "$pass"} --daemonize yes
+` + waitFor(`[ "$("$cli" -p 6390 PING)" = PONG ]`) +
+			waitFor(`[ "$("$cli" -h "$CLOUDNESS_BACKUP_HOST" -p "$CLOUDNESS_BACKUP_PORT" PING)" = PONG ]`) + `
+"$cli" -h "$CLOUDNESS_BACKUP_HOST" -p "$CLOUDNESS_BACKUP_PORT" FLUSHALL >&2
+for db in $("$cli" -p 6390 INFO keyspace | sed -n 's/^db\([0-9][0-9]*\):.*/\1/p'); do
+  "$cli" -p 6390 -n "$db" --scan | while IFS= read -r key; do
+    out="$("$cli" -p 6390 -n "$db" MIGRATE "$CLOUDNESS_BACKUP_HOST" "$CLOUDNESS_BACKUP_PORT" "$key" "$db" 60000 \
+      COPY REPLACE ${pass:+AUTH "$pass"} 2>&1)" || true
+    case "$out" in
+      OK|NOKEY) ;;
+      *) echo "failed to restore key $key: $out" >&2; exit 1 ;;
+    esac
+  done || exit 1
+done
+`, nil
+	default:
+		return "", fmt.Errorf("restores are not supported for %q", kind)
+	}
+}
+
+// waitFor retries the check until the database answers.
+func waitFor(check string) string {
+	return fmt.Sprintf(`i=0
+until %s >/dev/null 2>&1; do
+  i=$((i+1))
+  if [ "$i" -ge %d ]; then
+    echo "database at $CLOUDNESS_BACKUP_HOST:$CLOUDNESS_BACKUP_PORT is not ready" >&2
+    exit 1
+  fi
+  sleep 2
+done
+`, check, readyAttempts)
+}
+
+// the variables fall back to the defaults of the official images when the template ones were removed
+const postgresEnv = `set -e
+export PGPASSWORD="${PGPASSWORD:-$POSTGRES_PASSWORD}"
+user="${PGUSER:-${POSTGRES_USER:-postgres}}"
+db="${PGDATABASE:-${POSTGRES_DB:-$user}}"
+`
+
+const mysqlEnv = `set -e
+export MYSQL_PWD="${MYSQL_ROOT_PASSWORD:-${MARIADB_ROOT_PASSWORD:-$MYSQL_PASSWORD}}"
+client="$(command -v mariadb || command -v mysql)"
+dump="$(command -v mariadb-dump || command -v mysqldump)"
+set -- --host="$CLOUDNESS_BACKUP_HOST" --port="$CLOUDNESS_BACKUP_PORT" --user="${MYSQLUSER:-root}"
+`
+
+const redisEnv = `set -e
+cli="$(command -v valkey-cli || command -v redis-cli)"
+pass="${REDIS_PASSWORD:-$VALKEY_PASSWORD}"
+if [ -n "$pass" ]; then
+  export REDISCLI_AUTH="$pass" VALKEYCLI_AUTH="$pass"
+fi
+`
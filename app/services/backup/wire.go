@@ -0,0 +1,37 @@
+package backup
+
+import (
+	"github.com/cloudness-io/cloudness/app/controller/server"
+	"github.com/cloudness-io/cloudness/app/services/manager"
+	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/blob"
+	"github.com/cloudness-io/cloudness/job"
+
+	"github.com/google/wire"
+)
+
+var WireSet = wire.NewSet(
+	ProvideService,
+)
+
+func ProvideService(
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	backupStore store.BackupStore,
+	policyStore store.BackupPolicyStore,
+	appStore store.ApplicationStore,
+	blobStore blob.Store,
+	serverCtrl *server.Controller,
+	factory manager.ManagerFactory,
+) *Service {
+	return New(
+		scheduler,
+		executor,
+		backupStore,
+		policyStore,
+		appStore,
+		blobStore,
+		serverCtrl,
+		factory,
+	)
+}
@@ -55,5 +55,19 @@ func (j *deletedArtifactsJob) purgeApplicationArtifacts(ctx context.Context, app
 		return err
 	}
 
-	return manager.DeleteApplication(ctx, server, app)
+	if err := manager.DeleteApplication(ctx, server, app); err != nil {
+		return err
+	}
+
+	// backup rows go with the application, their dumps have to be removed from the blob store
+	backups, err := j.backupStore.List(ctx, app.ID)
+	if err != nil {
+		return err
+	}
+	for _, backup := range backups {
+		if err := j.blobStore.Delete(ctx, backup.BlobPath); err != nil {
+			return err
+		}
+	}
+	return nil
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/cloudness-io/cloudness/app/services/manager"
 	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/blob"
 	"github.com/cloudness-io/cloudness/job"
 )
 
@@ -15,13 +16,16 @@ type Service struct {
 	executor  *job.Executor
 
 	//stores
-	serverStore  store.ServerStore
-	tenantStore  store.TenantStore
-	projectStore store.ProjectStore
-	envStore     store.EnvironmentStore
-	appStore     store.ApplicationStore
-	volumeStore  store.VolumeStore
-	tokenStore   store.TokenStore
+	serverStore   store.ServerStore
+	tenantStore   store.TenantStore
+	projectStore  store.ProjectStore
+	envStore      store.EnvironmentStore
+	appStore      store.ApplicationStore
+	volumeStore   store.VolumeStore
+	tokenStore    store.TokenStore
+	backupStore   store.BackupStore
+	snapshotStore store.VolumeSnapshotStore
+	blobStore     blob.Store
 
 	//factory
 	serverFactory manager.ManagerFactory
@@ -37,6 +41,9 @@ func New(
 	appStore store.ApplicationStore,
 	volumeStore store.VolumeStore,
 	tokenStore store.TokenStore,
+	backupStore store.BackupStore,
+	snapshotStore store.VolumeSnapshotStore,
+	blobStore blob.Store,
 	serverFactory manager.ManagerFactory,
 ) *Service {
 	return &Service{
@@ -50,6 +57,9 @@ func New(
 		appStore:      appStore,
 		volumeStore:   volumeStore,
 		tokenStore:    tokenStore,
+		backupStore:   backupStore,
+		snapshotStore: snapshotStore,
+		blobStore:     blobStore,
 		serverFactory: serverFactory,
 	}
 }
@@ -77,6 +87,9 @@ func (s *Service) registerJobHandlers() error {
 			s.envStore,
 			s.appStore,
 			s.volumeStore,
+			s.backupStore,
+			s.snapshotStore,
+			s.blobStore,
 			s.serverFactory,
 		),
 	); err != nil {
@@ -7,6 +7,7 @@ import (
 
 	"github.com/cloudness-io/cloudness/app/services/manager"
 	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/blob"
 	"github.com/cloudness-io/cloudness/job"
 
 	"github.com/rs/zerolog/log"
@@ -20,12 +21,15 @@ const (
 )
 
 type deletedArtifactsJob struct {
-	serverStore  store.ServerStore
-	tenantStore  store.TenantStore
-	projectStore store.ProjectStore
-	envStore     store.EnvironmentStore
-	appStore     store.ApplicationStore
-	volumeStore  store.VolumeStore
+	serverStore   store.ServerStore
+	tenantStore   store.TenantStore
+	projectStore  store.ProjectStore
+	envStore      store.EnvironmentStore
+	appStore      store.ApplicationStore
+	volumeStore   store.VolumeStore
+	backupStore   store.BackupStore
+	snapshotStore store.VolumeSnapshotStore
+	blobStore     blob.Store
 
 	serverFactory manager.ManagerFactory
 }
@@ -37,6 +41,9 @@ func newDeletedArtifactsJob(
 	envStore store.EnvironmentStore,
 	appStore store.ApplicationStore,
 	volumeStore store.VolumeStore,
+	backupStore store.BackupStore,
+	snapshotStore store.VolumeSnapshotStore,
+	blobStore blob.Store,
 	serverFactory manager.ManagerFactory,
 ) *deletedArtifactsJob {
 	return &deletedArtifactsJob{
@@ -46,6 +53,9 @@ func newDeletedArtifactsJob(
 		envStore:      envStore,
 		appStore:      appStore,
 		volumeStore:   volumeStore,
+		backupStore:   backupStore,
+		snapshotStore: snapshotStore,
+		blobStore:     blobStore,
 		serverFactory: serverFactory,
 	}
 }
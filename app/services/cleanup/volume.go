@@ -55,5 +55,15 @@ func (j *deletedArtifactsJob) purgeVolumeArtifact(ctx context.Context, volume *t
 		return err
 	}
 
+	snapshots, err := j.snapshotStore.List(ctx, volume.ID)
+	if err != nil {
+		return err
+	}
+	for _, snapshot := range snapshots {
+		if err := manager.DeleteVolumeSnapshot(ctx, server, snapshot); err != nil {
+			return fmt.Errorf("failed to delete volume snapshot %s: %w", snapshot.Name, err)
+		}
+	}
+
 	return manager.DeleteVolume(ctx, server, volume)
 }
@@ -3,6 +3,7 @@ package cleanup
 import (
 	"github.com/cloudness-io/cloudness/app/services/manager"
 	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/blob"
 	"github.com/cloudness-io/cloudness/job"
 
 	"github.com/google/wire"
@@ -22,6 +23,9 @@ func ProvideService(
 	appStore store.ApplicationStore,
 	volumeStore store.VolumeStore,
 	tokenStore store.TokenStore,
+	backupStore store.BackupStore,
+	snapshotStore store.VolumeSnapshotStore,
+	blobStore blob.Store,
 	serverFactory manager.ManagerFactory,
 ) *Service {
 	return New(
@@ -34,6 +38,9 @@ func ProvideService(
 		appStore,
 		volumeStore,
 		tokenStore,
+		backupStore,
+		snapshotStore,
+		blobStore,
 		serverFactory,
 	)
 }
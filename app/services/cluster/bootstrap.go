@@ -0,0 +1,75 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cloudness-io/cloudness/job"
+	baseStore "github.com/cloudness-io/cloudness/store"
+	"github.com/cloudness-io/cloudness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+type bootstrapJob struct {
+	svc *Service
+}
+
+func newBootstrapJob(svc *Service) *bootstrapJob {
+	return &bootstrapJob{
+		svc: svc,
+	}
+}
+
+// Handle applies the bootstrap manifests to the cluster of the server, failures are recorded as
+// the health of the server until the next check.
+func (j *bootstrapJob) Handle(ctx context.Context, data string, _ job.ProgressReporter) (string, error) {
+	id, err := strconv.ParseInt(data, 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid server id %q: %w", data, err)
+	}
+
+	server, err := j.svc.serverStore.Find(ctx, id)
+	if baseStore.IsNotFound(err) {
+		return "server was deleted", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to find server %d: %w", id, err)
+	}
+
+	mgr, err := j.svc.factory.GetServerManager(server)
+	if err != nil {
+		return "", err
+	}
+
+	bootstrapErr := mgr.Bootstrap(ctx, server)
+
+	// the outcome is recorded even when the job timed out
+	ctx = context.WithoutCancel(ctx)
+	if bootstrapErr != nil {
+		server.HealthStatus = enum.ServerHealthStatusDegraded
+		server.HealthMessage = fmt.Sprintf("Bootstrap failed: %s", bootstrapErr)
+		server.HealthChecked = time.Now().UTC().UnixMilli()
+		if err := j.svc.serverStore.UpdateHealth(ctx, server); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("cluster: failed to record bootstrap failure")
+		}
+		return "", fmt.Errorf("failed to bootstrap server %s: %w", server.Name, bootstrapErr)
+	}
+
+	// servers added before the snapshot CRDs were installed pick up the default class now
+	if server.VolumeSnapshotClass == "" {
+		if class := DetectVolumeSnapshotClass(ctx, server, mgr); class != "" {
+			server.VolumeSnapshotClass = class
+			if server, err = j.svc.serverStore.Update(ctx, server); err != nil {
+				return "", fmt.Errorf("failed to store snapshot class of server %d: %w", id, err)
+			}
+		}
+	}
+
+	if err := j.svc.CheckHealth(ctx, server); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Str("server", server.Name).Msg("cluster: failed to check server health")
+	}
+	return fmt.Sprintf("bootstrapped server %s", server.Name), nil
+}
@@ -0,0 +1,119 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cloudness-io/cloudness/app/services/manager"
+	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/job"
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	jobTypeHealth        = "cloudness:cluster:health"
+	jobCronHealth        = "*/1 * * * *" // every minute
+	jobMaxDurationHealth = 2 * time.Minute
+
+	jobTypeBootstrap        = "cloudness:cluster:bootstrap"
+	jobMaxDurationBootstrap = 15 * time.Minute
+)
+
+// Service checks the health of the clusters of all servers and bootstraps new clusters.
+type Service struct {
+	//job related
+	scheduler *job.Scheduler
+	executor  *job.Executor
+
+	//stores
+	serverStore store.ServerStore
+
+	//factory
+	factory manager.ManagerFactory
+}
+
+func New(
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	serverStore store.ServerStore,
+	factory manager.ManagerFactory,
+) *Service {
+	return &Service{
+		scheduler:   scheduler,
+		executor:    executor,
+		serverStore: serverStore,
+		factory:     factory,
+	}
+}
+
+func (s *Service) Register(ctx context.Context) error {
+	if err := s.executor.Register(jobTypeHealth, newHealthJob(s)); err != nil {
+		return fmt.Errorf("failed to register job handler for cluster health: %w", err)
+	}
+	if err := s.executor.Register(jobTypeBootstrap, newBootstrapJob(s)); err != nil {
+		return fmt.Errorf("failed to register job handler for cluster bootstrap: %w", err)
+	}
+
+	if err := s.scheduler.AddRecurring(
+		ctx,
+		jobTypeHealth,
+		jobTypeHealth,
+		jobCronHealth,
+		jobMaxDurationHealth,
+	); err != nil {
+		return fmt.Errorf("failed to schedule cluster health job: %w", err)
+	}
+
+	return nil
+}
+
+// Bootstrap queues the bootstrap of the cluster of the server, the health is checked once it is done.
+func (s *Service) Bootstrap(ctx context.Context, server *types.Server) error {
+	if err := s.scheduler.RunJob(ctx, job.Definition{
+		UID:     fmt.Sprintf("cluster-bootstrap-%d-%d", server.ID, time.Now().UnixMilli()),
+		Type:    jobTypeBootstrap,
+		Timeout: jobMaxDurationBootstrap,
+		Data:    strconv.FormatInt(server.ID, 10),
+	}); err != nil {
+		return fmt.Errorf("failed to queue cluster bootstrap: %w", err)
+	}
+	return nil
+}
+
+// CheckHealth checks the health of the server and stores the result.
+func (s *Service) CheckHealth(ctx context.Context, server *types.Server) error {
+	mgr, err := s.factory.GetServerManager(server)
+	if err != nil {
+		return err
+	}
+
+	health, err := mgr.CheckHealth(ctx, server)
+	if err != nil {
+		return err
+	}
+
+	server.HealthStatus = health.Status
+	server.HealthMessage = health.Message
+	server.HealthChecked = time.Now().UTC().UnixMilli()
+	return s.serverStore.UpdateHealth(ctx, server)
+}
+
+// DetectVolumeSnapshotClass returns the default VolumeSnapshotClass of the server, snapshots stay
+// disabled when the server has none.
+func DetectVolumeSnapshotClass(ctx context.Context, server *types.Server, mgr manager.ServerManager) string {
+	classes, err := mgr.ListVolumeSnapshotClasses(ctx, server)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Str("server", server.Name).Msg("Unable to list volume snapshot classes, skipping....")
+		return ""
+	}
+	for _, class := range classes {
+		if class.IsDefault {
+			return class.Name
+		}
+	}
+	return ""
+}
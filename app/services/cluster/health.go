@@ -0,0 +1,42 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudness-io/cloudness/job"
+	"github.com/cloudness-io/cloudness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+type healthJob struct {
+	svc *Service
+}
+
+func newHealthJob(svc *Service) *healthJob {
+	return &healthJob{
+		svc: svc,
+	}
+}
+
+// Handle checks the health of every server, a failing server does not stop the checks of the others.
+func (j *healthJob) Handle(ctx context.Context, _ string, _ job.ProgressReporter) (string, error) {
+	servers, err := j.svc.serverStore.List(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to list servers: %w", err)
+	}
+
+	healthy := 0
+	for _, server := range servers {
+		if err := j.svc.CheckHealth(ctx, server); err != nil {
+			log.Ctx(ctx).Warn().Err(err).Str("server", server.Name).Msg("cluster: failed to check server health")
+			continue
+		}
+		if server.HealthStatus == enum.ServerHealthStatusHealthy {
+			healthy++
+		}
+	}
+
+	return fmt.Sprintf("%d of %d servers healthy", healthy, len(servers)), nil
+}
@@ -0,0 +1,27 @@
+package cluster
+
+import (
+	"github.com/cloudness-io/cloudness/app/services/manager"
+	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/job"
+
+	"github.com/google/wire"
+)
+
+var WireSet = wire.NewSet(
+	ProvideService,
+)
+
+func ProvideService(
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	serverStore store.ServerStore,
+	factory manager.ManagerFactory,
+) *Service {
+	return New(
+		scheduler,
+		executor,
+		serverStore,
+		factory,
+	)
+}
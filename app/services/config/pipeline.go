@@ -1,6 +1,12 @@
 package config
 
-import "context"
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/app/services/manager/docker"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+)
 
 func (s *Service) Pipeline(ctx context.Context) (*PipelineConfig, error) {
 	instance, err := s.instanceStore.Get(ctx)
@@ -18,3 +24,19 @@ func (s *Service) Pipeline(ctx context.Context) (*PipelineConfig, error) {
 	}
 	return c, nil
 }
+
+// ServerPipeline returns the pipeline config of the deployments of the server. Docker servers build
+// against the registry of the host, the registry mirror only runs in the primary cluster.
+func (s *Service) ServerPipeline(ctx context.Context, server *types.Server) (*PipelineConfig, error) {
+	c, err := s.Pipeline(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if server.Type == enum.ServerTypeDocker {
+		c.PushRegistryURL = docker.RegistryPushURL
+		c.MirrorRegistryEnabled = false
+		c.MirrorRegistryURL = ""
+	}
+	return c, nil
+}
@@ -0,0 +1,66 @@
+package gitconnection
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/errors"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/drone/go-scm/scm/driver/bitbucket"
+	"github.com/drone/go-scm/scm/driver/gitea"
+	"github.com/drone/go-scm/scm/driver/gitlab"
+	"github.com/drone/go-scm/scm/transport"
+)
+
+const bitbucketApiUrl = "https://api.bitbucket.org"
+
+// resolve decrypts the access token of the connection.
+func (s *Service) resolve(conn *types.GitConnection) error {
+	if conn.Token != "" {
+		return nil
+	}
+	token, err := s.encrypter.Decrypt(conn.Secret)
+	if err != nil {
+		return err
+	}
+	conn.Token = token
+	return nil
+}
+
+func (s *Service) getScmClient(conn *types.GitConnection) (*scm.Client, error) {
+	if err := s.resolve(conn); err != nil {
+		return nil, err
+	}
+
+	var (
+		client *scm.Client
+		auth   http.RoundTripper
+		err    error
+	)
+	switch conn.Provider {
+	case enum.GitLabProvider:
+		client, err = gitlab.New(conn.ServerURL)
+		auth = &transport.PrivateToken{Token: conn.Token}
+	case enum.GiteaProvider:
+		client, err = gitea.New(conn.ServerURL)
+		auth = &transport.Authorization{Scheme: "token", Credentials: conn.Token}
+	case enum.BitbucketProvider:
+		client, err = bitbucket.New(bitbucketApiUrl)
+		if conn.Username != "" {
+			// app passwords authenticate with the account username
+			auth = &transport.BasicAuth{Username: conn.Username, Password: conn.Token}
+		} else {
+			auth = &transport.BearerToken{Token: conn.Token}
+		}
+	default:
+		return nil, errors.BadRequest("Scm client not configured for git provider %s", conn.Provider)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	client.Client = &http.Client{Transport: auth}
+	return client, nil
+}
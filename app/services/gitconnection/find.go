@@ -0,0 +1,34 @@
+package gitconnection
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudness-io/cloudness/store"
+	"github.com/cloudness-io/cloudness/types"
+)
+
+func (s *Service) Find(ctx context.Context, tenantID, projectID, id int64) (*types.GitConnection, error) {
+	conn, err := s.gitConnectionStore.Find(ctx, tenantID, projectID, id)
+	if err != nil && !errors.Is(err, store.ErrResourceNotFound) {
+		return nil, err
+	}
+	return conn, nil
+}
+
+func (s *Service) FindByUID(ctx context.Context, tenantID, projectID, uid int64) (*types.GitConnection, error) {
+	conn, err := s.gitConnectionStore.FindByUID(ctx, tenantID, projectID, uid)
+	if err != nil && !errors.Is(err, store.ErrResourceNotFound) {
+		return nil, err
+	}
+	return conn, nil
+}
+
+// FindByID finds the git connection by id in any tenant, nil is returned when it was deleted.
+func (s *Service) FindByID(ctx context.Context, id int64) (*types.GitConnection, error) {
+	conn, err := s.gitConnectionStore.FindByID(ctx, id)
+	if err != nil && !errors.Is(err, store.ErrResourceNotFound) {
+		return nil, err
+	}
+	return conn, nil
+}
@@ -0,0 +1,159 @@
+package gitconnection
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/errors"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/check"
+
+	"github.com/drone/go-scm/scm"
+)
+
+const (
+	listPageSize = 100
+	// listMaxPages bounds the repositories and branches fetched for the dropdowns.
+	listMaxPages = 10
+)
+
+func (s *Service) ListRepos(ctx context.Context, conn *types.GitConnection) ([]*types.GitRepo, error) {
+	client, err := s.getScmClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	repos := []*types.GitRepo{}
+	opts := scm.ListOptions{Page: 1, Size: listPageSize}
+	for range listMaxPages {
+		scmRepos, response, err := client.Repositories.List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, repo := range scmRepos {
+			repos = append(repos, &types.GitRepo{
+				Name:     repo.Name,
+				FullName: repo.Namespace + "/" + repo.Name,
+			})
+		}
+
+		if response == nil || response.Page.Next == 0 {
+			break
+		}
+		opts.Page = response.Page.Next
+	}
+
+	return repos, nil
+}
+
+func (s *Service) ListBranches(ctx context.Context, conn *types.GitConnection, repo string) ([]*types.GitBranch, error) {
+	client, err := s.getScmClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	branches := []*types.GitBranch{}
+	opts := scm.ListOptions{Page: 1, Size: listPageSize}
+	for range listMaxPages {
+		refs, response, err := client.Git.ListBranches(ctx, repo, opts)
+		if err != nil {
+			if errors.Is(err, scm.ErrNotFound) {
+				return nil, check.NewValidationErrorsKey("repo", "Git repository not found")
+			}
+			return nil, err
+		}
+
+		for _, ref := range refs {
+			branches = append(branches, &types.GitBranch{Name: ref.Name})
+		}
+
+		if response == nil || response.Page.Next == 0 {
+			break
+		}
+		opts.Page = response.Page.Next
+	}
+
+	return branches, nil
+}
+
+func (s *Service) GetLatestCommit(ctx context.Context, conn *types.GitConnection, repoURL string, branch string) (*types.GitCommit, error) {
+	return s.findCommit(ctx, conn, repoURL, branch)
+}
+
+func (s *Service) GetCommitBySha(ctx context.Context, conn *types.GitConnection, repoURL string, sha string) (*types.GitCommit, error) {
+	return s.findCommit(ctx, conn, repoURL, sha)
+}
+
+func (s *Service) findCommit(ctx context.Context, conn *types.GitConnection, repoURL string, ref string) (*types.GitCommit, error) {
+	repo := conn.RepoFullName(repoURL)
+	if repo == "" {
+		return nil, errors.BadRequest("Repository %s does not belong to the git connection", repoURL)
+	}
+
+	client, err := s.getScmClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	commit, _, err := client.Git.FindCommit(ctx, repo, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GitCommit{
+		Sha:     commit.Sha,
+		Message: commit.Message,
+	}, nil
+}
+
+// GetFileContent returns the content of the file at the ref, errors.NotFound is returned when the file does not exist.
+func (s *Service) GetFileContent(ctx context.Context, conn *types.GitConnection, repoURL string, ref string, path string) ([]byte, error) {
+	repo := conn.RepoFullName(repoURL)
+	if repo == "" {
+		return nil, errors.BadRequest("Repository %s does not belong to the git connection", repoURL)
+	}
+
+	client, err := s.getScmClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	content, response, err := client.Contents.Find(ctx, repo, path, ref)
+	if err != nil {
+		if errors.Is(err, scm.ErrNotFound) || (response != nil && response.Status == http.StatusNotFound) {
+			return nil, errors.NotFound("File %s not found", path)
+		}
+		return nil, err
+	}
+	return content.Data, nil
+}
+
+// ListFiles returns the paths of the files in the directory at the ref, sub directories are not listed.
+func (s *Service) ListFiles(ctx context.Context, conn *types.GitConnection, repoURL string, ref string, dir string) ([]string, error) {
+	repo := conn.RepoFullName(repoURL)
+	if repo == "" {
+		return nil, errors.BadRequest("Repository %s does not belong to the git connection", repoURL)
+	}
+
+	client, err := s.getScmClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	contents, response, err := client.Contents.List(ctx, repo, dir, ref, scm.ListOptions{})
+	if err != nil {
+		if errors.Is(err, scm.ErrNotFound) || (response != nil && response.Status == http.StatusNotFound) {
+			return nil, errors.NotFound("Directory %s not found", dir)
+		}
+		return nil, err
+	}
+
+	files := make([]string, 0, len(contents))
+	for _, content := range contents {
+		if content.Kind == scm.ContentKindFile {
+			files = append(files, content.Path)
+		}
+	}
+	return files, nil
+}
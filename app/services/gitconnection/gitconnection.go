@@ -0,0 +1,38 @@
+package gitconnection
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/encrypt"
+	"github.com/cloudness-io/cloudness/types"
+)
+
+type Service struct {
+	gitConnectionStore store.GitConnectionStore
+	encrypter          encrypt.Encrypter
+}
+
+func New(
+	gitConnectionStore store.GitConnectionStore,
+	encrypter encrypt.Encrypter,
+) *Service {
+	return &Service{
+		gitConnectionStore: gitConnectionStore,
+		encrypter:          encrypter,
+	}
+}
+
+// Create saves the git connection, the access token is encrypted at rest.
+func (s *Service) Create(ctx context.Context, conn *types.GitConnection, token string) (*types.GitConnection, error) {
+	secret, err := s.encrypter.Encrypt(token)
+	if err != nil {
+		return nil, err
+	}
+	conn.Secret = secret
+	return s.gitConnectionStore.Create(ctx, conn)
+}
+
+func (s *Service) Delete(ctx context.Context, conn *types.GitConnection) error {
+	return s.gitConnectionStore.Delete(ctx, conn.TenantID, conn.ID)
+}
@@ -0,0 +1,44 @@
+package gitconnection
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudness-io/cloudness/store"
+	"github.com/cloudness-io/cloudness/types"
+)
+
+func (s *Service) List(ctx context.Context, tenantID, projectID int64) ([]*types.GitConnection, error) {
+	conns, err := s.gitConnectionStore.List(ctx, tenantID, projectID)
+	if err != nil && !errors.Is(err, store.ErrResourceNotFound) {
+		return nil, err
+	}
+	return conns, nil
+}
+
+// ListByUID lists the git connections sharing the UID across tenants, used to resolve incoming webhooks.
+func (s *Service) ListByUID(ctx context.Context, uid int64) ([]*types.GitConnection, error) {
+	conns, err := s.gitConnectionStore.ListByUID(ctx, uid)
+	if err != nil && !errors.Is(err, store.ErrResourceNotFound) {
+		return nil, err
+	}
+	return conns, nil
+}
+
+// ListByTenant lists the git connections of every project of the tenant.
+func (s *Service) ListByTenant(ctx context.Context, tenantID int64) ([]*types.GitConnection, error) {
+	conns, err := s.gitConnectionStore.ListByTenant(ctx, tenantID)
+	if err != nil && !errors.Is(err, store.ErrResourceNotFound) {
+		return nil, err
+	}
+	return conns, nil
+}
+
+// ListAll lists the git connections of every tenant.
+func (s *Service) ListAll(ctx context.Context) ([]*types.GitConnection, error) {
+	conns, err := s.gitConnectionStore.ListAll(ctx)
+	if err != nil && !errors.Is(err, store.ErrResourceNotFound) {
+		return nil, err
+	}
+	return conns, nil
+}
@@ -0,0 +1,32 @@
+package gitconnection
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/errors"
+	"github.com/cloudness-io/cloudness/types"
+)
+
+func (s *Service) Netrc(ctx context.Context, tenantID, projectID, connID int64, repoURL string) (*types.Netrc, error) {
+	conn, err := s.Find(ctx, tenantID, projectID, connID)
+	if err != nil {
+		return nil, err
+	}
+	if conn == nil {
+		return nil, errors.NotFound("Git connection not found")
+	}
+	if err := s.resolve(conn); err != nil {
+		return nil, err
+	}
+
+	netrc := &types.Netrc{
+		Login:    conn.NetrcLogin(),
+		Password: conn.Token,
+	}
+
+	if err := netrc.SetMachine(repoURL); err != nil {
+		return nil, err
+	}
+
+	return netrc, nil
+}
@@ -0,0 +1,72 @@
+package gitconnection
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+
+	"github.com/drone/go-scm/scm"
+)
+
+const (
+	// bitbucket signs payloads with the webhook secret in this header, as github does.
+	bitbucketSignatureHeader = "X-Hub-Signature"
+	signaturePrefix          = "sha256="
+	maxWebhookBytes          = 10000000
+)
+
+// ParseWebhook parses the webhook request sent by the provider of the connection and
+// verifies it against the connection webhook secret.
+func (s *Service) ParseWebhook(conn *types.GitConnection, r *http.Request) (scm.Webhook, error) {
+	client, err := s.getScmClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	// go-scm only compares a secret passed in the query for bitbucket, the signature is verified here instead
+	if conn.Provider == enum.BitbucketProvider {
+		if err := verifySignature(r, conn.WebhookSecret); err != nil {
+			return nil, err
+		}
+		return client.Webhooks.Parse(r, func(scm.Webhook) (string, error) {
+			return "", nil
+		})
+	}
+
+	return client.Webhooks.Parse(r, func(scm.Webhook) (string, error) {
+		return conn.WebhookSecret, nil
+	})
+}
+
+// verifySignature checks the sha256 hmac of the request body against the signature header,
+// the body is restored for parsing.
+func verifySignature(r *http.Request, secret string) error {
+	signature, ok := strings.CutPrefix(r.Header.Get(bitbucketSignatureHeader), signaturePrefix)
+	if !ok || secret == "" {
+		return scm.ErrSignatureInvalid
+	}
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return scm.ErrSignatureInvalid
+	}
+
+	payload, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookBytes))
+	if err != nil {
+		return err
+	}
+	r.Body = io.NopCloser(bytes.NewReader(payload))
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	if !hmac.Equal(mac.Sum(nil), expected) {
+		return scm.ErrSignatureInvalid
+	}
+	return nil
+}
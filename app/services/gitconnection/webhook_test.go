@@ -0,0 +1,46 @@
+package gitconnection
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestVerifySignature(t *testing.T) {
+	payload := []byte(`{"push":{}}`)
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write(payload)
+	valid := signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+
+	tests := []struct {
+		name      string
+		secret    string
+		signature string
+		wantErr   bool
+	}{
+		{name: "valid", secret: "secret", signature: valid},
+		{name: "missing", secret: "secret", signature: "", wantErr: true},
+		{name: "wrong secret", secret: "other", signature: valid, wantErr: true},
+		{name: "no secret", secret: "", signature: valid, wantErr: true},
+		{name: "missing prefix", secret: "secret", signature: valid[len(signaturePrefix):], wantErr: true},
+		{name: "malformed", secret: "secret", signature: signaturePrefix + "zz", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("POST", "/webhooks/git/1/events", bytes.NewReader(payload))
+			r.Header.Set(bitbucketSignatureHeader, tt.signature)
+
+			err := verifySignature(r, tt.secret)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, want error %t", err, tt.wantErr)
+			}
+			if body, _ := io.ReadAll(r.Body); !bytes.Equal(body, payload) && !tt.wantErr {
+				t.Errorf("body was not restored: %s", body)
+			}
+		})
+	}
+}
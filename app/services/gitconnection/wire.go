@@ -0,0 +1,16 @@
+package gitconnection
+
+import (
+	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/encrypt"
+
+	"github.com/google/wire"
+)
+
+var WireSet = wire.NewSet(
+	ProvideService,
+)
+
+func ProvideService(gitConnectionStore store.GitConnectionStore, encrypter encrypt.Encrypter) *Service {
+	return New(gitConnectionStore, encrypter)
+}
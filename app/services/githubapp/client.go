@@ -76,3 +76,8 @@ func (s *Service) loadPrivateKey(ctx context.Context, ghApp *types.GithubApp) (*
 	}
 	return jwt.ParseRSAPrivateKeyFromPEM(keyBytes)
 }
+
+// AccessToken returns an installation access token of the github app.
+func (s *Service) AccessToken(ctx context.Context, ghApp *types.GithubApp) (string, error) {
+	return s.generateAccessToken(ctx, ghApp)
+}
@@ -0,0 +1,22 @@
+package githubapp
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/google/go-github/v69/github"
+)
+
+// CreatePullRequestComment posts a comment on the pull request through the github app installation.
+func (c *Service) CreatePullRequestComment(ctx context.Context, ghApp *types.GithubApp, owner, repo string, number int, body string) error {
+	ghClient, err := c.getGithubClient(ctx, ghApp)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = ghClient.Issues.CreateComment(ctx, owner, repo, number, &github.IssueComment{
+		Body: github.Ptr(body),
+	})
+	return err
+}
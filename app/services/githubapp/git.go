@@ -2,7 +2,9 @@ package githubapp
 
 import (
 	"context"
+	"net/http"
 
+	"github.com/cloudness-io/cloudness/errors"
 	"github.com/cloudness-io/cloudness/helpers"
 	"github.com/cloudness-io/cloudness/types"
 
@@ -67,3 +69,33 @@ func (c *Service) GetCommitBySha(ctx context.Context, ghApp *types.GithubApp, re
 	commit, _, err := ghClient.Repositories.GetCommit(ctx, owner, repo, sha, &github.ListOptions{})
 	return commit, err
 }
+
+// GetFileContent returns the content of the file at the ref, errors.NotFound is returned when the file does not exist.
+func (c *Service) GetFileContent(ctx context.Context, ghApp *types.GithubApp, repoURL string, ref string, path string) ([]byte, error) {
+	owner, repo, err := helpers.SplitGitRepoUrl(repoURL)
+	if err != nil {
+		return nil, err
+	}
+
+	ghClient, err := c.getGithubClient(ctx, ghApp)
+	if err != nil {
+		return nil, err
+	}
+
+	file, _, response, err := ghClient.Repositories.GetContents(ctx, owner, repo, path, &github.RepositoryContentGetOptions{Ref: ref})
+	if err != nil {
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			return nil, errors.NotFound("File %s not found", path)
+		}
+		return nil, err
+	}
+	if file == nil {
+		return nil, errors.BadRequest("%s is a directory", path)
+	}
+
+	content, err := file.GetContent()
+	if err != nil {
+		return nil, err
+	}
+	return []byte(content), nil
+}
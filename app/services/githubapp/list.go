@@ -15,3 +15,12 @@ func (s *Service) List(ctx context.Context, tenantID, projectID int64) ([]*types
 	}
 	return ghApps, nil
 }
+
+// ListByUID lists the github apps sharing the UID across tenants, used to resolve incoming webhooks.
+func (s *Service) ListByUID(ctx context.Context, githubAppUID int64) ([]*types.GithubApp, error) {
+	ghApps, err := s.githubAppStore.ListByUID(ctx, githubAppUID)
+	if err != nil && !errors.Is(err, store.ErrResourceNotFound) {
+		return nil, err
+	}
+	return ghApps, nil
+}
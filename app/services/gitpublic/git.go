@@ -2,6 +2,7 @@ package gitpublic
 
 import (
 	"context"
+	"net/http"
 	"strings"
 
 	"github.com/cloudness-io/cloudness/errors"
@@ -11,12 +12,17 @@ import (
 
 	"github.com/drone/go-scm/scm"
 	"github.com/drone/go-scm/scm/driver/github"
+	"github.com/drone/go-scm/scm/transport"
 )
 
 func (c *Service) getScmClient(repoURL string) (*scm.Client, error) {
 	switch true {
 	case strings.HasPrefix(repoURL, "https://github.com"):
-		return github.NewDefault(), nil
+		client := github.NewDefault()
+		if c.token != "" {
+			client.Client = &http.Client{Transport: &transport.BearerToken{Token: c.token}}
+		}
+		return client, nil
 		// case strings.HasPrefix(repoURL, "https://gitlab.com"):
 		// 	return gitlab.NewDefault(), nil
 		// case strings.HasPrefix(repoURL, "https://bitbucket.org"):
@@ -129,3 +135,54 @@ func (c *Service) GetCommitBySha(ctx context.Context, repoURL string, sha string
 		Message: commit.Message,
 	}, nil
 }
+
+// GetFileContent returns the content of the file at the ref, errors.NotFound is returned when the file does not exist.
+func (c *Service) GetFileContent(ctx context.Context, repoURL string, ref string, path string) ([]byte, error) {
+	owner, repo, err := helpers.SplitGitRepoUrl(repoURL)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := c.getScmClient(repoURL)
+	if err != nil {
+		return nil, err
+	}
+
+	content, response, err := client.Contents.Find(ctx, owner+"/"+repo, path, ref)
+	if err != nil {
+		if errors.Is(err, scm.ErrNotFound) || (response != nil && response.Status == http.StatusNotFound) {
+			return nil, errors.NotFound("File %s not found", path)
+		}
+		return nil, err
+	}
+	return content.Data, nil
+}
+
+// ListFiles returns the paths of the files in the directory at the ref, sub directories are not listed.
+func (c *Service) ListFiles(ctx context.Context, repoURL string, ref string, dir string) ([]string, error) {
+	owner, repo, err := helpers.SplitGitRepoUrl(repoURL)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := c.getScmClient(repoURL)
+	if err != nil {
+		return nil, err
+	}
+
+	contents, response, err := client.Contents.List(ctx, owner+"/"+repo, dir, ref, scm.ListOptions{})
+	if err != nil {
+		if errors.Is(err, scm.ErrNotFound) || (response != nil && response.Status == http.StatusNotFound) {
+			return nil, errors.NotFound("Directory %s not found", dir)
+		}
+		return nil, err
+	}
+
+	files := make([]string, 0, len(contents))
+	for _, content := range contents {
+		if content.Kind == scm.ContentKindFile {
+			files = append(files, content.Path)
+		}
+	}
+	return files, nil
+}
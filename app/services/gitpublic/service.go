@@ -1,7 +1,15 @@
 package gitpublic
 
-type Service struct{}
+type Service struct {
+	token string
+}
 
 func NewService() *Service {
 	return &Service{}
 }
+
+// WithToken returns a service sending the token with its requests, authenticated clients are not limited
+// to the 60 requests an hour of the public api.
+func (c *Service) WithToken(token string) *Service {
+	return &Service{token: token}
+}
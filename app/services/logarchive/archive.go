@@ -0,0 +1,92 @@
+package logarchive
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/blob"
+	"github.com/cloudness-io/cloudness/job"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	jobTypeArchive        = "cloudness:logs:archive"
+	jobCronArchive        = "40 */1 * * *" // At minute 40 past every hour
+	jobMaxDurationArchive = 30 * time.Minute
+
+	// archiveBatchSize is the number of logs moved per store round trip.
+	archiveBatchSize = 100
+)
+
+type archiveJob struct {
+	logStore  store.LogStore
+	blobStore blob.Store
+
+	enabled    bool
+	archiveAge time.Duration
+}
+
+func newArchiveJob(logStore store.LogStore, blobStore blob.Store, enabled bool, archiveAge time.Duration) *archiveJob {
+	return &archiveJob{
+		logStore:   logStore,
+		blobStore:  blobStore,
+		enabled:    enabled,
+		archiveAge: archiveAge,
+	}
+}
+
+// Handle moves the logs of deployments finished before the archive age from the database to the blob store.
+func (j *archiveJob) Handle(ctx context.Context, _ string, _ job.ProgressReporter) (string, error) {
+	if !j.enabled {
+		return "log archival is disabled", nil
+	}
+
+	stoppedBefore := time.Now().Add(-j.archiveAge)
+	log.Ctx(ctx).Info().Msgf("logs: starting to archive logs of deployments stopped before %s", stoppedBefore.Format(time.RFC3339))
+
+	total := 0
+	for {
+		ids, err := j.logStore.ListDeploymentIDsStoppedBefore(ctx, stoppedBefore.UnixMilli(), archiveBatchSize)
+		if err != nil {
+			return "", fmt.Errorf("failed to list logs to archive: %w", err)
+		}
+
+		for _, id := range ids {
+			if err := j.archive(ctx, id); err != nil {
+				return "", fmt.Errorf("failed to archive log of deployment %d: %w", id, err)
+			}
+			total++
+		}
+
+		if len(ids) < archiveBatchSize {
+			break
+		}
+	}
+
+	result := "no logs to archive"
+	if total > 0 {
+		result = fmt.Sprintf("archived %d deployment logs", total)
+	}
+	log.Ctx(ctx).Info().Msg(result)
+
+	return result, nil
+}
+
+// archive uploads the log before deleting it from the database, a failed delete leaves
+// both copies around and is retried on the next run.
+func (j *archiveJob) archive(ctx context.Context, deploymentID int64) error {
+	rc, err := j.logStore.Find(ctx, deploymentID)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	if err := j.blobStore.Upload(ctx, rc, BlobPath(deploymentID)); err != nil {
+		return err
+	}
+
+	return j.logStore.Delete(ctx, deploymentID)
+}
@@ -0,0 +1,68 @@
+package logarchive
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/blob"
+	"github.com/cloudness-io/cloudness/job"
+)
+
+// BlobPath returns the path of the archived log of the deployment in the blob store.
+func BlobPath(deploymentID int64) string {
+	return fmt.Sprintf("logs/%d.json", deploymentID)
+}
+
+type Service struct {
+	//job related
+	scheduler *job.Scheduler
+	executor  *job.Executor
+
+	//stores
+	logStore  store.LogStore
+	blobStore blob.Store
+
+	enabled    bool
+	archiveAge time.Duration
+}
+
+func New(
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	logStore store.LogStore,
+	blobStore blob.Store,
+	enabled bool,
+	archiveAfterDays int,
+) *Service {
+	return &Service{
+		scheduler:  scheduler,
+		executor:   executor,
+		logStore:   logStore,
+		blobStore:  blobStore,
+		enabled:    enabled,
+		archiveAge: time.Duration(archiveAfterDays) * 24 * time.Hour,
+	}
+}
+
+func (s *Service) Register(ctx context.Context) error {
+	if err := s.executor.Register(
+		jobTypeArchive,
+		newArchiveJob(s.logStore, s.blobStore, s.enabled, s.archiveAge),
+	); err != nil {
+		return fmt.Errorf("failed to register job handler for log archival: %w", err)
+	}
+
+	if err := s.scheduler.AddRecurring(
+		ctx,
+		jobTypeArchive,
+		jobTypeArchive,
+		jobCronArchive,
+		jobMaxDurationArchive,
+	); err != nil {
+		return fmt.Errorf("failed to schedule log archival job: %w", err)
+	}
+
+	return nil
+}
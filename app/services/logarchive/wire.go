@@ -0,0 +1,31 @@
+package logarchive
+
+import (
+	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/blob"
+	"github.com/cloudness-io/cloudness/job"
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/google/wire"
+)
+
+var WireSet = wire.NewSet(
+	ProvideService,
+)
+
+func ProvideService(
+	config *types.Config,
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	logStore store.LogStore,
+	blobStore blob.Store,
+) *Service {
+	return New(
+		scheduler,
+		executor,
+		logStore,
+		blobStore,
+		config.LogArchive.Enabled,
+		config.LogArchive.AfterDays,
+	)
+}
@@ -0,0 +1,111 @@
+package docker
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/rs/zerolog/log"
+)
+
+func (m *DockerManager) ListArtifacts(ctx context.Context, server *types.Server, app *types.Application) ([]*types.Artifact, error) {
+	cli, err := m.getClient(server)
+	if err != nil {
+		return nil, err
+	}
+
+	containers, err := listSortedAppContainers(ctx, cli, app)
+	if err != nil {
+		return nil, err
+	}
+
+	artifacts := make([]*types.Artifact, len(containers))
+	for i, c := range containers {
+		artifacts[i] = &types.Artifact{
+			UID:  containerName(c),
+			Name: fmt.Sprintf("%s-%d", app.Name, i+1),
+		}
+	}
+	return artifacts, nil
+}
+
+// TailLogs follows the docker logs of the containers of the application one after the other.
+func (m *DockerManager) TailLogs(ctx context.Context, server *types.Server, app *types.Application) (<-chan *types.ArtifactLogLine, <-chan error, error) {
+	cli, err := m.getClient(server)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	containers, err := listSortedAppContainers(ctx, cli, app)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	logc := make(chan *types.ArtifactLogLine)
+	errc := make(chan error)
+
+	go func() {
+		for i, c := range containers {
+			logs, err := cli.ContainerLogs(ctx, c.ID, container.LogsOptions{
+				ShowStdout: true,
+				ShowStderr: true,
+				Follow:     true,
+			})
+			if err != nil {
+				log.Ctx(ctx).Error().Err(err).Msg("error streaming logs")
+				errc <- err
+				return
+			}
+
+			// containers run without a tty, stdout and stderr are multiplexed on the stream
+			pr, pw := io.Pipe()
+			go func() {
+				_, err := stdcopy.StdCopy(pw, pw, logs)
+				logs.Close()
+				pw.CloseWithError(err)
+			}()
+
+			scanner := bufio.NewScanner(pr)
+		scan:
+			for {
+				select {
+				case <-ctx.Done():
+					log.Debug().Msg("closing artifacts log channel")
+					pr.Close()
+					return
+				default:
+					// stream ends once the container stops, move on to the next container
+					if !scanner.Scan() {
+						break scan
+					}
+					logc <- &types.ArtifactLogLine{
+						ArtifactUID: fmt.Sprintf("%s-%d", app.Name, i),
+						Log:         scanner.Text(),
+					}
+				}
+			}
+			pr.Close()
+		}
+	}()
+
+	return logc, errc, nil
+}
+
+// listSortedAppContainers returns the containers of the application ordered by name, so replicas keep their number.
+func listSortedAppContainers(ctx context.Context, cli client.APIClient, app *types.Application) ([]container.Summary, error) {
+	containers, err := ListAppContainers(ctx, cli, app.ParentSlug, app.GetIdentifierStr())
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(containers, func(i, j int) bool {
+		return containerName(containers[i]) < containerName(containers[j])
+	})
+	return containers, nil
+}
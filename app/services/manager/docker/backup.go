@@ -0,0 +1,159 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/cloudness-io/cloudness/types"
+
+	cerrdefs "github.com/containerd/errdefs"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/rs/zerolog/log"
+)
+
+// size of the stderr tail kept for error messages
+const backupStderrLimit = 4096
+
+// RunBackupJob runs the script of the backup job in a container on the network of the application, with the
+// environment of the application container. The script only starts once the first line arrives on stdin, so
+// no output is lost before the stream is attached. The rest of stdin is streamed into the script and its
+// stdout into the writer.
+func (m *DockerManager) RunBackupJob(ctx context.Context, server *types.Server, app *types.Application, job *types.BackupJob, stdin io.Reader, stdout io.Writer) error {
+	cli, err := m.getClient(server)
+	if err != nil {
+		return err
+	}
+
+	// the variables of the application carry the credentials of the database
+	env := []string{}
+	containers, err := listSortedAppContainers(ctx, cli, app)
+	if err != nil {
+		return err
+	}
+	if len(containers) > 0 {
+		inspect, err := cli.ContainerInspect(ctx, containers[0].ID)
+		if err != nil {
+			return err
+		}
+		env = append(env, inspect.Config.Env...)
+	}
+	keys := make([]string, 0, len(job.Env))
+	for key := range job.Env {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		env = append(env, key+"="+job.Env[key])
+	}
+
+	if _, err := cli.ImageInspect(ctx, job.Image); cerrdefs.IsNotFound(err) {
+		if err := PullImage(ctx, cli, job.Image, "", io.Discard); err != nil {
+			return fmt.Errorf("failed to pull %s: %w", job.Image, err)
+		}
+	}
+
+	name := VolumeName(app.ParentSlug, job.Name)
+	created, err := cli.ContainerCreate(ctx, &container.Config{
+		Image:        job.Image,
+		Cmd:          []string{"/bin/sh", "-c", "read -r _ || exit 1\n" + job.Script},
+		Env:          env,
+		OpenStdin:    true,
+		StdinOnce:    true,
+		AttachStdin:  true,
+		AttachStdout: true,
+		AttachStderr: true,
+		Labels: map[string]string{
+			LabelManagedBy: ManagedBy,
+			LabelName:      app.GetIdentifierStr(),
+			LabelComponent: "backup",
+		},
+	}, nil, &network.NetworkingConfig{
+		EndpointsConfig: map[string]*network.EndpointSettings{NamespaceNetwork(app.ParentSlug): {}},
+	}, nil, name)
+	if err != nil {
+		return fmt.Errorf("failed to create job %s: %w", job.Name, err)
+	}
+	defer func() {
+		// the container only carries the stream, there is nothing left to inspect once it returns
+		if err := removeContainer(context.WithoutCancel(ctx), cli, created.ID); err != nil {
+			log.Ctx(ctx).Warn().Err(err).Str("job", job.Name).Msg("backup: failed to remove container")
+		}
+	}()
+
+	resp, err := cli.ContainerAttach(ctx, created.ID, container.AttachOptions{
+		Stream: true,
+		Stdin:  true,
+		Stdout: true,
+		Stderr: true,
+	})
+	if err != nil {
+		return err
+	}
+	defer resp.Close()
+
+	waitc, errc := cli.ContainerWait(ctx, created.ID, container.WaitConditionNextExit)
+	if err := cli.ContainerStart(ctx, created.ID, container.StartOptions{}); err != nil {
+		return fmt.Errorf("job %s did not start: %w", job.Name, err)
+	}
+
+	if stdin == nil {
+		stdin = strings.NewReader("")
+	}
+	go func() {
+		_, _ = io.Copy(resp.Conn, io.MultiReader(strings.NewReader("\n"), stdin))
+		_ = resp.CloseWrite()
+	}()
+
+	stderr := &tailBuffer{limit: backupStderrLimit}
+	if _, err := stdcopy.StdCopy(stdout, stderr, resp.Reader); err != nil {
+		return fmt.Errorf("job %s stream failed: %w%s", job.Name, err, stderr.detail())
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case err := <-errc:
+		return fmt.Errorf("job %s did not finish: %w%s", job.Name, err, stderr.detail())
+	case res := <-waitc:
+		if res.StatusCode != 0 {
+			return fmt.Errorf("job %s failed%s", job.Name, stderr.detail())
+		}
+	}
+	return nil
+}
+
+// tailBuffer keeps the last bytes written to it.
+type tailBuffer struct {
+	mu    sync.Mutex
+	buf   []byte
+	limit int
+}
+
+func (b *tailBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.buf = append(b.buf, p...)
+	if len(b.buf) > b.limit {
+		b.buf = b.buf[len(b.buf)-b.limit:]
+	}
+	return len(p), nil
+}
+
+// detail returns the tail as a suffix for error messages.
+func (b *tailBuffer) detail() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	msg := strings.TrimSpace(string(b.buf))
+	if msg == "" {
+		return ""
+	}
+	return ": " + msg
+}
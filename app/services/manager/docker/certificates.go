@@ -0,0 +1,108 @@
+package docker
+
+import (
+	"archive/tar"
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+
+	cerrdefs "github.com/containerd/errdefs"
+)
+
+// traefik renews certificates 30 days before they expire
+const certificateRenewBefore = 30 * 24 * time.Hour
+
+// acmeStore is the subset of the acme storage of traefik holding the certificates of a resolver.
+type acmeStore map[string]struct {
+	Certificates []struct {
+		Domain struct {
+			Main string   `json:"main"`
+			SANs []string `json:"sans"`
+		} `json:"domain"`
+		Certificate []byte `json:"certificate"`
+	} `json:"Certificates"`
+}
+
+// AddWildcardDomainWithSSL is a no-op, traefik issues the certificate of every https route with the
+// http challenge when the route is first requested.
+func (m *DockerManager) AddWildcardDomainWithSSL(ctx context.Context, server *types.Server) error {
+	return nil
+}
+
+func (m *DockerManager) RemoveWildcardSSL(ctx context.Context, server *types.Server) error {
+	return nil
+}
+
+// AddSSLCertificate is a no-op, the route of the domain carries the certificate resolver.
+func (m *DockerManager) AddSSLCertificate(ctx context.Context, server *types.Server, namespace, dns, certKey string, dnsProvider enum.DNSProvider, dnsAuthKey string) error {
+	return nil
+}
+
+func (m *DockerManager) RemoveSSLCertificate(ctx context.Context, server *types.Server, namespace, certKey string) error {
+	return nil
+}
+
+// ListCertificates returns the certificates issued by traefik, read from its acme storage.
+func (m *DockerManager) ListCertificates(ctx context.Context, server *types.Server) ([]*types.Certificate, error) {
+	cli, err := m.getClient(server)
+	if err != nil {
+		return nil, err
+	}
+
+	rc, _, err := cli.CopyFromContainer(ctx, TraefikContainer, traefikACMEPath)
+	if cerrdefs.IsNotFound(err) {
+		// nothing was issued yet
+		return []*types.Certificate{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	tr := tar.NewReader(rc)
+	if _, err := tr.Next(); err != nil {
+		if errors.Is(err, io.EOF) {
+			return []*types.Certificate{}, nil
+		}
+		return nil, err
+	}
+	raw, err := io.ReadAll(tr)
+	if err != nil {
+		return nil, err
+	}
+
+	store := acmeStore{}
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &store); err != nil {
+			return nil, err
+		}
+	}
+
+	result := []*types.Certificate{}
+	for resolver, entry := range store {
+		for _, cert := range entry.Certificates {
+			certificate := &types.Certificate{
+				Name:      cert.Domain.Main,
+				DNSNames:  append([]string{cert.Domain.Main}, cert.Domain.SANs...),
+				IssuerRef: "Resolver/" + resolver,
+				Ready:     "True",
+			}
+			if block, _ := pem.Decode(cert.Certificate); block != nil {
+				if parsed, err := x509.ParseCertificate(block.Bytes); err == nil {
+					certificate.NotBefore = parsed.NotBefore
+					certificate.NotAfter = parsed.NotAfter
+					certificate.RenewalTime = parsed.NotAfter.Add(-certificateRenewBefore)
+				}
+			}
+			result = append(result, certificate)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,137 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+
+	cerrdefs "github.com/containerd/errdefs"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/go-connections/nat"
+)
+
+const traefikDynamicVolume = "cloudness-traefik-dynamic"
+
+// GetIP returns the address of a docker host reached over tcp, servers on the local socket have no known address.
+func (m *DockerManager) GetIP(ctx context.Context, server *types.Server) (string, error) {
+	if server == nil || server.DockerHost == "" {
+		return "", nil
+	}
+	u, err := url.Parse(server.DockerHost)
+	if err != nil {
+		return "", err
+	}
+	if u.Scheme != "tcp" {
+		return "", nil
+	}
+	if ip := net.ParseIP(u.Hostname()); ip != nil && ip.To4() != nil {
+		return ip.String(), nil
+	}
+	return "", nil
+}
+
+// CheckHealth checks that the docker engine is reachable and the bootstrap containers are running.
+func (m *DockerManager) CheckHealth(ctx context.Context, server *types.Server) (*types.ServerHealth, error) {
+	cli, err := m.getClient(server)
+	if err != nil {
+		return &types.ServerHealth{Status: enum.ServerHealthStatusUnreachable, Message: err.Error()}, nil
+	}
+
+	version, err := cli.ServerVersion(ctx)
+	if err != nil {
+		return &types.ServerHealth{Status: enum.ServerHealthStatusUnreachable, Message: err.Error()}, nil
+	}
+
+	problems := []string{}
+	if _, err := cli.NetworkInspect(ctx, Network, network.InspectOptions{}); err != nil {
+		problems = append(problems, fmt.Sprintf("network %s: %s", Network, reason(err)))
+	}
+	for _, name := range []string{TraefikContainer, RegistryContainer} {
+		inspect, err := cli.ContainerInspect(ctx, name)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("container %s: %s", name, reason(err)))
+			continue
+		}
+		if inspect.State == nil || !inspect.State.Running {
+			problems = append(problems, fmt.Sprintf("container %s: not running", name))
+		}
+	}
+
+	if len(problems) > 0 {
+		return &types.ServerHealth{Status: enum.ServerHealthStatusDegraded, Message: strings.Join(problems, "; ")}, nil
+	}
+	return &types.ServerHealth{
+		Status:  enum.ServerHealthStatusHealthy,
+		Message: fmt.Sprintf("Docker %s", version.Version),
+	}, nil
+}
+
+// Bootstrap creates the cloudness network, the traefik container routing the applications and the registry
+// the build steps push to. Existing containers are left as they are.
+func (m *DockerManager) Bootstrap(ctx context.Context, server *types.Server) error {
+	cli, err := m.getClient(server)
+	if err != nil {
+		return err
+	}
+
+	if err := EnsureNetwork(ctx, cli, Network); err != nil {
+		return fmt.Errorf("failed to create network %s: %w", Network, err)
+	}
+
+	labels := map[string]string{LabelManagedBy: ManagedBy, LabelComponent: "traefik"}
+	for _, name := range []string{traefikACMEVolume, traefikDynamicVolume} {
+		if err := EnsureVolume(ctx, cli, name, labels); err != nil {
+			return fmt.Errorf("failed to create volume %s: %w", name, err)
+		}
+	}
+
+	err = ensureContainer(ctx, cli, TraefikContainer, &container.Config{
+		Image:  traefikImage,
+		Labels: labels,
+		Cmd: []string{
+			"--providers.docker=true",
+			"--providers.docker.exposedbydefault=false",
+			"--providers.file.directory=" + traefikDynamicPath,
+			"--providers.file.watch=true",
+			"--entrypoints.web.address=:80",
+			"--entrypoints.websecure.address=:443",
+			"--certificatesresolvers." + CertResolver + ".acme.httpchallenge=true",
+			"--certificatesresolvers." + CertResolver + ".acme.httpchallenge.entrypoint=web",
+			"--certificatesresolvers." + CertResolver + ".acme.storage=" + traefikACMEPath,
+		},
+		ExposedPorts: nat.PortSet{"80/tcp": {}, "443/tcp": {}},
+	}, &container.HostConfig{
+		RestartPolicy: container.RestartPolicy{Name: container.RestartPolicyUnlessStopped},
+		PortBindings: nat.PortMap{
+			"80/tcp":  {{HostPort: "80"}},
+			"443/tcp": {{HostPort: "443"}},
+		},
+		Mounts: []mount.Mount{
+			{Type: mount.TypeBind, Source: "/var/run/docker.sock", Target: "/var/run/docker.sock", ReadOnly: true},
+			{Type: mount.TypeVolume, Source: traefikACMEVolume, Target: "/letsencrypt"},
+			{Type: mount.TypeVolume, Source: traefikDynamicVolume, Target: traefikDynamicPath},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to start %s: %w", TraefikContainer, err)
+	}
+
+	if err := m.ensureRegistry(ctx, server, RegistryContainer, true); err != nil {
+		return fmt.Errorf("failed to start %s: %w", RegistryContainer, err)
+	}
+	return nil
+}
+
+func reason(err error) string {
+	if cerrdefs.IsNotFound(err) {
+		return "not found"
+	}
+	return err.Error()
+}
@@ -0,0 +1,141 @@
+package docker
+
+import (
+	"context"
+	"io"
+
+	cerrdefs "github.com/containerd/errdefs"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/image"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/api/types/volume"
+	"github.com/docker/docker/client"
+)
+
+// EnsureNetwork creates the bridge network when it does not exist.
+func EnsureNetwork(ctx context.Context, cli client.APIClient, name string) error {
+	if _, err := cli.NetworkInspect(ctx, name, network.InspectOptions{}); err == nil {
+		return nil
+	} else if !cerrdefs.IsNotFound(err) {
+		return err
+	}
+
+	_, err := cli.NetworkCreate(ctx, name, network.CreateOptions{
+		Driver: "bridge",
+		Labels: map[string]string{LabelManagedBy: ManagedBy},
+	})
+	if cerrdefs.IsConflict(err) {
+		return nil
+	}
+	return err
+}
+
+// ConnectNetwork connects the container to the network, containers already connected are skipped.
+func ConnectNetwork(ctx context.Context, cli client.APIClient, networkName, containerName string) error {
+	inspect, err := cli.ContainerInspect(ctx, containerName)
+	if err != nil {
+		return err
+	}
+	if inspect.NetworkSettings != nil {
+		if _, ok := inspect.NetworkSettings.Networks[networkName]; ok {
+			return nil
+		}
+	}
+	return cli.NetworkConnect(ctx, networkName, containerName, nil)
+}
+
+// EnsureVolume creates the named volume when it does not exist.
+func EnsureVolume(ctx context.Context, cli client.APIClient, name string, labels map[string]string) error {
+	if _, err := cli.VolumeInspect(ctx, name); err == nil {
+		return nil
+	} else if !cerrdefs.IsNotFound(err) {
+		return err
+	}
+
+	_, err := cli.VolumeCreate(ctx, volume.CreateOptions{Name: name, Labels: labels})
+	return err
+}
+
+// PullImage pulls the image, the progress of the pull is written to w.
+func PullImage(ctx context.Context, cli client.APIClient, ref, registryAuth string, w io.Writer) error {
+	rc, err := cli.ImagePull(ctx, ref, image.PullOptions{RegistryAuth: registryAuth})
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	// the pull completes once the progress stream ends
+	_, err = io.Copy(w, rc)
+	return err
+}
+
+// ensureContainer creates and starts the container when it does not exist and starts it when stopped,
+// the image is pulled when missing.
+func ensureContainer(ctx context.Context, cli client.APIClient, name string, config *container.Config, hostConfig *container.HostConfig) error {
+	inspect, err := cli.ContainerInspect(ctx, name)
+	switch {
+	case err == nil:
+		if inspect.State != nil && inspect.State.Running {
+			return nil
+		}
+		return cli.ContainerStart(ctx, name, container.StartOptions{})
+	case !cerrdefs.IsNotFound(err):
+		return err
+	}
+
+	if _, err := cli.ImageInspect(ctx, config.Image); cerrdefs.IsNotFound(err) {
+		if err := PullImage(ctx, cli, config.Image, "", io.Discard); err != nil {
+			return err
+		}
+	}
+
+	networking := &network.NetworkingConfig{
+		EndpointsConfig: map[string]*network.EndpointSettings{
+			Network: {Aliases: []string{name}},
+		},
+	}
+	if _, err := cli.ContainerCreate(ctx, config, hostConfig, networking, nil, name); err != nil {
+		return err
+	}
+	return cli.ContainerStart(ctx, name, container.StartOptions{})
+}
+
+// removeContainer force removes the container, containers that do not exist are skipped.
+func removeContainer(ctx context.Context, cli client.APIClient, name string) error {
+	err := cli.ContainerRemove(ctx, name, container.RemoveOptions{Force: true})
+	if err != nil && !cerrdefs.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// listContainers returns the containers, running or not, carrying all the labels.
+func listContainers(ctx context.Context, cli client.APIClient, labels map[string]string) ([]container.Summary, error) {
+	args := filters.NewArgs()
+	for key, value := range labels {
+		args.Add("label", key+"="+value)
+	}
+	return cli.ContainerList(ctx, container.ListOptions{All: true, Filters: args})
+}
+
+// ListAppContainers returns the containers of the application instance in the namespace.
+func ListAppContainers(ctx context.Context, cli client.APIClient, namespace, identifier string) ([]container.Summary, error) {
+	return listContainers(ctx, cli, map[string]string{
+		LabelManagedBy: ManagedBy,
+		LabelNamespace: namespace,
+		LabelInstance:  identifier,
+	})
+}
+
+// containerName returns the name of the container without the leading slash of the engine.
+func containerName(c container.Summary) string {
+	if len(c.Names) == 0 {
+		return c.ID
+	}
+	name := c.Names[0]
+	if len(name) > 0 && name[0] == '/' {
+		return name[1:]
+	}
+	return name
+}
@@ -0,0 +1,48 @@
+package docker
+
+const (
+	// Network is the network of the traefik and registry containers and of the runner steps,
+	// applications join the network of their environment.
+	Network = "cloudness"
+
+	TraefikContainer   = "cloudness-traefik"
+	traefikImage       = "traefik:v3.3"
+	traefikDynamicPath = "/etc/traefik/dynamic"
+	traefikACMEPath    = "/letsencrypt/acme.json"
+	traefikACMEVolume  = "cloudness-traefik-letsencrypt"
+	// CertResolver is the traefik certificate resolver issuing the certificates of https routes
+	CertResolver = "letsencrypt"
+
+	RegistryContainer = "cloudness-registry"
+	registryImage     = "registry:2.8.3"
+	// RegistryPushURL is the address the build steps push images to over the cloudness network,
+	// the engine pulls them from the published port on localhost.
+	RegistryPushURL  = RegistryContainer + ":5000"
+	registryHostPort = "30050"
+
+	// container labels
+	LabelManagedBy   = "cloudness.io/managed-by"
+	LabelNamespace   = "cloudness.io/namespace"
+	LabelInstance    = "cloudness.io/instance"
+	LabelName        = "cloudness.io/name"
+	LabelApplication = "cloudness.io/application-uid"
+	LabelProject     = "cloudness.io/project-id"
+	LabelUpdatedAt   = "cloudness.io/deployment-time"
+	LabelComponent   = "cloudness.io/component"
+	LabelVolume      = "cloudness.io/volume"
+	LabelSize        = "cloudness.io/size"
+
+	ManagedBy    = "cloudness"
+	ComponentApp = "app"
+)
+
+// NamespaceNetwork returns the network of the applications of a namespace, the private domain
+// of an application resolves on the network of its environment only.
+func NamespaceNetwork(namespace string) string {
+	return "cloudness-" + namespace
+}
+
+// VolumeName returns the name of the docker volume of a volume, volumes are scoped by namespace.
+func VolumeName(namespace, identifier string) string {
+	return namespace + "-" + identifier
+}
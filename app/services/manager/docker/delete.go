@@ -0,0 +1,90 @@
+package docker
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/types"
+
+	cerrdefs "github.com/containerd/errdefs"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/client"
+)
+
+func (m *DockerManager) DeleteResources(ctx context.Context, server *types.Server, namespace string, identifier string) error {
+	cli, err := m.getClient(server)
+	if err != nil {
+		return err
+	}
+
+	containers, err := ListAppContainers(ctx, cli, namespace, identifier)
+	if err != nil {
+		return err
+	}
+	for _, c := range containers {
+		if err := removeContainer(ctx, cli, c.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteNamespace removes the network of the namespace, containers still attached to it, like traefik,
+// are disconnected first.
+func (m *DockerManager) DeleteNamespace(ctx context.Context, server *types.Server, namespace string) error {
+	cli, err := m.getClient(server)
+	if err != nil {
+		return err
+	}
+
+	containers, err := listContainers(ctx, cli, map[string]string{LabelManagedBy: ManagedBy, LabelNamespace: namespace})
+	if err != nil {
+		return err
+	}
+	for _, c := range containers {
+		if err := removeContainer(ctx, cli, c.ID); err != nil {
+			return err
+		}
+	}
+
+	name := NamespaceNetwork(namespace)
+	inspect, err := cli.NetworkInspect(ctx, name, network.InspectOptions{})
+	if cerrdefs.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for id := range inspect.Containers {
+		if err := cli.NetworkDisconnect(ctx, name, id, true); err != nil && !cerrdefs.IsNotFound(err) {
+			return err
+		}
+	}
+
+	err = cli.NetworkRemove(ctx, name)
+	if err != nil && !cerrdefs.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func (m *DockerManager) DeleteVolume(ctx context.Context, server *types.Server, volume *types.Volume) error {
+	cli, err := m.getClient(server)
+	if err != nil {
+		return err
+	}
+
+	return removeVolume(ctx, cli, VolumeName(volume.ParentSlug, volume.GetIdentifierStr()))
+}
+
+// DeleteApplication removes the containers of the application, traefik drops the routes with their labels.
+func (m *DockerManager) DeleteApplication(ctx context.Context, server *types.Server, app *types.Application) error {
+	return m.DeleteResources(ctx, server, app.ParentSlug, app.GetIdentifierStr())
+}
+
+func removeVolume(ctx context.Context, cli client.APIClient, name string) error {
+	err := cli.VolumeRemove(ctx, name, true)
+	if err != nil && !cerrdefs.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package docker
+
+import (
+	"context"
+	"io"
+
+	"github.com/cloudness-io/cloudness/errors"
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/docker/docker/api/types/container"
+)
+
+// appContainer is the name of the container of the exec targets, applications run a single container per replica.
+const appContainer = "app"
+
+// execShell starts bash when the image ships it, falling back to sh.
+var execShell = []string{"/bin/sh", "-c", "if command -v bash >/dev/null 2>&1; then exec bash; else exec sh; fi"}
+
+func (m *DockerManager) ListExecTargets(ctx context.Context, server *types.Server, app *types.Application) ([]*types.ExecTarget, error) {
+	cli, err := m.getClient(server)
+	if err != nil {
+		return nil, err
+	}
+
+	containers, err := listSortedAppContainers(ctx, cli, app)
+	if err != nil {
+		return nil, err
+	}
+
+	targets := []*types.ExecTarget{}
+	for _, c := range containers {
+		if c.State != container.StateRunning {
+			continue
+		}
+		targets = append(targets, &types.ExecTarget{
+			Pod:       containerName(c),
+			Container: appContainer,
+			Ready:     true,
+		})
+	}
+	return targets, nil
+}
+
+// Exec opens an interactive shell into the container, the container must belong to the application.
+func (m *DockerManager) Exec(ctx context.Context, server *types.Server, app *types.Application, target *types.ExecTarget, streams *types.ExecStreams) error {
+	cli, err := m.getClient(server)
+	if err != nil {
+		return err
+	}
+
+	inspect, err := cli.ContainerInspect(ctx, target.Pod)
+	if err != nil {
+		return err
+	}
+	if inspect.Config == nil ||
+		inspect.Config.Labels[LabelNamespace] != app.ParentSlug ||
+		inspect.Config.Labels[LabelInstance] != app.GetIdentifierStr() {
+		return errors.NotFound("Container %s not found", target.Pod)
+	}
+	if inspect.State == nil || !inspect.State.Running {
+		return errors.PreconditionFailed("Container %s is not running", target.Pod)
+	}
+
+	exec, err := cli.ContainerExecCreate(ctx, inspect.ID, container.ExecOptions{
+		Cmd:          execShell,
+		Tty:          true,
+		AttachStdin:  true,
+		AttachStdout: true,
+		AttachStderr: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	resp, err := cli.ContainerExecAttach(ctx, exec.ID, container.ExecAttachOptions{Tty: true})
+	if err != nil {
+		return err
+	}
+	defer resp.Close()
+
+	go func() {
+		for size := range streams.Resize {
+			_ = cli.ContainerExecResize(ctx, exec.ID, container.ResizeOptions{
+				Height: uint(size.Rows),
+				Width:  uint(size.Cols),
+			})
+		}
+	}()
+
+	go func() {
+		// the shell sees the end of its input once the session stdin is done
+		_, _ = io.Copy(resp.Conn, streams.Stdin)
+		_ = resp.CloseWrite()
+	}()
+
+	outc := make(chan error, 1)
+	go func() {
+		_, err := io.Copy(streams.Stdout, resp.Reader)
+		outc <- err
+	}()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case err := <-outc:
+		return err
+	}
+}
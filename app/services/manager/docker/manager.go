@@ -0,0 +1,71 @@
+package docker
+
+import (
+	"sync"
+
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/docker/docker/client"
+)
+
+type DockerManager struct {
+	// clients caches the engine clients of servers, by server id.
+	clientsMu sync.Mutex
+	clients   map[int64]*serverClient
+	// local is the engine client of the agent, created on first use.
+	local *client.Client
+}
+
+func NewDockerManager() *DockerManager {
+	return &DockerManager{
+		clients: map[int64]*serverClient{},
+	}
+}
+
+// serverClient is the engine client created from the docker host of a server at its last update.
+type serverClient struct {
+	updated int64
+	client  *client.Client
+}
+
+// getClient returns the engine client of the server, servers without a docker host and the agent,
+// which passes no server, use the environment of the process, usually the local socket. The
+// clients are kept for the life of the manager.
+func (m *DockerManager) getClient(server *types.Server) (*client.Client, error) {
+	m.clientsMu.Lock()
+	defer m.clientsMu.Unlock()
+
+	if server == nil {
+		if m.local == nil {
+			cli, err := NewClient("")
+			if err != nil {
+				return nil, err
+			}
+			m.local = cli
+		}
+		return m.local, nil
+	}
+
+	if cached, ok := m.clients[server.ID]; ok && cached.updated == server.Updated {
+		return cached.client, nil
+	}
+
+	cli, err := NewClient(server.DockerHost)
+	if err != nil {
+		return nil, err
+	}
+	if cached, ok := m.clients[server.ID]; ok {
+		cached.client.Close()
+	}
+	m.clients[server.ID] = &serverClient{updated: server.Updated, client: cli}
+	return cli, nil
+}
+
+// NewClient returns a client of the docker engine at host, the environment is used when host is empty.
+func NewClient(host string) (*client.Client, error) {
+	opts := []client.Opt{client.FromEnv, client.WithAPIVersionNegotiation()}
+	if host != "" {
+		opts = append(opts, client.WithHost(host))
+	}
+	return client.NewClientWithOpts(opts...)
+}
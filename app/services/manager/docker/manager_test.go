@@ -0,0 +1,18 @@
+package docker
+
+import "testing"
+
+func TestGetClientReusesLocalClient(t *testing.T) {
+	m := NewDockerManager()
+	first, err := m.getClient(nil)
+	if err != nil {
+		t.Fatalf("client: %v", err)
+	}
+	second, err := m.getClient(nil)
+	if err != nil {
+		t.Fatalf("client: %v", err)
+	}
+	if first != second {
+		t.Error("expected the local client to be reused")
+	}
+}
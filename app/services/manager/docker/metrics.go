@@ -0,0 +1,94 @@
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
+	"github.com/rs/zerolog/log"
+)
+
+// ListMetrics returns the usage of the running application containers from docker stats.
+func (m *DockerManager) ListMetrics(ctx context.Context, server *types.Server) ([]*types.AppMetrics, error) {
+	cli, err := m.getClient(server)
+	if err != nil {
+		return nil, err
+	}
+
+	containers, err := listContainers(ctx, cli, map[string]string{LabelManagedBy: ManagedBy, LabelComponent: ComponentApp})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	metrics := make([]*types.AppMetrics, 0)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, c := range containers {
+		appUID, _ := strconv.ParseInt(c.Labels[LabelApplication], 10, 64)
+		if appUID == 0 || c.State != container.StateRunning {
+			continue
+		}
+		// every sample waits for the next cpu reading of the engine, containers are sampled together
+		wg.Go(func() {
+			cpu, mem, err := containerUsage(ctx, cli, c.ID)
+			if err != nil {
+				log.Ctx(ctx).Debug().Err(err).Str("container", containerName(c)).Msg("docker: failed to read container stats")
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			metrics = append(metrics, &types.AppMetrics{
+				Timestamp:      now,
+				ApplicationUID: appUID,
+				InstanceName:   containerName(c),
+				CPU:            cpu,
+				Memory:         mem,
+			})
+		})
+	}
+	wg.Wait()
+
+	return metrics, nil
+}
+
+// containerUsage returns the cpu usage in millicores and the memory usage without the page cache in bytes.
+func containerUsage(ctx context.Context, cli client.APIClient, id string) (int64, int64, error) {
+	resp, err := cli.ContainerStats(ctx, id, false)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer resp.Body.Close()
+
+	stats := container.StatsResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(&stats); err != nil {
+		return 0, 0, err
+	}
+
+	var cpu int64
+	cpuDelta := float64(stats.CPUStats.CPUUsage.TotalUsage) - float64(stats.PreCPUStats.CPUUsage.TotalUsage)
+	systemDelta := float64(stats.CPUStats.SystemUsage) - float64(stats.PreCPUStats.SystemUsage)
+	if cpuDelta > 0 && systemDelta > 0 {
+		cpus := float64(stats.CPUStats.OnlineCPUs)
+		if cpus == 0 {
+			cpus = float64(len(stats.CPUStats.CPUUsage.PercpuUsage))
+		}
+		cpu = int64(cpuDelta / systemDelta * cpus * 1000)
+	}
+
+	mem := int64(stats.MemoryStats.Usage)
+	// cgroup v2 reports the page cache as inactive_file, v1 as total_inactive_file
+	for _, key := range []string{"inactive_file", "total_inactive_file"} {
+		if cache, ok := stats.MemoryStats.Stats[key]; ok && int64(cache) < mem {
+			mem -= int64(cache)
+			break
+		}
+	}
+	return cpu, mem, nil
+}
@@ -0,0 +1,71 @@
+package docker
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/go-connections/nat"
+)
+
+// AddOrUpdateRegistry runs a registry container on the cloudness network, docker volumes are not sized so
+// the size is ignored. The node port publishes the registry on localhost for the engine to pull from.
+func (m *DockerManager) AddOrUpdateRegistry(ctx context.Context, server *types.Server, name string, size int64, enableNodePort bool) error {
+	return m.ensureRegistry(ctx, server, registryContainerName(name), enableNodePort)
+}
+
+func (m *DockerManager) RemoveRegistry(ctx context.Context, server *types.Server, name string) error {
+	cli, err := m.getClient(server)
+	if err != nil {
+		return err
+	}
+
+	name = registryContainerName(name)
+	if err := removeContainer(ctx, cli, name); err != nil {
+		return err
+	}
+	return removeVolume(ctx, cli, name+"-data")
+}
+
+func (m *DockerManager) ensureRegistry(ctx context.Context, server *types.Server, name string, enableNodePort bool) error {
+	cli, err := m.getClient(server)
+	if err != nil {
+		return err
+	}
+
+	if err := EnsureNetwork(ctx, cli, Network); err != nil {
+		return err
+	}
+
+	labels := map[string]string{LabelManagedBy: ManagedBy, LabelComponent: "registry"}
+	volume := name + "-data"
+	if err := EnsureVolume(ctx, cli, volume, labels); err != nil {
+		return err
+	}
+
+	hostConfig := &container.HostConfig{
+		RestartPolicy: container.RestartPolicy{Name: container.RestartPolicyUnlessStopped},
+		Mounts: []mount.Mount{
+			{Type: mount.TypeVolume, Source: volume, Target: "/var/lib/registry"},
+		},
+	}
+	if enableNodePort {
+		// localhost is trusted as an insecure registry by the engine
+		hostConfig.PortBindings = nat.PortMap{
+			"5000/tcp": {{HostIP: "127.0.0.1", HostPort: registryHostPort}},
+		}
+	}
+
+	return ensureContainer(ctx, cli, name, &container.Config{
+		Image:        registryImage,
+		Labels:       labels,
+		ExposedPorts: nat.PortSet{"5000/tcp": {}},
+	}, hostConfig)
+}
+
+// registryContainerName returns the container of the registry, named like the bootstrap registry.
+func registryContainerName(name string) string {
+	return "cloudness-" + name
+}
@@ -0,0 +1,108 @@
+package docker
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
+)
+
+// routeConfig is the dynamic configuration of traefik routing a host to a container of a namespace network.
+const routeConfig = `http:
+  routers:
+    %[1]s:
+      rule: "Host(%[2]s)"
+      entryPoints:
+        - %[3]s
+      service: %[1]s
+%[4]s  services:
+    %[1]s:
+      loadBalancer:
+        servers:
+          - url: "http://%[5]s:%[6]d"
+`
+
+// AddHttpRoute writes a file provider configuration into the traefik container, traefik joins the network
+// of the namespace to reach the service.
+func (m *DockerManager) AddHttpRoute(ctx context.Context, server *types.Server, namespace, key, service string, port int32, host string, httpScheme string) error {
+	cli, err := m.getClient(server)
+	if err != nil {
+		return err
+	}
+
+	networkName := NamespaceNetwork(namespace)
+	if err := EnsureNetwork(ctx, cli, networkName); err != nil {
+		return err
+	}
+	if err := ConnectNetwork(ctx, cli, networkName, TraefikContainer); err != nil {
+		return err
+	}
+
+	entryPoint, tls := "web", ""
+	if httpScheme == "https" {
+		entryPoint = "websecure"
+		tls = fmt.Sprintf("      tls:\n        certResolver: %s\n", CertResolver)
+	}
+	content := fmt.Sprintf(routeConfig, key, "`"+host+"`", entryPoint, tls, service, port)
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	if err := tw.WriteHeader(&tar.Header{Name: routeFileName(key), Mode: 0o644, Size: int64(len(content))}); err != nil {
+		return err
+	}
+	if _, err := tw.Write([]byte(content)); err != nil {
+		return err
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+
+	return cli.CopyToContainer(ctx, TraefikContainer, traefikDynamicPath, &buf, container.CopyToContainerOptions{})
+}
+
+func (m *DockerManager) RemoveHttpRoute(ctx context.Context, server *types.Server, namespace, key string) error {
+	cli, err := m.getClient(server)
+	if err != nil {
+		return err
+	}
+
+	return execCommand(ctx, cli, TraefikContainer, []string{"rm", "-f", path.Join(traefikDynamicPath, routeFileName(key))})
+}
+
+func routeFileName(key string) string {
+	return key + ".yml"
+}
+
+// execCommand runs the command in the container and waits for it to exit successfully.
+func execCommand(ctx context.Context, cli client.APIClient, containerID string, cmd []string) error {
+	exec, err := cli.ContainerExecCreate(ctx, containerID, container.ExecOptions{Cmd: cmd})
+	if err != nil {
+		return err
+	}
+	resp, err := cli.ContainerExecAttach(ctx, exec.ID, container.ExecAttachOptions{})
+	if err != nil {
+		return err
+	}
+	defer resp.Close()
+
+	// the attach stream ends once the command exits
+	var out bytes.Buffer
+	if _, err := out.ReadFrom(resp.Reader); err != nil {
+		return err
+	}
+
+	inspect, err := cli.ContainerExecInspect(ctx, exec.ID)
+	if err != nil {
+		return err
+	}
+	if inspect.ExitCode != 0 {
+		return fmt.Errorf("%s exited with code %d: %s", cmd[0], inspect.ExitCode, bytes.TrimSpace(out.Bytes()))
+	}
+	return nil
+}
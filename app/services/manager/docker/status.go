@@ -0,0 +1,112 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+
+	"github.com/docker/docker/api/types/container"
+)
+
+// ListApplicationStatuses returns the status of every application from the state of its containers,
+// containers of a deployment younger than ten seconds are skipped while they start.
+func (m *DockerManager) ListApplicationStatuses(ctx context.Context, server *types.Server) ([]*types.AppStatus, error) {
+	cli, err := m.getClient(server)
+	if err != nil {
+		return nil, err
+	}
+
+	containers, err := listContainers(ctx, cli, map[string]string{LabelManagedBy: ManagedBy, LabelComponent: ComponentApp})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	nowMilli := now.UnixMilli()
+	statusByApp := make(map[int64]*types.AppStatus)
+
+	for _, c := range containers {
+		appUID, _ := strconv.ParseInt(c.Labels[LabelApplication], 10, 64)
+		projectID, _ := strconv.ParseInt(c.Labels[LabelProject], 10, 64)
+		updatedAt, _ := strconv.ParseInt(c.Labels[LabelUpdatedAt], 10, 64)
+
+		if appUID == 0 {
+			continue
+		}
+		if updatedAt != 0 && updatedAt > (nowMilli-10_000) {
+			continue
+		}
+
+		status, reason := evaluateContainerStatus(c)
+		mergeAppStatus(statusByApp, &types.AppStatus{
+			Timestamp:      now,
+			ApplicationUID: appUID,
+			ProjectID:      projectID,
+			InstanceName:   c.Labels[LabelName],
+			Status:         status,
+			Reason:         reason,
+		})
+	}
+
+	statuses := make([]*types.AppStatus, 0, len(statusByApp))
+	for _, s := range statusByApp {
+		statuses = append(statuses, s)
+	}
+	return statuses, nil
+}
+
+func evaluateContainerStatus(c container.Summary) (enum.ApplicationStatus, string) {
+	switch c.State {
+	case container.StateRunning:
+		// the status of running containers carries the result of the health check
+		if strings.Contains(c.Status, "(unhealthy)") {
+			return enum.ApplicationStatusError, "container unhealthy"
+		}
+		return enum.ApplicationStatusRunning, ""
+	case container.StateCreated:
+		return enum.ApplicationStatusPaused, "container created"
+	case container.StatePaused:
+		return enum.ApplicationStatusPaused, "container paused"
+	case container.StateRestarting:
+		return enum.ApplicationStatusError, firstNonEmpty(c.Status, "container restarting")
+	default:
+		return enum.ApplicationStatusError, firstNonEmpty(c.Status, fmt.Sprintf("container %s", c.State))
+	}
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+func mergeAppStatus(statusByApp map[int64]*types.AppStatus, candidate *types.AppStatus) {
+	current, found := statusByApp[candidate.ApplicationUID]
+	if !found || isHigherPriorityStatus(candidate.Status, current.Status) {
+		statusByApp[candidate.ApplicationUID] = candidate
+		return
+	}
+
+	if current.Status == candidate.Status && current.Reason == "" && candidate.Reason != "" {
+		current.Reason = candidate.Reason
+	}
+}
+
+func isHigherPriorityStatus(candidate enum.ApplicationStatus, current enum.ApplicationStatus) bool {
+	priority := map[enum.ApplicationStatus]int{
+		enum.ApplicationStatusError:    3,
+		enum.ApplicationStatusPaused:   2,
+		enum.ApplicationStatusRunning:  1,
+		enum.ApplicationStatusSleeping: 0,
+	}
+
+	return priority[candidate] > priority[current]
+}
@@ -0,0 +1,51 @@
+package docker
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/app/usererror"
+	"github.com/cloudness-io/cloudness/types"
+)
+
+// errNotSupported is returned for features that need kubernetes.
+var errNotSupported = usererror.BadRequest("Not supported on docker servers")
+
+// ListVolumeSnapshotClasses returns no classes, so snapshots stay disabled for the server.
+func (m *DockerManager) ListVolumeSnapshotClasses(ctx context.Context, server *types.Server) ([]*types.VolumeSnapshotClass, error) {
+	return []*types.VolumeSnapshotClass{}, nil
+}
+
+func (m *DockerManager) CreateVolumeSnapshot(ctx context.Context, server *types.Server, volume *types.Volume, snapshot *types.VolumeSnapshot) error {
+	return errNotSupported
+}
+
+func (m *DockerManager) GetVolumeSnapshotState(ctx context.Context, server *types.Server, snapshot *types.VolumeSnapshot) (*types.VolumeSnapshotState, error) {
+	return nil, errNotSupported
+}
+
+func (m *DockerManager) DeleteVolumeSnapshot(ctx context.Context, server *types.Server, snapshot *types.VolumeSnapshot) error {
+	return errNotSupported
+}
+
+func (m *DockerManager) RestoreVolumeSnapshot(ctx context.Context, server *types.Server, snapshot *types.VolumeSnapshot, volume *types.Volume) error {
+	return errNotSupported
+}
+
+// GetAutoscalingStatus returns nil, applications run a fixed number of replicas.
+func (m *DockerManager) GetAutoscalingStatus(ctx context.Context, server *types.Server, app *types.Application) (*types.AutoscalingStatus, error) {
+	return nil, nil
+}
+
+// IdleApplications idles nothing, applications do not sleep on docker servers.
+func (m *DockerManager) IdleApplications(ctx context.Context, server *types.Server) (int, error) {
+	return 0, nil
+}
+
+func (m *DockerManager) WakeApplication(ctx context.Context, server *types.Server, namespace, name, token string) (string, error) {
+	return "", errNotSupported
+}
+
+// ListCronRuns returns no runs, scheduled jobs are not deployed to docker servers.
+func (m *DockerManager) ListCronRuns(ctx context.Context, server *types.Server, app *types.Application) ([]*types.CronRun, error) {
+	return []*types.CronRun{}, nil
+}
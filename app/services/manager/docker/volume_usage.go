@@ -0,0 +1,44 @@
+package docker
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cloudness-io/cloudness/types"
+
+	dockertypes "github.com/docker/docker/api/types"
+)
+
+// ListVolumeUsage returns the disk usage of the application volumes. Docker volumes are not sized, the
+// capacity is the size requested for the volume.
+func (m *DockerManager) ListVolumeUsage(ctx context.Context, server *types.Server) ([]*types.VolumeUsage, error) {
+	cli, err := m.getClient(server)
+	if err != nil {
+		return nil, err
+	}
+
+	usage, err := cli.DiskUsage(ctx, dockertypes.DiskUsageOptions{Types: []dockertypes.DiskUsageObject{dockertypes.VolumeObject}})
+	if err != nil {
+		return nil, err
+	}
+
+	usages := make([]*types.VolumeUsage, 0)
+	for _, v := range usage.Volumes {
+		if v == nil || v.Labels[LabelManagedBy] != ManagedBy || v.Labels[LabelVolume] == "" {
+			continue
+		}
+		// the engine reports -1 while the size is being computed
+		if v.UsageData == nil || v.UsageData.Size < 0 {
+			continue
+		}
+		capacity, _ := strconv.ParseInt(v.Labels[LabelSize], 10, 64)
+
+		usages = append(usages, &types.VolumeUsage{
+			Namespace:     v.Labels[LabelNamespace],
+			Name:          v.Labels[LabelVolume],
+			UsedBytes:     v.UsageData.Size,
+			CapacityBytes: capacity,
+		})
+	}
+	return usages, nil
+}
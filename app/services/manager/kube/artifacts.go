@@ -62,17 +62,20 @@ func (m *K8sManager) TailLogs(ctx context.Context, server *types.Server, app *ty
 			defer podLogs.Close()
 
 			scanner := bufio.NewScanner(podLogs)
+		scan:
 			for {
 				select {
 				case <-ctx.Done():
 					log.Debug().Msg("closing artifacts log channel")
 					return
 				default:
-					if scanner.Scan() {
-						logc <- &types.ArtifactLogLine{
-							ArtifactUID: fmt.Sprintf("%s-%d", app.Name, i),
-							Log:         scanner.Text(),
-						}
+					// stream ends once the pod terminates (e.g. a completed cron run), move on to the next pod
+					if !scanner.Scan() {
+						break scan
+					}
+					logc <- &types.ArtifactLogLine{
+						ArtifactUID: fmt.Sprintf("%s-%d", app.Name, i),
+						Log:         scanner.Text(),
 					}
 				}
 			}
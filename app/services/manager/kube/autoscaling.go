@@ -0,0 +1,81 @@
+package kube
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/cloudness-io/cloudness/types"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const maxAutoscalingEvents = 20
+
+// GetAutoscalingStatus returns nil when the application has no autoscaler, e.g. stateful or cron apps.
+func (m *K8sManager) GetAutoscalingStatus(ctx context.Context, server *types.Server, app *types.Application) (*types.AutoscalingStatus, error) {
+	client, err := m.getInterface(ctx, server)
+	if err != nil {
+		return nil, err
+	}
+
+	name := hpaName(app.GetIdentifierStr())
+	hpa, err := client.AutoscalingV2().HorizontalPodAutoscalers(app.ParentSlug).Get(ctx, name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	status := &types.AutoscalingStatus{
+		MaxReplicas:     hpa.Spec.MaxReplicas,
+		CurrentReplicas: hpa.Status.CurrentReplicas,
+		DesiredReplicas: hpa.Status.DesiredReplicas,
+		Events:          make([]*types.AutoscalingEvent, 0),
+	}
+	if hpa.Spec.MinReplicas != nil {
+		status.MinReplicas = *hpa.Spec.MinReplicas
+	}
+	if hpa.Status.LastScaleTime != nil {
+		status.LastScaleTime = hpa.Status.LastScaleTime.UnixMilli()
+	}
+
+	events, err := client.CoreV1().Events(app.ParentSlug).List(ctx, metav1.ListOptions{
+		FieldSelector: fmt.Sprintf("involvedObject.kind=HorizontalPodAutoscaler,involvedObject.name=%s", name),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, event := range events.Items {
+		status.Events = append(status.Events, &types.AutoscalingEvent{
+			Type:    event.Type,
+			Reason:  event.Reason,
+			Message: event.Message,
+			Count:   event.Count,
+			Time:    eventTime(&event).UnixMilli(),
+		})
+	}
+	sort.Slice(status.Events, func(i, j int) bool {
+		return status.Events[i].Time > status.Events[j].Time
+	})
+	if len(status.Events) > maxAutoscalingEvents {
+		status.Events = status.Events[:maxAutoscalingEvents]
+	}
+
+	return status, nil
+}
+
+func eventTime(event *corev1.Event) metav1.Time {
+	switch {
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp
+	case !event.EventTime.IsZero():
+		return metav1.NewTime(event.EventTime.Time)
+	default:
+		return event.CreationTimestamp
+	}
+}
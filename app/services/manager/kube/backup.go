@@ -0,0 +1,259 @@
+package kube
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/rs/zerolog/log"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+const (
+	backupContainerName = "backup"
+
+	backupPodTimeout      = 5 * time.Minute
+	backupFinishTimeout   = time.Minute
+	backupPollInterval    = 2 * time.Second
+	backupDeadlineSeconds = int64(6 * 60 * 60)
+
+	// size of the stderr tail kept for error messages
+	backupStderrLimit = 4096
+)
+
+// RunBackupJob runs the script of the backup job as a kubernetes job in the namespace of the application,
+// with the variables of the application in its environment. The script only starts once the first line
+// arrives on stdin, so no output is lost before the stream is attached. The rest of stdin is streamed into
+// the script and its stdout into the writer.
+func (m *K8sManager) RunBackupJob(ctx context.Context, server *types.Server, app *types.Application, job *types.BackupJob, stdin io.Reader, stdout io.Writer) error {
+	config, err := m.getClientConfig(ctx, server)
+	if err != nil {
+		return err
+	}
+
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	namespace := app.ParentSlug
+	pullSecret := ""
+	if _, err := client.CoreV1().Secrets(namespace).Get(ctx, app.GetIdentifierStr()+"-registry", metav1.GetOptions{}); err == nil {
+		pullSecret = app.GetIdentifierStr() + "-registry"
+	}
+
+	created, err := client.BatchV1().Jobs(namespace).Create(ctx, toBackupJob(app, job, pullSecret), metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to create job %s: %w", job.Name, err)
+	}
+	defer func() {
+		// the job only carries the stream, there is nothing left to inspect once it returns
+		propagation := metav1.DeletePropagationBackground
+		if err := client.BatchV1().Jobs(namespace).Delete(context.WithoutCancel(ctx), created.Name, metav1.DeleteOptions{
+			PropagationPolicy: &propagation,
+		}); err != nil && !errors.IsNotFound(err) {
+			log.Ctx(ctx).Warn().Err(err).Str("job", created.Name).Msg("backup: failed to delete job")
+		}
+	}()
+
+	pod, err := waitForBackupPod(ctx, client, namespace, created.Name)
+	if err != nil {
+		return err
+	}
+
+	req := client.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(pod.Namespace).
+		Name(pod.Name).
+		SubResource("attach").
+		VersionedParams(&corev1.PodAttachOptions{
+			Container: backupContainerName,
+			Stdin:     true,
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+
+	exec, err := newStreamExecutor(config, req)
+	if err != nil {
+		return err
+	}
+
+	if stdin == nil {
+		stdin = strings.NewReader("")
+	}
+	stderr := &tailBuffer{limit: backupStderrLimit}
+	if err := exec.StreamWithContext(ctx, remotecommand.StreamOptions{
+		Stdin:  io.MultiReader(strings.NewReader("\n"), stdin),
+		Stdout: stdout,
+		Stderr: stderr,
+	}); err != nil {
+		return fmt.Errorf("job %s stream failed: %w%s", created.Name, err, stderr.detail())
+	}
+
+	return waitForBackupJob(ctx, client, namespace, created.Name, stderr)
+}
+
+func toBackupJob(app *types.Application, job *types.BackupJob, pullSecret string) *batchv1.Job {
+	labels := map[string]string{
+		"app.kubernetes.io/name":       app.GetIdentifierStr(),
+		"app.kubernetes.io/component":  "backup",
+		"app.kubernetes.io/managed-by": "cloudness",
+	}
+
+	env := make([]corev1.EnvVar, 0, len(job.Env))
+	for key, value := range job.Env {
+		env = append(env, corev1.EnvVar{Name: key, Value: value})
+	}
+	sort.Slice(env, func(i, j int) bool {
+		return env[i].Name < env[j].Name
+	})
+
+	optional := true
+	ref := corev1.LocalObjectReference{Name: app.GetIdentifierStr()}
+	backoffLimit := int32(0)
+	deadline := backupDeadlineSeconds
+	automount := false
+
+	spec := corev1.PodSpec{
+		RestartPolicy:                corev1.RestartPolicyNever,
+		AutomountServiceAccountToken: &automount,
+		EnableServiceLinks:           &automount,
+		Containers: []corev1.Container{{
+			Name:      backupContainerName,
+			Image:     job.Image,
+			Command:   []string{"/bin/sh", "-c", "read -r _ || exit 1\n" + job.Script},
+			Stdin:     true,
+			StdinOnce: true,
+			Env:       env,
+			// the variables of the application carry the credentials of the database
+			EnvFrom: []corev1.EnvFromSource{
+				{ConfigMapRef: &corev1.ConfigMapEnvSource{LocalObjectReference: ref, Optional: &optional}},
+				{SecretRef: &corev1.SecretEnvSource{LocalObjectReference: ref, Optional: &optional}},
+			},
+		}},
+	}
+	if pullSecret != "" {
+		spec.ImagePullSecrets = []corev1.LocalObjectReference{{Name: pullSecret}}
+	}
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      job.Name,
+			Namespace: app.ParentSlug,
+			Labels:    labels,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:          &backoffLimit,
+			ActiveDeadlineSeconds: &deadline,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec:       spec,
+			},
+		},
+	}
+}
+
+// waitForBackupPod waits for the pod of the job to run, pods stuck pulling their image fail early.
+func waitForBackupPod(ctx context.Context, client kubernetes.Interface, namespace, jobName string) (*corev1.Pod, error) {
+	var pod *corev1.Pod
+	err := wait.PollUntilContextTimeout(ctx, backupPollInterval, backupPodTimeout, true, func(ctx context.Context) (bool, error) {
+		pods, err := client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+			LabelSelector: fmt.Sprintf("%s=%s", batchv1.JobNameLabel, jobName),
+		})
+		if err != nil {
+			return false, err
+		}
+		for i := range pods.Items {
+			p := &pods.Items[i]
+			switch p.Status.Phase {
+			case corev1.PodRunning:
+				pod = p
+				return true, nil
+			case corev1.PodSucceeded, corev1.PodFailed:
+				return false, fmt.Errorf("pod %s of job %s stopped before the stream was attached", p.Name, jobName)
+			}
+			for _, status := range p.Status.ContainerStatuses {
+				if waiting := status.State.Waiting; waiting != nil {
+					switch waiting.Reason {
+					case "ErrImagePull", "ImagePullBackOff", "InvalidImageName", "CreateContainerConfigError":
+						return false, fmt.Errorf("pod %s of job %s cannot start: %s %s", p.Name, jobName, waiting.Reason, waiting.Message)
+					}
+				}
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("job %s did not start: %w", jobName, err)
+	}
+	return pod, nil
+}
+
+// waitForBackupJob waits for the job to report the exit of the script once the stream is done.
+func waitForBackupJob(ctx context.Context, client kubernetes.Interface, namespace, jobName string, stderr *tailBuffer) error {
+	var failed bool
+	err := wait.PollUntilContextTimeout(ctx, backupPollInterval, backupFinishTimeout, true, func(ctx context.Context) (bool, error) {
+		job, err := client.BatchV1().Jobs(namespace).Get(ctx, jobName, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		switch {
+		case job.Status.Succeeded > 0:
+			return true, nil
+		case job.Status.Failed > 0:
+			failed = true
+			return true, nil
+		}
+		return false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("job %s did not finish: %w%s", jobName, err, stderr.detail())
+	}
+	if failed {
+		return fmt.Errorf("job %s failed%s", jobName, stderr.detail())
+	}
+	return nil
+}
+
+// tailBuffer keeps the last bytes written to it.
+type tailBuffer struct {
+	mu    sync.Mutex
+	buf   []byte
+	limit int
+}
+
+func (b *tailBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.buf = append(b.buf, p...)
+	if len(b.buf) > b.limit {
+		b.buf = b.buf[len(b.buf)-b.limit:]
+	}
+	return len(p), nil
+}
+
+// detail returns the tail as a suffix for error messages.
+func (b *tailBuffer) detail() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	msg := strings.TrimSpace(string(b.buf))
+	if msg == "" {
+		return ""
+	}
+	return ": " + msg
+}
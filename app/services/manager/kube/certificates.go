@@ -147,7 +147,12 @@ func (m *K8sManager) ListCertificates(ctx context.Context, server *types.Server)
 			DNSNames:   cert.Spec.DNSNames,
 			IssuerRef:  fmt.Sprintf("%s/%s", cert.Spec.IssuerRef.Kind, cert.Spec.IssuerRef.Name),
 			SecretName: cert.Spec.SecretName,
-			Ready:      string(cert.Status.Conditions[len(cert.Status.Conditions)-1].Status),
+		}
+		// certificates that were just created have no conditions yet
+		if len(cert.Status.Conditions) > 0 {
+			condition := cert.Status.Conditions[len(cert.Status.Conditions)-1]
+			result[i].Ready = string(condition.Status)
+			result[i].Message = condition.Message
 		}
 		if cert.Status.NotBefore != nil {
 			result[i].NotBefore = cert.Status.NotBefore.Time
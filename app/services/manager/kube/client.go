@@ -2,6 +2,7 @@ package kube
 
 import (
 	"context"
+	"fmt"
 	"os"
 
 	"github.com/cloudness-io/cloudness/types"
@@ -11,21 +12,18 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	metricsclient "k8s.io/metrics/pkg/client/clientset/versioned"
+	externalmetrics "k8s.io/metrics/pkg/client/external_metrics"
 	gatewayclientset "sigs.k8s.io/gateway-api/pkg/client/clientset/versioned"
 )
 
-// TODO: get server creds from server object
 func (m *K8sManager) getInterface(ctx context.Context, server *types.Server) (kubernetes.Interface, error) {
-	var kubeClient kubernetes.Interface
-	_, err := rest.InClusterConfig()
+	config, err := m.getClientConfig(ctx, server)
 	if err != nil {
-		kubeClient, err = getClientOutOfCluster()
-	} else {
-		kubeClient, err = getClientInCluster()
+		return nil, err
 	}
-
-	return kubeClient, err
+	return kubernetes.NewForConfig(config)
 }
 
 func (m *K8sManager) getDynamicClient(ctx context.Context, server *types.Server) (*dynamic.DynamicClient, error) {
@@ -60,42 +58,115 @@ func (m *K8sManager) getMetricsClient(ctx context.Context, server *types.Server)
 	return metricsclient.NewForConfig(config)
 }
 
-func (m *K8sManager) getClientConfig(ctx context.Context, server *types.Server) (*rest.Config, error) {
-	config, err := rest.InClusterConfig()
+func (m *K8sManager) getExternalMetricsClient(ctx context.Context, server *types.Server) (externalmetrics.ExternalMetricsClient, error) {
+	config, err := m.getClientConfig(ctx, server)
 	if err != nil {
-		config, err = clientcmd.BuildConfigFromFlags("", getOutofClusterKubeConfigPath())
-		if err != nil {
-			return nil, err
-		}
+		return nil, err
 	}
-	return config, err
+	return externalmetrics.NewForConfig(config)
 }
 
-func getOutofClusterKubeConfigPath() string {
-	kubeConfigPath := os.Getenv("KUBECONFIG")
-	if len(kubeConfigPath) == 0 {
-		kubeConfigPath = os.Getenv("HOME") + "/.kube/config"
+// serverConfig is the client config parsed from the kubeconfig of a server at its last update.
+type serverConfig struct {
+	updated int64
+	config  *rest.Config
+}
+
+// getClientConfig returns the client config of the server, servers without a kubeconfig use the
+// cluster cloudness runs in, or the local kubeconfig outside of a cluster.
+func (m *K8sManager) getClientConfig(ctx context.Context, server *types.Server) (*rest.Config, error) {
+	if server == nil || len(server.KubeConfig) == 0 {
+		return getLocalClientConfig()
 	}
 
-	return kubeConfigPath
-}
+	m.configsMu.Lock()
+	defer m.configsMu.Unlock()
 
-func getClientOutOfCluster() (kubernetes.Interface, error) {
-	kubeConfigPath := getOutofClusterKubeConfigPath()
+	if cached, ok := m.configs[server.ID]; ok && cached.updated == server.Updated {
+		return rest.CopyConfig(cached.config), nil
+	}
 
-	//use current context in kubeconfig
-	config, err := clientcmd.BuildConfigFromFlags("", kubeConfigPath)
+	if m.encrypter == nil {
+		return nil, fmt.Errorf("unable to read the kubeconfig of server %s", server.Name)
+	}
+	kubeconfig, err := m.encrypter.Decrypt(server.KubeConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt the kubeconfig of server %s: %w", server.Name, err)
+	}
+	config, err := ParseKubeConfig([]byte(kubeconfig))
 	if err != nil {
 		return nil, err
 	}
 
-	return kubernetes.NewForConfig(config)
+	m.configs[server.ID] = &serverConfig{updated: server.Updated, config: config}
+	return rest.CopyConfig(config), nil
 }
 
-func getClientInCluster() (kubernetes.Interface, error) {
-	config, err := rest.InClusterConfig()
+// ParseKubeConfig returns the client config of the current context of the kubeconfig. Kubeconfigs are
+// uploaded by users and read on the control plane, so only inline credentials are accepted: exec and
+// auth provider plugins would run commands and file paths would read files of the host.
+func ParseKubeConfig(kubeconfig []byte) (*rest.Config, error) {
+	config, err := clientcmd.Load(kubeconfig)
 	if err != nil {
 		return nil, err
 	}
-	return kubernetes.NewForConfig(config)
+	if err := checkInlineCredentials(config); err != nil {
+		return nil, err
+	}
+	return clientcmd.NewDefaultClientConfig(*config, &clientcmd.ConfigOverrides{}).ClientConfig()
+}
+
+// checkInlineCredentials returns an error if a user or cluster of the kubeconfig reads its credentials
+// from a plugin or a file.
+func checkInlineCredentials(config *clientcmdapi.Config) error {
+	for name, authInfo := range config.AuthInfos {
+		switch {
+		case authInfo.Exec != nil:
+			return fmt.Errorf("user %s: exec plugins are not supported", name)
+		case authInfo.AuthProvider != nil:
+			return fmt.Errorf("user %s: auth providers are not supported", name)
+		case authInfo.TokenFile != "":
+			return fmt.Errorf("user %s: tokenFile is not supported, use token", name)
+		case authInfo.ClientCertificate != "":
+			return fmt.Errorf("user %s: client-certificate is not supported, use client-certificate-data", name)
+		case authInfo.ClientKey != "":
+			return fmt.Errorf("user %s: client-key is not supported, use client-key-data", name)
+		}
+	}
+	for name, cluster := range config.Clusters {
+		if cluster.CertificateAuthority != "" {
+			return fmt.Errorf("cluster %s: certificate-authority is not supported, use certificate-authority-data", name)
+		}
+	}
+	return nil
+}
+
+// TokenKubeConfig returns a kubeconfig authenticating to the api server with a service account token.
+func TokenKubeConfig(name, server, caCert, token string) ([]byte, error) {
+	config := clientcmdapi.NewConfig()
+	config.Clusters[name] = &clientcmdapi.Cluster{
+		Server:                   server,
+		CertificateAuthorityData: []byte(caCert),
+	}
+	config.AuthInfos[name] = &clientcmdapi.AuthInfo{Token: token}
+	config.Contexts[name] = &clientcmdapi.Context{Cluster: name, AuthInfo: name}
+	config.CurrentContext = name
+	return clientcmd.Write(*config)
+}
+
+func getLocalClientConfig() (*rest.Config, error) {
+	config, err := rest.InClusterConfig()
+	if err != nil {
+		return clientcmd.BuildConfigFromFlags("", getOutofClusterKubeConfigPath())
+	}
+	return config, nil
+}
+
+func getOutofClusterKubeConfigPath() string {
+	kubeConfigPath := os.Getenv("KUBECONFIG")
+	if len(kubeConfigPath) == 0 {
+		kubeConfigPath = os.Getenv("HOME") + "/.kube/config"
+	}
+
+	return kubeConfigPath
 }
@@ -0,0 +1,61 @@
+package kube
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+const testKubeConfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: remote
+  cluster:
+    server: https://203.0.113.10:6443
+%s
+users:
+- name: remote
+  user:
+%s
+contexts:
+- name: remote
+  context:
+    cluster: remote
+    user: remote
+current-context: remote
+`
+
+func TestParseKubeConfig(t *testing.T) {
+	config, err := ParseKubeConfig([]byte(fmt.Sprintf(testKubeConfig, "    insecure-skip-tls-verify: true", "    token: secret")))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if config.Host != "https://203.0.113.10:6443" || config.BearerToken != "secret" {
+		t.Errorf("config = %s with token %q, want the server and token of the kubeconfig", config.Host, config.BearerToken)
+	}
+}
+
+func TestParseKubeConfigRejectsHostCredentials(t *testing.T) {
+	const inlineCA = "    certificate-authority-data: \"\""
+	tests := []struct {
+		name    string
+		cluster string
+		user    string
+		want    string
+	}{
+		{"exec plugin", inlineCA, "    exec:\n      apiVersion: client.authentication.k8s.io/v1\n      command: sh\n      args: [\"-c\", \"id\"]", "exec plugins"},
+		{"auth provider", inlineCA, "    auth-provider:\n      name: oidc", "auth providers"},
+		{"token file", inlineCA, "    tokenFile: /var/run/secrets/kubernetes.io/serviceaccount/token", "tokenFile"},
+		{"client certificate", inlineCA, "    client-certificate: /etc/kubernetes/pki/admin.crt", "client-certificate"},
+		{"client key", inlineCA, "    client-key: /etc/kubernetes/pki/admin.key", "client-key"},
+		{"certificate authority", "    certificate-authority: /etc/kubernetes/pki/ca.crt", "    token: secret", "certificate-authority"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseKubeConfig([]byte(fmt.Sprintf(testKubeConfig, tc.cluster, tc.user)))
+			if err == nil || !strings.Contains(err.Error(), tc.want) {
+				t.Fatalf("expected the kubeconfig to be rejected for %s, got %v", tc.want, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,253 @@
+package kube
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cloudness-io/cloudness/k8s"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/restmapper"
+)
+
+const (
+	// components installed by the bootstrap manifests
+	bootstrapNamespace    = "cloudness"
+	traefikNamespace      = "traefik"
+	traefikDeployment     = "traefik-deployment"
+	certManagerDeployment = "cert-manager"
+	traefikGatewayClass   = "traefik"
+	cnpgNamespace         = "cnpg-system"
+	cnpgDeployment        = "cnpg-controller-manager"
+	cnpgVersion           = "1.25.1"
+
+	// the cloudnative-pg operator is applied from its pinned release, too large to be embedded
+	cnpgManifestURL = "https://github.com/cloudnative-pg/cloudnative-pg/releases/download/v" + cnpgVersion + "/cnpg-" + cnpgVersion + ".yaml"
+	// sha256 of the release manifest, the download is refused when it does not match
+	cnpgManifestSHA256 = "ece141801fef6507451a3032b1eda29e9fa15944b741fa7301701c781160ce41"
+
+	crdEstablishTimeout        = time.Minute
+	crdEstablishRetryInterval  = 2 * time.Second
+	manifestDecoderBufferBytes = 4096
+	manifestDownloadTimeout    = time.Minute
+	maxManifestBytes           = 32 << 20
+)
+
+// CheckHealth checks that the api server of the cluster is reachable and the components of the
+// bootstrap manifests are ready.
+func (m *K8sManager) CheckHealth(ctx context.Context, server *types.Server) (*types.ServerHealth, error) {
+	client, err := m.getInterface(ctx, server)
+	if err != nil {
+		return &types.ServerHealth{Status: enum.ServerHealthStatusUnreachable, Message: err.Error()}, nil
+	}
+
+	version, err := client.Discovery().ServerVersion()
+	if err != nil {
+		return &types.ServerHealth{Status: enum.ServerHealthStatusUnreachable, Message: err.Error()}, nil
+	}
+
+	problems := []string{}
+	if _, err := client.CoreV1().Namespaces().Get(ctx, bootstrapNamespace, metav1.GetOptions{}); err != nil {
+		problems = append(problems, fmt.Sprintf("namespace %s: %s", bootstrapNamespace, reason(err)))
+	}
+	for _, name := range []string{traefikDeployment, certManagerDeployment} {
+		deploy, err := client.AppsV1().Deployments(traefikNamespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("deployment %s: %s", name, reason(err)))
+			continue
+		}
+		if deploy.Status.ReadyReplicas == 0 {
+			problems = append(problems, fmt.Sprintf("deployment %s: no ready replicas", name))
+		}
+	}
+	if deploy, err := client.AppsV1().Deployments(cnpgNamespace).Get(ctx, cnpgDeployment, metav1.GetOptions{}); err != nil {
+		problems = append(problems, fmt.Sprintf("deployment %s: %s", cnpgDeployment, reason(err)))
+	} else if deploy.Status.ReadyReplicas == 0 {
+		problems = append(problems, fmt.Sprintf("deployment %s: no ready replicas", cnpgDeployment))
+	}
+
+	gwClient, err := m.getGatewayClient(ctx, server)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := gwClient.GatewayV1().GatewayClasses().Get(ctx, traefikGatewayClass, metav1.GetOptions{}); err != nil {
+		problems = append(problems, fmt.Sprintf("gateway class %s: %s", traefikGatewayClass, reason(err)))
+	}
+
+	if len(problems) > 0 {
+		return &types.ServerHealth{Status: enum.ServerHealthStatusDegraded, Message: strings.Join(problems, "; ")}, nil
+	}
+	return &types.ServerHealth{
+		Status:  enum.ServerHealthStatusHealthy,
+		Message: fmt.Sprintf("Kubernetes %s", version.GitVersion),
+	}, nil
+}
+
+// Bootstrap server side applies the manifests of the k8s directory: the cloudness namespace and
+// runner permissions, the gateway api, traefik and cert-manager, then the cloudnative-pg operator
+// from its pinned release.
+func (m *K8sManager) Bootstrap(ctx context.Context, server *types.Server) error {
+	config, err := m.getClientConfig(ctx, server)
+	if err != nil {
+		return err
+	}
+	client, err := m.getInterface(ctx, server)
+	if err != nil {
+		return err
+	}
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(client.Discovery()))
+
+	files, err := fs.Glob(k8s.Manifests, "*.yaml")
+	if err != nil {
+		return err
+	}
+	sort.Strings(files)
+
+	for _, file := range files {
+		data, err := k8s.Manifests.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		objects, err := decodeManifests(data)
+		if err != nil {
+			return fmt.Errorf("failed to decode %s: %w", file, err)
+		}
+		for _, obj := range objects {
+			if err := applyManifest(ctx, dynamicClient, mapper, obj); err != nil {
+				return fmt.Errorf("failed to apply %s %s from %s: %w", obj.GetKind(), obj.GetName(), file, err)
+			}
+		}
+		// custom resources of the next files may use the definitions of this one
+		mapper.Reset()
+	}
+
+	data, err := downloadManifest(ctx, cnpgManifestURL, cnpgManifestSHA256)
+	if err != nil {
+		return fmt.Errorf("failed to download cloudnative-pg %s: %w", cnpgVersion, err)
+	}
+	objects, err := decodeManifests(data)
+	if err != nil {
+		return fmt.Errorf("failed to decode cloudnative-pg %s: %w", cnpgVersion, err)
+	}
+	for _, obj := range objects {
+		if err := applyManifest(ctx, dynamicClient, mapper, obj); err != nil {
+			return fmt.Errorf("failed to apply %s %s from cloudnative-pg %s: %w", obj.GetKind(), obj.GetName(), cnpgVersion, err)
+		}
+	}
+	return nil
+}
+
+// downloadManifest returns the manifest released at the url, its content must match the sha256 digest.
+func downloadManifest(ctx context.Context, url string, digest string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, manifestDownloadTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxManifestBytes))
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(data)
+	if got := hex.EncodeToString(sum[:]); got != digest {
+		return nil, fmt.Errorf("sha256 %s does not match the pinned %s", got, digest)
+	}
+	return data, nil
+}
+
+// applyManifest applies the object, kinds of custom resource definitions that are not established
+// yet are retried until they are served.
+func applyManifest(ctx context.Context, client dynamic.Interface, mapper *restmapper.DeferredDiscoveryRESTMapper, obj *unstructured.Unstructured) error {
+	gvk := obj.GroupVersionKind()
+	deadline := time.Now().Add(crdEstablishTimeout)
+	for {
+		mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+		if meta.IsNoMatchError(err) && time.Now().Before(deadline) {
+			mapper.Reset()
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(crdEstablishRetryInterval):
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		var resource dynamic.ResourceInterface = client.Resource(mapping.Resource)
+		if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+			namespace := obj.GetNamespace()
+			if namespace == "" {
+				namespace = metav1.NamespaceDefault
+			}
+			resource = client.Resource(mapping.Resource).Namespace(namespace)
+		} else {
+			// cluster scoped objects of the manifests may carry a namespace
+			obj.SetNamespace("")
+		}
+
+		_, err = resource.Apply(ctx, obj.GetName(), obj, applyOptions)
+		return err
+	}
+}
+
+// decodeManifests decodes the documents of a multi document yaml, empty documents are skipped.
+func decodeManifests(data []byte) ([]*unstructured.Unstructured, error) {
+	decoder := k8syaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), manifestDecoderBufferBytes)
+	objects := []*unstructured.Unstructured{}
+	for {
+		obj := map[string]any{}
+		err := decoder.Decode(&obj)
+		if errors.Is(err, io.EOF) {
+			return objects, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(obj) == 0 {
+			continue
+		}
+		objects = append(objects, &unstructured.Unstructured{Object: obj})
+	}
+}
+
+func reason(err error) string {
+	if apierrors.IsNotFound(err) {
+		return "not found"
+	}
+	return err.Error()
+}
@@ -0,0 +1,31 @@
+package kube
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDownloadManifestVerifiesDigest(t *testing.T) {
+	manifest := []byte("apiVersion: v1\nkind: Namespace\nmetadata:\n  name: cnpg-system\n")
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Write(manifest)
+	}))
+	defer srv.Close()
+
+	sum := sha256.Sum256(manifest)
+	data, err := downloadManifest(context.Background(), srv.URL, hex.EncodeToString(sum[:]))
+	if err != nil {
+		t.Fatalf("download: %v", err)
+	}
+	if string(data) != string(manifest) {
+		t.Errorf("manifest = %q, want %q", data, manifest)
+	}
+
+	if _, err := downloadManifest(context.Background(), srv.URL, cnpgManifestSHA256); err == nil {
+		t.Fatal("expected a manifest of another digest to be refused")
+	}
+}
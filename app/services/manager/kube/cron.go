@@ -0,0 +1,97 @@
+package kube
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/cloudness-io/cloudness/types"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	cronRunStatusRunning   = "Running"
+	cronRunStatusSucceeded = "Succeeded"
+	cronRunStatusFailed    = "Failed"
+)
+
+func (m *K8sManager) ListCronRuns(ctx context.Context, server *types.Server, app *types.Application) ([]*types.CronRun, error) {
+	client, err := m.getInterface(ctx, server)
+	if err != nil {
+		return nil, err
+	}
+
+	selector := fmt.Sprintf("app.kubernetes.io/instance=%s", app.GetIdentifierStr())
+	jobs, err := client.BatchV1().Jobs(app.ParentSlug).List(ctx, metav1.ListOptions{
+		LabelSelector: selector,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := client.CoreV1().Pods(app.ParentSlug).List(ctx, metav1.ListOptions{
+		LabelSelector: selector,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	runs := make([]*types.CronRun, 0, len(jobs.Items))
+	for _, job := range jobs.Items {
+		runs = append(runs, toCronRun(&job, pods))
+	}
+
+	sort.Slice(runs, func(i, j int) bool {
+		return runs[i].Started > runs[j].Started
+	})
+	return runs, nil
+}
+
+func toCronRun(job *batchv1.Job, pods *corev1.PodList) *types.CronRun {
+	run := &types.CronRun{
+		Name:   job.Name,
+		Status: cronRunStatusRunning,
+	}
+	if job.Status.StartTime != nil {
+		run.Started = job.Status.StartTime.UnixMilli()
+	}
+
+	for _, cond := range job.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobComplete:
+			run.Status = cronRunStatusSucceeded
+			run.Succeeded = true
+			run.Finished = cond.LastTransitionTime.UnixMilli()
+		case batchv1.JobFailed:
+			run.Status = cronRunStatusFailed
+			run.Finished = cond.LastTransitionTime.UnixMilli()
+		}
+	}
+	if job.Status.CompletionTime != nil {
+		run.Finished = job.Status.CompletionTime.UnixMilli()
+	}
+
+	// exit code of the latest terminated attempt of the job
+	var latest *corev1.ContainerStateTerminated
+	for _, pod := range pods.Items {
+		if pod.Labels[batchv1.JobNameLabel] != job.Name {
+			continue
+		}
+		for _, cs := range pod.Status.ContainerStatuses {
+			if t := cs.State.Terminated; t != nil && (latest == nil || t.FinishedAt.After(latest.FinishedAt.Time)) {
+				latest = t
+			}
+		}
+	}
+	if latest != nil {
+		run.ExitCode = &latest.ExitCode
+	}
+
+	return run
+}
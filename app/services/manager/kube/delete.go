@@ -40,6 +40,11 @@ func (m *K8sManager) DeleteResources(ctx context.Context, server *types.Server,
 			return err
 		}
 
+		err = deletePostgresClusters(ctx, dynamicClient, namespace, listOption)
+		if err != nil {
+			return err
+		}
+
 		err = client.AppsV1().StatefulSets(namespace).DeleteCollection(ctx, deleteOption, listOption)
 		if err != nil {
 			return err
@@ -122,6 +127,11 @@ func (m *K8sManager) DeleteApplication(ctx context.Context, server *types.Server
 			}
 		}
 
+		err = deletePostgresClusters(ctx, dynamicClient, namespace, listOption)
+		if err != nil {
+			return err
+		}
+
 		err = client.AppsV1().StatefulSets(namespace).DeleteCollection(ctx, deleteOption, listOption)
 		if err != nil {
 			return err
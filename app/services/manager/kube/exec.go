@@ -0,0 +1,125 @@
+package kube
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudness-io/cloudness/errors"
+	"github.com/cloudness-io/cloudness/types"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+// execShell starts bash when the image ships it, falling back to sh.
+var execShell = []string{"/bin/sh", "-c", "if command -v bash >/dev/null 2>&1; then exec bash; else exec sh; fi"}
+
+func (m *K8sManager) ListExecTargets(ctx context.Context, server *types.Server, app *types.Application) ([]*types.ExecTarget, error) {
+	client, err := m.getInterface(ctx, server)
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := client.CoreV1().Pods(app.ParentSlug).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("app.kubernetes.io/instance=%s", app.GetIdentifierStr()),
+		FieldSelector: "status.phase=Running",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	targets := []*types.ExecTarget{}
+	for _, pod := range pods.Items {
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+		for _, status := range pod.Status.ContainerStatuses {
+			targets = append(targets, &types.ExecTarget{
+				Pod:       pod.Name,
+				Container: status.Name,
+				Ready:     status.Ready,
+			})
+		}
+	}
+	return targets, nil
+}
+
+// Exec opens an interactive shell into the container, the pod must belong to the application.
+func (m *K8sManager) Exec(ctx context.Context, server *types.Server, app *types.Application, target *types.ExecTarget, streams *types.ExecStreams) error {
+	config, err := m.getClientConfig(ctx, server)
+	if err != nil {
+		return err
+	}
+
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	pod, err := client.CoreV1().Pods(app.ParentSlug).Get(ctx, target.Pod, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if pod.Labels["app.kubernetes.io/instance"] != app.GetIdentifierStr() {
+		return errors.NotFound("Pod %s not found", target.Pod)
+	}
+	if pod.Status.Phase != corev1.PodRunning {
+		return errors.PreconditionFailed("Pod %s is not running", target.Pod)
+	}
+
+	req := client.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(pod.Namespace).
+		Name(pod.Name).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: target.Container,
+			Command:   execShell,
+			Stdin:     true,
+			Stdout:    true,
+			TTY:       true,
+		}, scheme.ParameterCodec)
+
+	exec, err := newStreamExecutor(config, req)
+	if err != nil {
+		return err
+	}
+
+	return exec.StreamWithContext(ctx, remotecommand.StreamOptions{
+		Stdin:             streams.Stdin,
+		Stdout:            streams.Stdout,
+		Tty:               true,
+		TerminalSizeQueue: &terminalSizeQueue{resize: streams.Resize},
+	})
+}
+
+// newStreamExecutor prefers the websocket protocol for exec and attach requests, older api servers only speak spdy.
+func newStreamExecutor(config *rest.Config, req *rest.Request) (remotecommand.Executor, error) {
+	wsExec, err := remotecommand.NewWebSocketExecutor(config, "GET", req.URL().String())
+	if err != nil {
+		return nil, err
+	}
+	spdyExec, err := remotecommand.NewSPDYExecutor(config, "POST", req.URL())
+	if err != nil {
+		return nil, err
+	}
+	return remotecommand.NewFallbackExecutor(wsExec, spdyExec, httpstream.IsUpgradeFailure)
+}
+
+// terminalSizeQueue adapts the resize channel to the size queue of remotecommand.
+type terminalSizeQueue struct {
+	resize <-chan types.TerminalSize
+}
+
+func (q *terminalSizeQueue) Next() *remotecommand.TerminalSize {
+	size, ok := <-q.resize
+	if !ok {
+		return nil
+	}
+	return &remotecommand.TerminalSize{Width: size.Cols, Height: size.Rows}
+}
@@ -1,11 +1,25 @@
 package kube
 
-import "github.com/cloudness-io/cloudness/app/services/config"
+import (
+	"sync"
+
+	"github.com/cloudness-io/cloudness/app/services/config"
+	"github.com/cloudness-io/cloudness/encrypt"
+)
 
 type K8sManager struct {
 	configSvc *config.Service
+	encrypter encrypt.Encrypter
+
+	// configs caches the client configs of servers with a kubeconfig, by server id.
+	configsMu sync.Mutex
+	configs   map[int64]*serverConfig
 }
 
-func NewK8sManager(configSvc *config.Service) *K8sManager {
-	return &K8sManager{configSvc: configSvc}
+func NewK8sManager(configSvc *config.Service, encrypter encrypt.Encrypter) *K8sManager {
+	return &K8sManager{
+		configSvc: configSvc,
+		encrypter: encrypter,
+		configs:   map[int64]*serverConfig{},
+	}
 }
@@ -37,3 +37,15 @@ func listenerSectionName(key string) string {
 func httpRouteName(key string) string {
 	return key + "-http-route"
 }
+
+//autoscaling
+
+func hpaName(identifier string) string {
+	return identifier + "-hpa"
+}
+
+//sleep
+
+func activatorReferenceGrantName(namespace string) string {
+	return "activator-" + namespace
+}
@@ -0,0 +1,76 @@
+package kube
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cloudness-io/cloudness/types/enum"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+)
+
+const (
+	// label the cloudnative-pg operator sets on the pods of a cluster
+	postgresClusterPodLabel = "cnpg.io/cluster"
+	postgresHealthyPhase    = "Cluster in healthy state"
+)
+
+var postgresClusterGvr = schema.GroupVersionResource{
+	Group:    "postgresql.cnpg.io",
+	Version:  "v1",
+	Resource: "clusters",
+}
+
+// listPostgresClusters returns the postgres clusters managed by cloudness, none when the operator
+// is not installed on the server.
+func listPostgresClusters(ctx context.Context, dynamicClient dynamic.Interface) ([]unstructured.Unstructured, error) {
+	clusters, err := dynamicClient.Resource(postgresClusterGvr).Namespace(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		LabelSelector: "app.kubernetes.io/managed-by=cloudness",
+	})
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return clusters.Items, nil
+}
+
+// evaluatePostgresClusterStatus reports a failover or switchover in progress as paused, with the
+// instances the primary moves between.
+func evaluatePostgresClusterStatus(cluster unstructured.Unstructured) (enum.ApplicationStatus, string) {
+	instances, _, _ := unstructured.NestedInt64(cluster.Object, "spec", "instances")
+	ready, _, _ := unstructured.NestedInt64(cluster.Object, "status", "readyInstances")
+	phase, _, _ := unstructured.NestedString(cluster.Object, "status", "phase")
+	currentPrimary, _, _ := unstructured.NestedString(cluster.Object, "status", "currentPrimary")
+	targetPrimary, _, _ := unstructured.NestedString(cluster.Object, "status", "targetPrimary")
+
+	if currentPrimary != "" && targetPrimary != "" && currentPrimary != targetPrimary {
+		return enum.ApplicationStatusPaused, fmt.Sprintf("failing over from %s to %s", currentPrimary, targetPrimary)
+	}
+	if strings.Contains(phase, "Failing over") || strings.Contains(phase, "Switchover") {
+		return enum.ApplicationStatusPaused, phase
+	}
+	if phase == postgresHealthyPhase && ready >= instances {
+		return enum.ApplicationStatusRunning, ""
+	}
+	if ready == 0 {
+		return enum.ApplicationStatusError, firstNonEmpty(phase, "no ready instances")
+	}
+	return enum.ApplicationStatusPaused, firstNonEmpty(phase, fmt.Sprintf("ready instances %d/%d", ready, instances))
+}
+
+// deletePostgresClusters deletes the postgres clusters matching the list options, the operator
+// removes their pods, volumes and services.
+func deletePostgresClusters(ctx context.Context, dynamicClient dynamic.Interface, namespace string, listOption metav1.ListOptions) error {
+	err := dynamicClient.Resource(postgresClusterGvr).Namespace(namespace).DeleteCollection(ctx, metav1.DeleteOptions{}, listOption)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
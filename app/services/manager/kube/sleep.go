@@ -0,0 +1,384 @@
+package kube
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudness-io/cloudness/app/usererror"
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/rs/zerolog/log"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	externalmetrics "k8s.io/metrics/pkg/client/external_metrics"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+	gatewayclientset "sigs.k8s.io/gateway-api/pkg/client/clientset/versioned"
+)
+
+const (
+	// set by the pipeline on deployments of applications with sleep enabled
+	annotationSleepAfter  = "cloudness.io/sleep-after"
+	annotationSleepTarget = "cloudness.io/sleep-target"
+
+	annotationLastActive = "cloudness.io/last-active"
+	// original rules of a http route while it points to the activator
+	annotationSleepRules = "cloudness.io/sleep-rules"
+	// random token the gateway sends along with requests of the sleeping application
+	annotationActivatorToken = "cloudness.io/activator-token"
+
+	// ActivatorHeader is set by the gateway on requests of sleeping applications, value is namespace/name
+	ActivatorHeader = "X-Cloudness-Activate"
+	// ActivatorTokenHeader is set by the gateway next to the ActivatorHeader, the activator only wakes
+	// applications whose token matches.
+	ActivatorTokenHeader = "X-Cloudness-Activate-Token"
+
+	wakeTimeout      = 2 * time.Minute
+	wakePollInterval = time.Second
+)
+
+// IdleApplications scales applications without requests for their sleep window to zero and routes their
+// traffic to the activator, it returns the number of applications put to sleep.
+func (m *K8sManager) IdleApplications(ctx context.Context, server *types.Server) (int, error) {
+	client, err := m.getInterface(ctx, server)
+	if err != nil {
+		return 0, err
+	}
+	gwClient, err := m.getGatewayClient(ctx, server)
+	if err != nil {
+		return 0, err
+	}
+	metricsClient, err := m.getExternalMetricsClient(ctx, server)
+	if err != nil {
+		return 0, err
+	}
+
+	deployments, err := client.AppsV1().Deployments(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		LabelSelector: "app.kubernetes.io/managed-by=cloudness",
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now().UTC()
+	slept := 0
+	for i := range deployments.Items {
+		deploy := &deployments.Items[i]
+		sleepAfter, ok := getSleepAfter(deploy)
+		if !ok || isSleeping(deploy) {
+			continue
+		}
+
+		active, err := hasGatewayRequests(metricsClient, deploy)
+		if err != nil {
+			log.Ctx(ctx).Warn().Err(err).Str("deployment", deploy.Name).Msg("sleep: unable to read request metrics")
+			continue
+		}
+
+		// a new deployment counts as activity, it also wakes the application
+		lastActive := max(getLastActive(deploy), m.getUpdateTimeFromPodAnnotations(deploy.Spec.Template.Annotations))
+		if active || lastActive == 0 {
+			if err := markActive(ctx, client, deploy.Namespace, deploy.Name, now); err != nil {
+				return slept, err
+			}
+			continue
+		}
+		if now.Sub(time.UnixMilli(lastActive)) < sleepAfter {
+			continue
+		}
+
+		if err := m.sleepDeployment(ctx, client, gwClient, deploy); err != nil {
+			return slept, fmt.Errorf("failed to put %s/%s to sleep: %w", deploy.Namespace, deploy.Name, err)
+		}
+		log.Ctx(ctx).Info().Msgf("sleep: scaled %s/%s to zero after %s without requests", deploy.Namespace, deploy.Name, sleepAfter)
+		slept++
+	}
+
+	return slept, nil
+}
+
+// WakeApplication scales a sleeping application back up, waits for a ready replica and restores its routes.
+// It returns the in cluster address requests of the application are proxied to.
+func (m *K8sManager) WakeApplication(ctx context.Context, server *types.Server, namespace, name, token string) (string, error) {
+	client, err := m.getInterface(ctx, server)
+	if err != nil {
+		return "", err
+	}
+	gwClient, err := m.getGatewayClient(ctx, server)
+	if err != nil {
+		return "", err
+	}
+
+	return m.wakeDeployment(ctx, client, gwClient, namespace, name, token)
+}
+
+func (m *K8sManager) wakeDeployment(
+	ctx context.Context,
+	client kubernetes.Interface,
+	gwClient gatewayclientset.Interface,
+	namespace, name, token string,
+) (string, error) {
+	deploy, err := client.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return "", usererror.NotFoundf("Application %s/%s not found", namespace, name)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	target := deploy.Annotations[annotationSleepTarget]
+	if deploy.Labels["app.kubernetes.io/managed-by"] != "cloudness" || target == "" {
+		return "", usererror.BadRequestf("Application %s/%s does not sleep", namespace, name)
+	}
+	expected := deploy.Annotations[annotationActivatorToken]
+	if expected == "" || subtle.ConstantTimeCompare([]byte(expected), []byte(token)) != 1 {
+		return "", usererror.Forbidden("Invalid activation request")
+	}
+	host, port, err := net.SplitHostPort(target)
+	if err != nil {
+		return "", fmt.Errorf("invalid sleep target %q: %w", target, err)
+	}
+
+	if isSleeping(deploy) {
+		replicas := m.getMinReplicas(ctx, client, deploy)
+		if _, err := client.AppsV1().Deployments(namespace).UpdateScale(ctx, name, &autoscalingv1.Scale{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, ResourceVersion: deploy.ResourceVersion},
+			Spec:       autoscalingv1.ScaleSpec{Replicas: replicas},
+		}, metav1.UpdateOptions{}); err != nil && !errors.IsConflict(err) {
+			return "", err
+		}
+	}
+
+	if err := wait.PollUntilContextTimeout(ctx, wakePollInterval, wakeTimeout, true, func(ctx context.Context) (bool, error) {
+		deploy, err := client.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		return deploy.Status.ReadyReplicas > 0, nil
+	}); err != nil {
+		return "", fmt.Errorf("application %s/%s did not become ready: %w", namespace, name, err)
+	}
+
+	if err := restoreRoutes(ctx, gwClient, namespace, name); err != nil {
+		return "", err
+	}
+	if err := markActive(ctx, client, namespace, name, time.Now().UTC()); err != nil {
+		return "", err
+	}
+
+	return net.JoinHostPort(fmt.Sprintf("%s.%s.svc.cluster.local", host, namespace), port), nil
+}
+
+func (m *K8sManager) sleepDeployment(ctx context.Context, client kubernetes.Interface, gwClient gatewayclientset.Interface, deploy *appsv1.Deployment) error {
+	if err := ensureActivatorReferenceGrant(ctx, gwClient, deploy.Namespace); err != nil {
+		return err
+	}
+
+	token := deploy.Annotations[annotationActivatorToken]
+	if token == "" {
+		raw := make([]byte, 16)
+		if _, err := rand.Read(raw); err != nil {
+			return err
+		}
+		token = hex.EncodeToString(raw)
+		patch := fmt.Sprintf(`{"metadata":{"annotations":{%q:%q}}}`, annotationActivatorToken, token)
+		if _, err := client.AppsV1().Deployments(deploy.Namespace).Patch(ctx, deploy.Name, k8stypes.MergePatchType, []byte(patch), metav1.PatchOptions{}); err != nil {
+			return err
+		}
+	}
+
+	// route traffic to the activator before the replicas are gone
+	routes, err := gwClient.GatewayV1().HTTPRoutes(deploy.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("app.kubernetes.io/instance=%s", deploy.Name),
+	})
+	if err != nil {
+		return err
+	}
+	for i := range routes.Items {
+		route := &routes.Items[i]
+		// a redeploy while sleeping reapplies the rules but keeps the saved rules annotation
+		if route.Annotations[annotationSleepRules] != "" && routesToActivator(route) {
+			continue
+		}
+		rules, err := json.Marshal(route.Spec.Rules)
+		if err != nil {
+			return err
+		}
+		if route.Annotations == nil {
+			route.Annotations = make(map[string]string)
+		}
+		route.Annotations[annotationSleepRules] = string(rules)
+		for r := range route.Spec.Rules {
+			route.Spec.Rules[r].BackendRefs = []gwapiv1.HTTPBackendRef{activatorBackendRef()}
+			route.Spec.Rules[r].Filters = append(route.Spec.Rules[r].Filters, activatorFilter(deploy.Namespace, deploy.Name, token))
+		}
+		if _, err := gwClient.GatewayV1().HTTPRoutes(deploy.Namespace).Update(ctx, route, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+	}
+
+	_, err = client.AppsV1().Deployments(deploy.Namespace).UpdateScale(ctx, deploy.Name, &autoscalingv1.Scale{
+		ObjectMeta: metav1.ObjectMeta{Name: deploy.Name, Namespace: deploy.Namespace},
+		Spec:       autoscalingv1.ScaleSpec{Replicas: 0},
+	}, metav1.UpdateOptions{})
+	return err
+}
+
+func restoreRoutes(ctx context.Context, gwClient gatewayclientset.Interface, namespace, name string) error {
+	routes, err := gwClient.GatewayV1().HTTPRoutes(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("app.kubernetes.io/instance=%s", name),
+	})
+	if err != nil {
+		return err
+	}
+	for i := range routes.Items {
+		route := &routes.Items[i]
+		saved := route.Annotations[annotationSleepRules]
+		if saved == "" {
+			continue
+		}
+		// rules reapplied by a redeploy are newer than the saved ones
+		if routesToActivator(route) {
+			var rules []gwapiv1.HTTPRouteRule
+			if err := json.Unmarshal([]byte(saved), &rules); err != nil {
+				return fmt.Errorf("invalid saved rules of route %s: %w", route.Name, err)
+			}
+			route.Spec.Rules = rules
+		}
+		delete(route.Annotations, annotationSleepRules)
+		if _, err := gwClient.GatewayV1().HTTPRoutes(namespace).Update(ctx, route, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ensureActivatorReferenceGrant allows routes of the namespace to point to the cloudness service.
+func ensureActivatorReferenceGrant(ctx context.Context, gwClient gatewayclientset.Interface, namespace string) error {
+	refGrant := &gwapiv1b1.ReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      activatorReferenceGrantName(namespace),
+			Namespace: DefaultK8sCloudnessNamespace,
+		},
+		Spec: gwapiv1b1.ReferenceGrantSpec{
+			From: []gwapiv1b1.ReferenceGrantFrom{
+				{
+					Group:     gwapiv1.GroupName,
+					Kind:      "HTTPRoute",
+					Namespace: gwapiv1.Namespace(namespace),
+				},
+			},
+			To: []gwapiv1b1.ReferenceGrantTo{
+				{
+					Group: "",
+					Kind:  "Service",
+					Name:  ptr(gwapiv1.ObjectName(DefaultK8sCloudnessService)),
+				},
+			},
+		},
+	}
+
+	_, err := gwClient.GatewayV1beta1().ReferenceGrants(DefaultK8sCloudnessNamespace).Create(ctx, refGrant, metav1.CreateOptions{})
+	if errors.IsAlreadyExists(err) {
+		return nil
+	}
+	return err
+}
+
+func activatorBackendRef() gwapiv1.HTTPBackendRef {
+	return gwapiv1.HTTPBackendRef{
+		BackendRef: gwapiv1.BackendRef{
+			BackendObjectReference: gwapiv1.BackendObjectReference{
+				Name:      gwapiv1.ObjectName(DefaultK8sCloudnessService),
+				Namespace: ptr(gwapiv1.Namespace(DefaultK8sCloudnessNamespace)),
+				Port:      ptr(gwapiv1.PortNumber(DefaultK8sCloudnessPort)),
+			},
+		},
+	}
+}
+
+func activatorFilter(namespace, name, token string) gwapiv1.HTTPRouteFilter {
+	return gwapiv1.HTTPRouteFilter{
+		Type: gwapiv1.HTTPRouteFilterRequestHeaderModifier,
+		RequestHeaderModifier: &gwapiv1.HTTPHeaderFilter{
+			Set: []gwapiv1.HTTPHeader{
+				{Name: ActivatorHeader, Value: namespace + "/" + name},
+				{Name: ActivatorTokenHeader, Value: token},
+			},
+		},
+	}
+}
+
+// routesToActivator returns true if every rule of the route points to the activator.
+func routesToActivator(route *gwapiv1.HTTPRoute) bool {
+	activator := activatorBackendRef()
+	for _, rule := range route.Spec.Rules {
+		if len(rule.BackendRefs) != 1 || rule.BackendRefs[0].Name != activator.Name ||
+			rule.BackendRefs[0].Namespace == nil || *rule.BackendRefs[0].Namespace != *activator.Namespace {
+			return false
+		}
+	}
+	return len(route.Spec.Rules) > 0
+}
+
+func (m *K8sManager) getMinReplicas(ctx context.Context, client kubernetes.Interface, deploy *appsv1.Deployment) int32 {
+	hpa, err := client.AutoscalingV2().HorizontalPodAutoscalers(deploy.Namespace).Get(ctx, hpaName(deploy.Name), metav1.GetOptions{})
+	if err != nil || hpa.Spec.MinReplicas == nil {
+		return 1
+	}
+	return *hpa.Spec.MinReplicas
+}
+
+func hasGatewayRequests(metricsClient externalmetrics.ExternalMetricsClient, deploy *appsv1.Deployment) (bool, error) {
+	service, _, _ := strings.Cut(deploy.Annotations[annotationSleepTarget], ":")
+	values, err := metricsClient.NamespacedMetrics(deploy.Namespace).List(
+		types.GatewayRequestsMetric,
+		labels.SelectorFromSet(labels.Set{"service": service}),
+	)
+	if err != nil {
+		return false, err
+	}
+	for _, value := range values.Items {
+		if value.Value.Sign() > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func markActive(ctx context.Context, client kubernetes.Interface, namespace, name string, now time.Time) error {
+	patch := fmt.Sprintf(`{"metadata":{"annotations":{%q:%q}}}`, annotationLastActive, strconv.FormatInt(now.UnixMilli(), 10))
+	_, err := client.AppsV1().Deployments(namespace).Patch(ctx, name, k8stypes.MergePatchType, []byte(patch), metav1.PatchOptions{})
+	return err
+}
+
+func getSleepAfter(deploy *appsv1.Deployment) (time.Duration, bool) {
+	seconds, err := strconv.Atoi(deploy.Annotations[annotationSleepAfter])
+	if err != nil || seconds <= 0 {
+		return 0, false
+	}
+	return time.Duration(seconds) * time.Second, true
+}
+
+func getLastActive(deploy *appsv1.Deployment) int64 {
+	lastActive, _ := strconv.ParseInt(deploy.Annotations[annotationLastActive], 10, 64)
+	return lastActive
+}
+
+func isSleeping(deploy *appsv1.Deployment) bool {
+	return deploy.Annotations[annotationSleepAfter] != "" && deploy.Spec.Replicas != nil && *deploy.Spec.Replicas == 0
+}
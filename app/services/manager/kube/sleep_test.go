@@ -0,0 +1,209 @@
+package kube
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayfake "sigs.k8s.io/gateway-api/pkg/client/clientset/versioned/fake"
+)
+
+const (
+	testNamespace = "project-1"
+	testName      = "app-1"
+)
+
+func newSleepTestClients(t *testing.T) (*fake.Clientset, *gatewayfake.Clientset) {
+	t.Helper()
+	replicas := int32(1)
+	client := fake.NewClientset(&appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testName,
+			Namespace: testNamespace,
+			Labels:    map[string]string{"app.kubernetes.io/managed-by": "cloudness"},
+			Annotations: map[string]string{
+				annotationSleepAfter:  "300",
+				annotationSleepTarget: "app-1-svc:8080",
+			},
+		},
+		Spec:   appsv1.DeploymentSpec{Replicas: &replicas},
+		Status: appsv1.DeploymentStatus{ReadyReplicas: 1},
+	})
+	// the fake clientset does not implement the scale subresource
+	client.PrependReactor("update", "deployments", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		update, ok := action.(k8stesting.UpdateAction)
+		if !ok || update.GetSubresource() != "scale" {
+			return false, nil, nil
+		}
+		scale := update.GetObject().(*autoscalingv1.Scale)
+		deploy, err := client.Tracker().Get(appsv1.SchemeGroupVersion.WithResource("deployments"), update.GetNamespace(), scale.Name)
+		if err != nil {
+			return true, nil, err
+		}
+		d := deploy.(*appsv1.Deployment).DeepCopy()
+		d.Spec.Replicas = &scale.Spec.Replicas
+		return true, scale, client.Tracker().Update(appsv1.SchemeGroupVersion.WithResource("deployments"), d, update.GetNamespace())
+	})
+
+	gwClient := gatewayfake.NewSimpleClientset(&gwapiv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app-1-route",
+			Namespace: testNamespace,
+			Labels:    map[string]string{"app.kubernetes.io/instance": testName},
+		},
+		Spec: gwapiv1.HTTPRouteSpec{Rules: appRules()},
+	})
+	return client, gwClient
+}
+
+func appRules() []gwapiv1.HTTPRouteRule {
+	return []gwapiv1.HTTPRouteRule{{
+		BackendRefs: []gwapiv1.HTTPBackendRef{{
+			BackendRef: gwapiv1.BackendRef{
+				BackendObjectReference: gwapiv1.BackendObjectReference{
+					Name: "app-1-svc",
+					Port: ptr(gwapiv1.PortNumber(8080)),
+				},
+			},
+		}},
+	}}
+}
+
+func getRoute(t *testing.T, gwClient *gatewayfake.Clientset) *gwapiv1.HTTPRoute {
+	t.Helper()
+	route, err := gwClient.GatewayV1().HTTPRoutes(testNamespace).Get(context.Background(), "app-1-route", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get route: %v", err)
+	}
+	return route
+}
+
+func getDeployment(t *testing.T, client *fake.Clientset) *appsv1.Deployment {
+	t.Helper()
+	deploy, err := client.AppsV1().Deployments(testNamespace).Get(context.Background(), testName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get deployment: %v", err)
+	}
+	return deploy
+}
+
+func sleepAndCheck(t *testing.T, m *K8sManager, client *fake.Clientset, gwClient *gatewayfake.Clientset) string {
+	t.Helper()
+	ctx := context.Background()
+	if err := m.sleepDeployment(ctx, client, gwClient, getDeployment(t, client)); err != nil {
+		t.Fatalf("failed to put deployment to sleep: %v", err)
+	}
+
+	deploy := getDeployment(t, client)
+	if !isSleeping(deploy) {
+		t.Fatalf("deployment is not scaled to zero")
+	}
+	token := deploy.Annotations[annotationActivatorToken]
+	if token == "" {
+		t.Fatalf("deployment has no activator token")
+	}
+
+	route := getRoute(t, gwClient)
+	if !routesToActivator(route) {
+		t.Fatalf("route does not point to the activator: %+v", route.Spec.Rules)
+	}
+	headers := route.Spec.Rules[0].Filters[0].RequestHeaderModifier.Set
+	if len(headers) != 2 || headers[1].Name != ActivatorTokenHeader || headers[1].Value != token {
+		t.Errorf("route does not send the activator token: %+v", headers)
+	}
+	return token
+}
+
+func TestSleepWakeRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	m := &K8sManager{}
+	client, gwClient := newSleepTestClients(t)
+
+	token := sleepAndCheck(t, m, client, gwClient)
+
+	if _, err := m.wakeDeployment(ctx, client, gwClient, testNamespace, testName, "forged"); err == nil {
+		t.Fatalf("expected wake up with a wrong token to fail")
+	}
+	if _, err := m.wakeDeployment(ctx, client, gwClient, testNamespace, testName, ""); err == nil {
+		t.Fatalf("expected wake up without a token to fail")
+	}
+	if !isSleeping(getDeployment(t, client)) {
+		t.Fatalf("rejected wake up scaled the deployment")
+	}
+
+	target, err := m.wakeDeployment(ctx, client, gwClient, testNamespace, testName, token)
+	if err != nil {
+		t.Fatalf("failed to wake deployment: %v", err)
+	}
+	if target != "app-1-svc.project-1.svc.cluster.local:8080" {
+		t.Errorf("target = %s", target)
+	}
+	if deploy := getDeployment(t, client); isSleeping(deploy) || getLastActive(deploy) == 0 {
+		t.Errorf("deployment was not woken up")
+	}
+	route := getRoute(t, gwClient)
+	if route.Annotations[annotationSleepRules] != "" || routesToActivator(route) {
+		t.Errorf("routes were not restored: %+v", route.Spec.Rules)
+	}
+	want, _ := json.Marshal(appRules())
+	got, _ := json.Marshal(route.Spec.Rules)
+	if string(got) != string(want) {
+		t.Errorf("restored rules = %s, want %s", got, want)
+	}
+
+	// the application sleeps again with the same token
+	if again := sleepAndCheck(t, m, client, gwClient); again != token {
+		t.Errorf("token changed between sleeps")
+	}
+}
+
+// TestSleepStaleRules checks that a redeploy of a sleeping application, which reapplies the route rules
+// but keeps the saved rules annotation, does not leave the route pointing to no replicas.
+func TestSleepStaleRules(t *testing.T) {
+	ctx := context.Background()
+	m := &K8sManager{}
+	client, gwClient := newSleepTestClients(t)
+
+	sleepAndCheck(t, m, client, gwClient)
+
+	// redeploy: rules are reapplied and the deployment is scaled up, the annotation stays
+	route := getRoute(t, gwClient)
+	route.Spec.Rules = appRules()
+	if _, err := gwClient.GatewayV1().HTTPRoutes(testNamespace).Update(ctx, route, metav1.UpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	deploy := getDeployment(t, client)
+	replicas := int32(1)
+	deploy.Spec.Replicas = &replicas
+	if _, err := client.AppsV1().Deployments(testNamespace).Update(ctx, deploy, metav1.UpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// restoring keeps the reapplied rules
+	if err := restoreRoutes(ctx, gwClient, testNamespace, testName); err != nil {
+		t.Fatalf("failed to restore routes: %v", err)
+	}
+	route = getRoute(t, gwClient)
+	if route.Annotations[annotationSleepRules] != "" || routesToActivator(route) {
+		t.Errorf("stale rules were restored: %+v", route.Spec.Rules)
+	}
+
+	// sleeping with a stale annotation routes to the activator
+	route.Annotations[annotationSleepRules] = "[]"
+	if _, err := gwClient.GatewayV1().HTTPRoutes(testNamespace).Update(ctx, route, metav1.UpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	sleepAndCheck(t, m, client, gwClient)
+	saved := getRoute(t, gwClient).Annotations[annotationSleepRules]
+	want, _ := json.Marshal(appRules())
+	if saved != string(want) {
+		t.Errorf("saved rules = %s, want %s", saved, want)
+	}
+}
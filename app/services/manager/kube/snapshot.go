@@ -0,0 +1,230 @@
+package kube
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudness-io/cloudness/types"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	snapshotAPIGroup               = "snapshot.storage.k8s.io"
+	snapshotDefaultClassAnnotation = "snapshot.storage.kubernetes.io/is-default-class"
+)
+
+var (
+	volumeSnapshotGvr = schema.GroupVersionResource{
+		Group:    snapshotAPIGroup,
+		Version:  "v1",
+		Resource: "volumesnapshots",
+	}
+	volumeSnapshotClassGvr = schema.GroupVersionResource{
+		Group:    snapshotAPIGroup,
+		Version:  "v1",
+		Resource: "volumesnapshotclasses",
+	}
+)
+
+// ListVolumeSnapshotClasses returns no classes when the snapshot CRDs are not installed.
+func (m *K8sManager) ListVolumeSnapshotClasses(ctx context.Context, server *types.Server) ([]*types.VolumeSnapshotClass, error) {
+	dynamicClient, err := m.getDynamicClient(ctx, server)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := dynamicClient.Resource(volumeSnapshotClassGvr).List(ctx, metav1.ListOptions{})
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	classes := make([]*types.VolumeSnapshotClass, 0, len(list.Items))
+	for _, item := range list.Items {
+		driver, _, _ := unstructured.NestedString(item.Object, "driver")
+		classes = append(classes, &types.VolumeSnapshotClass{
+			Name:      item.GetName(),
+			Driver:    driver,
+			IsDefault: item.GetAnnotations()[snapshotDefaultClassAnnotation] == "true",
+		})
+	}
+	return classes, nil
+}
+
+func (m *K8sManager) CreateVolumeSnapshot(ctx context.Context, server *types.Server, volume *types.Volume, snapshot *types.VolumeSnapshot) error {
+	dynamicClient, err := m.getDynamicClient(ctx, server)
+	if err != nil {
+		return err
+	}
+
+	obj := &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": snapshotAPIGroup + "/v1",
+			"kind":       "VolumeSnapshot",
+			"metadata": map[string]any{
+				"name":      snapshot.Name,
+				"namespace": snapshot.Namespace,
+				"labels": map[string]any{
+					"app.kubernetes.io/instance":   volume.GetIdentifierStr(),
+					"app.kubernetes.io/component":  "snapshot",
+					"app.kubernetes.io/managed-by": "cloudness",
+				},
+			},
+			"spec": map[string]any{
+				"volumeSnapshotClassName": snapshot.Class,
+				"source": map[string]any{
+					"persistentVolumeClaimName": volume.GetIdentifierStr(),
+				},
+			},
+		},
+	}
+
+	_, err = dynamicClient.Resource(volumeSnapshotGvr).Namespace(snapshot.Namespace).Create(ctx, obj, metav1.CreateOptions{})
+	if errors.IsAlreadyExists(err) {
+		return nil
+	}
+	return err
+}
+
+func (m *K8sManager) GetVolumeSnapshotState(ctx context.Context, server *types.Server, snapshot *types.VolumeSnapshot) (*types.VolumeSnapshotState, error) {
+	dynamicClient, err := m.getDynamicClient(ctx, server)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := dynamicClient.Resource(volumeSnapshotGvr).Namespace(snapshot.Namespace).Get(ctx, snapshot.Name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return &types.VolumeSnapshotState{Error: "the snapshot no longer exists on the server"}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	state := &types.VolumeSnapshotState{}
+	state.ReadyToUse, _, _ = unstructured.NestedBool(obj.Object, "status", "readyToUse")
+	state.Error, _, _ = unstructured.NestedString(obj.Object, "status", "error", "message")
+	if size, ok, _ := unstructured.NestedString(obj.Object, "status", "restoreSize"); ok {
+		if q, err := resource.ParseQuantity(size); err == nil {
+			state.RestoreSize = q.Value()
+		}
+	}
+	return state, nil
+}
+
+func (m *K8sManager) DeleteVolumeSnapshot(ctx context.Context, server *types.Server, snapshot *types.VolumeSnapshot) error {
+	dynamicClient, err := m.getDynamicClient(ctx, server)
+	if err != nil {
+		return err
+	}
+
+	err = dynamicClient.Resource(volumeSnapshotGvr).Namespace(snapshot.Namespace).Delete(ctx, snapshot.Name, metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// RestoreVolumeSnapshot creates the claim of the volume with the snapshot as its data source, the claim
+// is adopted as is by the next deployment of the application the volume is attached to.
+func (m *K8sManager) RestoreVolumeSnapshot(ctx context.Context, server *types.Server, snapshot *types.VolumeSnapshot, volume *types.Volume) error {
+	client, err := m.getInterface(ctx, server)
+	if err != nil {
+		return err
+	}
+	dynamicClient, err := m.getDynamicClient(ctx, server)
+	if err != nil {
+		return err
+	}
+	return restoreVolumeSnapshot(ctx, client, dynamicClient, snapshot, volume)
+}
+
+func restoreVolumeSnapshot(
+	ctx context.Context,
+	client kubernetes.Interface,
+	dynamicClient dynamic.Interface,
+	snapshot *types.VolumeSnapshot,
+	volume *types.Volume,
+) error {
+	if volume.ParentSlug != snapshot.Namespace {
+		return fmt.Errorf("snapshot %s can only be restored in namespace %s", snapshot.Name, snapshot.Namespace)
+	}
+
+	storageClass, accessModes, err := snapshotSourceClaim(ctx, client, dynamicClient, snapshot)
+	if err != nil {
+		return err
+	}
+
+	apiGroup := snapshotAPIGroup
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      volume.GetIdentifierStr(),
+			Namespace: volume.ParentSlug,
+			Labels: map[string]string{
+				"app.kubernetes.io/component":  "storage",
+				"app.kubernetes.io/managed-by": "cloudness",
+			},
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			StorageClassName: storageClass,
+			AccessModes:      accessModes,
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: resource.MustParse(fmt.Sprintf("%dMi", volume.Size)),
+				},
+			},
+			DataSource: &corev1.TypedLocalObjectReference{
+				APIGroup: &apiGroup,
+				Kind:     "VolumeSnapshot",
+				Name:     snapshot.Name,
+			},
+		},
+	}
+
+	_, err = client.CoreV1().PersistentVolumeClaims(volume.ParentSlug).Create(ctx, pvc, metav1.CreateOptions{})
+	return err
+}
+
+// snapshotSourceClaim returns the storage class and access modes of the claim the snapshot was taken
+// from, the restored claim must use a class of the same CSI driver. When the source claim is gone the
+// default class of the cluster and ReadWriteOnce are used.
+func snapshotSourceClaim(
+	ctx context.Context,
+	client kubernetes.Interface,
+	dynamicClient dynamic.Interface,
+	snapshot *types.VolumeSnapshot,
+) (*string, []corev1.PersistentVolumeAccessMode, error) {
+	defaultModes := []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}
+
+	obj, err := dynamicClient.Resource(volumeSnapshotGvr).Namespace(snapshot.Namespace).Get(ctx, snapshot.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get snapshot %s: %w", snapshot.Name, err)
+	}
+	claimName, _, _ := unstructured.NestedString(obj.Object, "spec", "source", "persistentVolumeClaimName")
+	if claimName == "" {
+		return nil, defaultModes, nil
+	}
+
+	claim, err := client.CoreV1().PersistentVolumeClaims(snapshot.Namespace).Get(ctx, claimName, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return nil, defaultModes, nil
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get source claim %s: %w", claimName, err)
+	}
+
+	accessModes := claim.Spec.AccessModes
+	if len(accessModes) == 0 {
+		accessModes = defaultModes
+	}
+	return claim.Spec.StorageClassName, accessModes, nil
+}
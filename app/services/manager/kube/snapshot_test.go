@@ -0,0 +1,94 @@
+package kube
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudness-io/cloudness/types"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newTestVolumeSnapshot(name, claimName string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": snapshotAPIGroup + "/v1",
+		"kind":       "VolumeSnapshot",
+		"metadata":   map[string]any{"name": name, "namespace": testNamespace},
+		"spec": map[string]any{
+			"volumeSnapshotClassName": "csi-snapclass",
+			"source":                  map[string]any{"persistentVolumeClaimName": claimName},
+		},
+	}}
+}
+
+func TestRestoreVolumeSnapshot(t *testing.T) {
+	ctx := context.Background()
+	class := "fast-rwx"
+
+	tests := []struct {
+		name       string
+		objects    []runtime.Object
+		wantClass  *string
+		wantAccess corev1.PersistentVolumeAccessMode
+	}{
+		{
+			name: "copies the source claim",
+			objects: []runtime.Object{&corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Name: "data", Namespace: testNamespace},
+				Spec: corev1.PersistentVolumeClaimSpec{
+					StorageClassName: &class,
+					AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany},
+				},
+			}},
+			wantClass:  &class,
+			wantAccess: corev1.ReadWriteMany,
+		},
+		{
+			name:       "source claim deleted",
+			wantAccess: corev1.ReadWriteOnce,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := fake.NewClientset(tc.objects...)
+			dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), newTestVolumeSnapshot("data-snap-1", "data"))
+
+			snapshot := &types.VolumeSnapshot{Namespace: testNamespace, Name: "data-snap-1"}
+			volume := &types.Volume{Slug: "data-restored", ParentSlug: testNamespace, Size: 1024}
+			if err := restoreVolumeSnapshot(ctx, client, dynamicClient, snapshot, volume); err != nil {
+				t.Fatalf("restore: %v", err)
+			}
+
+			pvc, err := client.CoreV1().PersistentVolumeClaims(testNamespace).Get(ctx, "data-restored", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("get restored claim: %v", err)
+			}
+			if (pvc.Spec.StorageClassName == nil) != (tc.wantClass == nil) ||
+				(tc.wantClass != nil && *pvc.Spec.StorageClassName != *tc.wantClass) {
+				t.Errorf("storage class = %v, want %v", pvc.Spec.StorageClassName, tc.wantClass)
+			}
+			if len(pvc.Spec.AccessModes) != 1 || pvc.Spec.AccessModes[0] != tc.wantAccess {
+				t.Errorf("access modes = %v, want [%s]", pvc.Spec.AccessModes, tc.wantAccess)
+			}
+			if pvc.Spec.DataSource == nil || pvc.Spec.DataSource.Name != "data-snap-1" {
+				t.Errorf("data source = %v, want the snapshot", pvc.Spec.DataSource)
+			}
+		})
+	}
+}
+
+func TestRestoreVolumeSnapshotOtherNamespace(t *testing.T) {
+	snapshot := &types.VolumeSnapshot{Namespace: testNamespace, Name: "data-snap-1"}
+	volume := &types.Volume{Slug: "data", ParentSlug: "project-2"}
+	err := restoreVolumeSnapshot(context.Background(), fake.NewClientset(),
+		dynamicfake.NewSimpleDynamicClient(runtime.NewScheme()), snapshot, volume)
+	if err == nil {
+		t.Fatal("expected an error restoring into another namespace")
+	}
+}
@@ -87,6 +87,40 @@ func (m *K8sManager) ListApplicationStatuses(ctx context.Context, server *types.
 		mergeAppStatus(statusByApp, candidate)
 	}
 
+	dynamicClient, err := m.getDynamicClient(ctx, server)
+	if err != nil {
+		return nil, err
+	}
+	clusters, err := listPostgresClusters(ctx, dynamicClient)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cluster := range clusters {
+		appUID := m.getApplicationUIDFromPodLabels(cluster.GetLabels())
+		projectID := m.getProjectIDFromPodLabels(cluster.GetLabels())
+		updatedAt := m.getUpdateTimeFromPodAnnotations(cluster.GetAnnotations())
+
+		if appUID == 0 {
+			continue
+		}
+		if updatedAt != 0 && updatedAt > (nowMilli-10_000) {
+			continue
+		}
+
+		status, reason := evaluatePostgresClusterStatus(cluster)
+		candidate := &types.AppStatus{
+			Timestamp:      now,
+			ApplicationUID: appUID,
+			ProjectID:      projectID,
+			InstanceName:   getApplicationNameFromLabels(cluster.GetLabels(), cluster.GetName()),
+			Status:         status,
+			Reason:         reason,
+		}
+
+		mergeAppStatus(statusByApp, candidate)
+	}
+
 	pods, err := client.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
 		LabelSelector: "app.kubernetes.io/managed-by=cloudness",
 	})
@@ -95,6 +129,10 @@ func (m *K8sManager) ListApplicationStatuses(ctx context.Context, server *types.
 	}
 
 	for _, pod := range pods.Items {
+		// pods of a postgres cluster restart on failover, the cluster reports their status
+		if pod.Labels[postgresClusterPodLabel] != "" {
+			continue
+		}
 		appUID := m.getApplicationUIDFromPodLabels(pod.Labels)
 		projectID := m.getProjectIDFromPodLabels(pod.Labels)
 		updatedAt := m.getUpdateTimeFromPodAnnotations(pod.Annotations)
@@ -202,6 +240,9 @@ func evaluateDeploymentStatus(deploy appsv1.Deployment) (enum.ApplicationStatus,
 	// if desired == 0 {
 	// 	return enum.ApplicationStatusSleeping, "scaled to zero"
 	// }
+	if isSleeping(&deploy) {
+		return enum.ApplicationStatusSleeping, "scaled to zero"
+	}
 
 	for _, cond := range deploy.Status.Conditions {
 		if cond.Type == appsv1.DeploymentProgressing && cond.Status == corev1.ConditionFalse {
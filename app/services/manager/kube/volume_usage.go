@@ -0,0 +1,78 @@
+package kube
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cloudness-io/cloudness/types"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// statsSummary is the subset of the kubelet stats summary holding the usage of pod volumes.
+type statsSummary struct {
+	Pods []struct {
+		Volumes []struct {
+			CapacityBytes *int64 `json:"capacityBytes"`
+			UsedBytes     *int64 `json:"usedBytes"`
+			PVCRef        *struct {
+				Name      string `json:"name"`
+				Namespace string `json:"namespace"`
+			} `json:"pvcRef"`
+		} `json:"volume"`
+	} `json:"pods"`
+}
+
+// ListVolumeUsage returns the disk usage of the mounted persistent volume claims from the kubelet of every node.
+// Volumes that are not mounted by a running pod are not reported.
+func (m *K8sManager) ListVolumeUsage(ctx context.Context, server *types.Server) ([]*types.VolumeUsage, error) {
+	client, err := m.getInterface(ctx, server)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes, err := client.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	usages := make([]*types.VolumeUsage, 0)
+	seen := make(map[string]bool)
+	for _, node := range nodes.Items {
+		raw, err := client.CoreV1().RESTClient().Get().
+			AbsPath("/api/v1/nodes", node.Name, "proxy", "stats", "summary").
+			DoRaw(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get stats summary of node %s: %w", node.Name, err)
+		}
+
+		summary := new(statsSummary)
+		if err := json.Unmarshal(raw, summary); err != nil {
+			return nil, fmt.Errorf("failed to parse stats summary of node %s: %w", node.Name, err)
+		}
+
+		for _, pod := range summary.Pods {
+			for _, volume := range pod.Volumes {
+				if volume.PVCRef == nil || volume.CapacityBytes == nil || volume.UsedBytes == nil {
+					continue
+				}
+				// a claim mounted by several pods is reported once per pod
+				key := volume.PVCRef.Namespace + "/" + volume.PVCRef.Name
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+
+				usages = append(usages, &types.VolumeUsage{
+					Namespace:     volume.PVCRef.Namespace,
+					Name:          volume.PVCRef.Name,
+					UsedBytes:     *volume.UsedBytes,
+					CapacityBytes: *volume.CapacityBytes,
+				})
+			}
+		}
+	}
+
+	return usages, nil
+}
@@ -2,6 +2,7 @@ package manager
 
 import (
 	"context"
+	"io"
 
 	"github.com/cloudness-io/cloudness/types"
 	"github.com/cloudness-io/cloudness/types/enum"
@@ -9,6 +10,11 @@ import (
 
 type ServerManager interface {
 	GetIP(ctx context.Context, server *types.Server) (string, error)
+
+	//Cluster
+	CheckHealth(ctx context.Context, server *types.Server) (*types.ServerHealth, error)
+	Bootstrap(ctx context.Context, server *types.Server) error
+
 	//delets
 	DeleteResources(ctx context.Context, server *types.Server, namespace string, identifier string) error
 	DeleteNamespace(ctx context.Context, server *types.Server, namespace string) error
@@ -33,9 +39,36 @@ type ServerManager interface {
 	ListArtifacts(ctx context.Context, server *types.Server, app *types.Application) ([]*types.Artifact, error)
 	TailLogs(ctx context.Context, server *types.Server, app *types.Application) (<-chan *types.ArtifactLogLine, <-chan error, error)
 
+	//Terminal
+	ListExecTargets(ctx context.Context, server *types.Server, app *types.Application) ([]*types.ExecTarget, error)
+	Exec(ctx context.Context, server *types.Server, app *types.Application, target *types.ExecTarget, streams *types.ExecStreams) error
+
+	//Backups
+	RunBackupJob(ctx context.Context, server *types.Server, app *types.Application, job *types.BackupJob, stdin io.Reader, stdout io.Writer) error
+
+	//Volume snapshots
+	ListVolumeSnapshotClasses(ctx context.Context, server *types.Server) ([]*types.VolumeSnapshotClass, error)
+	CreateVolumeSnapshot(ctx context.Context, server *types.Server, volume *types.Volume, snapshot *types.VolumeSnapshot) error
+	GetVolumeSnapshotState(ctx context.Context, server *types.Server, snapshot *types.VolumeSnapshot) (*types.VolumeSnapshotState, error)
+	DeleteVolumeSnapshot(ctx context.Context, server *types.Server, snapshot *types.VolumeSnapshot) error
+	RestoreVolumeSnapshot(ctx context.Context, server *types.Server, snapshot *types.VolumeSnapshot, volume *types.Volume) error
+
+	//Autoscaling
+	GetAutoscalingStatus(ctx context.Context, server *types.Server, app *types.Application) (*types.AutoscalingStatus, error)
+
+	//Sleep
+	IdleApplications(ctx context.Context, server *types.Server) (int, error)
+	WakeApplication(ctx context.Context, server *types.Server, namespace, name, token string) (string, error)
+
+	//Cron
+	ListCronRuns(ctx context.Context, server *types.Server, app *types.Application) ([]*types.CronRun, error)
+
 	//Metrics
 	ListMetrics(ctx context.Context, server *types.Server) ([]*types.AppMetrics, error)
 
 	//Application status
 	ListApplicationStatuses(ctx context.Context, server *types.Server) ([]*types.AppStatus, error)
+
+	//Volume usage
+	ListVolumeUsage(ctx context.Context, server *types.Server) ([]*types.VolumeUsage, error)
 }
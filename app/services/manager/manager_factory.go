@@ -3,6 +3,7 @@ package manager
 import (
 	"fmt"
 
+	"github.com/cloudness-io/cloudness/app/services/manager/docker"
 	"github.com/cloudness-io/cloudness/app/services/manager/kube"
 	"github.com/cloudness-io/cloudness/types"
 	"github.com/cloudness-io/cloudness/types/enum"
@@ -12,9 +13,10 @@ type ManagerFactory struct {
 	managers map[enum.ServerType]ServerManager
 }
 
-func NewManagerFactory(k8sManager *kube.K8sManager) ManagerFactory {
+func NewManagerFactory(k8sManager *kube.K8sManager, dockerManager *docker.DockerManager) ManagerFactory {
 	managers := make(map[enum.ServerType]ServerManager)
 	managers[enum.ServerTypeK8s] = k8sManager
+	managers[enum.ServerTypeDocker] = dockerManager
 	return ManagerFactory{
 		managers: managers,
 	}
@@ -2,7 +2,9 @@ package manager
 
 import (
 	"github.com/cloudness-io/cloudness/app/services/config"
+	"github.com/cloudness-io/cloudness/app/services/manager/docker"
 	"github.com/cloudness-io/cloudness/app/services/manager/kube"
+	"github.com/cloudness-io/cloudness/encrypt"
 
 	"github.com/google/wire"
 )
@@ -10,12 +12,17 @@ import (
 var WireSet = wire.NewSet(
 	ProvideManagerFactory,
 	ProvideK8sManager,
+	ProvideDockerManager,
 )
 
-func ProvideK8sManager(configSvc *config.Service) *kube.K8sManager {
-	return kube.NewK8sManager(configSvc)
+func ProvideK8sManager(configSvc *config.Service, encrypter encrypt.Encrypter) *kube.K8sManager {
+	return kube.NewK8sManager(configSvc, encrypter)
 }
 
-func ProvideManagerFactory(k8sManager *kube.K8sManager) ManagerFactory {
-	return NewManagerFactory(k8sManager)
+func ProvideDockerManager() *docker.DockerManager {
+	return docker.NewDockerManager()
+}
+
+func ProvideManagerFactory(k8sManager *kube.K8sManager, dockerManager *docker.DockerManager) ManagerFactory {
+	return NewManagerFactory(k8sManager, dockerManager)
 }
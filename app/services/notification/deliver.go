@@ -0,0 +1,170 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	cloudhttp "github.com/cloudness-io/cloudness/http"
+	"github.com/cloudness-io/cloudness/job"
+	baseStore "github.com/cloudness-io/cloudness/store"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+const (
+	// HeaderSignature holds the hex encoded HMAC-SHA256 of the body of generic webhooks, keyed with the signing key.
+	HeaderSignature = "X-Cloudness-Signature"
+	// HeaderEvent holds the event of generic webhooks.
+	HeaderEvent = "X-Cloudness-Event"
+)
+
+var httpClient = cloudhttp.NewExternalClient(15 * time.Second)
+
+type deliverJob struct {
+	svc *Service
+}
+
+func newDeliverJob(svc *Service) *deliverJob {
+	return &deliverJob{
+		svc: svc,
+	}
+}
+
+// Handle delivers the notification to its channel, failed deliveries are retried by the job scheduler.
+func (j *deliverJob) Handle(ctx context.Context, data string, _ job.ProgressReporter) (string, error) {
+	in := new(deliverData)
+	if err := json.Unmarshal([]byte(data), in); err != nil {
+		return "", fmt.Errorf("invalid notification data: %w", err)
+	}
+
+	channel, err := j.svc.channelStore.Find(ctx, in.ChannelID)
+	if baseStore.IsNotFound(err) {
+		return "channel was deleted", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to find notification channel %d: %w", in.ChannelID, err)
+	}
+
+	if err := j.svc.Resolve(channel); err != nil {
+		return "", fmt.Errorf("failed to decrypt notification channel %d: %w", channel.ID, err)
+	}
+
+	if err := j.svc.send(ctx, channel, in.Message); err != nil {
+		return "", fmt.Errorf("failed to deliver notification to channel %d: %w", channel.ID, err)
+	}
+	return fmt.Sprintf("delivered %s to %s channel", in.Message.Event, channel.Type), nil
+}
+
+func (s *Service) send(ctx context.Context, channel *types.NotificationChannel, msg *types.NotificationMessage) error {
+	switch channel.Type {
+	case enum.NotificationChannelTypeSlack:
+		return s.postJSON(ctx, channel.Target, map[string]string{"text": plainText(msg)}, nil)
+	case enum.NotificationChannelTypeDiscord:
+		return s.postJSON(ctx, channel.Target, map[string]string{"content": plainText(msg)}, nil)
+	case enum.NotificationChannelTypeWebhook:
+		return s.postJSON(ctx, channel.Target, msg, func(req *http.Request, body []byte) {
+			req.Header.Set(HeaderEvent, string(msg.Event))
+			if channel.Secret != "" {
+				req.Header.Set(HeaderSignature, "sha256="+Sign(channel.Secret, body))
+			}
+		})
+	case enum.NotificationChannelTypeEmail:
+		return s.sendEmail(channel.Target, msg)
+	default:
+		return fmt.Errorf("unknown notification channel type %q", channel.Type)
+	}
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of the body keyed with the secret.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s *Service) postJSON(ctx context.Context, url string, payload any, decorate func(*http.Request, []byte)) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if decorate != nil {
+		decorate(req, body)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// the body is not read, it is controlled by the target and must not end up in the ui
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// sendEmail sends the message through the configured smtp server, STARTTLS is used when the server offers it.
+func (s *Service) sendEmail(recipients string, msg *types.NotificationMessage) error {
+	if !s.SupportsEmail() {
+		return errors.New("no smtp server is configured")
+	}
+
+	to := SplitRecipients(recipients)
+	if len(to) == 0 {
+		return errors.New("no recipients")
+	}
+
+	var body bytes.Buffer
+	fmt.Fprintf(&body, "From: %s\r\n", s.smtp.From)
+	fmt.Fprintf(&body, "To: %s\r\n", strings.Join(to, ", "))
+	fmt.Fprintf(&body, "Subject: [Cloudness] %s\r\n", msg.Title)
+	fmt.Fprintf(&body, "Date: %s\r\n", time.UnixMilli(msg.Timestamp).UTC().Format(time.RFC1123Z))
+	body.WriteString("MIME-Version: 1.0\r\n")
+	body.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	body.WriteString(strings.ReplaceAll(plainText(msg), "\n", "\r\n"))
+	body.WriteString("\r\n")
+
+	var auth smtp.Auth
+	if s.smtp.Username != "" {
+		auth = smtp.PlainAuth("", s.smtp.Username, s.smtp.Password, s.smtp.Host)
+	}
+	addr := net.JoinHostPort(s.smtp.Host, strconv.Itoa(s.smtp.Port))
+	return smtp.SendMail(addr, auth, s.smtp.From, to, body.Bytes())
+}
+
+// SplitRecipients splits a comma separated list of email addresses.
+func SplitRecipients(recipients string) []string {
+	to := []string{}
+	for _, r := range strings.Split(recipients, ",") {
+		if r = strings.TrimSpace(r); r != "" {
+			to = append(to, r)
+		}
+	}
+	return to
+}
+
+func plainText(msg *types.NotificationMessage) string {
+	if msg.Text == "" {
+		return msg.Title
+	}
+	return msg.Title + "\n" + msg.Text
+}
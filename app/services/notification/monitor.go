@@ -0,0 +1,201 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudness-io/cloudness/job"
+	baseStore "github.com/cloudness-io/cloudness/store"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	alertPrefixCertificate = "certificate:"
+	alertPrefixVolume      = "volume:"
+
+	mib = 1024 * 1024
+)
+
+// conditions are the certificates and volume usages of all servers, a kind is only checked
+// when it could be listed on every server.
+type conditions struct {
+	certs         []*types.Certificate
+	certsChecked  bool
+	usages        []*types.VolumeUsage
+	usagesChecked bool
+}
+
+type monitorJob struct {
+	svc *Service
+}
+
+func newMonitorJob(svc *Service) *monitorJob {
+	return &monitorJob{
+		svc: svc,
+	}
+}
+
+// Handle notifies failed certificates and volumes above the usage threshold of every server.
+// Every condition is notified once and notified again only after it resolved in between.
+func (j *monitorJob) Handle(ctx context.Context, _ string, _ job.ProgressReporter) (string, error) {
+	conditions, err := j.collect(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	keys, err := j.svc.alertStore.List(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to list open alerts: %w", err)
+	}
+	open := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		open[key] = true
+	}
+
+	// alerts are only resolved for the conditions that could be checked
+	active := make(map[string]bool)
+	checked := []string{}
+	notified := 0
+
+	if conditions.certsChecked {
+		checked = append(checked, alertPrefixCertificate)
+	}
+	for _, cert := range conditions.certs {
+		if cert.Ready != "False" {
+			continue
+		}
+		key := alertPrefixCertificate + cert.Namespace + "/" + cert.Name
+		active[key] = true
+		if open[key] {
+			continue
+		}
+		if err := j.svc.NotifyAllTenants(ctx, certificateMessage(cert)); err != nil {
+			return "", err
+		}
+		if err := j.svc.alertStore.Create(ctx, key, time.Now().UTC().UnixMilli()); err != nil {
+			return "", err
+		}
+		notified++
+	}
+
+	if conditions.usagesChecked {
+		checked = append(checked, alertPrefixVolume)
+	}
+	for _, usage := range conditions.usages {
+		if usage.UsedPercent() < j.svc.volumeUsageThreshold {
+			continue
+		}
+		key := alertPrefixVolume + usage.Namespace + "/" + usage.Name
+		active[key] = true
+		if open[key] {
+			continue
+		}
+		sent, err := j.notifyVolume(ctx, usage)
+		if err != nil {
+			return "", err
+		}
+		if !sent {
+			continue
+		}
+		if err := j.svc.alertStore.Create(ctx, key, time.Now().UTC().UnixMilli()); err != nil {
+			return "", err
+		}
+		notified++
+	}
+
+	for _, key := range keys {
+		if active[key] || !hasAnyPrefix(key, checked) {
+			continue
+		}
+		if err := j.svc.alertStore.Delete(ctx, key); err != nil {
+			return "", err
+		}
+	}
+
+	return fmt.Sprintf("notified %d alerts", notified), nil
+}
+
+// collect lists the certificates and volume usages of every server.
+func (j *monitorJob) collect(ctx context.Context) (*conditions, error) {
+	servers, err := j.svc.serverCtrl.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list servers: %w", err)
+	}
+
+	out := &conditions{certsChecked: true, usagesChecked: true}
+	for _, server := range servers {
+		mgr, err := j.svc.factory.GetServerManager(server)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get server manager: %w", err)
+		}
+
+		certs, err := mgr.ListCertificates(ctx, server)
+		if err != nil {
+			log.Ctx(ctx).Warn().Err(err).Str("server", server.Name).Msg("notification: failed to list certificates")
+			out.certsChecked = false
+		}
+		out.certs = append(out.certs, certs...)
+
+		usages, err := mgr.ListVolumeUsage(ctx, server)
+		if err != nil {
+			log.Ctx(ctx).Warn().Err(err).Str("server", server.Name).Msg("notification: failed to list volume usage")
+			out.usagesChecked = false
+		}
+		out.usages = append(out.usages, usages...)
+	}
+	return out, nil
+}
+
+// notifyVolume notifies the project of the volume, claims that do not belong to a volume are ignored.
+func (j *monitorJob) notifyVolume(ctx context.Context, usage *types.VolumeUsage) (bool, error) {
+	volume, err := j.svc.volumeStore.FindBySlug(ctx, usage.Namespace, usage.Name)
+	if baseStore.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to find volume %s/%s: %w", usage.Namespace, usage.Name, err)
+	}
+
+	err = j.svc.Notify(ctx, volume.TenantID, volume.ProjectID, &types.NotificationMessage{
+		Event: enum.NotificationEventVolumeNearFull,
+		Title: fmt.Sprintf("%s: %s", enum.NotificationEventVolumeNearFull.Title(), volume.Name),
+		Text: fmt.Sprintf("%d MiB of %d MiB used (%.0f%%)",
+			usage.UsedBytes/mib, usage.CapacityBytes/mib, usage.UsedPercent()),
+		Attributes: map[string]string{
+			"volume":     volume.Name,
+			"volume_uid": strconv.FormatInt(volume.UID, 10),
+		},
+	})
+	return err == nil, err
+}
+
+func certificateMessage(cert *types.Certificate) *types.NotificationMessage {
+	text := strings.Join(cert.DNSNames, ", ")
+	if cert.Message != "" {
+		text = fmt.Sprintf("%s\n%s", text, cert.Message)
+	}
+	return &types.NotificationMessage{
+		Event: enum.NotificationEventCertificateFailed,
+		Title: fmt.Sprintf("%s: %s", enum.NotificationEventCertificateFailed.Title(), cert.Name),
+		Text:  text,
+		Attributes: map[string]string{
+			"certificate": cert.Name,
+			"dns_names":   strings.Join(cert.DNSNames, ","),
+		},
+	}
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
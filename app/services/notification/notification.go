@@ -0,0 +1,229 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cloudness-io/cloudness/app/controller/server"
+	"github.com/cloudness-io/cloudness/app/services/manager"
+	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/encrypt"
+	"github.com/cloudness-io/cloudness/job"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+const (
+	jobTypeDeliver        = "cloudness:notifications:deliver"
+	jobMaxDurationDeliver = 30 * time.Second
+	jobMaxRetriesDeliver  = 3
+
+	jobTypeMonitor        = "cloudness:notifications:monitor"
+	jobCronMonitor        = "*/5 * * * *" // Every 5 minutes
+	jobMaxDurationMonitor = 2 * time.Minute
+)
+
+// SMTPConfig is the mail server used to deliver email channels.
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+}
+
+type Service struct {
+	//job related
+	scheduler *job.Scheduler
+	executor  *job.Executor
+
+	//stores
+	channelStore store.NotificationChannelStore
+	alertStore   store.NotificationAlertStore
+	volumeStore  store.VolumeStore
+
+	encrypter  encrypt.Encrypter
+	serverCtrl *server.Controller
+	factory    manager.ManagerFactory
+
+	smtp                 SMTPConfig
+	volumeUsageThreshold float64
+}
+
+func New(
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	channelStore store.NotificationChannelStore,
+	alertStore store.NotificationAlertStore,
+	volumeStore store.VolumeStore,
+	encrypter encrypt.Encrypter,
+	serverCtrl *server.Controller,
+	factory manager.ManagerFactory,
+	smtp SMTPConfig,
+	volumeUsageThreshold int,
+) *Service {
+	return &Service{
+		scheduler:            scheduler,
+		executor:             executor,
+		channelStore:         channelStore,
+		alertStore:           alertStore,
+		volumeStore:          volumeStore,
+		encrypter:            encrypter,
+		serverCtrl:           serverCtrl,
+		factory:              factory,
+		smtp:                 smtp,
+		volumeUsageThreshold: float64(volumeUsageThreshold),
+	}
+}
+
+func (s *Service) Register(ctx context.Context) error {
+	if err := s.executor.Register(jobTypeDeliver, newDeliverJob(s)); err != nil {
+		return fmt.Errorf("failed to register job handler for notification delivery: %w", err)
+	}
+	if err := s.executor.Register(jobTypeMonitor, newMonitorJob(s)); err != nil {
+		return fmt.Errorf("failed to register job handler for notification monitor: %w", err)
+	}
+
+	if err := s.scheduler.AddRecurring(
+		ctx,
+		jobTypeMonitor,
+		jobTypeMonitor,
+		jobCronMonitor,
+		jobMaxDurationMonitor,
+	); err != nil {
+		return fmt.Errorf("failed to schedule notification monitor job: %w", err)
+	}
+
+	return nil
+}
+
+// SupportsEmail returns true if a mail server is configured for email channels.
+func (s *Service) SupportsEmail() bool {
+	return s.smtp.Host != ""
+}
+
+// NotifyDeployment notifies the subscribers of the application project about the deployment status.
+func (s *Service) NotifyDeployment(ctx context.Context, app *types.Application, deployment *types.Deployment) error {
+	event := enum.NotificationEventFromDeploymentStatus(deployment.Status)
+	if event == "" {
+		return nil
+	}
+
+	text := deployment.GetInfo().Title
+	if deployment.Status == enum.DeploymentStatusFailed && deployment.Error != "" {
+		text = fmt.Sprintf("%s\n%s", text, deployment.Error)
+	}
+
+	return s.Notify(ctx, app.TenantID, app.ProjectID, &types.NotificationMessage{
+		Event: event,
+		Title: fmt.Sprintf("%s: %s", event.Title(), app.Name),
+		Text:  text,
+		Attributes: map[string]string{
+			"application":     app.Name,
+			"application_uid": strconv.FormatInt(app.UID, 10),
+			"deployment_uid":  strconv.FormatInt(deployment.UID, 10),
+			"status":          string(deployment.Status),
+		},
+	})
+}
+
+// NotifyApplicationCrashed notifies the subscribers of the application project that its instances are failing.
+func (s *Service) NotifyApplicationCrashed(ctx context.Context, app *types.Application, reason string) error {
+	return s.Notify(ctx, app.TenantID, app.ProjectID, &types.NotificationMessage{
+		Event: enum.NotificationEventAppCrashed,
+		Title: fmt.Sprintf("%s: %s", enum.NotificationEventAppCrashed.Title(), app.Name),
+		Text:  reason,
+		Attributes: map[string]string{
+			"application":     app.Name,
+			"application_uid": strconv.FormatInt(app.UID, 10),
+		},
+	})
+}
+
+// Notify queues the delivery of the message to the channels of the project and its tenant subscribed to the event.
+func (s *Service) Notify(ctx context.Context, tenantID, projectID int64, msg *types.NotificationMessage) error {
+	channels, err := s.channelStore.ListForProject(ctx, tenantID, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to list notification channels: %w", err)
+	}
+	return s.queue(ctx, channels, msg)
+}
+
+// NotifyAllTenants queues the delivery of the message to the tenant level channels of every tenant,
+// it is used for events of the server shared by all tenants.
+func (s *Service) NotifyAllTenants(ctx context.Context, msg *types.NotificationMessage) error {
+	channels, err := s.channelStore.ListAllTenantLevel(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list notification channels: %w", err)
+	}
+	return s.queue(ctx, channels, msg)
+}
+
+type deliverData struct {
+	ChannelID int64                      `json:"channel_id"`
+	Message   *types.NotificationMessage `json:"message"`
+}
+
+func (s *Service) queue(ctx context.Context, channels []*types.NotificationChannel, msg *types.NotificationMessage) error {
+	if msg.Timestamp == 0 {
+		msg.Timestamp = time.Now().UTC().UnixMilli()
+	}
+
+	defs := []job.Definition{}
+	for _, channel := range channels {
+		if !channel.Subscribes(msg.Event) {
+			continue
+		}
+
+		data, err := json.Marshal(&deliverData{ChannelID: channel.ID, Message: msg})
+		if err != nil {
+			return fmt.Errorf("failed to marshal notification: %w", err)
+		}
+		defs = append(defs, job.Definition{
+			UID:        fmt.Sprintf("notification-%d-%d", channel.ID, time.Now().UnixNano()),
+			Type:       jobTypeDeliver,
+			MaxRetries: jobMaxRetriesDeliver,
+			Timeout:    jobMaxDurationDeliver,
+			Data:       string(data),
+		})
+	}
+
+	if err := s.scheduler.RunJobs(ctx, jobTypeDeliver, defs); err != nil {
+		return fmt.Errorf("failed to queue notifications: %w", err)
+	}
+	return nil
+}
+
+// Resolve decrypts the destination and signing key of the channel.
+func (s *Service) Resolve(channel *types.NotificationChannel) error {
+	target, err := s.encrypter.Decrypt(channel.Destination)
+	if err != nil {
+		return err
+	}
+	channel.Target = target
+
+	if len(channel.SigningKey) > 0 {
+		secret, err := s.encrypter.Decrypt(channel.SigningKey)
+		if err != nil {
+			return err
+		}
+		channel.Secret = secret
+	}
+	return nil
+}
+
+// SendTest delivers a test message to the channel right away.
+func (s *Service) SendTest(ctx context.Context, channel *types.NotificationChannel) error {
+	if err := s.Resolve(channel); err != nil {
+		return err
+	}
+	return s.send(ctx, channel, &types.NotificationMessage{
+		Event:     enum.NotificationEventDeploySucceeded,
+		Title:     "Test notification",
+		Text:      fmt.Sprintf("Notifications of %s are delivered to this channel.", channel.Name),
+		Timestamp: time.Now().UTC().UnixMilli(),
+	})
+}
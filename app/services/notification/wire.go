@@ -0,0 +1,47 @@
+package notification
+
+import (
+	"github.com/cloudness-io/cloudness/app/controller/server"
+	"github.com/cloudness-io/cloudness/app/services/manager"
+	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/encrypt"
+	"github.com/cloudness-io/cloudness/job"
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/google/wire"
+)
+
+var WireSet = wire.NewSet(
+	ProvideService,
+)
+
+func ProvideService(
+	config *types.Config,
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	channelStore store.NotificationChannelStore,
+	alertStore store.NotificationAlertStore,
+	volumeStore store.VolumeStore,
+	encrypter encrypt.Encrypter,
+	serverCtrl *server.Controller,
+	factory manager.ManagerFactory,
+) *Service {
+	return New(
+		scheduler,
+		executor,
+		channelStore,
+		alertStore,
+		volumeStore,
+		encrypter,
+		serverCtrl,
+		factory,
+		SMTPConfig{
+			Host:     config.Notification.SMTP.Host,
+			Port:     config.Notification.SMTP.Port,
+			Username: config.Notification.SMTP.Username,
+			Password: config.Notification.SMTP.Password,
+			From:     config.Notification.SMTP.From,
+		},
+		config.Notification.VolumeUsageThreshold,
+	)
+}
@@ -0,0 +1,90 @@
+package sleep
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+
+	"github.com/cloudness-io/cloudness/app/controller/server"
+	"github.com/cloudness-io/cloudness/app/services/manager"
+	"github.com/cloudness-io/cloudness/app/services/manager/kube"
+	"github.com/cloudness-io/cloudness/app/usererror"
+
+	"github.com/rs/zerolog/log"
+	"golang.org/x/sync/singleflight"
+)
+
+// Activator holds requests of sleeping applications, the gateway routes them here with the
+// kube.ActivatorHeader and kube.ActivatorTokenHeader set. It wakes the application and proxies
+// the request once a replica is ready. Only the gateway of the primary cluster reaches the
+// activator, applications of other servers are never put to sleep.
+type Activator struct {
+	serverCtrl    *server.Controller
+	serverFactory manager.ManagerFactory
+
+	// concurrent requests of one application share a single wake up
+	wakes singleflight.Group
+}
+
+func NewActivator(serverCtrl *server.Controller, serverFactory manager.ManagerFactory) *Activator {
+	return &Activator{
+		serverCtrl:    serverCtrl,
+		serverFactory: serverFactory,
+	}
+}
+
+// IsActivatorTraffic returns true if the request is for a sleeping application.
+func IsActivatorTraffic(r *http.Request) bool {
+	return r.Header.Get(kube.ActivatorHeader) != "" && r.Header.Get(kube.ActivatorTokenHeader) != ""
+}
+
+// StripActivatorHeaders removes the activator headers a client sent along with a public request.
+func StripActivatorHeaders(r *http.Request) {
+	r.Header.Del(kube.ActivatorHeader)
+	r.Header.Del(kube.ActivatorTokenHeader)
+}
+
+func (a *Activator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	key := r.Header.Get(kube.ActivatorHeader)
+	token := r.Header.Get(kube.ActivatorTokenHeader)
+	namespace, name, ok := strings.Cut(key, "/")
+	if !ok || namespace == "" || name == "" {
+		http.Error(w, "invalid activation request", http.StatusBadRequest)
+		return
+	}
+
+	// requests only share a wake up with requests carrying the same token
+	target, err, _ := a.wakes.Do(key+"/"+token, func() (any, error) {
+		// shared by all waiting requests, a disconnecting client must not abort the wake up
+		ctx := context.WithoutCancel(ctx)
+		// the primary server, the activator is only routed to from its cluster
+		server, err := a.serverCtrl.Get(ctx)
+		if err != nil {
+			return "", err
+		}
+		mgr, err := a.serverFactory.GetServerManager(server)
+		if err != nil {
+			return "", err
+		}
+		return mgr.WakeApplication(ctx, server, namespace, name, token)
+	})
+	var uErr *usererror.Error
+	if errors.As(err, &uErr) {
+		log.Ctx(ctx).Warn().Err(err).Str("application", key).Msg("activator: rejected activation request")
+		http.Error(w, uErr.Message, uErr.Status)
+		return
+	}
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Str("application", key).Msg("activator: failed to wake application")
+		http.Error(w, "application is waking up, please retry", http.StatusServiceUnavailable)
+		return
+	}
+
+	StripActivatorHeaders(r)
+	proxy := httputil.NewSingleHostReverseProxy(&url.URL{Scheme: "http", Host: target.(string)})
+	proxy.ServeHTTP(w, r)
+}
@@ -0,0 +1,63 @@
+package sleep
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cloudness-io/cloudness/app/services/manager"
+	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/job"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	jobTypeIdle        = "cloudness:sleep:idle"
+	jobCronIdle        = "*/1 * * * *" // every minute
+	jobMaxDurationIdle = 5 * time.Minute
+)
+
+type idleJob struct {
+	serverStore   store.ServerStore
+	serverFactory manager.ManagerFactory
+}
+
+func newIdleJob(serverStore store.ServerStore, serverFactory manager.ManagerFactory) *idleJob {
+	return &idleJob{
+		serverStore:   serverStore,
+		serverFactory: serverFactory,
+	}
+}
+
+// Handle scales idle applications with sleep enabled to zero on every server.
+func (j *idleJob) Handle(ctx context.Context, _ string, _ job.ProgressReporter) (string, error) {
+	servers, err := j.serverStore.List(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to list servers: %w", err)
+	}
+
+	total := 0
+	for _, server := range servers {
+		// the activator waking sleeping applications runs in the cluster of the primary server
+		if !server.IsPrimary() {
+			continue
+		}
+		mgr, err := j.serverFactory.GetServerManager(server)
+		if err != nil {
+			return "", err
+		}
+
+		n, err := mgr.IdleApplications(ctx, server)
+		total += n
+		if err != nil {
+			return fmt.Sprintf("put %d applications to sleep", total), fmt.Errorf("failed to idle applications on server %d: %w", server.ID, err)
+		}
+	}
+
+	result := fmt.Sprintf("put %d applications to sleep", total)
+	if total > 0 {
+		log.Ctx(ctx).Info().Msg(result)
+	}
+	return result, nil
+}
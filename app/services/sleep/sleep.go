@@ -0,0 +1,57 @@
+package sleep
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudness-io/cloudness/app/services/manager"
+	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/job"
+)
+
+type Service struct {
+	//job related
+	scheduler *job.Scheduler
+	executor  *job.Executor
+
+	//stores
+	serverStore store.ServerStore
+
+	//factory
+	serverFactory manager.ManagerFactory
+}
+
+func New(
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	serverStore store.ServerStore,
+	serverFactory manager.ManagerFactory,
+) *Service {
+	return &Service{
+		scheduler:     scheduler,
+		executor:      executor,
+		serverStore:   serverStore,
+		serverFactory: serverFactory,
+	}
+}
+
+func (s *Service) Register(ctx context.Context) error {
+	if err := s.executor.Register(
+		jobTypeIdle,
+		newIdleJob(s.serverStore, s.serverFactory),
+	); err != nil {
+		return fmt.Errorf("failed to register job handler for idle applications: %w", err)
+	}
+
+	if err := s.scheduler.AddRecurring(
+		ctx,
+		jobTypeIdle,
+		jobTypeIdle,
+		jobCronIdle,
+		jobMaxDurationIdle,
+	); err != nil {
+		return fmt.Errorf("failed to schedule idle applications job: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package sleep
+
+import (
+	"github.com/cloudness-io/cloudness/app/controller/server"
+	"github.com/cloudness-io/cloudness/app/services/manager"
+	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/job"
+
+	"github.com/google/wire"
+)
+
+var WireSet = wire.NewSet(
+	ProvideService,
+	ProvideActivator,
+)
+
+func ProvideService(
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	serverStore store.ServerStore,
+	serverFactory manager.ManagerFactory,
+) *Service {
+	return New(
+		scheduler,
+		executor,
+		serverStore,
+		serverFactory,
+	)
+}
+
+func ProvideActivator(
+	serverCtrl *server.Controller,
+	serverFactory manager.ManagerFactory,
+) *Activator {
+	return NewActivator(serverCtrl, serverFactory)
+}
@@ -0,0 +1,74 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cloudness-io/cloudness/job"
+	baseStore "github.com/cloudness-io/cloudness/store"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+type scheduleJob struct {
+	svc *Service
+}
+
+func newScheduleJob(svc *Service) *scheduleJob {
+	return &scheduleJob{
+		svc: svc,
+	}
+}
+
+// Handle starts the snapshots of the policies that are due.
+func (j *scheduleJob) Handle(ctx context.Context, _ string, _ job.ProgressReporter) (string, error) {
+	now := time.Now()
+	policies, err := j.svc.policyStore.ListDue(ctx, now.UnixMilli())
+	if err != nil {
+		return "", fmt.Errorf("failed to list due snapshot policies: %w", err)
+	}
+
+	total := 0
+	for _, policy := range policies {
+		started, err := j.start(ctx, policy)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Int64("volume_id", policy.VolumeID).Msg("snapshot: failed to start scheduled snapshot")
+		}
+		if started {
+			total++
+		}
+
+		// the next run moves on even when the snapshot could not start, a failing policy is retried on schedule
+		nextRun, err := NextRun(policy.Schedule, now)
+		if err != nil {
+			return "", fmt.Errorf("failed to compute next run of snapshot policy %d: %w", policy.ID, err)
+		}
+		if err := j.svc.policyStore.UpdateNextRun(ctx, policy.ID, nextRun); err != nil {
+			return "", fmt.Errorf("failed to update next run of snapshot policy %d: %w", policy.ID, err)
+		}
+	}
+
+	result := fmt.Sprintf("started %d scheduled snapshots", total)
+	if total > 0 {
+		log.Ctx(ctx).Info().Msg(result)
+	}
+	return result, nil
+}
+
+func (j *scheduleJob) start(ctx context.Context, policy *types.VolumeSnapshotPolicy) (bool, error) {
+	volume, err := j.svc.volumeStore.Find(ctx, policy.VolumeID)
+	if baseStore.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := j.svc.Start(ctx, volume, enum.VolumeSnapshotTriggerSchedule, string(enum.VolumeSnapshotTriggerSchedule)); err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,224 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudness-io/cloudness/app/controller/server"
+	"github.com/cloudness-io/cloudness/app/services/manager"
+	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/errors"
+	"github.com/cloudness-io/cloudness/helpers"
+	"github.com/cloudness-io/cloudness/job"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/check"
+	"github.com/cloudness-io/cloudness/types/enum"
+
+	"github.com/gorhill/cronexpr"
+)
+
+const (
+	jobTypeSchedule        = "cloudness:snapshots:schedule"
+	jobCronSchedule        = "* * * * *" // Every minute
+	jobMaxDurationSchedule = time.Minute
+
+	jobTypeWait        = "cloudness:snapshots:wait"
+	jobMaxDurationWait = time.Hour
+
+	scheduleFields = 5
+	maxRetention   = 100
+)
+
+type Service struct {
+	//job related
+	scheduler *job.Scheduler
+	executor  *job.Executor
+
+	//stores
+	snapshotStore store.VolumeSnapshotStore
+	policyStore   store.VolumeSnapshotPolicyStore
+	volumeStore   store.VolumeStore
+
+	serverCtrl *server.Controller
+	factory    manager.ManagerFactory
+}
+
+func New(
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	snapshotStore store.VolumeSnapshotStore,
+	policyStore store.VolumeSnapshotPolicyStore,
+	volumeStore store.VolumeStore,
+	serverCtrl *server.Controller,
+	factory manager.ManagerFactory,
+) *Service {
+	return &Service{
+		scheduler:     scheduler,
+		executor:      executor,
+		snapshotStore: snapshotStore,
+		policyStore:   policyStore,
+		volumeStore:   volumeStore,
+		serverCtrl:    serverCtrl,
+		factory:       factory,
+	}
+}
+
+func (s *Service) Register(ctx context.Context) error {
+	if err := s.executor.Register(jobTypeSchedule, newScheduleJob(s)); err != nil {
+		return fmt.Errorf("failed to register job handler for snapshot schedules: %w", err)
+	}
+	if err := s.executor.Register(jobTypeWait, newWaitJob(s)); err != nil {
+		return fmt.Errorf("failed to register job handler for snapshots: %w", err)
+	}
+
+	if err := s.scheduler.AddRecurring(
+		ctx,
+		jobTypeSchedule,
+		jobTypeSchedule,
+		jobCronSchedule,
+		jobMaxDurationSchedule,
+	); err != nil {
+		return fmt.Errorf("failed to schedule snapshot schedule job: %w", err)
+	}
+
+	return nil
+}
+
+// ValidatePolicy validates the five field cron schedule and the retention of a snapshot policy.
+func ValidatePolicy(in *types.VolumeSnapshotPolicyInput) error {
+	errs := check.NewValidationErrors()
+
+	if strings.HasPrefix(in.Schedule, "@") || len(strings.Fields(in.Schedule)) != scheduleFields {
+		errs.AddValidationError("schedule", check.NewValidationError("Schedule must have five fields, e.g. 0 3 * * *"))
+	} else if _, err := cronexpr.Parse(in.Schedule); err != nil {
+		errs.AddValidationError("schedule", check.NewValidationErrorf("Invalid schedule: %s", err))
+	}
+	if in.Retention < 1 || in.Retention > maxRetention {
+		errs.AddValidationError("retention", check.NewValidationErrorf("Retention must be between 1 and %d snapshots", maxRetention))
+	}
+
+	if errs.HasError() {
+		return errs
+	}
+	return nil
+}
+
+// NextRun returns the next time the schedule is due after the given time.
+func NextRun(schedule string, after time.Time) (int64, error) {
+	expr, err := cronexpr.Parse(schedule)
+	if err != nil {
+		return 0, err
+	}
+	return expr.Next(after).UnixMilli(), nil
+}
+
+// Start creates the snapshot of the volume on the server and queues the wait for it to be ready.
+func (s *Service) Start(ctx context.Context, volume *types.Volume, trigger enum.VolumeSnapshotTrigger, createdBy string) (*types.VolumeSnapshot, error) {
+	server, mgr, err := s.getManager(ctx, volume.ServerID)
+	if err != nil {
+		return nil, err
+	}
+	if !server.SupportsVolumeSnapshots() {
+		return nil, errors.PreconditionFailed("Volume snapshots are not enabled on the server, set a VolumeSnapshotClass in the server settings")
+	}
+
+	uid := helpers.GenerateUID()
+	snapshot := &types.VolumeSnapshot{
+		UID:           uid,
+		TenantID:      volume.TenantID,
+		ProjectID:     volume.ProjectID,
+		EnvironmentID: volume.EnvironmentID,
+		VolumeID:      volume.ID,
+		ServerID:      server.ID,
+		Namespace:     volume.ParentSlug,
+		Name:          fmt.Sprintf("%s-snap-%d", volume.GetIdentifierStr(), uid),
+		Class:         server.VolumeSnapshotClass,
+		Trigger:       trigger,
+		Status:        enum.VolumeSnapshotStatusPending,
+		CreatedBy:     createdBy,
+	}
+
+	snapshot, err = s.snapshotStore.Create(ctx, snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := mgr.CreateVolumeSnapshot(ctx, server, volume, snapshot); err != nil {
+		snapshot.Status = enum.VolumeSnapshotStatusFailed
+		snapshot.Error = err.Error()
+		if _, uErr := s.snapshotStore.Update(ctx, snapshot); uErr != nil {
+			return nil, uErr
+		}
+		return nil, fmt.Errorf("failed to create snapshot: %w", err)
+	}
+
+	if err := s.scheduler.RunJob(ctx, job.Definition{
+		UID:     fmt.Sprintf("snapshot-%d", snapshot.ID),
+		Type:    jobTypeWait,
+		Timeout: jobMaxDurationWait,
+		Data:    strconv.FormatInt(snapshot.ID, 10),
+	}); err != nil {
+		return nil, fmt.Errorf("failed to queue snapshot: %w", err)
+	}
+
+	return snapshot, nil
+}
+
+// Restore creates the claim of the new volume from the snapshot, the volume must be in the
+// environment of the snapshot.
+func (s *Service) Restore(ctx context.Context, snapshot *types.VolumeSnapshot, volume *types.Volume) error {
+	if snapshot.Status != enum.VolumeSnapshotStatusReady {
+		return errors.PreconditionFailed("Only ready snapshots can be restored")
+	}
+	if volume.EnvironmentID != snapshot.EnvironmentID {
+		return errors.PreconditionFailed("Snapshots can only be restored into the environment they were taken in")
+	}
+
+	server, mgr, err := s.getManager(ctx, snapshot.ServerID)
+	if err != nil {
+		return err
+	}
+	return mgr.RestoreVolumeSnapshot(ctx, server, snapshot, volume)
+}
+
+// Delete removes the snapshot from the server and the store.
+func (s *Service) Delete(ctx context.Context, snapshot *types.VolumeSnapshot) error {
+	server, mgr, err := s.getManager(ctx, snapshot.ServerID)
+	if err != nil {
+		return err
+	}
+	if err := mgr.DeleteVolumeSnapshot(ctx, server, snapshot); err != nil {
+		return err
+	}
+	return s.snapshotStore.Delete(ctx, snapshot.ID)
+}
+
+// DeleteForVolume removes all snapshots of the volume, used when the volume is purged.
+func (s *Service) DeleteForVolume(ctx context.Context, volumeID int64) error {
+	snapshots, err := s.snapshotStore.List(ctx, volumeID)
+	if err != nil {
+		return err
+	}
+	for _, snapshot := range snapshots {
+		if err := s.Delete(ctx, snapshot); err != nil {
+			return fmt.Errorf("failed to delete snapshot %d: %w", snapshot.ID, err)
+		}
+	}
+	return nil
+}
+
+func (s *Service) getManager(ctx context.Context, serverID int64) (*types.Server, manager.ServerManager, error) {
+	server, err := s.serverCtrl.FindByID(ctx, serverID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mgr, err := s.factory.GetServerManager(server)
+	if err != nil {
+		return nil, nil, err
+	}
+	return server, mgr, nil
+}
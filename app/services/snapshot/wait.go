@@ -0,0 +1,135 @@
+package snapshot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cloudness-io/cloudness/job"
+	baseStore "github.com/cloudness-io/cloudness/store"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+const waitPollInterval = 5 * time.Second
+
+type waitJob struct {
+	svc *Service
+}
+
+func newWaitJob(svc *Service) *waitJob {
+	return &waitJob{
+		svc: svc,
+	}
+}
+
+// Handle waits for the snapshot to be ready to use and prunes the snapshots beyond the retention
+// of the policy.
+func (j *waitJob) Handle(ctx context.Context, data string, _ job.ProgressReporter) (string, error) {
+	id, err := strconv.ParseInt(data, 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid snapshot id %q: %w", data, err)
+	}
+
+	snapshot, err := j.svc.snapshotStore.Find(ctx, id)
+	if baseStore.IsNotFound(err) {
+		return "snapshot was deleted", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to find snapshot %d: %w", id, err)
+	}
+	if snapshot.Status.IsDone() {
+		return "snapshot already finished", nil
+	}
+
+	state, waitErr := j.wait(ctx, snapshot)
+
+	// the outcome is recorded even when the job timed out
+	ctx = context.WithoutCancel(ctx)
+	if waitErr != nil {
+		snapshot.Status = enum.VolumeSnapshotStatusFailed
+		snapshot.Error = waitErr.Error()
+	} else {
+		snapshot.Status = enum.VolumeSnapshotStatusReady
+		snapshot.Size = state.RestoreSize
+	}
+	if _, err := j.svc.snapshotStore.Update(ctx, snapshot); err != nil {
+		return "", err
+	}
+	if waitErr != nil {
+		return "", waitErr
+	}
+
+	if err := j.svc.prune(ctx, snapshot.VolumeID); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Int64("volume_id", snapshot.VolumeID).Msg("snapshot: failed to prune snapshots")
+	}
+
+	return fmt.Sprintf("snapshot %s is ready", snapshot.Name), nil
+}
+
+func (j *waitJob) wait(ctx context.Context, snapshot *types.VolumeSnapshot) (*types.VolumeSnapshotState, error) {
+	server, mgr, err := j.svc.getManager(ctx, snapshot.ServerID)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		state, err := mgr.GetVolumeSnapshotState(ctx, server, snapshot)
+		if err != nil {
+			return nil, err
+		}
+		if state.ReadyToUse {
+			return state, nil
+		}
+		if state.Error != "" {
+			return nil, errors.New(state.Error)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("snapshot was not ready within %s", jobMaxDurationWait)
+		case <-time.After(waitPollInterval):
+		}
+	}
+}
+
+// prune deletes the ready snapshots beyond the retention of the policy, failed snapshots are kept
+// as long as they are newer than the oldest snapshot kept.
+func (s *Service) prune(ctx context.Context, volumeID int64) error {
+	policy, err := s.policyStore.Find(ctx, volumeID)
+	if baseStore.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if policy.Retention < 1 {
+		return nil
+	}
+
+	snapshots, err := s.snapshotStore.List(ctx, volumeID)
+	if err != nil {
+		return err
+	}
+
+	kept := 0
+	for _, snapshot := range snapshots {
+		if !snapshot.Status.IsDone() {
+			continue
+		}
+		if kept < policy.Retention {
+			if snapshot.Status == enum.VolumeSnapshotStatusReady {
+				kept++
+			}
+			continue
+		}
+		if err := s.Delete(ctx, snapshot); err != nil {
+			return fmt.Errorf("failed to delete snapshot %d: %w", snapshot.ID, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package snapshot
+
+import (
+	"github.com/cloudness-io/cloudness/app/controller/server"
+	"github.com/cloudness-io/cloudness/app/services/manager"
+	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/job"
+
+	"github.com/google/wire"
+)
+
+var WireSet = wire.NewSet(
+	ProvideService,
+)
+
+func ProvideService(
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	snapshotStore store.VolumeSnapshotStore,
+	policyStore store.VolumeSnapshotPolicyStore,
+	volumeStore store.VolumeStore,
+	serverCtrl *server.Controller,
+	factory manager.ManagerFactory,
+) *Service {
+	return New(
+		scheduler,
+		executor,
+		snapshotStore,
+		policyStore,
+		volumeStore,
+		serverCtrl,
+		factory,
+	)
+}
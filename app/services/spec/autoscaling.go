@@ -0,0 +1,47 @@
+package spec
+
+import (
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/check"
+)
+
+const (
+	maxUtilizationTarget   = 100
+	maxStabilizationWindow = 3600 // kubernetes caps the hpa stabilization window at one hour
+	maxSleepAfterMinutes   = 24 * 60
+)
+
+// validateAutoscaling validates the autoscaling settings of the deploy configuration, zero values
+// are left for UpdateDefaults.
+func validateAutoscaling(config *types.DeployConfiguration) error {
+	errors := check.NewValidationErrors()
+
+	if config.MinReplicas < 0 {
+		errors.AddValidationError("minReplicas", check.NewValidationError("Min replicas must not be negative"))
+	} else if config.MaxReplicas > 0 && config.MinReplicas > config.MaxReplicas {
+		errors.AddValidationError("minReplicas", check.NewValidationError("Min replicas must not exceed max replicas"))
+	}
+	if config.SleepAfterMinutes < 0 || config.SleepAfterMinutes > maxSleepAfterMinutes {
+		errors.AddValidationError("sleepAfterMinutes", check.NewValidationErrorf("Sleep after must be between 1 and %d minutes", maxSleepAfterMinutes))
+	}
+	if config.TargetCPUUtilization < 0 || config.TargetCPUUtilization > maxUtilizationTarget {
+		errors.AddValidationError("targetCPUUtilization", check.NewValidationErrorf("CPU target must be between 1 and %d percent", maxUtilizationTarget))
+	}
+	if config.TargetMemoryUtilization < 0 || config.TargetMemoryUtilization > maxUtilizationTarget {
+		errors.AddValidationError("targetMemoryUtilization", check.NewValidationErrorf("Memory target must be between 1 and %d percent", maxUtilizationTarget))
+	}
+	if config.TargetRequestsPerReplica < 0 {
+		errors.AddValidationError("targetRequestsPerReplica", check.NewValidationError("Requests per replica must not be negative"))
+	}
+	if config.ScaleUpStabilizationSeconds < 0 || config.ScaleUpStabilizationSeconds > maxStabilizationWindow {
+		errors.AddValidationError("scaleUpStabilizationSeconds", check.NewValidationErrorf("Scale up window must be between 0 and %d seconds", maxStabilizationWindow))
+	}
+	if config.ScaleDownStabilizationSeconds < 0 || config.ScaleDownStabilizationSeconds > maxStabilizationWindow {
+		errors.AddValidationError("scaleDownStabilizationSeconds", check.NewValidationErrorf("Scale down window must be between 0 and %d seconds", maxStabilizationWindow))
+	}
+
+	if errors.HasError() {
+		return errors
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package spec
+
+import (
+	"strings"
+
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+// backupKindByImage maps the image names of the database templates to their backup kind.
+var backupKindByImage = map[string]enum.BackupKind{
+	"postgres": enum.BackupKindPostgres,
+	"postgis":  enum.BackupKindPostgres,
+	"mysql":    enum.BackupKindMySQL,
+	"mariadb":  enum.BackupKindMySQL,
+	"redis":    enum.BackupKindRedis,
+	"valkey":   enum.BackupKindValkey,
+}
+
+// GetBackupKind returns the backup kind of database applications deployed from a registry image,
+// empty for every other application.
+func GetBackupKind(spec *types.ApplicationSpec) enum.BackupKind {
+	if spec == nil || !spec.IsRegistry() || IsCron(spec) {
+		return ""
+	}
+
+	image := spec.Build.Source.Registry.Image
+	image, _, _ = strings.Cut(image, "@")
+	if i := strings.LastIndex(image, "/"); i >= 0 {
+		image = image[i+1:]
+	}
+	image, _, _ = strings.Cut(image, ":")
+
+	return backupKindByImage[strings.ToLower(image)]
+}
+
+// SupportsBackup returns true if the application is a database that can be backed up.
+func SupportsBackup(spec *types.ApplicationSpec) bool {
+	return GetBackupKind(spec) != ""
+}
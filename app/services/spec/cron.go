@@ -0,0 +1,43 @@
+package spec
+
+import (
+	"strings"
+
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/check"
+	"github.com/cloudness-io/cloudness/types/enum"
+
+	"github.com/gorhill/cronexpr"
+)
+
+const (
+	cronScheduleFields = 5
+	maxCronHistory     = 50
+)
+
+// validateSchedule validates the cron settings of the deploy configuration, kubernetes only accepts
+// the standard five field format or one of the predefined macros.
+func validateSchedule(config *types.DeployConfiguration) error {
+	errors := check.NewValidationErrors()
+
+	if !strings.HasPrefix(config.Schedule, "@") && len(strings.Fields(config.Schedule)) != cronScheduleFields {
+		errors.AddValidationError("schedule", check.NewValidationError("Schedule must have five fields, e.g. 0 3 * * *"))
+	} else if _, err := cronexpr.Parse(config.Schedule); err != nil {
+		errors.AddValidationError("schedule", check.NewValidationErrorf("Invalid schedule: %s", err))
+	}
+
+	if config.ConcurrencyPolicy != "" && enum.CronConcurrencyPolicyFromString(string(config.ConcurrencyPolicy)) == "" {
+		errors.AddValidationError("concurrencyPolicy", check.NewValidationError("Invalid concurrency policy"))
+	}
+	if config.SuccessfulJobsHistoryLimit > maxCronHistory {
+		errors.AddValidationError("successfulJobsHistoryLimit", check.NewValidationErrorf("History limit must not exceed %d", maxCronHistory))
+	}
+	if config.FailedJobsHistoryLimit > maxCronHistory {
+		errors.AddValidationError("failedJobsHistoryLimit", check.NewValidationErrorf("History limit must not exceed %d", maxCronHistory))
+	}
+
+	if errors.HasError() {
+		return errors
+	}
+	return nil
+}
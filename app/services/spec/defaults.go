@@ -48,6 +48,7 @@ func (s *Service) DefaultGitIn() *types.ApplicationInput {
 			IsStaticSite: true,
 			PublishPath:  "/dist",
 			Dockerfile:   "Dockerfile",
+			BuilderImage: defaultBuilderImage,
 		},
 		NetworkInput: DefaultNetworkInput(),
 	}
@@ -0,0 +1,65 @@
+package spec
+
+import (
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DiffLine is a line of the yaml diff between two specs.
+type DiffLine struct {
+	Text    string
+	Added   bool
+	Removed bool
+}
+
+// Diff returns the line diff of the yaml representation of the specs, nil when they are equal.
+func Diff(from, to any) ([]*DiffLine, error) {
+	fromYaml, err := yaml.Marshal(from)
+	if err != nil {
+		return nil, err
+	}
+	toYaml, err := yaml.Marshal(to)
+	if err != nil {
+		return nil, err
+	}
+	if string(fromYaml) == string(toYaml) {
+		return nil, nil
+	}
+
+	a := strings.Split(strings.TrimSuffix(string(fromYaml), "\n"), "\n")
+	b := strings.Split(strings.TrimSuffix(string(toYaml), "\n"), "\n")
+
+	// longest common subsequence of the lines, specs are small enough for the quadratic table
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	lines := make([]*DiffLine, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			lines = append(lines, &DiffLine{Text: a[i]})
+			i++
+			j++
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			lines = append(lines, &DiffLine{Text: a[i], Removed: true})
+			i++
+		default:
+			lines = append(lines, &DiffLine{Text: b[j], Added: true})
+			j++
+		}
+	}
+	return lines, nil
+}
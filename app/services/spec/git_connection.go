@@ -0,0 +1,20 @@
+package spec
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/errors"
+	"github.com/cloudness-io/cloudness/types"
+)
+
+// findGitConnection finds the git connection the application is deployed from.
+func (s *Service) findGitConnection(ctx context.Context, app *types.Application) (*types.GitConnection, error) {
+	conn, err := s.gitConnSvc.Find(ctx, app.TenantID, app.ProjectID, app.GetGitConnectionID())
+	if err != nil {
+		return nil, err
+	}
+	if conn == nil {
+		return nil, errors.NotFound("Git connection not found")
+	}
+	return conn, nil
+}
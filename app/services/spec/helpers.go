@@ -12,17 +12,38 @@ import (
 )
 
 func GetImage(app *types.Application, deployment *types.Deployment, config *config.PipelineConfig) (buildImage, pullImage, cacheImage string) {
+	spec := app.Spec
+	if deployment.Spec != nil {
+		spec = deployment.Spec
+	}
 	switch true {
-	case app.Spec.IsGit():
-		buildImage = fmt.Sprintf("%s/%d:%d", config.PushRegistryURL, app.UID, deployment.UID)
-		pullImage = fmt.Sprintf("%s/%d:%d", config.PullRegistryURL, app.UID, deployment.UID)
+	case spec.IsGit():
+		buildImage = fmt.Sprintf("%s/%d:%d", config.PushRegistryURL, app.UID, deployment.GetImageUID())
+		pullImage = fmt.Sprintf("%s/%d:%d", config.PullRegistryURL, app.UID, deployment.GetImageUID())
 		cacheImage = fmt.Sprintf("%s/%d-cache", config.PushRegistryURL, app.UID)
-	case app.Spec.IsRegistry():
-		pullImage = app.Spec.Build.Source.Registry.Image
+	case spec.IsRegistry():
+		pullImage = spec.Build.Source.Registry.Image
 	}
 	return
 }
 
+// GetRegistryCredentialUID returns the registry credential used to pull the image of the deployment.
+func GetRegistryCredentialUID(app *types.Application, deployment *types.Deployment) int64 {
+	spec := app.Spec
+	if deployment.Spec != nil {
+		spec = deployment.Spec
+	}
+	if spec.IsRegistry() {
+		return spec.Build.Source.Registry.CredentialUID
+	}
+	return 0
+}
+
+// IsCron returns true if the application runs as a scheduled job.
+func IsCron(spec *types.ApplicationSpec) bool {
+	return spec.Deploy != nil && spec.Deploy.Schedule != ""
+}
+
 func IsStateless(spec *types.ApplicationSpec) bool {
 	return spec.Deploy.MaxReplicas <= 1
 }
@@ -47,6 +68,14 @@ func GetBuilder(spec *types.ApplicationSpec) enum.BuilderType {
 	return enum.BuilderTypeStatic
 }
 
+// GetBuilderImage returns the buildpacks builder image of the git spec.
+func GetBuilderImage(spec *types.ApplicationSpec) string {
+	if spec.IsGit() && spec.Build.Source.Git.BuilderImage != "" {
+		return spec.Build.Source.Git.BuilderImage
+	}
+	return defaultBuilderImage
+}
+
 func GetGitRepoUrl(spec *types.ApplicationSpec) string {
 	if spec.IsGit() {
 		return spec.Build.Source.Git.RepoURL
@@ -93,6 +122,10 @@ func getNameFromImage(identifier string) string {
 
 func getAppType(spec *types.ApplicationSpec) enum.ApplicationType {
 	switch true {
+	case IsPostgresHA(spec):
+		return enum.ApplicationTypePostgresHA
+	case IsCron(spec):
+		return enum.ApplicationTypeCron
 	case IsStateful(spec):
 		return enum.ApplicationTypeStateful
 	default:
@@ -105,6 +138,8 @@ func GetSourceIcon(app *types.Application) string {
 	switch true {
 	case app.GetGithubAppID() > 0:
 		return icons.SourceGithubIcon
+	case app.GetGitConnectionID() > 0:
+		return icons.SourceGitConnIcon
 	case app.Spec.IsGit():
 		return icons.SourceGitIcon
 	case app.Spec.IsRegistry():
@@ -117,6 +152,8 @@ func GetSourceText(app *types.Application) string {
 	switch true {
 	case app.GetGithubAppID() > 0:
 		return "GitHub Source"
+	case app.GetGitConnectionID() > 0:
+		return "Git Provider Source"
 	case app.Spec.IsGit():
 		return "Git Source"
 	case app.Spec.IsRegistry():
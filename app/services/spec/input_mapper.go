@@ -18,18 +18,28 @@ const (
 	defaultBasePath           = "/"
 	defaultPublishPath        = "/dist"
 	defaultDockerfile         = "Dockerfile"
+	defaultBuilderImage       = "paketobuildpacks/builder-jammy-base"
 	defaultHTTPPort           = 8080
 	defaultTCPPort            = 8000
 	defaultMountName          = "Storage"
 	defaultMountPath          = "/data"
 	defaultMountSize          = 1024
 	defaultReplicas           = 1
+	defaultMinReplicas        = 1
 	defaultCPU                = 1
 	defaultMemory             = 0.5
 	defaultRestartPolicy      = enum.RestartPolicyTypeOnFailure
 	defaultRestartMaxRetries  = 5
 	defaultHealthCheckPath    = "/"
 	defaultHealthCheckTimeout = 300
+
+	defaultSleepAfterMinutes      = 15
+	defaultTargetUtilization      = 75
+	defaultScaleDownStabilization = 300
+
+	defaultCronConcurrencyPolicy = enum.CronConcurrencyPolicyForbid
+	defaultCronSuccessfulHistory = 3
+	defaultCronFailedHistory     = 1
 )
 
 // Application input mapper example
@@ -135,11 +145,12 @@ func (s *Service) ToBuildConfigration(ctx context.Context, in *types.Application
 	switch {
 	case in.GitInput != nil:
 		git := &types.GitSource{
-			RepoURL:  in.RepoURL,
-			Branch:   in.Branch,
-			Commit:   in.Commit,
-			Builder:  in.Builder,
-			BasePath: in.BasePath,
+			RepoURL:    in.RepoURL,
+			Branch:     in.Branch,
+			Commit:     in.Commit,
+			Builder:    in.Builder,
+			BasePath:   in.BasePath,
+			ConfigPath: strings.TrimSpace(in.ConfigPath),
 		}
 
 		//repo url
@@ -149,6 +160,13 @@ func (s *Service) ToBuildConfigration(ctx context.Context, in *types.Application
 			} else {
 				git.RepoURL = ghApp.GetHttpUrl(in.Repo)
 			}
+		} else if application.GetGitConnectionID() > 0 && in.Repo != "" {
+			// repo is left empty when it can't be derived from the url of a nested repository
+			conn, err := s.findGitConnection(ctx, application)
+			if err != nil {
+				return nil, err
+			}
+			git.RepoURL = conn.GetHttpUrl(in.Repo)
 		}
 
 		if in.BasePath == "" {
@@ -162,6 +180,12 @@ func (s *Service) ToBuildConfigration(ctx context.Context, in *types.Application
 			} else {
 				git.Dockerfile = defaultDockerfile
 			}
+		case enum.BuilderTypeBuildpacks:
+			if in.BuilderImage != "" {
+				git.BuilderImage = in.BuilderImage
+			} else {
+				git.BuilderImage = defaultBuilderImage
+			}
 		case enum.BuilderTypeNixpacks:
 			git.BuildCommand = in.BuildCommand
 			// git.StartCommand = in.StartCommand
@@ -183,6 +207,15 @@ func (s *Service) ToBuildConfigration(ctx context.Context, in *types.Application
 			Image: in.Image,
 		}
 
+		//private registry
+		if in.CredentialUID > 0 {
+			if _, err := s.registryCredentialStore.FindByUID(ctx, application.TenantID, in.CredentialUID); err != nil {
+				return nil, err
+			}
+			reg.IsPrivate = true
+			reg.CredentialUID = in.CredentialUID
+		}
+
 		return &types.BuildConfiguration{
 			Source: &types.Source{Registry: reg},
 		}, nil
@@ -198,12 +231,39 @@ func (s *Service) ToBuildConfigration(ctx context.Context, in *types.Application
 func (s *Service) ToDeployConfigration(in *types.ApplicationInput, application *types.Application) (*types.DeployConfiguration, error) {
 	if in.DeployInput != nil {
 		config := &types.DeployConfiguration{
-			StartCommand:     in.StartCommand,
-			SleepApplication: in.SleepApplication,
-			MaxReplicas:      in.MaxReplicas,
-			CPU:              in.CPU,
-			Memory:           in.Memory,
-			HealthcheckPath:  in.HealthcheckPath,
+			StartCommand:      in.StartCommand,
+			SleepApplication:  in.SleepApplication,
+			SleepAfterMinutes: in.SleepAfterMinutes,
+			MaxReplicas:       in.MaxReplicas,
+			MinReplicas:       in.MinReplicas,
+			CPU:               in.CPU,
+			Memory:            in.Memory,
+			HealthcheckPath:   in.HealthcheckPath,
+
+			TargetCPUUtilization:          in.TargetCPUUtilization,
+			TargetMemoryUtilization:       in.TargetMemoryUtilization,
+			TargetRequestsPerReplica:      in.TargetRequestsPerReplica,
+			ScaleUpStabilizationSeconds:   in.ScaleUpStabilizationSeconds,
+			ScaleDownStabilizationSeconds: in.ScaleDownStabilizationSeconds,
+
+			Schedule:                   strings.TrimSpace(in.Schedule),
+			ConcurrencyPolicy:          in.ConcurrencyPolicy,
+			SuccessfulJobsHistoryLimit: in.SuccessfulJobsHistoryLimit,
+			FailedJobsHistoryLimit:     in.FailedJobsHistoryLimit,
+
+			PostgresInstances: in.PostgresInstances,
+		}
+
+		if err := validateAutoscaling(config); err != nil {
+			return nil, err
+		}
+		if config.Schedule != "" {
+			if err := validateSchedule(config); err != nil {
+				return nil, err
+			}
+		}
+		if err := validatePostgresHA(config, application); err != nil {
+			return nil, err
 		}
 
 		return config, nil
@@ -275,6 +335,24 @@ func (s *Service) UpdateDefaults(in *types.ApplicationInput, spec *types.Applica
 	if spec.Deploy.MaxReplicas == 0 {
 		spec.Deploy.MaxReplicas = defaultReplicas
 	}
+	if spec.Deploy.SleepApplication && spec.Deploy.SleepAfterMinutes == 0 {
+		spec.Deploy.SleepAfterMinutes = defaultSleepAfterMinutes
+	}
+	if spec.Deploy.MinReplicas == 0 {
+		spec.Deploy.MinReplicas = defaultMinReplicas
+	}
+	if spec.Deploy.MinReplicas > spec.Deploy.MaxReplicas {
+		spec.Deploy.MinReplicas = spec.Deploy.MaxReplicas
+	}
+	if spec.Deploy.TargetCPUUtilization == 0 {
+		spec.Deploy.TargetCPUUtilization = defaultTargetUtilization
+	}
+	if spec.Deploy.TargetMemoryUtilization == 0 {
+		spec.Deploy.TargetMemoryUtilization = defaultTargetUtilization
+	}
+	if spec.Deploy.ScaleDownStabilizationSeconds == 0 {
+		spec.Deploy.ScaleDownStabilizationSeconds = defaultScaleDownStabilization
+	}
 	if spec.Deploy.Memory == 0 {
 		spec.Deploy.Memory = defaultMemory
 	}
@@ -287,4 +365,17 @@ func (s *Service) UpdateDefaults(in *types.ApplicationInput, spec *types.Applica
 	if spec.Deploy.RestartPolicyMaxRetries == 0 {
 		spec.Deploy.RestartPolicyMaxRetries = defaultRestartMaxRetries
 	}
+
+	//cron defaults
+	if spec.Deploy.Schedule != "" {
+		if spec.Deploy.ConcurrencyPolicy == "" {
+			spec.Deploy.ConcurrencyPolicy = defaultCronConcurrencyPolicy
+		}
+		if spec.Deploy.SuccessfulJobsHistoryLimit <= 0 {
+			spec.Deploy.SuccessfulJobsHistoryLimit = defaultCronSuccessfulHistory
+		}
+		if spec.Deploy.FailedJobsHistoryLimit <= 0 {
+			spec.Deploy.FailedJobsHistoryLimit = defaultCronFailedHistory
+		}
+	}
 }
@@ -0,0 +1,34 @@
+package spec
+
+import (
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/check"
+)
+
+const maxPostgresInstances = 9
+
+// IsPostgresHA returns true if the application runs as a postgres cluster managed by the operator.
+func IsPostgresHA(spec *types.ApplicationSpec) bool {
+	return spec.Deploy != nil && spec.Deploy.PostgresInstances > 0
+}
+
+// validatePostgresHA validates the instances of the postgres cluster, a cluster can not be turned back
+// into a single container as its data lives in the volumes of the operator.
+func validatePostgresHA(config *types.DeployConfiguration, application *types.Application) error {
+	errors := check.NewValidationErrors()
+
+	switch {
+	case config.PostgresInstances < 0 || config.PostgresInstances > maxPostgresInstances:
+		errors.AddValidationError("postgresInstances", check.NewValidationErrorf("Instances must be between 1 and %d", maxPostgresInstances))
+	case application.IsPostgresHA() && config.PostgresInstances == 0:
+		errors.AddValidationError("postgresInstances", check.NewValidationError("A postgres cluster needs at least one instance"))
+	}
+	if config.PostgresInstances > 0 && config.Schedule != "" {
+		errors.AddValidationError("schedule", check.NewValidationError("A postgres cluster can not run as a scheduled job"))
+	}
+
+	if errors.HasError() {
+		return errors
+	}
+	return nil
+}
@@ -0,0 +1,219 @@
+package spec
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/cloudness-io/cloudness/errors"
+	"github.com/cloudness-io/cloudness/types"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	defaultConfigPath = "cloudness.yaml"
+	// maxConfigSize bounds the repository config read on every deploy.
+	maxConfigSize = 64 * 1024
+)
+
+// GetConfigPath returns the path of the repository config relative to the repository root.
+func GetConfigPath(spec *types.ApplicationSpec) string {
+	if !spec.IsGit() {
+		return ""
+	}
+	git := spec.Build.Source.Git
+	configPath := git.ConfigPath
+	if configPath == "" {
+		configPath = defaultConfigPath
+	}
+	return strings.TrimPrefix(path.Join("/", git.BasePath, configPath), "/")
+}
+
+// FindRepoConfig fetches the repository config of the git application at the commit being deployed,
+// the branch head is used when no commit is pinned. Nil is returned when the repository has no config.
+func (s *Service) FindRepoConfig(ctx context.Context, app *types.Application) ([]byte, error) {
+	if !app.Spec.IsGit() {
+		return nil, nil
+	}
+
+	gitSpec := app.Spec.Build.Source.Git
+	ref := gitSpec.Commit
+	if ref == "" {
+		ref = gitSpec.Branch
+	}
+	configPath := GetConfigPath(app.Spec)
+
+	var (
+		data []byte
+		err  error
+	)
+	switch true {
+	case app.GithubAppID != nil:
+		// github app
+		ghApp, findErr := s.ghAppSvc.Find(ctx, app.TenantID, app.ProjectID, *app.GithubAppID)
+		if findErr != nil {
+			return nil, findErr
+		}
+		data, err = s.ghAppSvc.GetFileContent(ctx, ghApp, gitSpec.RepoURL, ref, configPath)
+	case app.GitConnectionID != nil:
+		// git connection
+		conn, findErr := s.findGitConnection(ctx, app)
+		if findErr != nil {
+			return nil, findErr
+		}
+		data, err = s.gitConnSvc.GetFileContent(ctx, conn, gitSpec.RepoURL, ref, configPath)
+	default:
+		// git public
+		data, err = s.gitpublicSvc.GetFileContent(ctx, gitSpec.RepoURL, ref, configPath)
+	}
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// MergeRepoConfig merges the build, deploy, networking and volumes of the repository config over the spec.
+// Keys present in the config replace the values of the spec, lists are replaced as a whole except the
+// volumes, merged by name. The name, the repository and the paths locating the config stay owned by the
+// application settings.
+func MergeRepoConfig(spec *types.ApplicationSpec, data []byte) (*types.ApplicationSpec, error) {
+	if len(data) > maxConfigSize {
+		return nil, errors.BadRequest("the config exceeds %d bytes", maxConfigSize)
+	}
+
+	merged, err := copySpec(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	merged.Volumes = nil
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(merged); err != nil && !errors.Is(err, io.EOF) {
+		return nil, errors.BadRequest("%s", err.Error())
+	}
+	volumes, err := mergeVolumes(spec.Volumes, merged.Volumes)
+	if err != nil {
+		return nil, err
+	}
+	merged.Volumes = volumes
+
+	merged.Icon = spec.Icon
+	merged.Name = spec.Name
+	merged.Description = spec.Description
+
+	git := *spec.Build.Source.Git
+	if merged.IsGit() {
+		git = *merged.Build.Source.Git
+		git.RepoURL = spec.Build.Source.Git.RepoURL
+		git.Branch = spec.Build.Source.Git.Branch
+		git.Commit = spec.Build.Source.Git.Commit
+		git.BasePath = spec.Build.Source.Git.BasePath
+		git.ConfigPath = spec.Build.Source.Git.ConfigPath
+	}
+	merged.Build = &types.BuildConfiguration{
+		Source: &types.Source{Git: &git},
+	}
+
+	if merged.Deploy == nil {
+		merged.Deploy = spec.Deploy
+	}
+	if merged.Networking == nil {
+		merged.Networking = spec.Networking
+	}
+
+	if err := validateAutoscaling(merged.Deploy); err != nil {
+		return nil, err
+	}
+	if merged.Deploy.Schedule != "" {
+		if err := validateSchedule(merged.Deploy); err != nil {
+			return nil, err
+		}
+	}
+
+	return merged, nil
+}
+
+// mergeVolumes merges the volumes of the config over the volumes of the settings by name, the config can
+// move the mount path of a volume and grow it. Volumes are claimed when they are added in the settings,
+// the config can not create them.
+func mergeVolumes(volumes []*types.VolumeMounts, config []*types.VolumeMounts) ([]*types.VolumeMounts, error) {
+	merged := make([]*types.VolumeMounts, 0, len(volumes))
+	for _, volume := range volumes {
+		mount := *volume
+		merged = append(merged, &mount)
+	}
+
+	for _, in := range config {
+		idx := slices.IndexFunc(merged, func(v *types.VolumeMounts) bool { return v.VolumeName == in.VolumeName })
+		if idx < 0 {
+			return nil, errors.BadRequest("volume %s is not attached to the application, add it in the application settings", in.VolumeName)
+		}
+		volume := merged[idx]
+		if in.MountPath != "" {
+			volume.MountPath = in.MountPath
+		}
+		if in.VolumeSize != 0 {
+			if in.VolumeSize < volume.VolumeSize {
+				return nil, errors.BadRequest("volume %s can not shrink below %dMiB", in.VolumeName, volume.VolumeSize)
+			}
+			volume.VolumeSize = in.VolumeSize
+		}
+	}
+
+	mountPaths := map[string]bool{}
+	for _, volume := range merged {
+		if mountPaths[volume.MountPath] {
+			return nil, errors.BadRequest("mount path %s is used by more than one volume", volume.MountPath)
+		}
+		mountPaths[volume.MountPath] = true
+	}
+	return merged, nil
+}
+
+// ApplyVolumeMounts sets the mount path and size of the volumes from the volume mounts of the deployed
+// spec, which the repository config may have changed. A volume never shrinks, the volumes that grew
+// are returned to be saved, with their new size and the mount path of the settings.
+func ApplyVolumeMounts(volumes []*types.Volume, spec *types.ApplicationSpec) []*types.Volume {
+	if spec == nil {
+		return nil
+	}
+	grown := []*types.Volume{}
+	for _, volume := range volumes {
+		idx := slices.IndexFunc(spec.Volumes, func(v *types.VolumeMounts) bool { return v.VolumeName == volume.Name })
+		if idx < 0 {
+			continue
+		}
+		mount := spec.Volumes[idx]
+		if mount.VolumeSize > volume.Size {
+			volume.Size = mount.VolumeSize
+			stored := *volume
+			grown = append(grown, &stored)
+		}
+		if mount.MountPath != "" {
+			volume.MountPath = mount.MountPath
+		}
+	}
+	return grown
+}
+
+// copySpec deep copies the spec so the config can be decoded over it.
+func copySpec(spec *types.ApplicationSpec) (*types.ApplicationSpec, error) {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	out := new(types.ApplicationSpec)
+	if err := json.Unmarshal(data, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,93 @@
+package spec
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cloudness-io/cloudness/types"
+)
+
+func newRepoConfigTestSpec() *types.ApplicationSpec {
+	return &types.ApplicationSpec{
+		Name: "web",
+		Build: &types.BuildConfiguration{Source: &types.Source{Git: &types.GitSource{
+			RepoURL: "https://github.com/acme/web.git",
+			Branch:  "main",
+		}}},
+		Deploy:  &types.DeployConfiguration{},
+		Volumes: []*types.VolumeMounts{{VolumeName: "data", VolumeSize: 1024, MountPath: "/data"}},
+	}
+}
+
+func TestMergeRepoConfig(t *testing.T) {
+	base := newRepoConfigTestSpec()
+	merged, err := MergeRepoConfig(base, []byte("build:\n  source:\n    git:\n      repoURL: https://evil.example.com/x.git\n      builder: Dockerfile\n"))
+	if err != nil {
+		t.Fatalf("merge: %v", err)
+	}
+	git := merged.Build.Source.Git
+	if git.Builder != "Dockerfile" {
+		t.Errorf("builder = %q, want Dockerfile", git.Builder)
+	}
+	if git.RepoURL != base.Build.Source.Git.RepoURL {
+		t.Errorf("repo url = %q, the config must not change it", git.RepoURL)
+	}
+	if len(merged.Volumes) != 1 || merged.Volumes[0].VolumeName != "data" {
+		t.Errorf("volumes = %v, want the volumes of the settings", merged.Volumes)
+	}
+}
+
+func TestMergeRepoConfigVolumes(t *testing.T) {
+	base := newRepoConfigTestSpec()
+	merged, err := MergeRepoConfig(base, []byte("volumes:\n  - volumeName: data\n    mountPath: /var/data\n    volumeSize: 2048\n"))
+	if err != nil {
+		t.Fatalf("merge: %v", err)
+	}
+	if len(merged.Volumes) != 1 {
+		t.Fatalf("volumes = %v, want the volume of the settings", merged.Volumes)
+	}
+	if volume := merged.Volumes[0]; volume.MountPath != "/var/data" || volume.VolumeSize != 2048 {
+		t.Errorf("volume = %+v, want /var/data of 2048MiB", volume)
+	}
+	if base.Volumes[0].MountPath != "/data" || base.Volumes[0].VolumeSize != 1024 {
+		t.Errorf("settings volume = %+v, the merge must not change it", base.Volumes[0])
+	}
+}
+
+func TestApplyVolumeMounts(t *testing.T) {
+	volumes := []*types.Volume{
+		{Name: "data", MountPath: "/data", Size: 1024},
+		{Name: "cache", MountPath: "/cache", Size: 2048},
+	}
+	spec := &types.ApplicationSpec{Volumes: []*types.VolumeMounts{
+		{VolumeName: "data", MountPath: "/var/data", VolumeSize: 2048},
+		{VolumeName: "cache", MountPath: "/cache", VolumeSize: 1024},
+	}}
+
+	grown := ApplyVolumeMounts(volumes, spec)
+	if volumes[0].MountPath != "/var/data" || volumes[0].Size != 2048 {
+		t.Errorf("data = %+v, want /var/data of 2048MiB", volumes[0])
+	}
+	if volumes[1].Size != 2048 {
+		t.Errorf("cache size = %d, a volume must not shrink", volumes[1].Size)
+	}
+	if len(grown) != 1 || grown[0].Name != "data" || grown[0].Size != 2048 || grown[0].MountPath != "/data" {
+		t.Errorf("grown = %v, want data of 2048MiB at the mount path of the settings", grown)
+	}
+}
+
+func TestMergeRepoConfigInvalid(t *testing.T) {
+	tests := map[string]string{
+		"new volume":    "volumes:\n  - volumeName: cache\n    mountPath: /cache\n",
+		"shrink volume": "volumes:\n  - volumeName: data\n    volumeSize: 512\n",
+		"unknown field": "deploy:\n  cpus: 2\n",
+		"too large":     "# " + strings.Repeat("x", maxConfigSize) + "\n",
+	}
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := MergeRepoConfig(newRepoConfigTestSpec(), []byte(data)); err == nil {
+				t.Fatal("expected the config to be rejected")
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package spec
+
+import (
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/check"
+)
+
+// ValidateRestrictions validates the deploy configuration against the resource limits of the tenant.
+func ValidateRestrictions(deploySpec *types.DeployConfiguration, restrictions *types.TenantRestrictions) error {
+	err := check.NewValidationErrors()
+	if deploySpec.CPU > restrictions.MaxCPU {
+		err.AddValidationError("cpu", check.NewValidationErrorf("CPU is above max allowed limit"))
+	}
+	if deploySpec.MaxReplicas > restrictions.MaxInstances {
+		err.AddValidationError("maxReplicas", check.NewValidationErrorf("Max Replicas is above max allowed limit"))
+	}
+	if deploySpec.MinReplicas > restrictions.MaxInstances {
+		err.AddValidationError("minReplicas", check.NewValidationErrorf("Min Replicas is above max allowed limit"))
+	}
+	if deploySpec.Memory > restrictions.MaxMemory {
+		err.AddValidationError("memory", check.NewValidationErrorf("Memory is above max allowed limit"))
+	}
+
+	if err.HasError() {
+		return err
+	}
+	return nil
+}
+
+// ValidateVolumeRestrictions validates the size of the volume mounts against the volume limit of the tenant.
+func ValidateVolumeRestrictions(volumes []*types.VolumeMounts, restrictions *types.TenantRestrictions) error {
+	err := check.NewValidationErrors()
+	for _, volume := range volumes {
+		if volume.VolumeSize > restrictions.MaxVolumeSize {
+			err.AddValidationError("volumeSize", check.NewValidationErrorf("Volume %s is above max allowed limit of %dMiB", volume.VolumeName, restrictions.MaxVolumeSize))
+		}
+	}
+
+	if err.HasError() {
+		return err
+	}
+	return nil
+}
@@ -1,21 +1,29 @@
 package spec
 
 import (
+	"github.com/cloudness-io/cloudness/app/services/gitconnection"
 	"github.com/cloudness-io/cloudness/app/services/githubapp"
 	"github.com/cloudness-io/cloudness/app/services/gitpublic"
+	"github.com/cloudness-io/cloudness/app/store"
 )
 
 type Service struct {
-	ghAppSvc     *githubapp.Service
-	gitpublicSvc *gitpublic.Service
+	ghAppSvc                *githubapp.Service
+	gitConnSvc              *gitconnection.Service
+	gitpublicSvc            *gitpublic.Service
+	registryCredentialStore store.RegistryCredentialStore
 }
 
 func NewService(
 	ghAppSvc *githubapp.Service,
+	gitConnSvc *gitconnection.Service,
 	gitpublicSvc *gitpublic.Service,
+	registryCredentialStore store.RegistryCredentialStore,
 ) *Service {
 	return &Service{
-		ghAppSvc:     ghAppSvc,
-		gitpublicSvc: gitpublicSvc,
+		ghAppSvc:                ghAppSvc,
+		gitConnSvc:              gitConnSvc,
+		gitpublicSvc:            gitpublicSvc,
+		registryCredentialStore: registryCredentialStore,
 	}
 }
@@ -25,6 +25,18 @@ func (s *Service) GetLatestTag(ctx context.Context, app *types.Application) (str
 			}
 			gitSpec.Commit = commit.GetSHA()
 			return commit.Commit.GetMessage(), nil
+		case app.GitConnectionID != nil:
+			// git connection
+			conn, err := s.findGitConnection(ctx, app)
+			if err != nil {
+				return "", err
+			}
+			commit, err := s.gitConnSvc.GetLatestCommit(ctx, conn, gitSpec.RepoURL, gitSpec.Branch)
+			if err != nil {
+				return "", err
+			}
+			gitSpec.Commit = commit.Sha
+			return commit.Message, nil
 		default:
 			// git public
 			commit, err := s.gitpublicSvc.GetLatestCommit(ctx, gitSpec.RepoURL, gitSpec.Branch)
@@ -62,6 +74,17 @@ func (s *Service) GetCurrentTag(ctx context.Context, app *types.Application) (st
 			} else {
 				return commit.Commit.GetMessage(), nil
 			}
+		case app.GitConnectionID != nil:
+			// git connection
+			conn, err := s.findGitConnection(ctx, app)
+			if err != nil {
+				return "", err
+			}
+			if commit, err := s.gitConnSvc.GetCommitBySha(ctx, conn, gitSpec.RepoURL, gitSpec.Commit); err != nil {
+				return "", err
+			} else {
+				return commit.Message, nil
+			}
 		default:
 			// git public
 			if commit, err := s.gitpublicSvc.GetCommitBySha(ctx, gitSpec.RepoURL, gitSpec.Commit); err != nil {
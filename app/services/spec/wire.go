@@ -1,8 +1,10 @@
 package spec
 
 import (
+	"github.com/cloudness-io/cloudness/app/services/gitconnection"
 	"github.com/cloudness-io/cloudness/app/services/githubapp"
 	"github.com/cloudness-io/cloudness/app/services/gitpublic"
+	"github.com/cloudness-io/cloudness/app/store"
 
 	"github.com/google/wire"
 )
@@ -13,10 +15,14 @@ var WireSet = wire.NewSet(
 
 func ProvideSpecService(
 	ghAppSvc *githubapp.Service,
+	gitConnSvc *gitconnection.Service,
 	gitpublicSvc *gitpublic.Service,
+	registryCredentialStore store.RegistryCredentialStore,
 ) *Service {
 	return NewService(
 		ghAppSvc,
+		gitConnSvc,
 		gitpublicSvc,
+		registryCredentialStore,
 	)
 }
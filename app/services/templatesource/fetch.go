@@ -0,0 +1,265 @@
+package templatesource
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/cloudness-io/cloudness/app/services/gitconnection"
+	"github.com/cloudness-io/cloudness/app/services/gitpublic"
+	"github.com/cloudness-io/cloudness/helpers"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+
+	"github.com/rs/zerolog/log"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	maxTemplateFiles = 100
+	maxFileBytes     = 1 << 20
+	revisionLength   = 12
+)
+
+// templateFile is a template definition read from a template source, in json or yaml.
+type templateFile struct {
+	path string
+	data []byte
+}
+
+// index lists the template files of an https source, relative urls are resolved against the index url.
+type index struct {
+	Templates []string `yaml:"templates"`
+}
+
+// gitReader reads the files of a git repository.
+type gitReader interface {
+	GetLatestCommit(ctx context.Context, repoURL string, branch string) (*types.GitCommit, error)
+	ListFiles(ctx context.Context, repoURL string, ref string, dir string) ([]string, error)
+	GetFileContent(ctx context.Context, repoURL string, ref string, path string) ([]byte, error)
+}
+
+// connectionReader reads the files of a repository of a git connection.
+type connectionReader struct {
+	svc  *gitconnection.Service
+	conn *types.GitConnection
+}
+
+func (r *connectionReader) GetLatestCommit(ctx context.Context, repoURL string, branch string) (*types.GitCommit, error) {
+	return r.svc.GetLatestCommit(ctx, r.conn, repoURL, branch)
+}
+
+func (r *connectionReader) ListFiles(ctx context.Context, repoURL string, ref string, dir string) ([]string, error) {
+	return r.svc.ListFiles(ctx, r.conn, repoURL, ref, dir)
+}
+
+func (r *connectionReader) GetFileContent(ctx context.Context, repoURL string, ref string, path string) ([]byte, error) {
+	return r.svc.GetFileContent(ctx, r.conn, repoURL, ref, path)
+}
+
+// fetch returns the revision of the source and its template files, the files are nil when the revision
+// is the one of the last sync and that sync succeeded.
+func (s *Service) fetch(ctx context.Context, source *types.TemplateSource) (string, []*templateFile, error) {
+	switch source.Type {
+	case enum.TemplateSourceTypeGit:
+		return s.fetchGit(ctx, source)
+	case enum.TemplateSourceTypeHTTPS:
+		return s.fetchIndex(ctx, source)
+	default:
+		return "", nil, fmt.Errorf("unsupported template source type %s", source.Type)
+	}
+}
+
+// fetchGit reads the json and yaml files of the directory of the repository at the head of the branch,
+// the revision is the sha of the commit.
+func (s *Service) fetchGit(ctx context.Context, source *types.TemplateSource) (string, []*templateFile, error) {
+	gitSvc, err := s.gitService(ctx, source)
+	if err != nil {
+		return "", nil, err
+	}
+	commit, err := gitSvc.GetLatestCommit(ctx, source.URL, source.Branch)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to find branch %s: %w", source.Branch, err)
+	}
+	if commit.Sha == source.Revision && source.SyncError == "" {
+		return commit.Sha, nil, nil
+	}
+
+	paths, err := gitSvc.ListFiles(ctx, source.URL, commit.Sha, source.Path)
+	if err != nil {
+		return "", nil, err
+	}
+
+	files := []*templateFile{}
+	for _, p := range paths {
+		if !isTemplateFile(p) {
+			continue
+		}
+		if len(files) == maxTemplateFiles {
+			return "", nil, fmt.Errorf("more than %d template files in %s", maxTemplateFiles, source.Path)
+		}
+		data, err := gitSvc.GetFileContent(ctx, source.URL, commit.Sha, p)
+		if err != nil {
+			return "", nil, err
+		}
+		files = append(files, &templateFile{path: p, data: data})
+	}
+
+	return commit.Sha, files, nil
+}
+
+// gitService returns the client of the repository of the source. Sources with a git connection are read
+// through it, the github repositories of team sources with an installed github app of the team when one
+// exists: a github app of the owner of the repository, or else any other.
+func (s *Service) gitService(ctx context.Context, source *types.TemplateSource) (gitReader, error) {
+	if source.GitConnectionID != nil {
+		conn, err := s.gitConnectionSvc.FindByID(ctx, *source.GitConnectionID)
+		if err != nil {
+			return nil, err
+		}
+		if conn == nil {
+			return nil, fmt.Errorf("the git connection of the source was deleted")
+		}
+		return &connectionReader{svc: s.gitConnectionSvc, conn: conn}, nil
+	}
+	return s.githubService(ctx, source), nil
+}
+
+// githubService returns the github client of the source, see gitService.
+func (s *Service) githubService(ctx context.Context, source *types.TemplateSource) *gitpublic.Service {
+	if source.IsInstanceLevel() {
+		return s.gitPublicSvc
+	}
+	owner, _, err := helpers.SplitGitRepoUrl(source.URL)
+	if err != nil {
+		return s.gitPublicSvc
+	}
+
+	ghApps, err := s.githubAppSvc.List(ctx, *source.TenantID, 0)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Str("template_source", source.Name).Msg("templatesource: failed to list github apps")
+		return s.gitPublicSvc
+	}
+	installed := []*types.GithubApp{}
+	for _, ghApp := range ghApps {
+		if ghApp.InstallationID == 0 || !strings.HasPrefix(ghApp.HtmlUrl, "https://github.com") {
+			continue
+		}
+		if strings.EqualFold(ghApp.Organization, owner) {
+			installed = append([]*types.GithubApp{ghApp}, installed...)
+		} else {
+			installed = append(installed, ghApp)
+		}
+	}
+
+	for _, ghApp := range installed {
+		token, err := s.githubAppSvc.AccessToken(ctx, ghApp)
+		if err != nil {
+			log.Ctx(ctx).Warn().Err(err).Str("github_app", ghApp.Name).Msg("templatesource: failed to create github app token")
+			continue
+		}
+		return s.gitPublicSvc.WithToken(token)
+	}
+	return s.gitPublicSvc
+}
+
+// fetchIndex reads the index and the template files it lists, the revision is the digest of their content.
+func (s *Service) fetchIndex(ctx context.Context, source *types.TemplateSource) (string, []*templateFile, error) {
+	base, err := url.Parse(source.URL)
+	if err != nil {
+		return "", nil, err
+	}
+
+	token := ""
+	if len(source.Token) > 0 {
+		token, err = s.encrypter.Decrypt(source.Token)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	client := s.externalClient
+	if source.IsInstanceLevel() {
+		client = s.httpClient
+	}
+
+	data, err := s.get(ctx, client, base, base, token)
+	if err != nil {
+		return "", nil, err
+	}
+	idx := new(index)
+	if err := yaml.Unmarshal(data, idx); err != nil {
+		return "", nil, fmt.Errorf("invalid index: %w", err)
+	}
+	if len(idx.Templates) > maxTemplateFiles {
+		return "", nil, fmt.Errorf("more than %d templates in the index", maxTemplateFiles)
+	}
+
+	digest := sha256.New()
+	digest.Write(data)
+
+	files := make([]*templateFile, 0, len(idx.Templates))
+	for _, ref := range idx.Templates {
+		u, err := base.Parse(ref)
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid template url %s: %w", ref, err)
+		}
+		data, err := s.get(ctx, client, base, u, token)
+		if err != nil {
+			return "", nil, err
+		}
+		digest.Write(data)
+		files = append(files, &templateFile{path: ref, data: data})
+	}
+
+	return hex.EncodeToString(digest.Sum(nil))[:revisionLength], files, nil
+}
+
+// get reads the document at the url with the client, the token is only sent to the host of the index.
+func (s *Service) get(ctx context.Context, client *http.Client, base *url.URL, u *url.URL, token string) ([]byte, error) {
+	if u.Scheme != "https" {
+		return nil, fmt.Errorf("%s is not an https url", u.Redacted())
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if token != "" && u.Host == base.Host {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: %s", u.Redacted(), resp.Status)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxFileBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxFileBytes {
+		return nil, fmt.Errorf("%s exceeds %d bytes", u.Redacted(), maxFileBytes)
+	}
+	return data, nil
+}
+
+func isTemplateFile(p string) bool {
+	switch strings.ToLower(path.Ext(p)) {
+	case ".json", ".yaml", ".yml":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,62 @@
+package templatesource
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/cloudness-io/cloudness/job"
+	baseStore "github.com/cloudness-io/cloudness/store"
+
+	"github.com/rs/zerolog/log"
+)
+
+type syncJob struct {
+	svc *Service
+}
+
+func newSyncJob(svc *Service) *syncJob {
+	return &syncJob{
+		svc: svc,
+	}
+}
+
+// Handle syncs the template source of the job data, or every template source for the recurring job.
+// A failing source does not stop the sync of the others.
+func (j *syncJob) Handle(ctx context.Context, data string, _ job.ProgressReporter) (string, error) {
+	if data != "" {
+		id, err := strconv.ParseInt(data, 10, 64)
+		if err != nil {
+			return "", fmt.Errorf("invalid template source id %q: %w", data, err)
+		}
+
+		source, err := j.svc.sourceStore.Find(ctx, id)
+		if baseStore.IsNotFound(err) {
+			return "template source was deleted", nil
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to find template source %d: %w", id, err)
+		}
+
+		if err := j.svc.sync(ctx, source); err != nil {
+			return "", fmt.Errorf("failed to sync template source %s: %w", source.Name, err)
+		}
+		return fmt.Sprintf("synced template source %s", source.Name), nil
+	}
+
+	sources, err := j.svc.sourceStore.ListAll(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to list template sources: %w", err)
+	}
+
+	synced := 0
+	for _, source := range sources {
+		if err := j.svc.sync(ctx, source); err != nil {
+			log.Ctx(ctx).Warn().Err(err).Str("template_source", source.Name).Msg("templatesource: failed to sync template source")
+			continue
+		}
+		synced++
+	}
+
+	return fmt.Sprintf("synced %d of %d template sources", synced, len(sources)), nil
+}
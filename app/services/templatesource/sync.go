@@ -0,0 +1,148 @@
+package templatesource
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	baseStore "github.com/cloudness-io/cloudness/store"
+	"github.com/cloudness-io/cloudness/types"
+
+	"gopkg.in/yaml.v3"
+)
+
+// sync reads the templates of the source and saves them, a new template is published as its first version
+// while a change to an existing template is saved as a pending version until it is published.
+// Templates removed from the source are deleted only when every template of the source synced, a source
+// still at the revision of the last successful sync is not read again.
+func (s *Service) sync(ctx context.Context, source *types.TemplateSource) error {
+	revision, files, err := s.fetch(ctx, source)
+	if err != nil {
+		return s.updateSync(ctx, source, source.Revision, err.Error())
+	}
+	if files == nil {
+		return s.updateSync(ctx, source, revision, "")
+	}
+
+	problems := []string{}
+	slugs := []string{}
+	templates := []*types.Template{}
+	for _, file := range files {
+		tmpl, err := s.parse(ctx, file)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %s", file.path, err))
+			continue
+		}
+		tmpl.Slug = fmt.Sprintf("%s-%d", tmpl.Slug, source.ID)
+		tmpl.SourceID = &source.ID
+		if slices.Contains(slugs, tmpl.Slug) {
+			problems = append(problems, fmt.Sprintf("%s: duplicate template name %s", file.path, tmpl.Name))
+			continue
+		}
+		slugs = append(slugs, tmpl.Slug)
+		templates = append(templates, tmpl)
+	}
+
+	for _, tmpl := range templates {
+		if err := s.save(ctx, source, revision, tmpl); err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %s", tmpl.Name, err))
+		}
+	}
+
+	if len(problems) == 0 {
+		if err := s.templateStore.DeleteBySourceNotInSlugs(ctx, source.ID, slugs); err != nil {
+			return err
+		}
+	}
+
+	return s.updateSync(ctx, source, revision, strings.Join(problems, "\n"))
+}
+
+// save creates the template, or a new version of it when its spec changed.
+func (s *Service) save(ctx context.Context, source *types.TemplateSource, revision string, tmpl *types.Template) error {
+	now := time.Now().UTC().UnixMilli()
+	existing, err := s.templateStore.FindBySlug(ctx, tmpl.Slug)
+	if baseStore.IsNotFound(err) {
+		tmpl.Version = 1
+		return s.templateStore.CreateSynced(ctx, tmpl, &types.TemplateVersion{
+			Number:   1,
+			Revision: revision,
+			SpecJson: tmpl.SpecJson,
+			Created:  now,
+		})
+	}
+	if err != nil {
+		return err
+	}
+	if existing.SourceID == nil || *existing.SourceID != source.ID {
+		return fmt.Errorf("template %s already exists", tmpl.Name)
+	}
+
+	latest, err := s.templateStore.FindLatestVersion(ctx, existing.ID)
+	if err != nil {
+		return err
+	}
+	if latest.SpecJson == tmpl.SpecJson {
+		return nil
+	}
+
+	return s.templateStore.CreateVersion(ctx, &types.TemplateVersion{
+		TemplateID: existing.ID,
+		Number:     latest.Number + 1,
+		Revision:   revision,
+		SpecJson:   tmpl.SpecJson,
+		Created:    now,
+	})
+}
+
+// parse converts a yaml file to json, validates it against the template schema and maps it to a template.
+func (s *Service) parse(ctx context.Context, file *templateFile) (*types.Template, error) {
+	data := file.data
+	if strings.ToLower(path.Ext(file.path)) != ".json" {
+		var doc any
+		if err := yaml.Unmarshal(data, &doc); err != nil {
+			return nil, fmt.Errorf("invalid yaml: %w", err)
+		}
+		converted, err := json.Marshal(doc)
+		if err != nil {
+			return nil, fmt.Errorf("invalid yaml: %w", err)
+		}
+		data = converted
+	}
+
+	if err := s.schemaSvc.ValidateTemplate(ctx, data); err != nil {
+		return nil, err
+	}
+
+	spec := new(types.TemplateSpec)
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if err := decoder.Decode(spec); err != nil {
+		return nil, fmt.Errorf("invalid template: %w", err)
+	}
+	if spec.Name == "" {
+		return nil, errors.New("template name is required")
+	}
+
+	return spec.ToTemplate()
+}
+
+func (s *Service) updateSync(ctx context.Context, source *types.TemplateSource, revision string, syncError string) error {
+	now := time.Now().UTC().UnixMilli()
+	source.Revision = revision
+	source.Synced = now
+	source.SyncError = syncError
+	source.Updated = now
+	if err := s.sourceStore.UpdateSync(ctx, source); err != nil {
+		return err
+	}
+	if syncError != "" {
+		return errors.New(syncError)
+	}
+	return nil
+}
@@ -0,0 +1,211 @@
+package templatesource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cloudness-io/cloudness/app/services/schema"
+	"github.com/cloudness-io/cloudness/app/store"
+	baseStore "github.com/cloudness-io/cloudness/store"
+	"github.com/cloudness-io/cloudness/types"
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+const testTemplateYAML = `name: Whoami
+tags: [tools]
+services:
+  - name: whoami
+    build:
+      source:
+        registry:
+          image: traefik/whoami:%s
+    deploy: {}
+    networking:
+      containerPorts: [80]
+`
+
+func testTemplate(tag string) string {
+	return fmt.Sprintf(testTemplateYAML, tag)
+}
+
+// fakeTemplateStore keeps the synced templates and their versions in memory.
+type fakeTemplateStore struct {
+	store.TemplateStore
+
+	templates map[string]*types.Template
+	versions  map[int64][]*types.TemplateVersion
+	kept      []string
+}
+
+func newFakeTemplateStore() *fakeTemplateStore {
+	return &fakeTemplateStore{
+		templates: map[string]*types.Template{},
+		versions:  map[int64][]*types.TemplateVersion{},
+	}
+}
+
+func (s *fakeTemplateStore) FindBySlug(_ context.Context, slug string) (*types.Template, error) {
+	if tmpl, ok := s.templates[slug]; ok {
+		return tmpl, nil
+	}
+	return nil, baseStore.ErrResourceNotFound
+}
+
+func (s *fakeTemplateStore) CreateSynced(_ context.Context, tmpl *types.Template, version *types.TemplateVersion) error {
+	tmpl.ID = int64(len(s.templates) + 1)
+	s.templates[tmpl.Slug] = tmpl
+	version.TemplateID = tmpl.ID
+	s.versions[tmpl.ID] = append(s.versions[tmpl.ID], version)
+	return nil
+}
+
+func (s *fakeTemplateStore) FindLatestVersion(_ context.Context, templateID int64) (*types.TemplateVersion, error) {
+	versions := s.versions[templateID]
+	if len(versions) == 0 {
+		return nil, baseStore.ErrResourceNotFound
+	}
+	return versions[len(versions)-1], nil
+}
+
+func (s *fakeTemplateStore) CreateVersion(_ context.Context, version *types.TemplateVersion) error {
+	s.versions[version.TemplateID] = append(s.versions[version.TemplateID], version)
+	return nil
+}
+
+func (s *fakeTemplateStore) DeleteBySourceNotInSlugs(_ context.Context, _ int64, slugs []string) error {
+	s.kept = slugs
+	return nil
+}
+
+type fakeSourceStore struct {
+	store.TemplateSourceStore
+}
+
+func (s *fakeSourceStore) UpdateSync(_ context.Context, _ *types.TemplateSource) error {
+	return nil
+}
+
+func newTestService(templateStore store.TemplateStore) *Service {
+	return New(nil, nil, &fakeSourceStore{}, templateStore, schema.NewService(), nil, nil, nil, nil)
+}
+
+func TestParse(t *testing.T) {
+	svc := newTestService(newFakeTemplateStore())
+
+	tmpl, err := svc.parse(context.Background(), &templateFile{path: "whoami.yaml", data: []byte(testTemplate("v1.10"))})
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if tmpl.Name != "Whoami" || tmpl.Slug != "whoami" {
+		t.Errorf("template = %s (%s), want Whoami (whoami)", tmpl.Name, tmpl.Slug)
+	}
+	if !strings.Contains(tmpl.SpecJson, "traefik/whoami:v1.10") {
+		t.Errorf("spec = %s, want the image of the yaml", tmpl.SpecJson)
+	}
+
+	tests := map[string]*templateFile{
+		"invalid yaml":  {path: "whoami.yml", data: []byte("name: [")},
+		"invalid json":  {path: "whoami.json", data: []byte(`{"name": "Whoami"`)},
+		"no services":   {path: "whoami.json", data: []byte(`{"name": "Whoami"}`)},
+		"unknown field": {path: "whoami.yaml", data: []byte(testTemplate("v1.10") + "unknown: true\n")},
+	}
+	for name, file := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := svc.parse(context.Background(), file); err == nil {
+				t.Fatal("expected the template to be rejected")
+			}
+		})
+	}
+}
+
+func TestSyncVersions(t *testing.T) {
+	tag := "v1.10"
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/index.yaml":
+			w.Write([]byte("templates:\n  - whoami.yaml\n"))
+		case "/whoami.yaml":
+			w.Write([]byte(testTemplate(tag)))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	templateStore := newFakeTemplateStore()
+	svc := newTestService(templateStore)
+	svc.httpClient = srv.Client()
+	source := &types.TemplateSource{ID: 7, Name: "catalog", Type: enum.TemplateSourceTypeHTTPS, URL: srv.URL + "/index.yaml"}
+
+	sync := func() {
+		t.Helper()
+		if err := svc.sync(context.Background(), source); err != nil {
+			t.Fatalf("sync: %v", err)
+		}
+	}
+
+	sync()
+	tmpl, ok := templateStore.templates["whoami-7"]
+	if !ok {
+		t.Fatalf("templates = %v, want whoami-7", templateStore.templates)
+	}
+	if tmpl.Version != 1 || tmpl.SourceID == nil || *tmpl.SourceID != source.ID {
+		t.Errorf("template version %d of source %v, want version 1 of source %d", tmpl.Version, tmpl.SourceID, source.ID)
+	}
+	revision := source.Revision
+
+	sync()
+	if got := len(templateStore.versions[tmpl.ID]); got != 1 {
+		t.Errorf("versions = %d after an unchanged sync, want 1", got)
+	}
+
+	tag = "v1.11"
+	sync()
+	versions := templateStore.versions[tmpl.ID]
+	if len(versions) != 2 {
+		t.Fatalf("versions = %d after a change, want 2", len(versions))
+	}
+	if versions[1].Number != 2 || !strings.Contains(versions[1].SpecJson, "traefik/whoami:v1.11") {
+		t.Errorf("version %d spec = %s, want version 2 of the changed image", versions[1].Number, versions[1].SpecJson)
+	}
+	if source.Revision == revision {
+		t.Error("revision did not change with the content of the source")
+	}
+	if !strings.Contains(tmpl.SpecJson, "traefik/whoami:v1.10") {
+		t.Errorf("published spec = %s, the new version must wait to be published", tmpl.SpecJson)
+	}
+	if len(templateStore.kept) != 1 || templateStore.kept[0] != "whoami-7" {
+		t.Errorf("kept templates = %v, want whoami-7", templateStore.kept)
+	}
+}
+
+func TestSyncInternalIndex(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Write([]byte("templates: []\n"))
+	}))
+	defer srv.Close()
+
+	svc := newTestService(newFakeTemplateStore())
+	svc.httpClient = srv.Client()
+
+	tenantID := int64(3)
+	teamSource := &types.TemplateSource{ID: 7, TenantID: &tenantID, Name: "catalog", Type: enum.TemplateSourceTypeHTTPS, URL: srv.URL + "/index.yaml"}
+	if err := svc.sync(context.Background(), teamSource); err == nil {
+		t.Fatal("expected the sync of a loopback index of a team source to fail")
+	}
+	if teamSource.SyncError == "" {
+		t.Error("expected the sync error to be recorded")
+	}
+
+	instanceSource := &types.TemplateSource{ID: 8, Name: "stack", Type: enum.TemplateSourceTypeHTTPS, URL: srv.URL + "/index.yaml"}
+	if err := svc.sync(context.Background(), instanceSource); err != nil {
+		t.Fatalf("sync of a loopback index of an instance source: %v", err)
+	}
+	if instanceSource.SyncError != "" || instanceSource.Revision == "" {
+		t.Errorf("sync error %q at revision %q, want a successful sync", instanceSource.SyncError, instanceSource.Revision)
+	}
+}
@@ -0,0 +1,107 @@
+package templatesource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/cloudness-io/cloudness/app/services/gitconnection"
+	"github.com/cloudness-io/cloudness/app/services/githubapp"
+	"github.com/cloudness-io/cloudness/app/services/gitpublic"
+	"github.com/cloudness-io/cloudness/app/services/schema"
+	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/encrypt"
+	cloudhttp "github.com/cloudness-io/cloudness/http"
+	"github.com/cloudness-io/cloudness/job"
+	"github.com/cloudness-io/cloudness/types"
+)
+
+const (
+	jobTypeSync        = "cloudness:templatesource:sync"
+	jobCronSync        = "*/30 * * * *" // every 30 minutes
+	jobMaxDurationSync = 10 * time.Minute
+
+	fetchTimeout = 30 * time.Second
+)
+
+// Service syncs the templates of the template sources, periodically and on demand.
+type Service struct {
+	//job related
+	scheduler *job.Scheduler
+	executor  *job.Executor
+
+	//stores
+	sourceStore   store.TemplateSourceStore
+	templateStore store.TemplateStore
+
+	//services
+	schemaSvc        *schema.Service
+	gitPublicSvc     *gitpublic.Service
+	githubAppSvc     *githubapp.Service
+	gitConnectionSvc *gitconnection.Service
+	encrypter        encrypt.Encrypter
+
+	// httpClient reads the indexes of instance sources, which may be served from private hosts,
+	// externalClient reads the indexes of team sources and refuses private addresses.
+	httpClient     *http.Client
+	externalClient *http.Client
+}
+
+func New(
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	sourceStore store.TemplateSourceStore,
+	templateStore store.TemplateStore,
+	schemaSvc *schema.Service,
+	gitPublicSvc *gitpublic.Service,
+	githubAppSvc *githubapp.Service,
+	gitConnectionSvc *gitconnection.Service,
+	encrypter encrypt.Encrypter,
+) *Service {
+	return &Service{
+		scheduler:        scheduler,
+		executor:         executor,
+		sourceStore:      sourceStore,
+		templateStore:    templateStore,
+		schemaSvc:        schemaSvc,
+		gitPublicSvc:     gitPublicSvc,
+		githubAppSvc:     githubAppSvc,
+		gitConnectionSvc: gitConnectionSvc,
+		encrypter:        encrypter,
+		httpClient:       &http.Client{Timeout: fetchTimeout},
+		externalClient:   cloudhttp.NewExternalClient(fetchTimeout),
+	}
+}
+
+func (s *Service) Register(ctx context.Context) error {
+	if err := s.executor.Register(jobTypeSync, newSyncJob(s)); err != nil {
+		return fmt.Errorf("failed to register job handler for template source sync: %w", err)
+	}
+
+	if err := s.scheduler.AddRecurring(
+		ctx,
+		jobTypeSync,
+		jobTypeSync,
+		jobCronSync,
+		jobMaxDurationSync,
+	); err != nil {
+		return fmt.Errorf("failed to schedule template source sync job: %w", err)
+	}
+
+	return nil
+}
+
+// Sync queues the sync of the template source.
+func (s *Service) Sync(ctx context.Context, source *types.TemplateSource) error {
+	if err := s.scheduler.RunJob(ctx, job.Definition{
+		UID:     fmt.Sprintf("template-source-sync-%d-%d", source.ID, time.Now().UnixMilli()),
+		Type:    jobTypeSync,
+		Timeout: jobMaxDurationSync,
+		Data:    strconv.FormatInt(source.ID, 10),
+	}); err != nil {
+		return fmt.Errorf("failed to queue template source sync: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package templatesource
+
+import (
+	"github.com/cloudness-io/cloudness/app/services/gitconnection"
+	"github.com/cloudness-io/cloudness/app/services/githubapp"
+	"github.com/cloudness-io/cloudness/app/services/gitpublic"
+	"github.com/cloudness-io/cloudness/app/services/schema"
+	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/encrypt"
+	"github.com/cloudness-io/cloudness/job"
+
+	"github.com/google/wire"
+)
+
+var WireSet = wire.NewSet(
+	ProvideService,
+)
+
+func ProvideService(
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	sourceStore store.TemplateSourceStore,
+	templateStore store.TemplateStore,
+	schemaSvc *schema.Service,
+	gitPublicSvc *gitpublic.Service,
+	githubAppSvc *githubapp.Service,
+	gitConnectionSvc *gitconnection.Service,
+	encrypter encrypt.Encrypter,
+) *Service {
+	return New(
+		scheduler,
+		executor,
+		sourceStore,
+		templateStore,
+		schemaSvc,
+		gitPublicSvc,
+		githubAppSvc,
+		gitConnectionSvc,
+		encrypter,
+	)
+}
@@ -1,7 +1,14 @@
 package services
 
 import (
+	"github.com/cloudness-io/cloudness/app/services/backup"
 	"github.com/cloudness-io/cloudness/app/services/cleanup"
+	"github.com/cloudness-io/cloudness/app/services/cluster"
+	"github.com/cloudness-io/cloudness/app/services/logarchive"
+	"github.com/cloudness-io/cloudness/app/services/notification"
+	"github.com/cloudness-io/cloudness/app/services/sleep"
+	"github.com/cloudness-io/cloudness/app/services/snapshot"
+	"github.com/cloudness-io/cloudness/app/services/templatesource"
 	"github.com/cloudness-io/cloudness/job"
 
 	"github.com/google/wire"
@@ -12,16 +19,37 @@ var WireSet = wire.NewSet(
 )
 
 type Services struct {
-	JobScheduler *job.Scheduler
-	Cleanup      *cleanup.Service
+	JobScheduler   *job.Scheduler
+	Cleanup        *cleanup.Service
+	Sleep          *sleep.Service
+	LogArchive     *logarchive.Service
+	Backup         *backup.Service
+	Snapshot       *snapshot.Service
+	Notification   *notification.Service
+	Cluster        *cluster.Service
+	TemplateSource *templatesource.Service
 }
 
 func ProvideServices(
 	jobScheduler *job.Scheduler,
 	cleanupSvc *cleanup.Service,
+	sleepSvc *sleep.Service,
+	logArchiveSvc *logarchive.Service,
+	backupSvc *backup.Service,
+	snapshotSvc *snapshot.Service,
+	notificationSvc *notification.Service,
+	clusterSvc *cluster.Service,
+	templateSourceSvc *templatesource.Service,
 ) Services {
 	return Services{
-		JobScheduler: jobScheduler,
-		Cleanup:      cleanupSvc,
+		JobScheduler:   jobScheduler,
+		Cleanup:        cleanupSvc,
+		Sleep:          sleepSvc,
+		LogArchive:     logArchiveSvc,
+		Backup:         backupSvc,
+		Snapshot:       snapshotSvc,
+		Notification:   notificationSvc,
+		Cluster:        clusterSvc,
+		TemplateSource: templateSourceSvc,
 	}
 }
@@ -46,6 +46,9 @@ type (
 		//FindByUID the server by id.
 		FindByUID(ctx context.Context, serverUID int64) (*types.Server, error)
 
+		// FindByRunnerTokenHash finds the server the runner token was issued for.
+		FindByRunnerTokenHash(ctx context.Context, hash string) (*types.Server, error)
+
 		//Update  updates the server details
 		Update(ctx context.Context, server *types.Server) (*types.Server, error)
 
@@ -54,6 +57,15 @@ type (
 
 		//List lists the servers
 		List(ctx context.Context) ([]*types.Server, error)
+
+		// UpdateHealth updates the result of the last health check of the server.
+		UpdateHealth(ctx context.Context, server *types.Server) error
+
+		// UpdateRunnerTokenHash replaces the runner token of the server.
+		UpdateRunnerTokenHash(ctx context.Context, server *types.Server) error
+
+		// Delete deletes the server.
+		Delete(ctx context.Context, id int64) error
 	}
 
 	// PrincipalStore defines the principal data storage.
@@ -85,6 +97,25 @@ type (
 
 		// Count counts the user.
 		CountUsers(ctx context.Context) (int64, error)
+
+		/*
+		 * SERVICE ACCOUNT RELATED OPERATIONS.
+		 */
+
+		// FindServiceAccount finds the service account of the tenant by id.
+		FindServiceAccount(ctx context.Context, tenantID, id int64) (*types.ServiceAccount, error)
+
+		// FindServiceAccountByUID finds the service account of the tenant by uid.
+		FindServiceAccountByUID(ctx context.Context, tenantID int64, uid string) (*types.ServiceAccount, error)
+
+		// ListServiceAccounts lists the service accounts of the tenant.
+		ListServiceAccounts(ctx context.Context, tenantID int64) ([]*types.ServiceAccount, error)
+
+		// CreateServiceAccount saves the service account details.
+		CreateServiceAccount(ctx context.Context, sa *types.ServiceAccount) (*types.ServiceAccount, error)
+
+		// DeleteServiceAccount deletes the service account.
+		DeleteServiceAccount(ctx context.Context, id int64) error
 	}
 
 	// TokenStore defines the token data storage.
@@ -172,6 +203,24 @@ type (
 		Delete(ctx context.Context, tenantID, id int64) error
 	}
 
+	// RegistryCredentialStore defines the private registry credential data storage
+	RegistryCredentialStore interface {
+		// Find the registry credential by id.
+		Find(ctx context.Context, tenantID, id int64) (*types.RegistryCredential, error)
+
+		// FindByUID the registry credential by uid.
+		FindByUID(ctx context.Context, tenantID, uid int64) (*types.RegistryCredential, error)
+
+		// List lists the registry credentials of the tenant.
+		List(ctx context.Context, tenantID int64) ([]*types.RegistryCredential, error)
+
+		// Create saves the registry credential.
+		Create(ctx context.Context, credential *types.RegistryCredential) (*types.RegistryCredential, error)
+
+		// Delete deletes the registry credential.
+		Delete(ctx context.Context, tenantID, id int64) error
+	}
+
 	// ProjectStore defines the project data storage
 	ProjectStore interface {
 		// Find the project by id.
@@ -252,6 +301,9 @@ type (
 		//List lists the github apps for tenant and project
 		List(ctx context.Context, tenantID, projectID int64) ([]*types.GithubApp, error)
 
+		// ListByUID lists the github apps matching the UID across all tenants.
+		ListByUID(ctx context.Context, githubAppUID int64) ([]*types.GithubApp, error)
+
 		// Update updates the github app.
 		Update(ctx context.Context, githubapp *types.GithubApp) (*types.GithubApp, error)
 
@@ -262,6 +314,36 @@ type (
 		Delete(ctx context.Context, githubapp *types.GithubApp) error
 	}
 
+	// GitConnectionStore defines the git provider connection data storage
+	GitConnectionStore interface {
+		// Find the git connection by id.
+		Find(ctx context.Context, tenantID, projectID, id int64) (*types.GitConnection, error)
+
+		// FindByUID finds the git connection by uid.
+		FindByUID(ctx context.Context, tenantID, projectID, uid int64) (*types.GitConnection, error)
+
+		// FindByID finds the git connection by id in any tenant, used to read the repositories of template sources.
+		FindByID(ctx context.Context, id int64) (*types.GitConnection, error)
+
+		// List lists the git connections of the project.
+		List(ctx context.Context, tenantID, projectID int64) ([]*types.GitConnection, error)
+
+		// ListByUID lists the git connections matching the uid across all tenants.
+		ListByUID(ctx context.Context, uid int64) ([]*types.GitConnection, error)
+
+		// ListByTenant lists the git connections of every project of the tenant.
+		ListByTenant(ctx context.Context, tenantID int64) ([]*types.GitConnection, error)
+
+		// ListAll lists the git connections of every tenant.
+		ListAll(ctx context.Context) ([]*types.GitConnection, error)
+
+		// Create saves the git connection.
+		Create(ctx context.Context, connection *types.GitConnection) (*types.GitConnection, error)
+
+		// Delete deletes the git connection.
+		Delete(ctx context.Context, tenantID, id int64) error
+	}
+
 	// ApplicationStore defines the application data storage
 	ApplicationStore interface {
 		// create save the application
@@ -273,8 +355,8 @@ type (
 		// UpdateDeploymentStatus updates application deployment status
 		UpdateDeploymentStatus(ctx context.Context, application *types.Application) (*types.Application, error)
 
-		// UpdateStatus updates application status
-		UpdateStatus(ctx context.Context, appUID int64, status enum.ApplicationStatus) (*types.Application, error)
+		// UpdateStatus updates application status, it returns false if the status was unchanged.
+		UpdateStatus(ctx context.Context, appUID int64, status enum.ApplicationStatus) (bool, error)
 
 		// UpdateDeploymentTriggerTime updates the application to latest trigger time
 		UpdateDeploymentTriggerTime(ctx context.Context, application *types.Application) (*types.Application, error)
@@ -314,6 +396,9 @@ type (
 		//FindByUID finds the volume by tenant id, project id ,environment id and volume u_id
 		FindByUID(ctx context.Context, tenantID, projectID, environmentID, volumeUID int64) (*types.Volume, error)
 
+		// FindBySlug finds the volume by the slug of its namespace and its slug.
+		FindBySlug(ctx context.Context, parentSlug, slug string) (*types.Volume, error)
+
 		//List lists the applications by tenant id, project id and environment id
 		List(ctx context.Context, filter *types.VolumeFilter) ([]*types.Volume, error)
 
@@ -359,7 +444,10 @@ type (
 		FindByUID(ctx context.Context, applicationID int64, deploymentUID int64) (*types.Deployment, error)
 
 		// List lists the deployments by application id
-		List(ctx context.Context, applicationID int64) ([]*types.Deployment, error)
+		List(ctx context.Context, applicationID int64, filter *types.ListQueryFilter) ([]*types.Deployment, error)
+
+		// Count counts the deployments by application id
+		Count(ctx context.Context, applicationID int64) (int64, error)
 
 		// Create save the deployment
 		Create(ctx context.Context, deployment *types.Deployment) (*types.Deployment, error)
@@ -381,30 +469,94 @@ type (
 
 		// Create writes copies of log stream from reader to store
 		Create(ctx context.Context, deploymentID int64, r io.Reader) error
+
+		// Delete deletes the log of the deployment
+		Delete(ctx context.Context, deploymentID int64) error
+
+		// ListDeploymentIDsStoppedBefore lists the ids of deployments stopped before the given time,
+		// whose logs are still kept in the store.
+		ListDeploymentIDsStoppedBefore(ctx context.Context, stoppedBefore int64, limit int) ([]int64, error)
 	}
 
 	// TemplateStore defines the template data storage
 	TemplateStore interface {
-		//Find the template by id
-		Find(ctx context.Context, id int64) (*types.Template, error)
+		//Find the template by id, templates of template sources of other tenants are not found
+		Find(ctx context.Context, tenantID int64, id int64) (*types.Template, error)
+
+		// FindBySource finds the template of the template source by id
+		FindBySource(ctx context.Context, sourceID int64, id int64) (*types.Template, error)
+
+		// FindBySlug finds the template by slug
+		FindBySlug(ctx context.Context, slug string) (*types.Template, error)
 
 		// UpsertMany updates or inserts the templates
 		UpsertMany(ctx context.Context, templates []*types.Template) error
 
-		// List lists the templates
-		List(ctx context.Context) ([]*types.Template, error)
+		// List lists the templates available to the tenant
+		List(ctx context.Context, tenantID int64) ([]*types.Template, error)
+
+		// ListBySource lists the templates of the template source
+		ListBySource(ctx context.Context, sourceID int64) ([]*types.Template, error)
 
 		// ListTags lists all template tags
 		ListTags(ctx context.Context) ([]*types.Tag, error)
 
-		// ListByTag lists templates associated with a tag slug
-		ListByTag(ctx context.Context, tag string) ([]*types.Template, error)
+		// ListByTag lists templates available to the tenant associated with a tag slug
+		ListByTag(ctx context.Context, tenantID int64, tag string) ([]*types.Template, error)
 
 		// List by slugs
 		ListBySlugs(ctx context.Context, slugs []string) ([]*types.Template, error)
 
-		// List not in slugs
-		ListNotInSlugs(ctx context.Context, slugs []string) ([]*types.Template, error)
+		// List not in slugs, of the templates available to the tenant
+		ListNotInSlugs(ctx context.Context, tenantID int64, slugs []string) ([]*types.Template, error)
+
+		// CreateSynced inserts the template of a template source with its first version
+		CreateSynced(ctx context.Context, tmpl *types.Template, version *types.TemplateVersion) error
+
+		// CreateVersion saves a new version of the template
+		CreateVersion(ctx context.Context, version *types.TemplateVersion) error
+
+		// Publish updates the template to the spec and version it carries
+		Publish(ctx context.Context, tmpl *types.Template) error
+
+		// FindVersion finds the version of the template by number
+		FindVersion(ctx context.Context, templateID int64, number int64) (*types.TemplateVersion, error)
+
+		// FindLatestVersion finds the newest version of the template
+		FindLatestVersion(ctx context.Context, templateID int64) (*types.TemplateVersion, error)
+
+		// ListVersions lists the versions of the template, newest first
+		ListVersions(ctx context.Context, templateID int64) ([]*types.TemplateVersion, error)
+
+		// DeleteBySourceNotInSlugs deletes the templates of the template source that are not in the slugs
+		DeleteBySourceNotInSlugs(ctx context.Context, sourceID int64, slugs []string) error
+	}
+
+	// TemplateSourceStore defines the storage of the catalogs templates are synced from
+	TemplateSourceStore interface {
+		// Find finds the template source by id.
+		Find(ctx context.Context, id int64) (*types.TemplateSource, error)
+
+		// FindByUID finds the template source by uid.
+		FindByUID(ctx context.Context, uid int64) (*types.TemplateSource, error)
+
+		// ListInstanceLevel lists the template sources available to every tenant.
+		ListInstanceLevel(ctx context.Context) ([]*types.TemplateSource, error)
+
+		// ListTenantLevel lists the template sources of the tenant.
+		ListTenantLevel(ctx context.Context, tenantID int64) ([]*types.TemplateSource, error)
+
+		// ListAll lists the template sources of the instance and of every tenant.
+		ListAll(ctx context.Context) ([]*types.TemplateSource, error)
+
+		// Create saves the template source.
+		Create(ctx context.Context, source *types.TemplateSource) (*types.TemplateSource, error)
+
+		// UpdateSync updates the revision and the result of the last sync of the template source.
+		UpdateSync(ctx context.Context, source *types.TemplateSource) error
+
+		// Delete deletes the template source, its templates are deleted with it.
+		Delete(ctx context.Context, id int64) error
 	}
 
 	// FavoriteStore defines the favorite data storage
@@ -430,4 +582,127 @@ type (
 		// ListByApplicationUID lists the metrics by application uid
 		ListByApplicationUID(ctx context.Context, applicationUID int64, from time.Time, to time.Time, bucketSeconds int64) ([]*types.AppMetricsAggregate, error)
 	}
+
+	// AuditEventStore defines the audit event data storage
+	AuditEventStore interface {
+		// Create saves the audit event.
+		Create(ctx context.Context, event *types.AuditEvent) error
+
+		// List lists the audit events matching the filter, newest first.
+		List(ctx context.Context, filter *types.AuditFilter) ([]*types.AuditEvent, error)
+
+		// Count counts the audit events matching the filter.
+		Count(ctx context.Context, filter *types.AuditFilter) (int64, error)
+	}
+
+	// BackupPolicyStore defines the backup policy data storage
+	BackupPolicyStore interface {
+		// Find finds the backup policy of the application.
+		Find(ctx context.Context, applicationID int64) (*types.BackupPolicy, error)
+
+		// ListDue lists the enabled backup policies with a next run at or before the time.
+		ListDue(ctx context.Context, now int64) ([]*types.BackupPolicy, error)
+
+		// Upsert creates or updates the backup policy of the application.
+		Upsert(ctx context.Context, policy *types.BackupPolicy) (*types.BackupPolicy, error)
+
+		// UpdateNextRun updates the time the policy is due next.
+		UpdateNextRun(ctx context.Context, id int64, nextRun int64) error
+	}
+
+	// BackupStore defines the backup data storage
+	BackupStore interface {
+		// Find finds the backup by id.
+		Find(ctx context.Context, id int64) (*types.Backup, error)
+
+		// FindByUID finds the backup of the application by uid.
+		FindByUID(ctx context.Context, applicationID, uid int64) (*types.Backup, error)
+
+		// List lists the backups of the application, newest first.
+		List(ctx context.Context, applicationID int64) ([]*types.Backup, error)
+
+		// Create saves the backup.
+		Create(ctx context.Context, backup *types.Backup) (*types.Backup, error)
+
+		// Update updates the state of the backup.
+		Update(ctx context.Context, backup *types.Backup) (*types.Backup, error)
+
+		// Delete deletes the backup.
+		Delete(ctx context.Context, id int64) error
+	}
+
+	// VolumeSnapshotPolicyStore defines the volume snapshot policy data storage
+	VolumeSnapshotPolicyStore interface {
+		// Find finds the snapshot policy of the volume.
+		Find(ctx context.Context, volumeID int64) (*types.VolumeSnapshotPolicy, error)
+
+		// ListDue lists the enabled snapshot policies with a next run at or before the time.
+		ListDue(ctx context.Context, now int64) ([]*types.VolumeSnapshotPolicy, error)
+
+		// Upsert creates or updates the snapshot policy of the volume.
+		Upsert(ctx context.Context, policy *types.VolumeSnapshotPolicy) (*types.VolumeSnapshotPolicy, error)
+
+		// UpdateNextRun updates the time the policy is due next.
+		UpdateNextRun(ctx context.Context, id int64, nextRun int64) error
+	}
+
+	// VolumeSnapshotStore defines the volume snapshot data storage
+	VolumeSnapshotStore interface {
+		// Find finds the volume snapshot by id.
+		Find(ctx context.Context, id int64) (*types.VolumeSnapshot, error)
+
+		// FindByUID finds the snapshot of the volume by uid.
+		FindByUID(ctx context.Context, volumeID, uid int64) (*types.VolumeSnapshot, error)
+
+		// List lists the snapshots of the volume, newest first.
+		List(ctx context.Context, volumeID int64) ([]*types.VolumeSnapshot, error)
+
+		// Create saves the volume snapshot.
+		Create(ctx context.Context, snapshot *types.VolumeSnapshot) (*types.VolumeSnapshot, error)
+
+		// Update updates the state of the volume snapshot.
+		Update(ctx context.Context, snapshot *types.VolumeSnapshot) (*types.VolumeSnapshot, error)
+
+		// Delete deletes the volume snapshot.
+		Delete(ctx context.Context, id int64) error
+	}
+
+	// NotificationChannelStore defines the notification channel data storage
+	NotificationChannelStore interface {
+		// Find finds the notification channel by id.
+		Find(ctx context.Context, id int64) (*types.NotificationChannel, error)
+
+		// FindByUID finds the notification channel of the tenant by uid.
+		FindByUID(ctx context.Context, tenantID, uid int64) (*types.NotificationChannel, error)
+
+		// ListTenantLevel lists the channels of the tenant that are not scoped to a project.
+		ListTenantLevel(ctx context.Context, tenantID int64) ([]*types.NotificationChannel, error)
+
+		// ListProjectLevel lists the channels scoped to the project.
+		ListProjectLevel(ctx context.Context, tenantID, projectID int64) ([]*types.NotificationChannel, error)
+
+		// ListForProject lists the channels receiving the events of the project, tenant level channels included.
+		ListForProject(ctx context.Context, tenantID, projectID int64) ([]*types.NotificationChannel, error)
+
+		// ListAllTenantLevel lists the tenant level channels of every tenant.
+		ListAllTenantLevel(ctx context.Context) ([]*types.NotificationChannel, error)
+
+		// Create saves the notification channel.
+		Create(ctx context.Context, channel *types.NotificationChannel) (*types.NotificationChannel, error)
+
+		// Delete deletes the notification channel.
+		Delete(ctx context.Context, tenantID, id int64) error
+	}
+
+	// NotificationAlertStore defines the storage of alerts that were already notified
+	NotificationAlertStore interface {
+		// List lists the keys of the open alerts.
+		List(ctx context.Context) ([]string, error)
+
+		// Create opens the alert, it is a no-op for an alert that is already open.
+		Create(ctx context.Context, key string, created int64) error
+
+		// Delete resolves the alert.
+		Delete(ctx context.Context, key string) error
+	}
 )
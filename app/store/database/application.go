@@ -53,6 +53,7 @@ const applicationColumns = `
 	,application_status
 	,application_spec
 	,application_githubapp_id
+	,application_git_connection_id
 	,application_domain
 	,application_custom_domain
 	,application_private_domain
@@ -79,6 +80,7 @@ INSERT INTO applications  (
 	,application_status
 	,application_spec
 	,application_githubapp_id
+	,application_git_connection_id
 	,application_domain
 	,application_custom_domain
 	,application_private_domain
@@ -103,6 +105,7 @@ INSERT INTO applications  (
 	,:application_status
 	,:application_spec
 	,:application_githubapp_id
+	,:application_git_connection_id
 	,:application_domain
 	,:application_custom_domain
 	,:application_private_domain
@@ -156,13 +159,24 @@ func (s *ApplicationStore) UpdateDeploymentStatus(ctx context.Context, applicati
 	return s.update(ctx, application, applicationUpdateDeploymentStatus)
 }
 
-func (s *ApplicationStore) UpdateStatus(ctx context.Context, appUID int64, status enum.ApplicationStatus) (*types.Application, error) {
-	const applicationUpdateStatus = `UPDATE applications 
+func (s *ApplicationStore) UpdateStatus(ctx context.Context, appUID int64, status enum.ApplicationStatus) (bool, error) {
+	const applicationUpdateStatus = `UPDATE applications
 		SET
-			application_status = :application_status
-		WHERE application_uid = :application_uid`
+			application_status = $1
+		WHERE application_uid = $2 AND application_status <> $1`
 
-	return s.update(ctx, &types.Application{UID: appUID, Status: status}, applicationUpdateStatus)
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	result, err := db.ExecContext(ctx, applicationUpdateStatus, status, appUID)
+	if err != nil {
+		return false, database.ProcessSQLErrorf(ctx, err, "Update application status query failed")
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return false, database.ProcessSQLErrorf(ctx, err, "Failed to get update count")
+	}
+	return count > 0, nil
 }
 
 func (s *ApplicationStore) UpdateDeploymentTriggerTime(ctx context.Context, application *types.Application) (*types.Application, error) {
@@ -397,6 +411,18 @@ func (s *ApplicationStore) applyQueryFilter(stmt squirrel.SelectBuilder, filter
 		stmt = stmt.Where("application_environment_id = ?", filter.EnvironmentID)
 	}
 
+	if filter.ServerID != nil {
+		stmt = stmt.Where("application_server_id = ?", filter.ServerID)
+	}
+
+	if filter.GithubAppID != nil {
+		stmt = stmt.Where("application_githubapp_id = ?", filter.GithubAppID)
+	}
+
+	if filter.GitConnectionID != nil {
+		stmt = stmt.Where("application_git_connection_id = ?", filter.GitConnectionID)
+	}
+
 	return stmt
 }
 
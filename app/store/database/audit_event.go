@@ -0,0 +1,179 @@
+package database
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/store/database"
+	"github.com/cloudness-io/cloudness/store/database/dbtx"
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+var _ store.AuditEventStore = (*AuditEventStore)(nil)
+
+func NewAuditEventStore(db *sqlx.DB) *AuditEventStore {
+	return &AuditEventStore{
+		db: db,
+	}
+}
+
+// AuditEventStore implements a AuditEventStore backed by a relational database.
+type AuditEventStore struct {
+	db *sqlx.DB
+}
+
+const auditEventColumns = `
+	audit_event_id
+	,audit_event_tenant_id
+	,audit_event_project_id
+	,audit_event_environment_id
+	,audit_event_application_id
+	,audit_event_actor_id
+	,audit_event_actor_name
+	,audit_event_actor_email
+	,audit_event_action
+	,audit_event_resource_type
+	,audit_event_resource_id
+	,audit_event_resource_name
+	,audit_event_scope
+	,audit_event_diff
+	,audit_event_client_ip
+	,audit_event_request_id
+	,audit_event_request_method
+	,audit_event_created`
+
+const auditEventInsert = `
+INSERT INTO audit_events (
+	audit_event_tenant_id
+	,audit_event_project_id
+	,audit_event_environment_id
+	,audit_event_application_id
+	,audit_event_actor_id
+	,audit_event_actor_name
+	,audit_event_actor_email
+	,audit_event_action
+	,audit_event_resource_type
+	,audit_event_resource_id
+	,audit_event_resource_name
+	,audit_event_scope
+	,audit_event_diff
+	,audit_event_client_ip
+	,audit_event_request_id
+	,audit_event_request_method
+	,audit_event_created
+) values (
+	:audit_event_tenant_id
+	,:audit_event_project_id
+	,:audit_event_environment_id
+	,:audit_event_application_id
+	,:audit_event_actor_id
+	,:audit_event_actor_name
+	,:audit_event_actor_email
+	,:audit_event_action
+	,:audit_event_resource_type
+	,:audit_event_resource_id
+	,:audit_event_resource_name
+	,:audit_event_scope
+	,:audit_event_diff
+	,:audit_event_client_ip
+	,:audit_event_request_id
+	,:audit_event_request_method
+	,:audit_event_created
+	) RETURNING audit_event_id
+	`
+
+// Create saves the audit event.
+func (s *AuditEventStore) Create(ctx context.Context, event *types.AuditEvent) error {
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	query, arg, err := db.BindNamed(auditEventInsert, event)
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to bind audit event object")
+	}
+
+	if err = db.QueryRowContext(ctx, query, arg...).Scan(&event.ID); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Insert audit event query failed")
+	}
+
+	return nil
+}
+
+// List lists the audit events matching the filter, newest first.
+func (s *AuditEventStore) List(ctx context.Context, filter *types.AuditFilter) ([]*types.AuditEvent, error) {
+	stmt := database.Builder.
+		Select(auditEventColumns).
+		From("audit_events").
+		OrderBy("audit_event_created DESC", "audit_event_id DESC")
+
+	stmt = s.applyFilter(stmt, filter)
+	if filter.Size > 0 {
+		stmt = stmt.Limit(database.Limit(filter.Size))
+		stmt = stmt.Offset(database.Offset(filter.Page, filter.Size))
+	}
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to convert query to sql")
+	}
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := []*types.AuditEvent{}
+	if err := db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "List audit events query failed")
+	}
+	return dst, nil
+}
+
+// Count counts the audit events matching the filter.
+func (s *AuditEventStore) Count(ctx context.Context, filter *types.AuditFilter) (int64, error) {
+	stmt := database.Builder.
+		Select("COUNT(*)").
+		From("audit_events")
+
+	stmt = s.applyFilter(stmt, filter)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return 0, errors.Wrap(err, "Failed to convert query to sql")
+	}
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	var count int64
+	if err := db.QueryRowContext(ctx, sql, args...).Scan(&count); err != nil {
+		return 0, database.ProcessSQLErrorf(ctx, err, "Count audit events query failed")
+	}
+	return count, nil
+}
+
+func (s *AuditEventStore) applyFilter(stmt squirrel.SelectBuilder, filter *types.AuditFilter) squirrel.SelectBuilder {
+	stmt = stmt.Where("audit_event_tenant_id = ?", filter.TenantID)
+
+	if filter.ProjectID > 0 {
+		stmt = stmt.Where("audit_event_project_id = ?", filter.ProjectID)
+	}
+	if filter.ActorID > 0 {
+		stmt = stmt.Where("audit_event_actor_id = ?", filter.ActorID)
+	}
+	if filter.Action != "" {
+		stmt = stmt.Where("audit_event_action = ?", filter.Action)
+	}
+	if filter.ResourceType != "" {
+		stmt = stmt.Where("audit_event_resource_type = ?", filter.ResourceType)
+	}
+	if filter.CreatedGt > 0 {
+		stmt = stmt.Where("audit_event_created > ?", filter.CreatedGt)
+	}
+	if filter.CreatedLt > 0 {
+		stmt = stmt.Where("audit_event_created < ?", filter.CreatedLt)
+	}
+	if filter.Query != "" {
+		stmt = stmt.Where(PartialMatch("audit_event_resource_name", filter.Query))
+	}
+	return stmt
+}
@@ -38,6 +38,15 @@ const authSettingColumns = `
    auth_client_id,
    auth_client_secret,
    auth_base_url,
+   auth_display_name,
+   auth_scopes,
+   auth_email_claim,
+   auth_name_claim,
+   auth_groups_claim,
+   auth_join_tenant_uid,
+   auth_join_role,
+   auth_join_domains,
+   auth_join_groups,
    auth_created,
    auth_updated
 `
@@ -49,6 +58,15 @@ INSERT INTO auth_settings (
 	,auth_client_id
 	,auth_client_secret
 	,auth_base_url
+	,auth_display_name
+	,auth_scopes
+	,auth_email_claim
+	,auth_name_claim
+	,auth_groups_claim
+	,auth_join_tenant_uid
+	,auth_join_role
+	,auth_join_domains
+	,auth_join_groups
 	,auth_created
 	,auth_updated
 ) VALUES (
@@ -57,6 +75,15 @@ INSERT INTO auth_settings (
 	,:auth_client_id
 	,:auth_client_secret
 	,:auth_base_url
+	,:auth_display_name
+	,:auth_scopes
+	,:auth_email_claim
+	,:auth_name_claim
+	,:auth_groups_claim
+	,:auth_join_tenant_uid
+	,:auth_join_role
+	,:auth_join_domains
+	,:auth_join_groups
 	,:auth_created
 	,:auth_updated
 ) 
@@ -87,6 +114,15 @@ func (s *AuthSettingsStore) Update(ctx context.Context, auth *types.AuthSetting)
 		,auth_client_id = :auth_client_id
 		,auth_client_secret = :auth_client_secret
 		,auth_base_url = :auth_base_url
+		,auth_display_name = :auth_display_name
+		,auth_scopes = :auth_scopes
+		,auth_email_claim = :auth_email_claim
+		,auth_name_claim = :auth_name_claim
+		,auth_groups_claim = :auth_groups_claim
+		,auth_join_tenant_uid = :auth_join_tenant_uid
+		,auth_join_role = :auth_join_role
+		,auth_join_domains = :auth_join_domains
+		,auth_join_groups = :auth_join_groups
 		,auth_updated = :auth_updated
 	WHERE auth_provider = :auth_provider`
 
@@ -0,0 +1,203 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/store/database"
+	"github.com/cloudness-io/cloudness/store/database/dbtx"
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/jmoiron/sqlx"
+)
+
+var _ store.BackupStore = (*BackupStore)(nil)
+
+func NewBackupStore(db *sqlx.DB) *BackupStore {
+	return &BackupStore{
+		db: db,
+	}
+}
+
+type BackupStore struct {
+	db *sqlx.DB
+}
+
+const backupColumns = `
+	backup_id
+	,backup_uid
+	,backup_tenant_id
+	,backup_project_id
+	,backup_environment_id
+	,backup_application_id
+	,backup_kind
+	,backup_trigger
+	,backup_status
+	,backup_blob_path
+	,backup_size
+	,backup_error
+	,backup_created_by
+	,backup_started
+	,backup_finished
+	,backup_restore_status
+	,backup_restore_error
+	,backup_restored
+	,backup_created
+	,backup_updated`
+
+const backupInsert = `
+INSERT INTO backups (
+	backup_uid
+	,backup_tenant_id
+	,backup_project_id
+	,backup_environment_id
+	,backup_application_id
+	,backup_kind
+	,backup_trigger
+	,backup_status
+	,backup_blob_path
+	,backup_size
+	,backup_error
+	,backup_created_by
+	,backup_started
+	,backup_finished
+	,backup_restore_status
+	,backup_restore_error
+	,backup_restored
+	,backup_created
+	,backup_updated
+) values (
+	:backup_uid
+	,:backup_tenant_id
+	,:backup_project_id
+	,:backup_environment_id
+	,:backup_application_id
+	,:backup_kind
+	,:backup_trigger
+	,:backup_status
+	,:backup_blob_path
+	,:backup_size
+	,:backup_error
+	,:backup_created_by
+	,:backup_started
+	,:backup_finished
+	,:backup_restore_status
+	,:backup_restore_error
+	,:backup_restored
+	,:backup_created
+	,:backup_updated
+	) RETURNING backup_id
+	`
+
+const backupUpdate = `
+UPDATE backups
+SET
+	backup_status = :backup_status
+	,backup_blob_path = :backup_blob_path
+	,backup_size = :backup_size
+	,backup_error = :backup_error
+	,backup_started = :backup_started
+	,backup_finished = :backup_finished
+	,backup_restore_status = :backup_restore_status
+	,backup_restore_error = :backup_restore_error
+	,backup_restored = :backup_restored
+	,backup_updated = :backup_updated
+WHERE backup_id = :backup_id`
+
+const backupSelectBase = `
+	SELECT` + backupColumns + `
+	FROM backups`
+
+// Find finds the backup by id.
+func (s *BackupStore) Find(ctx context.Context, id int64) (*types.Backup, error) {
+	const sqlQuery = backupSelectBase + `
+	WHERE backup_id = $1`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := new(types.Backup)
+	if err := db.GetContext(ctx, dst, sqlQuery, id); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Select backup by id query failed")
+	}
+	return dst, nil
+}
+
+// FindByUID finds the backup of the application by uid.
+func (s *BackupStore) FindByUID(ctx context.Context, applicationID, uid int64) (*types.Backup, error) {
+	const sqlQuery = backupSelectBase + `
+	WHERE backup_application_id = $1 AND backup_uid = $2`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := new(types.Backup)
+	if err := db.GetContext(ctx, dst, sqlQuery, applicationID, uid); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Select backup by uid query failed")
+	}
+	return dst, nil
+}
+
+// List lists the backups of the application, newest first.
+func (s *BackupStore) List(ctx context.Context, applicationID int64) ([]*types.Backup, error) {
+	const sqlQuery = backupSelectBase + `
+	WHERE backup_application_id = $1
+	ORDER BY backup_created DESC`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := []*types.Backup{}
+	if err := db.SelectContext(ctx, &dst, sqlQuery, applicationID); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Select backups query failed")
+	}
+	return dst, nil
+}
+
+// Create saves the backup.
+func (s *BackupStore) Create(ctx context.Context, backup *types.Backup) (*types.Backup, error) {
+	now := time.Now().UTC().UnixMilli()
+	backup.Created = now
+	backup.Updated = now
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	query, arg, err := db.BindNamed(backupInsert, backup)
+	if err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to bind backup object")
+	}
+
+	if err = db.QueryRowContext(ctx, query, arg...).Scan(&backup.ID); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Insert backup query failed")
+	}
+
+	return backup, nil
+}
+
+// Update updates the state of the backup.
+func (s *BackupStore) Update(ctx context.Context, backup *types.Backup) (*types.Backup, error) {
+	backup.Updated = time.Now().UTC().UnixMilli()
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	query, arg, err := db.BindNamed(backupUpdate, backup)
+	if err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to bind backup object")
+	}
+
+	if _, err = db.ExecContext(ctx, query, arg...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Update backup query failed")
+	}
+
+	return backup, nil
+}
+
+// Delete deletes the backup.
+func (s *BackupStore) Delete(ctx context.Context, id int64) error {
+	const sqlQuery = `DELETE FROM backups WHERE backup_id = $1`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	if _, err := db.ExecContext(ctx, sqlQuery, id); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Delete backup query failed")
+	}
+	return nil
+}
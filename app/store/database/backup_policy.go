@@ -0,0 +1,128 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/store/database"
+	"github.com/cloudness-io/cloudness/store/database/dbtx"
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/jmoiron/sqlx"
+)
+
+var _ store.BackupPolicyStore = (*BackupPolicyStore)(nil)
+
+func NewBackupPolicyStore(db *sqlx.DB) *BackupPolicyStore {
+	return &BackupPolicyStore{
+		db: db,
+	}
+}
+
+type BackupPolicyStore struct {
+	db *sqlx.DB
+}
+
+const backupPolicyColumns = `
+	backup_policy_id
+	,backup_policy_application_id
+	,backup_policy_enabled
+	,backup_policy_schedule
+	,backup_policy_retention
+	,backup_policy_next_run
+	,backup_policy_created
+	,backup_policy_updated`
+
+const backupPolicyUpsert = `
+INSERT INTO backup_policies (
+	backup_policy_application_id
+	,backup_policy_enabled
+	,backup_policy_schedule
+	,backup_policy_retention
+	,backup_policy_next_run
+	,backup_policy_created
+	,backup_policy_updated
+) values (
+	:backup_policy_application_id
+	,:backup_policy_enabled
+	,:backup_policy_schedule
+	,:backup_policy_retention
+	,:backup_policy_next_run
+	,:backup_policy_created
+	,:backup_policy_updated
+) ON CONFLICT (backup_policy_application_id)
+DO UPDATE SET
+	backup_policy_enabled = EXCLUDED.backup_policy_enabled
+	,backup_policy_schedule = EXCLUDED.backup_policy_schedule
+	,backup_policy_retention = EXCLUDED.backup_policy_retention
+	,backup_policy_next_run = EXCLUDED.backup_policy_next_run
+	,backup_policy_updated = EXCLUDED.backup_policy_updated
+RETURNING backup_policy_id`
+
+const backupPolicySelectBase = `
+	SELECT` + backupPolicyColumns + `
+	FROM backup_policies`
+
+// Find finds the backup policy of the application.
+func (s *BackupPolicyStore) Find(ctx context.Context, applicationID int64) (*types.BackupPolicy, error) {
+	const sqlQuery = backupPolicySelectBase + `
+	WHERE backup_policy_application_id = $1`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := new(types.BackupPolicy)
+	if err := db.GetContext(ctx, dst, sqlQuery, applicationID); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Select backup policy query failed")
+	}
+	return dst, nil
+}
+
+// ListDue lists the enabled backup policies with a next run at or before the time.
+func (s *BackupPolicyStore) ListDue(ctx context.Context, now int64) ([]*types.BackupPolicy, error) {
+	const sqlQuery = backupPolicySelectBase + `
+	WHERE backup_policy_enabled = $1 AND backup_policy_next_run <= $2
+	ORDER BY backup_policy_next_run`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := []*types.BackupPolicy{}
+	if err := db.SelectContext(ctx, &dst, sqlQuery, true, now); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Select due backup policies query failed")
+	}
+	return dst, nil
+}
+
+// Upsert creates or updates the backup policy of the application.
+func (s *BackupPolicyStore) Upsert(ctx context.Context, policy *types.BackupPolicy) (*types.BackupPolicy, error) {
+	now := time.Now().UTC().UnixMilli()
+	if policy.Created == 0 {
+		policy.Created = now
+	}
+	policy.Updated = now
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	query, arg, err := db.BindNamed(backupPolicyUpsert, policy)
+	if err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to bind backup policy object")
+	}
+
+	if err = db.QueryRowContext(ctx, query, arg...).Scan(&policy.ID); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Upsert backup policy query failed")
+	}
+
+	return policy, nil
+}
+
+// UpdateNextRun updates the time the policy is due next.
+func (s *BackupPolicyStore) UpdateNextRun(ctx context.Context, id int64, nextRun int64) error {
+	const sqlQuery = `UPDATE backup_policies SET backup_policy_next_run = $1 WHERE backup_policy_id = $2`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	if _, err := db.ExecContext(ctx, sqlQuery, nextRun, id); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Update backup policy next run query failed")
+	}
+	return nil
+}
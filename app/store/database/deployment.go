@@ -40,6 +40,7 @@ const deploymentColumns = `
 	,deployment_uid
    ,deployment_application_id
 	,deployment_spec
+	,deployment_base_spec
 	,deployment_needs_build
    ,deployment_triggerer
    ,deployment_title
@@ -48,6 +49,8 @@ const deploymentColumns = `
    ,deployment_error
 	,deployment_version
 	,deployment_machine
+	,deployment_source_uid
+	,deployment_image_uid
    ,deployment_started
 	,deployment_stopped
    ,deployment_created
@@ -58,6 +61,7 @@ INSERT INTO deployments  (
    deployment_uid
    ,deployment_application_id
    ,deployment_spec
+   ,deployment_base_spec
    ,deployment_needs_build
    ,deployment_triggerer
    ,deployment_title
@@ -66,6 +70,8 @@ INSERT INTO deployments  (
    ,deployment_error
    ,deployment_version
 	,deployment_machine
+	,deployment_source_uid
+	,deployment_image_uid
    ,deployment_started
    ,deployment_stopped
    ,deployment_created
@@ -74,6 +80,7 @@ INSERT INTO deployments  (
    :deployment_uid
    ,:deployment_application_id
    ,:deployment_spec
+   ,:deployment_base_spec
    ,:deployment_needs_build
 	,:deployment_triggerer
 	,:deployment_title
@@ -82,6 +89,8 @@ INSERT INTO deployments  (
    ,:deployment_error
    ,:deployment_version
 	,:deployment_machine
+	,:deployment_source_uid
+	,:deployment_image_uid
    ,:deployment_started
    ,:deployment_stopped
    ,:deployment_created
@@ -134,7 +143,7 @@ func (s *DeploymentStore) FindByUID(ctx context.Context, applicationID int64, ui
 	return s.mapDBDeployment(dst)
 }
 
-func (s *DeploymentStore) List(ctx context.Context, applicationID int64) ([]*types.Deployment, error) {
+func (s *DeploymentStore) List(ctx context.Context, applicationID int64, filter *types.ListQueryFilter) ([]*types.Deployment, error) {
 	db := dbtx.GetAccessor(ctx, s.db)
 	dst := []*deployment{}
 
@@ -142,7 +151,9 @@ func (s *DeploymentStore) List(ctx context.Context, applicationID int64) ([]*typ
 		Select(deploymentColumns).
 		From("deployments").
 		Where("deployment_application_id = ?", applicationID).
-		OrderBy("deployment_created DESC")
+		OrderBy("deployment_created DESC").
+		Limit(database.Limit(filter.Size)).
+		Offset(database.Offset(filter.Page, filter.Size))
 
 	sql, args, err := stmt.ToSql()
 	if err != nil {
@@ -157,6 +168,19 @@ func (s *DeploymentStore) List(ctx context.Context, applicationID int64) ([]*typ
 
 }
 
+func (s *DeploymentStore) Count(ctx context.Context, applicationID int64) (int64, error) {
+	const sqlQuery = `SELECT COUNT(*) FROM deployments WHERE deployment_application_id = $1`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	var count int64
+	if err := db.QueryRowContext(ctx, sqlQuery, applicationID).Scan(&count); err != nil {
+		return 0, database.ProcessSQLErrorf(ctx, err, "Count deployments by application id query failed")
+	}
+
+	return count, nil
+}
+
 func (s *DeploymentStore) Update(ctx context.Context, deployment *types.Deployment) error {
 	const deploymentUpdate = `UPDATE deployments 
 	SET 
@@ -237,6 +261,14 @@ func (s *DeploymentStore) mapDBDeployment(dbDeployment *deployment) (*types.Depl
 		return nil, err
 	}
 	d.Spec = compSpec
+
+	if d.BaseSpecJson != "" {
+		baseSpec := new(types.ApplicationSpec)
+		if err := json.Unmarshal([]byte(d.BaseSpecJson), baseSpec); err != nil {
+			return nil, err
+		}
+		d.BaseSpec = baseSpec
+	}
 	return d, nil
 }
 
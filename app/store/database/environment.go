@@ -42,6 +42,10 @@ const environmentColumns = `
    environment_name,
 	environment_slug,
    environment_created_by,
+	environment_server_id,
+	environment_preview_source_id,
+	environment_preview_repo,
+	environment_preview_number,
    environment_created,
    environment_updated,
 	environment_deleted`
@@ -55,6 +59,10 @@ INSERT INTO environments (
    ,environment_name
 	,environment_slug
    ,environment_created_by
+	,environment_server_id
+	,environment_preview_source_id
+	,environment_preview_repo
+	,environment_preview_number
    ,environment_created
    ,environment_updated
 ) values (
@@ -65,6 +73,10 @@ INSERT INTO environments (
    ,:environment_name
 	,:environment_slug
    ,:environment_created_by
+	,:environment_server_id
+	,:environment_preview_source_id
+	,:environment_preview_repo
+	,:environment_preview_number
    ,:environment_created
    ,:environment_updated
 ) RETURNING environment_id`
@@ -252,6 +264,9 @@ func (s *EnvironmentStore) applyQueryFilter(stmt squirrel.SelectBuilder, filter
 	if filter.ProjectID != nil {
 		stmt = stmt.Where("environment_project_id = ?", filter.ProjectID)
 	}
+	if filter.ServerID != nil {
+		stmt = stmt.Where("environment_server_id = ?", filter.ServerID)
+	}
 
 	//nolint:gocritic
 	if filter.DeletedAt != nil {
@@ -262,6 +277,16 @@ func (s *EnvironmentStore) applyQueryFilter(stmt squirrel.SelectBuilder, filter
 		stmt = stmt.Where("environment_deleted IS NULL")
 	}
 
+	if filter.PreviewSourceID != nil {
+		stmt = stmt.Where("environment_preview_source_id = ?", filter.PreviewSourceID)
+	}
+	if filter.PreviewRepo != nil {
+		stmt = stmt.Where("environment_preview_repo = ?", filter.PreviewRepo)
+	}
+	if filter.PreviewNumber != nil {
+		stmt = stmt.Where("environment_preview_number = ?", filter.PreviewNumber)
+	}
+
 	return stmt
 }
 
@@ -0,0 +1,201 @@
+package database
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/store/database"
+	"github.com/cloudness-io/cloudness/store/database/dbtx"
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/jmoiron/sqlx"
+)
+
+var _ store.GitConnectionStore = (*GitConnectionStore)(nil)
+
+func NewGitConnectionStore(db *sqlx.DB) *GitConnectionStore {
+	return &GitConnectionStore{
+		db: db,
+	}
+}
+
+type GitConnectionStore struct {
+	db *sqlx.DB
+}
+
+const gitConnectionColumns = `
+	git_connection_id
+	,git_connection_uid
+	,git_connection_tenant_id
+	,git_connection_project_id
+	,git_connection_name
+	,git_connection_provider
+	,git_connection_server_url
+	,git_connection_username
+	,git_connection_secret
+	,git_connection_webhook_secret
+	,git_connection_created_by
+	,git_connection_created
+	,git_connection_updated`
+
+const gitConnectionInsert = `
+INSERT INTO git_connections (
+	git_connection_uid
+	,git_connection_tenant_id
+	,git_connection_project_id
+	,git_connection_name
+	,git_connection_provider
+	,git_connection_server_url
+	,git_connection_username
+	,git_connection_secret
+	,git_connection_webhook_secret
+	,git_connection_created_by
+	,git_connection_created
+	,git_connection_updated
+) values (
+	:git_connection_uid
+	,:git_connection_tenant_id
+	,:git_connection_project_id
+	,:git_connection_name
+	,:git_connection_provider
+	,:git_connection_server_url
+	,:git_connection_username
+	,:git_connection_secret
+	,:git_connection_webhook_secret
+	,:git_connection_created_by
+	,:git_connection_created
+	,:git_connection_updated
+	) RETURNING git_connection_id
+	`
+
+const gitConnectionSelectBase = `
+	SELECT` + gitConnectionColumns + `
+	FROM git_connections`
+
+// Find the git connection by id.
+func (s *GitConnectionStore) Find(ctx context.Context, tenantID, projectID, id int64) (*types.GitConnection, error) {
+	const sqlQuery = gitConnectionSelectBase + `
+	WHERE git_connection_tenant_id = $1 AND git_connection_project_id = $2 AND git_connection_id = $3`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := new(types.GitConnection)
+	if err := db.GetContext(ctx, dst, sqlQuery, tenantID, projectID, id); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Select git connection by id query failed")
+	}
+	return dst, nil
+}
+
+// FindByUID finds the git connection by uid.
+func (s *GitConnectionStore) FindByUID(ctx context.Context, tenantID, projectID, uid int64) (*types.GitConnection, error) {
+	const sqlQuery = gitConnectionSelectBase + `
+	WHERE git_connection_tenant_id = $1 AND git_connection_project_id = $2 AND git_connection_uid = $3`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := new(types.GitConnection)
+	if err := db.GetContext(ctx, dst, sqlQuery, tenantID, projectID, uid); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Select git connection by uid query failed")
+	}
+	return dst, nil
+}
+
+// FindByID finds the git connection by id in any tenant.
+func (s *GitConnectionStore) FindByID(ctx context.Context, id int64) (*types.GitConnection, error) {
+	const sqlQuery = gitConnectionSelectBase + `
+	WHERE git_connection_id = $1`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := new(types.GitConnection)
+	if err := db.GetContext(ctx, dst, sqlQuery, id); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Select git connection by id query failed")
+	}
+	return dst, nil
+}
+
+// List lists the git connections of the project.
+func (s *GitConnectionStore) List(ctx context.Context, tenantID, projectID int64) ([]*types.GitConnection, error) {
+	const sqlQuery = gitConnectionSelectBase + `
+	WHERE git_connection_tenant_id = $1 AND git_connection_project_id = $2
+	ORDER BY git_connection_name`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := []*types.GitConnection{}
+	if err := db.SelectContext(ctx, &dst, sqlQuery, tenantID, projectID); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Select git connections query failed")
+	}
+	return dst, nil
+}
+
+// ListByUID lists the git connections matching the uid across all tenants.
+func (s *GitConnectionStore) ListByUID(ctx context.Context, uid int64) ([]*types.GitConnection, error) {
+	const sqlQuery = gitConnectionSelectBase + `
+	WHERE git_connection_uid = $1`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := []*types.GitConnection{}
+	if err := db.SelectContext(ctx, &dst, sqlQuery, uid); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Select git connections by uid query failed")
+	}
+	return dst, nil
+}
+
+// ListByTenant lists the git connections of every project of the tenant.
+func (s *GitConnectionStore) ListByTenant(ctx context.Context, tenantID int64) ([]*types.GitConnection, error) {
+	const sqlQuery = gitConnectionSelectBase + `
+	WHERE git_connection_tenant_id = $1
+	ORDER BY git_connection_name`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := []*types.GitConnection{}
+	if err := db.SelectContext(ctx, &dst, sqlQuery, tenantID); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Select git connections by tenant query failed")
+	}
+	return dst, nil
+}
+
+// ListAll lists the git connections of every tenant.
+func (s *GitConnectionStore) ListAll(ctx context.Context) ([]*types.GitConnection, error) {
+	const sqlQuery = gitConnectionSelectBase + `
+	ORDER BY git_connection_name`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := []*types.GitConnection{}
+	if err := db.SelectContext(ctx, &dst, sqlQuery); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Select all git connections query failed")
+	}
+	return dst, nil
+}
+
+// Create saves the git connection.
+func (s *GitConnectionStore) Create(ctx context.Context, connection *types.GitConnection) (*types.GitConnection, error) {
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	query, arg, err := db.BindNamed(gitConnectionInsert, connection)
+	if err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to bind git connection object")
+	}
+
+	if err = db.QueryRowContext(ctx, query, arg...).Scan(&connection.ID); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Insert git connection query failed")
+	}
+
+	return connection, nil
+}
+
+// Delete deletes the git connection.
+func (s *GitConnectionStore) Delete(ctx context.Context, tenantID, id int64) error {
+	const sqlQuery = `DELETE FROM git_connections WHERE git_connection_tenant_id = $1 AND git_connection_id = $2`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	if _, err := db.ExecContext(ctx, sqlQuery, tenantID, id); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Delete git connection query failed")
+	}
+	return nil
+}
@@ -167,6 +167,21 @@ func (s *GithubAppStore) List(ctx context.Context, tenantID, projectID int64) ([
 	return s.mapDBGithubApps(dst), nil
 }
 
+// ListByUID lists the github apps matching the UID across all tenants.
+func (s *GithubAppStore) ListByUID(ctx context.Context, githubAppUID int64) ([]*types.GithubApp, error) {
+	const sqlQuery = githubappSelectBase + `
+	WHERE github_app_uid = $1`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+	dst := []*githubapp{}
+
+	if err := db.SelectContext(ctx, &dst, sqlQuery, githubAppUID); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "List github app by uid query failed")
+	}
+
+	return s.mapDBGithubApps(dst), nil
+}
+
 // Update updates the github app.
 func (s *GithubAppStore) Update(ctx context.Context, githubapp *types.GithubApp) (*types.GithubApp, error) {
 	githubapp.Updated = time.Now().UTC().UnixMilli()
@@ -85,3 +85,39 @@ func (s *LogStore) Create(ctx context.Context, deploymentID int64, r io.Reader)
 
 	return nil
 }
+
+func (s *LogStore) Delete(ctx context.Context, deploymentID int64) error {
+	const sqlQuery = `DELETE FROM logs WHERE log_deployment_id = $1`
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	if _, err := db.ExecContext(ctx, sqlQuery, deploymentID); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "failed to delete log")
+	}
+
+	return nil
+}
+
+func (s *LogStore) ListDeploymentIDsStoppedBefore(ctx context.Context, stoppedBefore int64, limit int) ([]int64, error) {
+	stmt := database.Builder.
+		Select("log_deployment_id").
+		From("logs").
+		InnerJoin("deployments ON deployment_id = log_deployment_id").
+		Where("deployment_stopped > 0").
+		Where("deployment_stopped < ?", stoppedBefore).
+		OrderBy("log_deployment_id").
+		Limit(uint64(limit))
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	ids := []int64{}
+	if err := db.SelectContext(ctx, &ids, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "failed to list logs of stopped deployments")
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,5 @@
+ALTER TABLE environments ADD COLUMN environment_preview_source_id INTEGER DEFAULT NULL;
+ALTER TABLE environments ADD COLUMN environment_preview_repo TEXT NOT NULL DEFAULT '';
+ALTER TABLE environments ADD COLUMN environment_preview_number INTEGER NOT NULL DEFAULT 0;
+
+CREATE INDEX environments_preview_source_id ON environments (environment_preview_source_id);
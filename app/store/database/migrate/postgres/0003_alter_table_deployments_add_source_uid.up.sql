@@ -0,0 +1 @@
+ALTER TABLE deployments ADD COLUMN deployment_source_uid INTEGER DEFAULT NULL;
@@ -0,0 +1,17 @@
+CREATE TABLE registry_credentials (
+    registry_credential_id SERIAL PRIMARY KEY,
+    registry_credential_uid INTEGER NOT NULL,
+    registry_credential_tenant_id INTEGER NOT NULL REFERENCES tenants (tenant_id) ON UPDATE NO ACTION ON DELETE CASCADE,
+    registry_credential_name TEXT NOT NULL,
+    registry_credential_provider TEXT NOT NULL,
+    registry_credential_server TEXT NOT NULL,
+    registry_credential_username TEXT NOT NULL,
+    registry_credential_secret BYTEA NOT NULL,
+    registry_credential_created_by INTEGER NOT NULL,
+    registry_credential_created BIGINT NOT NULL,
+    registry_credential_updated BIGINT NOT NULL,
+    UNIQUE (
+        registry_credential_tenant_id,
+        registry_credential_uid
+    )
+);
@@ -0,0 +1,9 @@
+ALTER TABLE auth_settings ADD COLUMN auth_display_name TEXT NOT NULL DEFAULT '';
+ALTER TABLE auth_settings ADD COLUMN auth_scopes TEXT NOT NULL DEFAULT '';
+ALTER TABLE auth_settings ADD COLUMN auth_email_claim TEXT NOT NULL DEFAULT '';
+ALTER TABLE auth_settings ADD COLUMN auth_name_claim TEXT NOT NULL DEFAULT '';
+ALTER TABLE auth_settings ADD COLUMN auth_groups_claim TEXT NOT NULL DEFAULT '';
+ALTER TABLE auth_settings ADD COLUMN auth_join_tenant_uid INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE auth_settings ADD COLUMN auth_join_role TEXT NOT NULL DEFAULT '';
+ALTER TABLE auth_settings ADD COLUMN auth_join_domains TEXT NOT NULL DEFAULT '';
+ALTER TABLE auth_settings ADD COLUMN auth_join_groups TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,22 @@
+CREATE TABLE audit_events (
+    audit_event_id SERIAL PRIMARY KEY,
+    audit_event_tenant_id INTEGER NOT NULL,
+    audit_event_project_id INTEGER NOT NULL,
+    audit_event_environment_id INTEGER NOT NULL,
+    audit_event_application_id INTEGER NOT NULL,
+    audit_event_actor_id INTEGER NOT NULL,
+    audit_event_actor_name TEXT NOT NULL,
+    audit_event_actor_email TEXT NOT NULL,
+    audit_event_action TEXT NOT NULL,
+    audit_event_resource_type TEXT NOT NULL,
+    audit_event_resource_id TEXT NOT NULL,
+    audit_event_resource_name TEXT NOT NULL,
+    audit_event_scope TEXT NOT NULL,
+    audit_event_diff TEXT NOT NULL,
+    audit_event_client_ip TEXT NOT NULL,
+    audit_event_request_id TEXT NOT NULL,
+    audit_event_request_method TEXT NOT NULL,
+    audit_event_created BIGINT NOT NULL
+);
+
+CREATE INDEX idx_audit_events_tenant_created ON audit_events (audit_event_tenant_id, audit_event_created DESC);
@@ -0,0 +1,38 @@
+CREATE TABLE backup_policies (
+    backup_policy_id SERIAL PRIMARY KEY,
+    backup_policy_application_id INTEGER NOT NULL UNIQUE REFERENCES applications (application_id) ON UPDATE NO ACTION ON DELETE CASCADE,
+    backup_policy_enabled BOOLEAN NOT NULL,
+    backup_policy_schedule TEXT NOT NULL,
+    backup_policy_retention INTEGER NOT NULL,
+    backup_policy_next_run BIGINT NOT NULL,
+    backup_policy_created BIGINT NOT NULL,
+    backup_policy_updated BIGINT NOT NULL
+);
+
+CREATE INDEX idx_backup_policies_next_run ON backup_policies (backup_policy_enabled, backup_policy_next_run);
+
+CREATE TABLE backups (
+    backup_id SERIAL PRIMARY KEY,
+    backup_uid BIGINT NOT NULL,
+    backup_tenant_id INTEGER NOT NULL,
+    backup_project_id INTEGER NOT NULL,
+    backup_environment_id INTEGER NOT NULL,
+    backup_application_id INTEGER NOT NULL REFERENCES applications (application_id) ON UPDATE NO ACTION ON DELETE CASCADE,
+    backup_kind TEXT NOT NULL,
+    backup_trigger TEXT NOT NULL,
+    backup_status TEXT NOT NULL,
+    backup_blob_path TEXT NOT NULL,
+    backup_size BIGINT NOT NULL,
+    backup_error TEXT NOT NULL,
+    backup_created_by TEXT NOT NULL,
+    backup_started BIGINT NOT NULL,
+    backup_finished BIGINT NOT NULL,
+    backup_restore_status TEXT NOT NULL,
+    backup_restore_error TEXT NOT NULL,
+    backup_restored BIGINT NOT NULL,
+    backup_created BIGINT NOT NULL,
+    backup_updated BIGINT NOT NULL,
+    UNIQUE (backup_application_id, backup_uid)
+);
+
+CREATE INDEX idx_backups_application_created ON backups (backup_application_id, backup_created DESC);
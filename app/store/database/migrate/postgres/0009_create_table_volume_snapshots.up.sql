@@ -0,0 +1,37 @@
+ALTER TABLE servers ADD COLUMN server_volume_snapshot_class TEXT NOT NULL DEFAULT '';
+
+CREATE TABLE snapshot_policies (
+    snapshot_policy_id SERIAL PRIMARY KEY,
+    snapshot_policy_volume_id INTEGER NOT NULL UNIQUE REFERENCES volumes (volume_id) ON UPDATE NO ACTION ON DELETE CASCADE,
+    snapshot_policy_enabled BOOLEAN NOT NULL,
+    snapshot_policy_schedule TEXT NOT NULL,
+    snapshot_policy_retention INTEGER NOT NULL,
+    snapshot_policy_next_run BIGINT NOT NULL,
+    snapshot_policy_created BIGINT NOT NULL,
+    snapshot_policy_updated BIGINT NOT NULL
+);
+
+CREATE INDEX idx_snapshot_policies_next_run ON snapshot_policies (snapshot_policy_enabled, snapshot_policy_next_run);
+
+CREATE TABLE volume_snapshots (
+    volume_snapshot_id SERIAL PRIMARY KEY,
+    volume_snapshot_uid BIGINT NOT NULL,
+    volume_snapshot_tenant_id INTEGER NOT NULL,
+    volume_snapshot_project_id INTEGER NOT NULL,
+    volume_snapshot_environment_id INTEGER NOT NULL,
+    volume_snapshot_volume_id INTEGER NOT NULL REFERENCES volumes (volume_id) ON UPDATE NO ACTION ON DELETE CASCADE,
+    volume_snapshot_server_id INTEGER NOT NULL,
+    volume_snapshot_namespace TEXT NOT NULL,
+    volume_snapshot_name TEXT NOT NULL,
+    volume_snapshot_class TEXT NOT NULL,
+    volume_snapshot_trigger TEXT NOT NULL,
+    volume_snapshot_status TEXT NOT NULL,
+    volume_snapshot_size BIGINT NOT NULL,
+    volume_snapshot_error TEXT NOT NULL,
+    volume_snapshot_created_by TEXT NOT NULL,
+    volume_snapshot_created BIGINT NOT NULL,
+    volume_snapshot_updated BIGINT NOT NULL,
+    UNIQUE (volume_snapshot_volume_id, volume_snapshot_uid)
+);
+
+CREATE INDEX idx_volume_snapshots_volume_created ON volume_snapshots (volume_snapshot_volume_id, volume_snapshot_created DESC);
@@ -0,0 +1 @@
+ALTER TABLE deployments ADD COLUMN deployment_base_spec TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,6 @@
+ALTER TABLE servers ADD COLUMN server_kubeconfig BYTEA DEFAULT NULL;
+ALTER TABLE servers ADD COLUMN server_health_status TEXT NOT NULL DEFAULT 'unknown';
+ALTER TABLE servers ADD COLUMN server_health_message TEXT NOT NULL DEFAULT '';
+ALTER TABLE servers ADD COLUMN server_health_checked BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE environments ADD COLUMN environment_server_id INTEGER NOT NULL DEFAULT 1;
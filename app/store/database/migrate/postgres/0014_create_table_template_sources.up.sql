@@ -0,0 +1,38 @@
+CREATE TABLE template_sources (
+    template_source_id SERIAL PRIMARY KEY,
+    template_source_uid BIGINT NOT NULL,
+    template_source_tenant_id INTEGER REFERENCES tenants (tenant_id) ON UPDATE NO ACTION ON DELETE CASCADE,
+    template_source_name TEXT NOT NULL,
+    template_source_type TEXT NOT NULL,
+    template_source_url TEXT NOT NULL,
+    template_source_branch TEXT NOT NULL DEFAULT '',
+    template_source_path TEXT NOT NULL DEFAULT '',
+    template_source_token BYTEA NOT NULL,
+    template_source_revision TEXT NOT NULL DEFAULT '',
+    template_source_synced BIGINT NOT NULL DEFAULT 0,
+    template_source_sync_error TEXT NOT NULL DEFAULT '',
+    template_source_created_by INTEGER NOT NULL,
+    template_source_created BIGINT NOT NULL,
+    template_source_updated BIGINT NOT NULL,
+    UNIQUE (template_source_uid)
+);
+
+CREATE INDEX idx_template_sources_tenant_id ON template_sources (template_source_tenant_id);
+
+ALTER TABLE templates ADD COLUMN template_source_id INTEGER REFERENCES template_sources (template_source_id) ON UPDATE NO ACTION ON DELETE CASCADE;
+ALTER TABLE templates ADD COLUMN template_version INTEGER NOT NULL DEFAULT 0;
+
+CREATE INDEX idx_templates_source_id ON templates (template_source_id);
+
+CREATE TABLE template_versions (
+    template_version_id SERIAL PRIMARY KEY,
+    template_version_template_id INTEGER NOT NULL REFERENCES templates (template_id) ON UPDATE NO ACTION ON DELETE CASCADE,
+    template_version_number INTEGER NOT NULL,
+    template_version_revision TEXT NOT NULL,
+    template_version_spec TEXT NOT NULL,
+    template_version_created BIGINT NOT NULL,
+    UNIQUE (
+        template_version_template_id,
+        template_version_number
+    )
+);
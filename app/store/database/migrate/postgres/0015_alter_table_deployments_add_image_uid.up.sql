@@ -0,0 +1,2 @@
+ALTER TABLE deployments ADD COLUMN deployment_image_uid INTEGER DEFAULT NULL;
+UPDATE deployments SET deployment_image_uid = deployment_source_uid;
@@ -0,0 +1 @@
+ALTER TABLE servers ADD COLUMN server_runner_token_hash TEXT NOT NULL DEFAULT '';
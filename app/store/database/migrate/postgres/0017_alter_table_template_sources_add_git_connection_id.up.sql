@@ -0,0 +1 @@
+ALTER TABLE template_sources ADD COLUMN template_source_git_connection_id INTEGER DEFAULT NULL REFERENCES git_connections (git_connection_id) ON UPDATE NO ACTION ON DELETE SET NULL;
@@ -0,0 +1,20 @@
+CREATE TABLE registry_credentials (
+ registry_credential_id          INTEGER PRIMARY KEY AUTOINCREMENT
+,registry_credential_uid         INTEGER NOT NULL
+,registry_credential_tenant_id   INTEGER NOT NULL
+,registry_credential_name        TEXT NOT NULL
+,registry_credential_provider    TEXT NOT NULL
+,registry_credential_server      TEXT NOT NULL
+,registry_credential_username    TEXT NOT NULL
+,registry_credential_secret      BLOB NOT NULL
+,registry_credential_created_by  INTEGER NOT NULL
+,registry_credential_created     BIGINT NOT NULL
+,registry_credential_updated     BIGINT NOT NULL
+
+,UNIQUE(registry_credential_tenant_id, registry_credential_uid)
+
+,CONSTRAINT fk_registry_credential_tenant_id FOREIGN KEY (registry_credential_tenant_id)
+    REFERENCES tenants (tenant_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE CASCADE
+);
@@ -0,0 +1,31 @@
+CREATE TABLE git_connections (
+ git_connection_id              INTEGER PRIMARY KEY AUTOINCREMENT
+,git_connection_uid             INTEGER NOT NULL
+,git_connection_tenant_id       INTEGER NOT NULL
+,git_connection_project_id      INTEGER NOT NULL
+,git_connection_name            TEXT NOT NULL
+,git_connection_provider        TEXT NOT NULL
+,git_connection_server_url      TEXT NOT NULL
+,git_connection_username        TEXT NOT NULL
+,git_connection_secret          BLOB NOT NULL
+,git_connection_webhook_secret  TEXT NOT NULL
+,git_connection_created_by      INTEGER NOT NULL
+,git_connection_created         BIGINT NOT NULL
+,git_connection_updated         BIGINT NOT NULL
+
+,UNIQUE(git_connection_tenant_id, git_connection_project_id, git_connection_uid)
+
+,CONSTRAINT fk_git_connection_tenant_id FOREIGN KEY (git_connection_tenant_id)
+    REFERENCES tenants (tenant_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE CASCADE
+
+,CONSTRAINT fk_git_connection_project_id FOREIGN KEY (git_connection_project_id)
+    REFERENCES projects (project_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE CASCADE
+);
+
+CREATE INDEX git_connections_uid ON git_connections (git_connection_uid);
+
+ALTER TABLE applications ADD COLUMN application_git_connection_id INTEGER DEFAULT NULL REFERENCES git_connections (git_connection_id);
@@ -0,0 +1,49 @@
+CREATE TABLE backup_policies (
+ backup_policy_id              INTEGER PRIMARY KEY AUTOINCREMENT
+,backup_policy_application_id  INTEGER NOT NULL UNIQUE
+,backup_policy_enabled         BOOLEAN NOT NULL
+,backup_policy_schedule        TEXT NOT NULL
+,backup_policy_retention       INTEGER NOT NULL
+,backup_policy_next_run        BIGINT NOT NULL
+,backup_policy_created         BIGINT NOT NULL
+,backup_policy_updated         BIGINT NOT NULL
+
+,CONSTRAINT fk_backup_policy_application_id FOREIGN KEY (backup_policy_application_id)
+    REFERENCES applications (application_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE CASCADE
+);
+
+CREATE INDEX idx_backup_policies_next_run ON backup_policies (backup_policy_enabled, backup_policy_next_run);
+
+CREATE TABLE backups (
+ backup_id              INTEGER PRIMARY KEY AUTOINCREMENT
+,backup_uid             BIGINT NOT NULL
+,backup_tenant_id       INTEGER NOT NULL
+,backup_project_id      INTEGER NOT NULL
+,backup_environment_id  INTEGER NOT NULL
+,backup_application_id  INTEGER NOT NULL
+,backup_kind            TEXT NOT NULL
+,backup_trigger         TEXT NOT NULL
+,backup_status          TEXT NOT NULL
+,backup_blob_path       TEXT NOT NULL
+,backup_size            BIGINT NOT NULL
+,backup_error           TEXT NOT NULL
+,backup_created_by      TEXT NOT NULL
+,backup_started         BIGINT NOT NULL
+,backup_finished        BIGINT NOT NULL
+,backup_restore_status  TEXT NOT NULL
+,backup_restore_error   TEXT NOT NULL
+,backup_restored        BIGINT NOT NULL
+,backup_created         BIGINT NOT NULL
+,backup_updated         BIGINT NOT NULL
+
+,UNIQUE(backup_application_id, backup_uid)
+
+,CONSTRAINT fk_backup_application_id FOREIGN KEY (backup_application_id)
+    REFERENCES applications (application_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE CASCADE
+);
+
+CREATE INDEX idx_backups_application_created ON backups (backup_application_id, backup_created DESC);
@@ -0,0 +1,33 @@
+CREATE TABLE notification_channels (
+ notification_channel_id           INTEGER PRIMARY KEY AUTOINCREMENT
+,notification_channel_uid          BIGINT NOT NULL
+,notification_channel_tenant_id    INTEGER NOT NULL
+,notification_channel_project_id   INTEGER
+,notification_channel_name         TEXT NOT NULL
+,notification_channel_type         TEXT NOT NULL
+,notification_channel_events       TEXT NOT NULL
+,notification_channel_destination  BLOB NOT NULL
+,notification_channel_signing_key  BLOB NOT NULL
+,notification_channel_created_by   INTEGER NOT NULL
+,notification_channel_created      BIGINT NOT NULL
+,notification_channel_updated      BIGINT NOT NULL
+
+,UNIQUE(notification_channel_tenant_id, notification_channel_uid)
+
+,CONSTRAINT fk_notification_channel_tenant_id FOREIGN KEY (notification_channel_tenant_id)
+    REFERENCES tenants (tenant_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE CASCADE
+
+,CONSTRAINT fk_notification_channel_project_id FOREIGN KEY (notification_channel_project_id)
+    REFERENCES projects (project_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE CASCADE
+);
+
+CREATE INDEX idx_notification_channels_tenant_project ON notification_channels (notification_channel_tenant_id, notification_channel_project_id);
+
+CREATE TABLE notification_alerts (
+ notification_alert_key      TEXT PRIMARY KEY
+,notification_alert_created  BIGINT NOT NULL
+);
@@ -0,0 +1 @@
+ALTER TABLE servers ADD COLUMN server_docker_host TEXT NOT NULL DEFAULT '';
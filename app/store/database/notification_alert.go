@@ -0,0 +1,63 @@
+package database
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/store/database"
+	"github.com/cloudness-io/cloudness/store/database/dbtx"
+
+	"github.com/jmoiron/sqlx"
+)
+
+var _ store.NotificationAlertStore = (*NotificationAlertStore)(nil)
+
+func NewNotificationAlertStore(db *sqlx.DB) *NotificationAlertStore {
+	return &NotificationAlertStore{
+		db: db,
+	}
+}
+
+// NotificationAlertStore remembers the conditions that were already notified,
+// so an alert is only sent once until its condition resolves.
+type NotificationAlertStore struct {
+	db *sqlx.DB
+}
+
+// List lists the keys of the open alerts.
+func (s *NotificationAlertStore) List(ctx context.Context) ([]string, error) {
+	const sqlQuery = `SELECT notification_alert_key FROM notification_alerts`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := []string{}
+	if err := db.SelectContext(ctx, &dst, sqlQuery); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Select notification alerts query failed")
+	}
+	return dst, nil
+}
+
+// Create opens the alert.
+func (s *NotificationAlertStore) Create(ctx context.Context, key string, created int64) error {
+	const sqlQuery = `INSERT INTO notification_alerts (notification_alert_key, notification_alert_created)
+	VALUES ($1, $2) ON CONFLICT (notification_alert_key) DO NOTHING`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	if _, err := db.ExecContext(ctx, sqlQuery, key, created); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Insert notification alert query failed")
+	}
+	return nil
+}
+
+// Delete resolves the alert.
+func (s *NotificationAlertStore) Delete(ctx context.Context, key string) error {
+	const sqlQuery = `DELETE FROM notification_alerts WHERE notification_alert_key = $1`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	if _, err := db.ExecContext(ctx, sqlQuery, key); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Delete notification alert query failed")
+	}
+	return nil
+}
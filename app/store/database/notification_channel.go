@@ -0,0 +1,171 @@
+package database
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/store/database"
+	"github.com/cloudness-io/cloudness/store/database/dbtx"
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/jmoiron/sqlx"
+)
+
+var _ store.NotificationChannelStore = (*NotificationChannelStore)(nil)
+
+func NewNotificationChannelStore(db *sqlx.DB) *NotificationChannelStore {
+	return &NotificationChannelStore{
+		db: db,
+	}
+}
+
+type NotificationChannelStore struct {
+	db *sqlx.DB
+}
+
+const notificationChannelColumns = `
+	notification_channel_id
+	,notification_channel_uid
+	,notification_channel_tenant_id
+	,notification_channel_project_id
+	,notification_channel_name
+	,notification_channel_type
+	,notification_channel_events
+	,notification_channel_destination
+	,notification_channel_signing_key
+	,notification_channel_created_by
+	,notification_channel_created
+	,notification_channel_updated`
+
+const notificationChannelInsert = `
+INSERT INTO notification_channels (
+	notification_channel_uid
+	,notification_channel_tenant_id
+	,notification_channel_project_id
+	,notification_channel_name
+	,notification_channel_type
+	,notification_channel_events
+	,notification_channel_destination
+	,notification_channel_signing_key
+	,notification_channel_created_by
+	,notification_channel_created
+	,notification_channel_updated
+) values (
+	:notification_channel_uid
+	,:notification_channel_tenant_id
+	,:notification_channel_project_id
+	,:notification_channel_name
+	,:notification_channel_type
+	,:notification_channel_events
+	,:notification_channel_destination
+	,:notification_channel_signing_key
+	,:notification_channel_created_by
+	,:notification_channel_created
+	,:notification_channel_updated
+	) RETURNING notification_channel_id
+	`
+
+const notificationChannelSelectBase = `
+	SELECT` + notificationChannelColumns + `
+	FROM notification_channels`
+
+// Find finds the notification channel by id.
+func (s *NotificationChannelStore) Find(ctx context.Context, id int64) (*types.NotificationChannel, error) {
+	const sqlQuery = notificationChannelSelectBase + `
+	WHERE notification_channel_id = $1`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := new(types.NotificationChannel)
+	if err := db.GetContext(ctx, dst, sqlQuery, id); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Select notification channel by id query failed")
+	}
+	return dst, nil
+}
+
+// FindByUID finds the notification channel of the tenant by uid.
+func (s *NotificationChannelStore) FindByUID(ctx context.Context, tenantID, uid int64) (*types.NotificationChannel, error) {
+	const sqlQuery = notificationChannelSelectBase + `
+	WHERE notification_channel_tenant_id = $1 AND notification_channel_uid = $2`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := new(types.NotificationChannel)
+	if err := db.GetContext(ctx, dst, sqlQuery, tenantID, uid); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Select notification channel by uid query failed")
+	}
+	return dst, nil
+}
+
+// ListTenantLevel lists the channels of the tenant that are not scoped to a project.
+func (s *NotificationChannelStore) ListTenantLevel(ctx context.Context, tenantID int64) ([]*types.NotificationChannel, error) {
+	const sqlQuery = notificationChannelSelectBase + `
+	WHERE notification_channel_tenant_id = $1 AND notification_channel_project_id IS NULL
+	ORDER BY notification_channel_name`
+
+	return s.list(ctx, sqlQuery, tenantID)
+}
+
+// ListProjectLevel lists the channels scoped to the project.
+func (s *NotificationChannelStore) ListProjectLevel(ctx context.Context, tenantID, projectID int64) ([]*types.NotificationChannel, error) {
+	const sqlQuery = notificationChannelSelectBase + `
+	WHERE notification_channel_tenant_id = $1 AND notification_channel_project_id = $2
+	ORDER BY notification_channel_name`
+
+	return s.list(ctx, sqlQuery, tenantID, projectID)
+}
+
+// ListForProject lists the channels receiving the events of the project, tenant level channels included.
+func (s *NotificationChannelStore) ListForProject(ctx context.Context, tenantID, projectID int64) ([]*types.NotificationChannel, error) {
+	const sqlQuery = notificationChannelSelectBase + `
+	WHERE notification_channel_tenant_id = $1
+	AND (notification_channel_project_id IS NULL OR notification_channel_project_id = $2)`
+
+	return s.list(ctx, sqlQuery, tenantID, projectID)
+}
+
+// ListAllTenantLevel lists the tenant level channels of every tenant.
+func (s *NotificationChannelStore) ListAllTenantLevel(ctx context.Context) ([]*types.NotificationChannel, error) {
+	const sqlQuery = notificationChannelSelectBase + `
+	WHERE notification_channel_project_id IS NULL`
+
+	return s.list(ctx, sqlQuery)
+}
+
+func (s *NotificationChannelStore) list(ctx context.Context, sqlQuery string, args ...any) ([]*types.NotificationChannel, error) {
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := []*types.NotificationChannel{}
+	if err := db.SelectContext(ctx, &dst, sqlQuery, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Select notification channels query failed")
+	}
+	return dst, nil
+}
+
+// Create saves the notification channel.
+func (s *NotificationChannelStore) Create(ctx context.Context, channel *types.NotificationChannel) (*types.NotificationChannel, error) {
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	query, arg, err := db.BindNamed(notificationChannelInsert, channel)
+	if err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to bind notification channel object")
+	}
+
+	if err = db.QueryRowContext(ctx, query, arg...).Scan(&channel.ID); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Insert notification channel query failed")
+	}
+
+	return channel, nil
+}
+
+// Delete deletes the notification channel.
+func (s *NotificationChannelStore) Delete(ctx context.Context, tenantID, id int64) error {
+	const sqlQuery = `DELETE FROM notification_channels WHERE notification_channel_tenant_id = $1 AND notification_channel_id = $2`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	if _, err := db.ExecContext(ctx, sqlQuery, tenantID, id); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Delete notification channel query failed")
+	}
+	return nil
+}
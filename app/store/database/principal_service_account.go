@@ -0,0 +1,155 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudness-io/cloudness/store/database"
+	"github.com/cloudness-io/cloudness/store/database/dbtx"
+	"github.com/cloudness-io/cloudness/types"
+)
+
+// serviceAccount is a DB representation of a service account principal.
+// It is required to allow storing transformed UIDs used for uniquness constraints and searching.
+type serviceAccount struct {
+	types.ServiceAccount
+}
+
+const serviceAccountSelectBase = `
+	SELECT` + principalCommonColumns + `
+	,tenant_membership_role
+	FROM principals
+	INNER JOIN tenant_memberships ON tenant_membership_principal_id = principal_id`
+
+// FindServiceAccount finds the service account of the tenant by id.
+func (s *PrincipalStore) FindServiceAccount(ctx context.Context, tenantID, id int64) (*types.ServiceAccount, error) {
+	const sqlQuery = serviceAccountSelectBase + `
+		WHERE principal_type = 'serviceaccount' AND tenant_membership_tenant_id = $1 AND principal_id = $2`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := new(serviceAccount)
+	if err := db.GetContext(ctx, dst, sqlQuery, tenantID, id); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Select by id query failed")
+	}
+
+	return s.mapDBServiceAccount(dst), nil
+}
+
+// FindServiceAccountByUID finds the service account of the tenant by uid.
+func (s *PrincipalStore) FindServiceAccountByUID(ctx context.Context, tenantID int64, uid string) (*types.ServiceAccount, error) {
+	const sqlQuery = serviceAccountSelectBase + `
+		WHERE principal_type = 'serviceaccount' AND tenant_membership_tenant_id = $1 AND principal_uid = $2`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := new(serviceAccount)
+	if err := db.GetContext(ctx, dst, sqlQuery, tenantID, uid); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Select by uid query failed")
+	}
+
+	return s.mapDBServiceAccount(dst), nil
+}
+
+// ListServiceAccounts lists the service accounts of the tenant.
+func (s *PrincipalStore) ListServiceAccounts(ctx context.Context, tenantID int64) ([]*types.ServiceAccount, error) {
+	const sqlQuery = serviceAccountSelectBase + `
+		WHERE principal_type = 'serviceaccount' AND tenant_membership_tenant_id = $1
+		ORDER BY principal_display_name ASC`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := []*serviceAccount{}
+	if err := db.SelectContext(ctx, &dst, sqlQuery, tenantID); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed executing service account list query")
+	}
+
+	return s.mapDBServiceAccounts(dst), nil
+}
+
+// CreateServiceAccount saves the service account details.
+func (s *PrincipalStore) CreateServiceAccount(ctx context.Context, sa *types.ServiceAccount) (*types.ServiceAccount, error) {
+	const sqlQuery = `
+		INSERT INTO principals (
+			principal_type
+			,principal_uid
+			,principal_email
+			,principal_display_name
+			,principal_avatar_url
+			,principal_blocked
+			,principal_user_password
+			,principal_salt
+			,principal_created
+			,principal_updated
+		) values (
+			'serviceaccount'
+			,:principal_uid
+			,:principal_email
+			,:principal_display_name
+			,''
+			,:principal_blocked
+			,''
+			,:principal_salt
+			,:principal_created
+			,:principal_updated
+		) RETURNING principal_id`
+
+	dbSA, err := s.mapToDBServiceAccount(sa)
+	if err != nil {
+		return nil, fmt.Errorf("failed to map db service account: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	query, arg, err := db.BindNamed(sqlQuery, dbSA)
+	if err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to bind service account object")
+	}
+
+	if err = db.QueryRowContext(ctx, query, arg...).Scan(&sa.ID); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Insert query failed")
+	}
+
+	return sa, nil
+}
+
+// DeleteServiceAccount deletes the service account.
+// Memberships and tokens of the service account are removed by cascade.
+func (s *PrincipalStore) DeleteServiceAccount(ctx context.Context, id int64) error {
+	const sqlQuery = `
+		DELETE FROM principals
+		WHERE principal_type = 'serviceaccount' AND principal_id = $1`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	if _, err := db.ExecContext(ctx, sqlQuery, id); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "The delete query failed")
+	}
+
+	return nil
+}
+
+func (s *PrincipalStore) mapDBServiceAccount(dbSA *serviceAccount) *types.ServiceAccount {
+	return &dbSA.ServiceAccount
+}
+
+func (s *PrincipalStore) mapDBServiceAccounts(dbSAs []*serviceAccount) []*types.ServiceAccount {
+	res := make([]*types.ServiceAccount, len(dbSAs))
+	for i := range dbSAs {
+		res[i] = s.mapDBServiceAccount(dbSAs[i])
+	}
+	return res
+}
+
+func (s *PrincipalStore) mapToDBServiceAccount(sa *types.ServiceAccount) (*serviceAccount, error) {
+	// service account comes from outside.
+	if sa == nil {
+		return nil, fmt.Errorf("service account is nil")
+	}
+
+	dbSA := &serviceAccount{
+		ServiceAccount: *sa,
+	}
+
+	return dbSA, nil
+}
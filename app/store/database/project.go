@@ -142,6 +142,7 @@ func (s *ProjectStore) Count(ctx context.Context, filter *types.ProjectFilter) (
 		Select("count(1)").
 		From("projects")
 
+	stmt = s.applyPrincipalFilter(stmt, filter)
 	stmt = s.applyQueryFilter(stmt, filter)
 
 	sql, args, err := stmt.ToSql()
@@ -166,22 +167,7 @@ func (s *ProjectStore) List(ctx context.Context, filter *types.ProjectFilter) ([
 		Select(projectColumns).
 		From("projects")
 
-	if filter.PrincipalID != nil {
-		stmt = stmt.
-			LeftJoin(`project_memberships 
-				ON project_memberships.project_membership_project_id = projects.project_id
-				AND project_memberships.project_membership_principal_id = ?`, filter.PrincipalID).
-			LeftJoin(`tenant_memberships 
-				ON tenant_memberships.tenant_membership_tenant_id = projects.project_tenant_id 
-				AND tenant_memberships.tenant_membership_principal_id = ?`, filter.PrincipalID).
-			Where(
-				sq.Or{
-					sq.Eq{`tenant_memberships.tenant_membership_role`: enum.TenantRoleAdmin},
-					sq.NotEq{`project_memberships.project_membership_role`: nil},
-				},
-			)
-	}
-
+	stmt = s.applyPrincipalFilter(stmt, filter)
 	stmt = s.applyQueryFilter(stmt, filter)
 	stmt = s.applySortFilter(stmt, filter)
 
@@ -272,6 +258,26 @@ func (s *ProjectStore) Purge(ctx context.Context, id int64, deletedAt *int64) er
 	return nil
 }
 
+func (s *ProjectStore) applyPrincipalFilter(stmt sq.SelectBuilder, filter *types.ProjectFilter) sq.SelectBuilder {
+	if filter.PrincipalID == nil {
+		return stmt
+	}
+
+	return stmt.
+		LeftJoin(`project_memberships 
+			ON project_memberships.project_membership_project_id = projects.project_id
+			AND project_memberships.project_membership_principal_id = ?`, filter.PrincipalID).
+		LeftJoin(`tenant_memberships 
+			ON tenant_memberships.tenant_membership_tenant_id = projects.project_tenant_id 
+			AND tenant_memberships.tenant_membership_principal_id = ?`, filter.PrincipalID).
+		Where(
+			sq.Or{
+				sq.Eq{`tenant_memberships.tenant_membership_role`: enum.TenantRoleAdmin},
+				sq.NotEq{`project_memberships.project_membership_role`: nil},
+			},
+		)
+}
+
 func (s *ProjectStore) applyQueryFilter(stmt sq.SelectBuilder, filter *types.ProjectFilter) sq.SelectBuilder {
 	if filter.Query != "" {
 		stmt = stmt.Where(PartialMatch("project_name", filter.Query))
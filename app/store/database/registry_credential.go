@@ -0,0 +1,138 @@
+package database
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/store/database"
+	"github.com/cloudness-io/cloudness/store/database/dbtx"
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/jmoiron/sqlx"
+)
+
+var _ store.RegistryCredentialStore = (*RegistryCredentialStore)(nil)
+
+func NewRegistryCredentialStore(db *sqlx.DB) *RegistryCredentialStore {
+	return &RegistryCredentialStore{
+		db: db,
+	}
+}
+
+type RegistryCredentialStore struct {
+	db *sqlx.DB
+}
+
+const registryCredentialColumns = `
+	registry_credential_id
+	,registry_credential_uid
+	,registry_credential_tenant_id
+	,registry_credential_name
+	,registry_credential_provider
+	,registry_credential_server
+	,registry_credential_username
+	,registry_credential_secret
+	,registry_credential_created_by
+	,registry_credential_created
+	,registry_credential_updated`
+
+const registryCredentialInsert = `
+INSERT INTO registry_credentials (
+	registry_credential_uid
+	,registry_credential_tenant_id
+	,registry_credential_name
+	,registry_credential_provider
+	,registry_credential_server
+	,registry_credential_username
+	,registry_credential_secret
+	,registry_credential_created_by
+	,registry_credential_created
+	,registry_credential_updated
+) values (
+	:registry_credential_uid
+	,:registry_credential_tenant_id
+	,:registry_credential_name
+	,:registry_credential_provider
+	,:registry_credential_server
+	,:registry_credential_username
+	,:registry_credential_secret
+	,:registry_credential_created_by
+	,:registry_credential_created
+	,:registry_credential_updated
+	) RETURNING registry_credential_id
+	`
+
+const registryCredentialSelectBase = `
+	SELECT` + registryCredentialColumns + `
+	FROM registry_credentials`
+
+// Find the registry credential by id.
+func (s *RegistryCredentialStore) Find(ctx context.Context, tenantID, id int64) (*types.RegistryCredential, error) {
+	const sqlQuery = registryCredentialSelectBase + `
+	WHERE registry_credential_tenant_id = $1 AND registry_credential_id = $2`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := new(types.RegistryCredential)
+	if err := db.GetContext(ctx, dst, sqlQuery, tenantID, id); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Select registry credential by id query failed")
+	}
+	return dst, nil
+}
+
+// FindByUID finds the registry credential by uid.
+func (s *RegistryCredentialStore) FindByUID(ctx context.Context, tenantID, uid int64) (*types.RegistryCredential, error) {
+	const sqlQuery = registryCredentialSelectBase + `
+	WHERE registry_credential_tenant_id = $1 AND registry_credential_uid = $2`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := new(types.RegistryCredential)
+	if err := db.GetContext(ctx, dst, sqlQuery, tenantID, uid); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Select registry credential by uid query failed")
+	}
+	return dst, nil
+}
+
+// List lists the registry credentials of the tenant.
+func (s *RegistryCredentialStore) List(ctx context.Context, tenantID int64) ([]*types.RegistryCredential, error) {
+	const sqlQuery = registryCredentialSelectBase + `
+	WHERE registry_credential_tenant_id = $1
+	ORDER BY registry_credential_name`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := []*types.RegistryCredential{}
+	if err := db.SelectContext(ctx, &dst, sqlQuery, tenantID); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Select registry credentials query failed")
+	}
+	return dst, nil
+}
+
+// Create saves the registry credential.
+func (s *RegistryCredentialStore) Create(ctx context.Context, credential *types.RegistryCredential) (*types.RegistryCredential, error) {
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	query, arg, err := db.BindNamed(registryCredentialInsert, credential)
+	if err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to bind registry credential object")
+	}
+
+	if err = db.QueryRowContext(ctx, query, arg...).Scan(&credential.ID); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Insert registry credential query failed")
+	}
+
+	return credential, nil
+}
+
+// Delete deletes the registry credential.
+func (s *RegistryCredentialStore) Delete(ctx context.Context, tenantID, id int64) error {
+	const sqlQuery = `DELETE FROM registry_credentials WHERE registry_credential_tenant_id = $1 AND registry_credential_id = $2`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	if _, err := db.ExecContext(ctx, sqlQuery, tenantID, id); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Delete registry credential query failed")
+	}
+	return nil
+}
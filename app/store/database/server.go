@@ -52,6 +52,13 @@ const serverColumns = `
 	,server_builder_max_cpu
 	,server_builder_max_memory
 	,server_volume_min_size
+	,server_volume_snapshot_class
+	,server_kubeconfig
+	,server_docker_host
+	,server_health_status
+	,server_health_message
+	,server_health_checked
+	,server_runner_token_hash
 	,server_created
 	,server_updated
 	`
@@ -77,6 +84,10 @@ INSERT INTO servers (
 	,server_builder_max_cpu
 	,server_builder_max_memory
 	,server_volume_min_size
+	,server_volume_snapshot_class
+	,server_kubeconfig
+	,server_docker_host
+	,server_health_status
 	,server_created
 	,server_updated
 ) VALUES (
@@ -99,6 +110,10 @@ INSERT INTO servers (
 	,:server_builder_max_cpu
 	,:server_builder_max_memory
 	,:server_volume_min_size
+	,:server_volume_snapshot_class
+	,:server_kubeconfig
+	,:server_docker_host
+	,:server_health_status
 	,:server_created
 	,:server_updated
 ) RETURNING server_id`
@@ -122,10 +137,29 @@ const serverUpdateStmt = `UPDATE servers
 		,server_builder_max_cpu = :server_builder_max_cpu
 		,server_builder_max_memory = :server_builder_max_memory
 		,server_volume_min_size = :server_volume_min_size
+		,server_volume_snapshot_class = :server_volume_snapshot_class
+		,server_kubeconfig = :server_kubeconfig
+		,server_docker_host = :server_docker_host
 		,server_updated = :server_updated
 	WHERE server_id = :server_id
 	`
 
+// serverUpdateHealthStmt does not touch server_updated, agents restart when the server is updated.
+const serverUpdateHealthStmt = `UPDATE servers
+	SET
+		server_health_status = :server_health_status
+		,server_health_message = :server_health_message
+		,server_health_checked = :server_health_checked
+	WHERE server_id = :server_id
+	`
+
+// serverUpdateRunnerTokenStmt does not touch server_updated, agents restart when the server is updated.
+const serverUpdateRunnerTokenStmt = `UPDATE servers
+	SET
+		server_runner_token_hash = :server_runner_token_hash
+	WHERE server_id = :server_id
+	`
+
 // Find the server by id.
 func (s *ServerStore) Find(ctx context.Context, id int64) (*types.Server, error) {
 	stmt := database.Builder.
@@ -168,6 +202,28 @@ func (s *ServerStore) FindByUID(ctx context.Context, serverUID int64) (*types.Se
 	return s.mapDBServer(dst), nil
 }
 
+// FindByRunnerTokenHash finds the server the runner token was issued for.
+func (s *ServerStore) FindByRunnerTokenHash(ctx context.Context, hash string) (*types.Server, error) {
+	stmt := database.Builder.
+		Select(serverColumns).
+		From("servers").
+		Where("server_runner_token_hash = ?", hash).
+		Where("server_runner_token_hash <> ''")
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to build sql")
+	}
+
+	db := dbtx.GetAccessor(ctx, s.db)
+	dst := new(server)
+
+	if err := db.GetContext(ctx, dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Select by runner token query failed")
+	}
+	return s.mapDBServer(dst), nil
+}
+
 // Update  updates the server details
 func (s *ServerStore) Update(ctx context.Context, server *types.Server) (*types.Server, error) {
 	server.Updated = time.Now().UTC().UnixMilli()
@@ -195,6 +251,52 @@ func (s *ServerStore) Update(ctx context.Context, server *types.Server) (*types.
 	return server, nil
 }
 
+// UpdateHealth updates the result of the last health check of the server.
+func (s *ServerStore) UpdateHealth(ctx context.Context, server *types.Server) error {
+	db := dbtx.GetAccessor(ctx, s.db)
+	query, args, err := db.BindNamed(serverUpdateHealthStmt, server)
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to bind server object")
+	}
+
+	if _, err := db.ExecContext(ctx, query, args...); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Update health query failed")
+	}
+	return nil
+}
+
+// UpdateRunnerTokenHash replaces the runner token of the server.
+func (s *ServerStore) UpdateRunnerTokenHash(ctx context.Context, server *types.Server) error {
+	db := dbtx.GetAccessor(ctx, s.db)
+	query, args, err := db.BindNamed(serverUpdateRunnerTokenStmt, server)
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to bind server object")
+	}
+
+	if _, err := db.ExecContext(ctx, query, args...); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Update runner token query failed")
+	}
+	return nil
+}
+
+// Delete deletes the server.
+func (s *ServerStore) Delete(ctx context.Context, id int64) error {
+	stmt := database.Builder.
+		Delete("servers").
+		Where("server_id = ?", id)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "Failed to build sql")
+	}
+
+	db := dbtx.GetAccessor(ctx, s.db)
+	if _, err := db.ExecContext(ctx, sql, args...); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Delete query failed")
+	}
+	return nil
+}
+
 // Create save the server details
 func (s *ServerStore) Create(ctx context.Context, server *types.Server) (*types.Server, error) {
 	server.Created = time.Now().UTC().UnixMilli()
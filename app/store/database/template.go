@@ -31,24 +31,52 @@ type TemplateStore struct {
 }
 
 type templateRow struct {
-	ID       int64  `db:"template_id"`
-	Slug     string `db:"template_slug"`
-	Name     string `db:"template_name"`
-	Icon     string `db:"template_icon"`
-	ReadMe   string `db:"template_readme"`
-	SpecJSON string `db:"template_spec"`
-	Created  int64  `db:"template_created"`
-	TagsJSON string `db:"tags_json"`
-}
-
-// Find the template by id
-func (s *TemplateStore) Find(ctx context.Context, id int64) (*types.Template, error) {
+	ID            int64  `db:"template_id"`
+	Slug          string `db:"template_slug"`
+	Name          string `db:"template_name"`
+	Icon          string `db:"template_icon"`
+	ReadMe        string `db:"template_readme"`
+	SpecJSON      string `db:"template_spec"`
+	Created       int64  `db:"template_created"`
+	SourceID      *int64 `db:"template_source_id"`
+	Version       int64  `db:"template_version"`
+	LatestVersion int64  `db:"latest_version"`
+	TagsJSON      string `db:"tags_json"`
+}
+
+const templateVersionColumns = `
+	template_version_id
+	,template_version_template_id
+	,template_version_number
+	,template_version_revision
+	,template_version_spec
+	,template_version_created`
+
+// Find the template by id, templates of template sources of other tenants are not found
+func (s *TemplateStore) Find(ctx context.Context, tenantID int64, id int64) (*types.Template, error) {
+	stmt := s.visibleTo(s.templateSelect(true), tenantID).
+		Where("t.template_id = ?", id)
+
+	return s.fetchTemplate(ctx, stmt, true)
+}
+
+// FindBySource finds the template of the template source by id
+func (s *TemplateStore) FindBySource(ctx context.Context, sourceID int64, id int64) (*types.Template, error) {
 	stmt := s.templateSelect(true).
+		Where("t.template_source_id = ?", sourceID).
 		Where("t.template_id = ?", id)
 
 	return s.fetchTemplate(ctx, stmt, true)
 }
 
+// FindBySlug finds the template by slug
+func (s *TemplateStore) FindBySlug(ctx context.Context, slug string) (*types.Template, error) {
+	stmt := s.templateSelect(false).
+		Where("t.template_slug = ?", slug)
+
+	return s.fetchTemplate(ctx, stmt, false)
+}
+
 // UpsertMany updates or inserts the templates
 func (s *TemplateStore) UpsertMany(ctx context.Context, templates []*types.Template) error {
 	now := time.Now().UTC().UnixMilli()
@@ -71,9 +99,18 @@ func (s *TemplateStore) UpsertMany(ctx context.Context, templates []*types.Templ
 	})
 }
 
-// List lists the templates
-func (s *TemplateStore) List(ctx context.Context) ([]*types.Template, error) {
-	stmt := s.templateSelect(false)
+// List lists the templates available to the tenant
+func (s *TemplateStore) List(ctx context.Context, tenantID int64) ([]*types.Template, error) {
+	stmt := s.visibleTo(s.templateSelect(false), tenantID)
+	return s.fetchTemplates(ctx, stmt, false)
+}
+
+// ListBySource lists the templates of the template source
+func (s *TemplateStore) ListBySource(ctx context.Context, sourceID int64) ([]*types.Template, error) {
+	stmt := s.templateSelect(false).
+		Where("t.template_source_id = ?", sourceID).
+		OrderBy("t.template_name ASC")
+
 	return s.fetchTemplates(ctx, stmt, false)
 }
 
@@ -102,8 +139,8 @@ func (s *TemplateStore) ListTags(ctx context.Context) ([]*types.Tag, error) {
 	return rows, nil
 }
 
-func (s *TemplateStore) ListNotInSlugs(ctx context.Context, slugs []string) ([]*types.Template, error) {
-	stmt := s.templateSelect(false).
+func (s *TemplateStore) ListNotInSlugs(ctx context.Context, tenantID int64, slugs []string) ([]*types.Template, error) {
+	stmt := s.visibleTo(s.templateSelect(false), tenantID).
 		Where(sq.NotEq{"t.template_slug": slugs})
 
 	return s.fetchTemplates(ctx, stmt, false)
@@ -116,18 +153,169 @@ func (s *TemplateStore) ListBySlugs(ctx context.Context, slugs []string) ([]*typ
 	return s.fetchTemplates(ctx, stmt, false)
 }
 
-func (s *TemplateStore) ListByTag(ctx context.Context, tag string) ([]*types.Template, error) {
+func (s *TemplateStore) ListByTag(ctx context.Context, tenantID int64, tag string) ([]*types.Template, error) {
 	slug := helpers.Normalize(tag)
 	if slug == "" {
 		return []*types.Template{}, nil
 	}
 
-	stmt := s.templateSelect(false).
+	stmt := s.visibleTo(s.templateSelect(false), tenantID).
 		Where("t.template_id IN (SELECT tt.template_id FROM template_tags tt JOIN tags tg ON tt.tag_id = tg.tag_id WHERE tg.tag_slug = ?)", slug)
 
 	return s.fetchTemplates(ctx, stmt, false)
 }
 
+// CreateSynced inserts the template of a template source with its first version
+func (s *TemplateStore) CreateSynced(ctx context.Context, tmpl *types.Template, version *types.TemplateVersion) error {
+	now := time.Now().UTC().UnixMilli()
+	runner := dbtx.New(s.db)
+
+	return runner.WithTx(ctx, func(ctx context.Context) error {
+		db := dbtx.GetAccessor(ctx, s.db)
+
+		if err := s.upsertTemplate(ctx, db, tmpl, now); err != nil {
+			return err
+		}
+
+		templateID, err := s.templateIDBySlug(ctx, db, tmpl.Slug)
+		if err != nil {
+			return err
+		}
+		tmpl.ID = templateID
+		version.TemplateID = templateID
+
+		return s.insertVersion(ctx, db, version)
+	})
+}
+
+// CreateVersion saves a new version of the template
+func (s *TemplateStore) CreateVersion(ctx context.Context, version *types.TemplateVersion) error {
+	return s.insertVersion(ctx, dbtx.GetAccessor(ctx, s.db), version)
+}
+
+// Publish updates the template to the spec and version it carries
+func (s *TemplateStore) Publish(ctx context.Context, tmpl *types.Template) error {
+	now := time.Now().UTC().UnixMilli()
+	runner := dbtx.New(s.db)
+
+	return runner.WithTx(ctx, func(ctx context.Context) error {
+		db := dbtx.GetAccessor(ctx, s.db)
+
+		stmt := database.Builder.Update("templates").
+			Set("template_name", tmpl.Name).
+			Set("template_icon", tmpl.Icon).
+			Set("template_readme", tmpl.ReadMe).
+			Set("template_spec", tmpl.SpecJson).
+			Set("template_version", tmpl.Version).
+			Where("template_id = ?", tmpl.ID)
+
+		query, args, err := stmt.ToSql()
+		if err != nil {
+			return database.ProcessSQLErrorf(ctx, err, "failed to convert publish template query to sql")
+		}
+
+		if _, err := db.ExecContext(ctx, query, args...); err != nil {
+			return database.ProcessSQLErrorf(ctx, err, "template publish query failed")
+		}
+
+		return s.replaceTemplateTags(ctx, db, tmpl.ID, tmpl.Tags, now)
+	})
+}
+
+// FindVersion finds the version of the template by number
+func (s *TemplateStore) FindVersion(ctx context.Context, templateID int64, number int64) (*types.TemplateVersion, error) {
+	const sqlQuery = `SELECT` + templateVersionColumns + `
+	FROM template_versions
+	WHERE template_version_template_id = $1 AND template_version_number = $2`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := new(types.TemplateVersion)
+	if err := db.GetContext(ctx, dst, sqlQuery, templateID, number); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Select template version query failed")
+	}
+	return dst, nil
+}
+
+// FindLatestVersion finds the newest version of the template
+func (s *TemplateStore) FindLatestVersion(ctx context.Context, templateID int64) (*types.TemplateVersion, error) {
+	const sqlQuery = `SELECT` + templateVersionColumns + `
+	FROM template_versions
+	WHERE template_version_template_id = $1
+	ORDER BY template_version_number DESC
+	LIMIT 1`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := new(types.TemplateVersion)
+	if err := db.GetContext(ctx, dst, sqlQuery, templateID); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Select latest template version query failed")
+	}
+	return dst, nil
+}
+
+// ListVersions lists the versions of the template, newest first
+func (s *TemplateStore) ListVersions(ctx context.Context, templateID int64) ([]*types.TemplateVersion, error) {
+	const sqlQuery = `SELECT` + templateVersionColumns + `
+	FROM template_versions
+	WHERE template_version_template_id = $1
+	ORDER BY template_version_number DESC`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := []*types.TemplateVersion{}
+	if err := db.SelectContext(ctx, &dst, sqlQuery, templateID); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Select template versions query failed")
+	}
+	return dst, nil
+}
+
+// DeleteBySourceNotInSlugs deletes the templates of the template source that are not in the slugs
+func (s *TemplateStore) DeleteBySourceNotInSlugs(ctx context.Context, sourceID int64, slugs []string) error {
+	stmt := database.Builder.Delete("templates").
+		Where("template_source_id = ?", sourceID)
+	if len(slugs) > 0 {
+		stmt = stmt.Where(sq.NotEq{"template_slug": slugs})
+	}
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "failed to convert delete source templates query to sql")
+	}
+
+	db := dbtx.GetAccessor(ctx, s.db)
+	if _, err := db.ExecContext(ctx, query, args...); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "delete source templates query failed")
+	}
+	return nil
+}
+
+func (s *TemplateStore) insertVersion(ctx context.Context, db dbtx.Accessor, version *types.TemplateVersion) error {
+	stmt := database.Builder.Insert("template_versions").
+		Columns(`template_version_template_id
+                ,template_version_number
+                ,template_version_revision
+                ,template_version_spec
+                ,template_version_created`).
+		Values(
+			version.TemplateID,
+			version.Number,
+			version.Revision,
+			version.SpecJson,
+			version.Created,
+		)
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "failed to convert insert template version query to sql")
+	}
+
+	if _, err := db.ExecContext(ctx, query, args...); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "template version insert query failed")
+	}
+	return nil
+}
+
 func (s *TemplateStore) templateSelect(withSpec bool) sq.SelectBuilder {
 	driver := s.db.DriverName()
 
@@ -138,6 +326,9 @@ func (s *TemplateStore) templateSelect(withSpec bool) sq.SelectBuilder {
 		"t.template_icon",
 		"t.template_readme",
 		"t.template_created",
+		"t.template_source_id",
+		"t.template_version",
+		"COALESCE((SELECT MAX(v.template_version_number) FROM template_versions v WHERE v.template_version_template_id = t.template_id), t.template_version) AS latest_version",
 		tagAggregationExpression(driver) + " AS tags_json",
 	}
 
@@ -148,6 +339,8 @@ func (s *TemplateStore) templateSelect(withSpec bool) sq.SelectBuilder {
 		"t.template_icon",
 		"t.template_readme",
 		"t.template_created",
+		"t.template_source_id",
+		"t.template_version",
 	}
 
 	if withSpec {
@@ -162,6 +355,14 @@ func (s *TemplateStore) templateSelect(withSpec bool) sq.SelectBuilder {
 		GroupBy(groupBy...)
 }
 
+// visibleTo filters the templates to the embedded ones and the ones of the template sources of the
+// instance and of the tenant.
+func (s *TemplateStore) visibleTo(stmt sq.SelectBuilder, tenantID int64) sq.SelectBuilder {
+	return stmt.Where(`(t.template_source_id IS NULL OR t.template_source_id IN (
+		SELECT template_source_id FROM template_sources
+		WHERE template_source_tenant_id IS NULL OR template_source_tenant_id = ?))`, tenantID)
+}
+
 func (s *TemplateStore) fetchTemplate(ctx context.Context, stmt sq.SelectBuilder, withSpec bool) (*types.Template, error) {
 	query, args, err := stmt.ToSql()
 	if err != nil {
@@ -214,6 +415,10 @@ func (s *TemplateStore) mapTemplateRow(dst *templateRow, withSpec bool) (*types.
 		ReadMe:   dst.ReadMe,
 		SpecJson: dst.SpecJSON,
 		Created:  dst.Created,
+
+		SourceID:      dst.SourceID,
+		Version:       dst.Version,
+		LatestVersion: dst.LatestVersion,
 	}
 
 	if dst.TagsJSON != "" {
@@ -245,7 +450,9 @@ func (s *TemplateStore) upsertTemplate(ctx context.Context, db dbtx.Accessor, tm
                 ,template_icon
                 ,template_readme
                 ,template_spec
-                ,template_created`).
+                ,template_created
+                ,template_source_id
+                ,template_version`).
 		Values(
 			tmpl.Slug,
 			tmpl.Name,
@@ -253,6 +460,8 @@ func (s *TemplateStore) upsertTemplate(ctx context.Context, db dbtx.Accessor, tm
 			tmpl.ReadMe,
 			tmpl.SpecJson,
 			tmpl.Created,
+			tmpl.SourceID,
+			tmpl.Version,
 		)
 
 	stmt = stmt.Suffix(`ON CONFLICT (template_slug) 
@@ -260,7 +469,8 @@ func (s *TemplateStore) upsertTemplate(ctx context.Context, db dbtx.Accessor, tm
         template_name = EXCLUDED.template_name
         ,template_icon = EXCLUDED.template_icon
         ,template_readme = EXCLUDED.template_readme
-        ,template_spec = EXCLUDED.template_spec`)
+        ,template_spec = EXCLUDED.template_spec
+        ,template_version = EXCLUDED.template_version`)
 
 	query, args, err := stmt.ToSql()
 	if err != nil {
@@ -0,0 +1,191 @@
+package database
+
+import (
+	"context"
+
+	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/store/database"
+	"github.com/cloudness-io/cloudness/store/database/dbtx"
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/jmoiron/sqlx"
+)
+
+var _ store.TemplateSourceStore = (*TemplateSourceStore)(nil)
+
+func NewTemplateSourceStore(db *sqlx.DB) *TemplateSourceStore {
+	return &TemplateSourceStore{
+		db: db,
+	}
+}
+
+type TemplateSourceStore struct {
+	db *sqlx.DB
+}
+
+const templateSourceColumns = `
+	template_source_id
+	,template_source_uid
+	,template_source_tenant_id
+	,template_source_name
+	,template_source_type
+	,template_source_url
+	,template_source_branch
+	,template_source_path
+	,template_source_token
+	,template_source_git_connection_id
+	,template_source_revision
+	,template_source_synced
+	,template_source_sync_error
+	,template_source_created_by
+	,template_source_created
+	,template_source_updated`
+
+const templateSourceInsert = `
+INSERT INTO template_sources (
+	template_source_uid
+	,template_source_tenant_id
+	,template_source_name
+	,template_source_type
+	,template_source_url
+	,template_source_branch
+	,template_source_path
+	,template_source_token
+	,template_source_git_connection_id
+	,template_source_revision
+	,template_source_synced
+	,template_source_sync_error
+	,template_source_created_by
+	,template_source_created
+	,template_source_updated
+) values (
+	:template_source_uid
+	,:template_source_tenant_id
+	,:template_source_name
+	,:template_source_type
+	,:template_source_url
+	,:template_source_branch
+	,:template_source_path
+	,:template_source_token
+	,:template_source_git_connection_id
+	,:template_source_revision
+	,:template_source_synced
+	,:template_source_sync_error
+	,:template_source_created_by
+	,:template_source_created
+	,:template_source_updated
+	) RETURNING template_source_id
+	`
+
+const templateSourceSelectBase = `
+	SELECT` + templateSourceColumns + `
+	FROM template_sources`
+
+// Find finds the template source by id.
+func (s *TemplateSourceStore) Find(ctx context.Context, id int64) (*types.TemplateSource, error) {
+	const sqlQuery = templateSourceSelectBase + `
+	WHERE template_source_id = $1`
+
+	return s.find(ctx, sqlQuery, id)
+}
+
+// FindByUID finds the template source by uid.
+func (s *TemplateSourceStore) FindByUID(ctx context.Context, uid int64) (*types.TemplateSource, error) {
+	const sqlQuery = templateSourceSelectBase + `
+	WHERE template_source_uid = $1`
+
+	return s.find(ctx, sqlQuery, uid)
+}
+
+func (s *TemplateSourceStore) find(ctx context.Context, sqlQuery string, args ...any) (*types.TemplateSource, error) {
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := new(types.TemplateSource)
+	if err := db.GetContext(ctx, dst, sqlQuery, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Select template source query failed")
+	}
+	return dst, nil
+}
+
+// ListInstanceLevel lists the template sources available to every tenant.
+func (s *TemplateSourceStore) ListInstanceLevel(ctx context.Context) ([]*types.TemplateSource, error) {
+	const sqlQuery = templateSourceSelectBase + `
+	WHERE template_source_tenant_id IS NULL
+	ORDER BY template_source_name`
+
+	return s.list(ctx, sqlQuery)
+}
+
+// ListTenantLevel lists the template sources of the tenant.
+func (s *TemplateSourceStore) ListTenantLevel(ctx context.Context, tenantID int64) ([]*types.TemplateSource, error) {
+	const sqlQuery = templateSourceSelectBase + `
+	WHERE template_source_tenant_id = $1
+	ORDER BY template_source_name`
+
+	return s.list(ctx, sqlQuery, tenantID)
+}
+
+// ListAll lists the template sources of the instance and of every tenant.
+func (s *TemplateSourceStore) ListAll(ctx context.Context) ([]*types.TemplateSource, error) {
+	const sqlQuery = templateSourceSelectBase + `
+	ORDER BY template_source_id`
+
+	return s.list(ctx, sqlQuery)
+}
+
+func (s *TemplateSourceStore) list(ctx context.Context, sqlQuery string, args ...any) ([]*types.TemplateSource, error) {
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := []*types.TemplateSource{}
+	if err := db.SelectContext(ctx, &dst, sqlQuery, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Select template sources query failed")
+	}
+	return dst, nil
+}
+
+// Create saves the template source.
+func (s *TemplateSourceStore) Create(ctx context.Context, source *types.TemplateSource) (*types.TemplateSource, error) {
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	query, arg, err := db.BindNamed(templateSourceInsert, source)
+	if err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to bind template source object")
+	}
+
+	if err = db.QueryRowContext(ctx, query, arg...).Scan(&source.ID); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Insert template source query failed")
+	}
+
+	return source, nil
+}
+
+// UpdateSync updates the revision and the result of the last sync of the template source.
+func (s *TemplateSourceStore) UpdateSync(ctx context.Context, source *types.TemplateSource) error {
+	const sqlQuery = `
+	UPDATE template_sources
+	SET
+		template_source_revision = $1
+		,template_source_synced = $2
+		,template_source_sync_error = $3
+		,template_source_updated = $4
+	WHERE template_source_id = $5`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	if _, err := db.ExecContext(ctx, sqlQuery, source.Revision, source.Synced, source.SyncError, source.Updated, source.ID); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Update template source sync query failed")
+	}
+	return nil
+}
+
+// Delete deletes the template source, its templates are deleted with it.
+func (s *TemplateSourceStore) Delete(ctx context.Context, id int64) error {
+	const sqlQuery = `DELETE FROM template_sources WHERE template_source_id = $1`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	if _, err := db.ExecContext(ctx, sqlQuery, id); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Delete template source query failed")
+	}
+	return nil
+}
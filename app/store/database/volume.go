@@ -207,6 +207,29 @@ func (s *VolumeStore) FindDeletedByUID(ctx context.Context, tenantID, projectID,
 	return s.mapDBVolume(dst)
 }
 
+// FindBySlug finds the volume by the slug of its namespace and its slug, which name its claim on the server.
+func (s *VolumeStore) FindBySlug(ctx context.Context, parentSlug, slug string) (*types.Volume, error) {
+	stmt := database.Builder.
+		Select(volumeColumns).
+		From("volumes").
+		Where("volume_parent_slug = ?", parentSlug).
+		Where("volume_slug = ?", slug).
+		Where("volume_deleted IS NULL")
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to build sql")
+	}
+
+	db := dbtx.GetAccessor(ctx, s.db)
+	dst := new(volume)
+
+	if err := db.GetContext(ctx, dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Select by slug query failed")
+	}
+	return s.mapDBVolume(dst)
+}
+
 // List lists the volumes by filter
 func (s *VolumeStore) List(ctx context.Context, filter *types.VolumeFilter) ([]*types.Volume, error) {
 	db := dbtx.GetAccessor(ctx, s.db)
@@ -0,0 +1,188 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/store/database"
+	"github.com/cloudness-io/cloudness/store/database/dbtx"
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/jmoiron/sqlx"
+)
+
+var _ store.VolumeSnapshotStore = (*VolumeSnapshotStore)(nil)
+
+func NewVolumeSnapshotStore(db *sqlx.DB) *VolumeSnapshotStore {
+	return &VolumeSnapshotStore{
+		db: db,
+	}
+}
+
+type VolumeSnapshotStore struct {
+	db *sqlx.DB
+}
+
+const volumeSnapshotColumns = `
+	volume_snapshot_id
+	,volume_snapshot_uid
+	,volume_snapshot_tenant_id
+	,volume_snapshot_project_id
+	,volume_snapshot_environment_id
+	,volume_snapshot_volume_id
+	,volume_snapshot_server_id
+	,volume_snapshot_namespace
+	,volume_snapshot_name
+	,volume_snapshot_class
+	,volume_snapshot_trigger
+	,volume_snapshot_status
+	,volume_snapshot_size
+	,volume_snapshot_error
+	,volume_snapshot_created_by
+	,volume_snapshot_created
+	,volume_snapshot_updated`
+
+const volumeSnapshotInsert = `
+INSERT INTO volume_snapshots (
+	volume_snapshot_uid
+	,volume_snapshot_tenant_id
+	,volume_snapshot_project_id
+	,volume_snapshot_environment_id
+	,volume_snapshot_volume_id
+	,volume_snapshot_server_id
+	,volume_snapshot_namespace
+	,volume_snapshot_name
+	,volume_snapshot_class
+	,volume_snapshot_trigger
+	,volume_snapshot_status
+	,volume_snapshot_size
+	,volume_snapshot_error
+	,volume_snapshot_created_by
+	,volume_snapshot_created
+	,volume_snapshot_updated
+) values (
+	:volume_snapshot_uid
+	,:volume_snapshot_tenant_id
+	,:volume_snapshot_project_id
+	,:volume_snapshot_environment_id
+	,:volume_snapshot_volume_id
+	,:volume_snapshot_server_id
+	,:volume_snapshot_namespace
+	,:volume_snapshot_name
+	,:volume_snapshot_class
+	,:volume_snapshot_trigger
+	,:volume_snapshot_status
+	,:volume_snapshot_size
+	,:volume_snapshot_error
+	,:volume_snapshot_created_by
+	,:volume_snapshot_created
+	,:volume_snapshot_updated
+	) RETURNING volume_snapshot_id
+	`
+
+const volumeSnapshotUpdate = `
+UPDATE volume_snapshots
+SET
+	volume_snapshot_status = :volume_snapshot_status
+	,volume_snapshot_size = :volume_snapshot_size
+	,volume_snapshot_error = :volume_snapshot_error
+	,volume_snapshot_updated = :volume_snapshot_updated
+WHERE volume_snapshot_id = :volume_snapshot_id`
+
+const volumeSnapshotSelectBase = `
+	SELECT` + volumeSnapshotColumns + `
+	FROM volume_snapshots`
+
+// Find finds the volume snapshot by id.
+func (s *VolumeSnapshotStore) Find(ctx context.Context, id int64) (*types.VolumeSnapshot, error) {
+	const sqlQuery = volumeSnapshotSelectBase + `
+	WHERE volume_snapshot_id = $1`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := new(types.VolumeSnapshot)
+	if err := db.GetContext(ctx, dst, sqlQuery, id); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Select volume snapshot by id query failed")
+	}
+	return dst, nil
+}
+
+// FindByUID finds the snapshot of the volume by uid.
+func (s *VolumeSnapshotStore) FindByUID(ctx context.Context, volumeID, uid int64) (*types.VolumeSnapshot, error) {
+	const sqlQuery = volumeSnapshotSelectBase + `
+	WHERE volume_snapshot_volume_id = $1 AND volume_snapshot_uid = $2`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := new(types.VolumeSnapshot)
+	if err := db.GetContext(ctx, dst, sqlQuery, volumeID, uid); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Select volume snapshot by uid query failed")
+	}
+	return dst, nil
+}
+
+// List lists the snapshots of the volume, newest first.
+func (s *VolumeSnapshotStore) List(ctx context.Context, volumeID int64) ([]*types.VolumeSnapshot, error) {
+	const sqlQuery = volumeSnapshotSelectBase + `
+	WHERE volume_snapshot_volume_id = $1
+	ORDER BY volume_snapshot_created DESC`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := []*types.VolumeSnapshot{}
+	if err := db.SelectContext(ctx, &dst, sqlQuery, volumeID); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Select volume snapshots query failed")
+	}
+	return dst, nil
+}
+
+// Create saves the volume snapshot.
+func (s *VolumeSnapshotStore) Create(ctx context.Context, snapshot *types.VolumeSnapshot) (*types.VolumeSnapshot, error) {
+	now := time.Now().UTC().UnixMilli()
+	snapshot.Created = now
+	snapshot.Updated = now
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	query, arg, err := db.BindNamed(volumeSnapshotInsert, snapshot)
+	if err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to bind volume snapshot object")
+	}
+
+	if err = db.QueryRowContext(ctx, query, arg...).Scan(&snapshot.ID); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Insert volume snapshot query failed")
+	}
+
+	return snapshot, nil
+}
+
+// Update updates the state of the volume snapshot.
+func (s *VolumeSnapshotStore) Update(ctx context.Context, snapshot *types.VolumeSnapshot) (*types.VolumeSnapshot, error) {
+	snapshot.Updated = time.Now().UTC().UnixMilli()
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	query, arg, err := db.BindNamed(volumeSnapshotUpdate, snapshot)
+	if err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to bind volume snapshot object")
+	}
+
+	if _, err = db.ExecContext(ctx, query, arg...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Update volume snapshot query failed")
+	}
+
+	return snapshot, nil
+}
+
+// Delete deletes the volume snapshot.
+func (s *VolumeSnapshotStore) Delete(ctx context.Context, id int64) error {
+	const sqlQuery = `DELETE FROM volume_snapshots WHERE volume_snapshot_id = $1`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	if _, err := db.ExecContext(ctx, sqlQuery, id); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Delete volume snapshot query failed")
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudness-io/cloudness/app/store"
+	"github.com/cloudness-io/cloudness/store/database"
+	"github.com/cloudness-io/cloudness/store/database/dbtx"
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/jmoiron/sqlx"
+)
+
+var _ store.VolumeSnapshotPolicyStore = (*VolumeSnapshotPolicyStore)(nil)
+
+func NewVolumeSnapshotPolicyStore(db *sqlx.DB) *VolumeSnapshotPolicyStore {
+	return &VolumeSnapshotPolicyStore{
+		db: db,
+	}
+}
+
+type VolumeSnapshotPolicyStore struct {
+	db *sqlx.DB
+}
+
+const snapshotPolicyColumns = `
+	snapshot_policy_id
+	,snapshot_policy_volume_id
+	,snapshot_policy_enabled
+	,snapshot_policy_schedule
+	,snapshot_policy_retention
+	,snapshot_policy_next_run
+	,snapshot_policy_created
+	,snapshot_policy_updated`
+
+const snapshotPolicyUpsert = `
+INSERT INTO snapshot_policies (
+	snapshot_policy_volume_id
+	,snapshot_policy_enabled
+	,snapshot_policy_schedule
+	,snapshot_policy_retention
+	,snapshot_policy_next_run
+	,snapshot_policy_created
+	,snapshot_policy_updated
+) values (
+	:snapshot_policy_volume_id
+	,:snapshot_policy_enabled
+	,:snapshot_policy_schedule
+	,:snapshot_policy_retention
+	,:snapshot_policy_next_run
+	,:snapshot_policy_created
+	,:snapshot_policy_updated
+) ON CONFLICT (snapshot_policy_volume_id)
+DO UPDATE SET
+	snapshot_policy_enabled = EXCLUDED.snapshot_policy_enabled
+	,snapshot_policy_schedule = EXCLUDED.snapshot_policy_schedule
+	,snapshot_policy_retention = EXCLUDED.snapshot_policy_retention
+	,snapshot_policy_next_run = EXCLUDED.snapshot_policy_next_run
+	,snapshot_policy_updated = EXCLUDED.snapshot_policy_updated
+RETURNING snapshot_policy_id`
+
+const snapshotPolicySelectBase = `
+	SELECT` + snapshotPolicyColumns + `
+	FROM snapshot_policies`
+
+// Find finds the snapshot policy of the volume.
+func (s *VolumeSnapshotPolicyStore) Find(ctx context.Context, volumeID int64) (*types.VolumeSnapshotPolicy, error) {
+	const sqlQuery = snapshotPolicySelectBase + `
+	WHERE snapshot_policy_volume_id = $1`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := new(types.VolumeSnapshotPolicy)
+	if err := db.GetContext(ctx, dst, sqlQuery, volumeID); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Select snapshot policy query failed")
+	}
+	return dst, nil
+}
+
+// ListDue lists the enabled snapshot policies with a next run at or before the time.
+func (s *VolumeSnapshotPolicyStore) ListDue(ctx context.Context, now int64) ([]*types.VolumeSnapshotPolicy, error) {
+	const sqlQuery = snapshotPolicySelectBase + `
+	WHERE snapshot_policy_enabled = $1 AND snapshot_policy_next_run <= $2
+	ORDER BY snapshot_policy_next_run`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := []*types.VolumeSnapshotPolicy{}
+	if err := db.SelectContext(ctx, &dst, sqlQuery, true, now); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Select due snapshot policies query failed")
+	}
+	return dst, nil
+}
+
+// Upsert creates or updates the snapshot policy of the volume.
+func (s *VolumeSnapshotPolicyStore) Upsert(ctx context.Context, policy *types.VolumeSnapshotPolicy) (*types.VolumeSnapshotPolicy, error) {
+	now := time.Now().UTC().UnixMilli()
+	if policy.Created == 0 {
+		policy.Created = now
+	}
+	policy.Updated = now
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	query, arg, err := db.BindNamed(snapshotPolicyUpsert, policy)
+	if err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to bind snapshot policy object")
+	}
+
+	if err = db.QueryRowContext(ctx, query, arg...).Scan(&policy.ID); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Upsert snapshot policy query failed")
+	}
+
+	return policy, nil
+}
+
+// UpdateNextRun updates the time the policy is due next.
+func (s *VolumeSnapshotPolicyStore) UpdateNextRun(ctx context.Context, id int64, nextRun int64) error {
+	const sqlQuery = `UPDATE snapshot_policies SET snapshot_policy_next_run = $1 WHERE snapshot_policy_id = $2`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	if _, err := db.ExecContext(ctx, sqlQuery, nextRun, id); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Update snapshot policy next run query failed")
+	}
+	return nil
+}
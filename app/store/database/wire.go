@@ -30,12 +30,22 @@ var WireSet = wire.NewSet(
 	ProvideLogStore,
 	ProvideGithubAppStore,
 	ProvidePrivateKeyStore,
+	ProvideRegistryCredentialStore,
+	ProvideGitConnectionStore,
 	ProvideVolumeStore,
 	ProvideVariableStore,
 	ProvideJobStore,
 	ProvideTemplateStore,
+	ProvideTemplateSourceStore,
 	ProvideFavoriteStore,
 	ProvideMetricsStore,
+	ProvideAuditEventStore,
+	ProvideBackupPolicyStore,
+	ProvideBackupStore,
+	ProvideVolumeSnapshotPolicyStore,
+	ProvideVolumeSnapshotStore,
+	ProvideNotificationChannelStore,
+	ProvideNotificationAlertStore,
 )
 
 // migrator is helper function to set up the database by performing automated
@@ -129,6 +139,16 @@ func ProvidePrivateKeyStore(db *sqlx.DB) store.PrivateKeyStore {
 	return NewPrivateKeyStore(db)
 }
 
+// ProvideRegistryCredentialStore provides a registry credential store.
+func ProvideRegistryCredentialStore(db *sqlx.DB) store.RegistryCredentialStore {
+	return NewRegistryCredentialStore(db)
+}
+
+// ProvideGitConnectionStore provides a git connection store.
+func ProvideGitConnectionStore(db *sqlx.DB) store.GitConnectionStore {
+	return NewGitConnectionStore(db)
+}
+
 // ProvideVolumeStore provides a volume store.
 func ProvideVolumeStore(db *sqlx.DB) store.VolumeStore {
 	return NewVolumeStore(db)
@@ -149,6 +169,11 @@ func ProvideTemplateStore(db *sqlx.DB) store.TemplateStore {
 	return NewTemplateStore(db)
 }
 
+// ProvideTemplateSourceStore provides a template source store.
+func ProvideTemplateSourceStore(db *sqlx.DB) store.TemplateSourceStore {
+	return NewTemplateSourceStore(db)
+}
+
 // ProvideFavoriteStore provides a favorite store.
 func ProvideFavoriteStore(db *sqlx.DB) store.FavoriteStore {
 	return NewFavoriteStore(db)
@@ -158,3 +183,38 @@ func ProvideFavoriteStore(db *sqlx.DB) store.FavoriteStore {
 func ProvideMetricsStore(db *sqlx.DB) store.MetricsStore {
 	return NewMetricsStore(db)
 }
+
+// ProvideAuditEventStore provides an audit event store.
+func ProvideAuditEventStore(db *sqlx.DB) store.AuditEventStore {
+	return NewAuditEventStore(db)
+}
+
+// ProvideBackupPolicyStore provides a backup policy store.
+func ProvideBackupPolicyStore(db *sqlx.DB) store.BackupPolicyStore {
+	return NewBackupPolicyStore(db)
+}
+
+// ProvideBackupStore provides a backup store.
+func ProvideBackupStore(db *sqlx.DB) store.BackupStore {
+	return NewBackupStore(db)
+}
+
+// ProvideVolumeSnapshotPolicyStore provides a volume snapshot policy store.
+func ProvideVolumeSnapshotPolicyStore(db *sqlx.DB) store.VolumeSnapshotPolicyStore {
+	return NewVolumeSnapshotPolicyStore(db)
+}
+
+// ProvideVolumeSnapshotStore provides a volume snapshot store.
+func ProvideVolumeSnapshotStore(db *sqlx.DB) store.VolumeSnapshotStore {
+	return NewVolumeSnapshotStore(db)
+}
+
+// ProvideNotificationChannelStore provides a notification channel store.
+func ProvideNotificationChannelStore(db *sqlx.DB) store.NotificationChannelStore {
+	return NewNotificationChannelStore(db)
+}
+
+// ProvideNotificationAlertStore provides a notification alert store.
+func ProvideNotificationAlertStore(db *sqlx.DB) store.NotificationAlertStore {
+	return NewNotificationAlertStore(db)
+}
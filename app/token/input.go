@@ -0,0 +1,43 @@
+package token
+
+import (
+	"time"
+
+	"github.com/cloudness-io/cloudness/types/check"
+
+	"github.com/gotidy/ptr"
+)
+
+// MaxLifetimeDays is the longest lifetime of an access token that can be requested.
+const MaxLifetimeDays = 365
+
+// CreateInput is the input used to mint a personal access token or a service account token.
+type CreateInput struct {
+	Identifier string `json:"identifier"`
+	// LifetimeDays is the number of days the token is valid for, 0 creates a token that never expires.
+	LifetimeDays int64 `json:"lifetime_days,string"`
+}
+
+// SanitizeCreateInput validates the identifier and the lifetime of the token.
+func SanitizeCreateInput(in *CreateInput) error {
+	errors := check.NewValidationErrors()
+	if err := check.Identifier(in.Identifier); err != nil {
+		errors.AddValidationError("identifier", err)
+	}
+	if in.LifetimeDays < 0 || in.LifetimeDays > MaxLifetimeDays {
+		errors.AddValidationError("lifetime_days",
+			check.NewValidationErrorf("Lifetime has to be between 0 and %d days", MaxLifetimeDays))
+	}
+	if errors.HasError() {
+		return errors
+	}
+	return nil
+}
+
+// Lifetime converts the lifetime in days to a duration, nil for a token that never expires.
+func (in *CreateInput) Lifetime() *time.Duration {
+	if in.LifetimeDays == 0 {
+		return nil
+	}
+	return ptr.Duration(time.Duration(in.LifetimeDays) * 24 * time.Hour)
+}
@@ -0,0 +1,27 @@
+package token
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/dchest/uniuri"
+)
+
+const (
+	runnerTokenPrefix = "cnr_"
+	runnerTokenLength = 48
+)
+
+// GenerateRunnerToken returns a new runner token of a server and the hash stored for it,
+// the token itself is only shown once.
+func GenerateRunnerToken() (string, string) {
+	token := runnerTokenPrefix + uniuri.NewLen(runnerTokenLength)
+	return token, HashRunnerToken(token)
+}
+
+// HashRunnerToken returns the hex encoded sha256 of the runner token, the tokens are random so
+// no salt or key stretching is needed.
+func HashRunnerToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
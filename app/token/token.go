@@ -42,6 +42,46 @@ func CreateUserSession(
 	)
 }
 
+// CreatePAT creates a personal access token for the user.
+func CreatePAT(
+	ctx context.Context,
+	tokenStore store.TokenStore,
+	user *types.User,
+	identifier string,
+	lifetime *time.Duration,
+) (*types.Token, string, error) {
+	principal := user.ToPrincipal()
+	return create(
+		ctx,
+		tokenStore,
+		enum.TokenTypePAT,
+		principal,
+		principal,
+		identifier,
+		lifetime,
+	)
+}
+
+// CreateSAT creates a service account access token on behalf of the creating principal.
+func CreateSAT(
+	ctx context.Context,
+	tokenStore store.TokenStore,
+	createdBy *types.Principal,
+	sa *types.ServiceAccount,
+	identifier string,
+	lifetime *time.Duration,
+) (*types.Token, string, error) {
+	return create(
+		ctx,
+		tokenStore,
+		enum.TokenTypeSAT,
+		createdBy,
+		sa.ToPrincipal(),
+		identifier,
+		lifetime,
+	)
+}
+
 func create(
 	ctx context.Context,
 	tokenStore store.TokenStore,
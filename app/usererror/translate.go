@@ -22,6 +22,7 @@ func Translate(ctx context.Context, err error) *Error {
 		rError     *Error
 		appError   *errors.Error
 		checkError *check.ValidationError
+		checkErrs  *check.ValidationErrors
 		lockError  *lock.Error
 	)
 
@@ -45,6 +46,12 @@ func Translate(ctx context.Context, err error) *Error {
 		return &Error{Status: httpStatusCode(appError.Status), Message: appError.Message}
 
 	// validation errors
+	case errors.As(err, &checkErrs):
+		values := make(map[string]any, len(checkErrs.Errors()))
+		for k, v := range checkErrs.Errors() {
+			values[k] = v
+		}
+		return BadRequestWithPayload("Validation failed", values)
 	case errors.As(err, &checkError):
 		return New(http.StatusBadRequest, checkError.Error())
 
@@ -3,5 +3,6 @@ package routes
 const (
 	AccountProfile = "/account"
 	AccountSession = "/account/session"
+	AccountTokens  = "/account/tokens"
 	AccountDelete  = "/account/delete"
 )
@@ -12,27 +12,34 @@ const (
 
 	AppNewGitPublic = "application/new/git-public"
 	AppNewGithub    = "application/new/github"
+	AppNewGit       = "application/new/git"
 	AppNewRegistry  = "application/new/registry"
 	AppNewDatabase  = "application/new/database"
 	AppNewOneclick  = "application/new/oneclick"
 
-	AppDeployments         = "deployments"
-	AppMetrics             = "metrics"
-	AppLogs                = "logs"
-	AppTerminal            = "terminal"
-	AppSource              = "source"
-	AppVolume              = "volumes"
-	AppVolumeDetach        = "detach"
-	AppVolumeUnAttached    = "volumes/unattached"
-	AppVolumeCreate        = "volumes/create"
-	AppSettings            = "settings"
-	AppVariables           = "variables"
-	AppDelete              = "delete"
-	AppNetworkPrivate      = "network/private"
-	AppNetworkHTTP         = "network/http"
-	AppNetworkHTTPGenerate = AppNetworkHTTP + "/generate"
-	AppNetworkTCP          = "network/tcp"
-	AppFavorite            = "favorite"
+	AppDeployments          = "deployments"
+	AppMetrics              = "metrics"
+	AppLogs                 = "logs"
+	AppRuns                 = "runs"
+	AppTerminal             = "terminal"
+	AppTerminalSession      = "terminal/ws"
+	AppBackups              = "backups"
+	AppBackupPolicy         = "backups/policy"
+	AppSource               = "source"
+	AppVolume               = "volumes"
+	AppVolumeDetach         = "detach"
+	AppVolumeUnAttached     = "volumes/unattached"
+	AppVolumeCreate         = "volumes/create"
+	AppVolumeSnapshots      = "snapshots"
+	AppVolumeSnapshotPolicy = "snapshots/policy"
+	AppSettings             = "settings"
+	AppVariables            = "variables"
+	AppDelete               = "delete"
+	AppNetworkPrivate       = "network/private"
+	AppNetworkHTTP          = "network/http"
+	AppNetworkHTTPGenerate  = AppNetworkHTTP + "/generate"
+	AppNetworkTCP           = "network/tcp"
+	AppFavorite             = "favorite"
 
 	AppNav = "/nav"
 
@@ -23,6 +23,10 @@ func DeploymentLogStream(uid int64) string {
 	return fmt.Sprintf("%s/logs/stream", Deployment(uid))
 }
 
+func DeploymentRollback(uid int64) string {
+	return fmt.Sprintf("%s/rollback", Deployment(uid))
+}
+
 func DeploymentCtx(ctx context.Context) string {
 	deployment, _ := request.DeploymentFrom(ctx)
 	return fmt.Sprintf("%s/deployment/%d", ApplicationCtx(ctx), deployment.UID)
@@ -15,6 +15,7 @@ const (
 	EnvironmentSettings    = "/settings"
 	EnvironmentDelete      = "/delete"
 	EnvironmentVolumes     = "/volumes"
+	EnvironmentExport      = "/export"
 	EnvironmentNav         = "/nav"
 )
 
@@ -39,6 +40,10 @@ func EnvironmentCtxUID(ctx context.Context, envUID int64) string {
 	return fmt.Sprintf("%s/environment/%d", ProjectCtx(ctx), envUID)
 }
 
+func EnvironmentExportUrl(ctx context.Context, envUID int64) string {
+	return EnvironmentCtxUID(ctx, envUID) + EnvironmentExport
+}
+
 func EnvironmentUID(envUID int64) string {
 	return fmt.Sprintf("%s/%d", EnvironmentBase, envUID)
 }
@@ -1,7 +1,23 @@
 package routes
 
+import "fmt"
+
 const (
 	InstanceAuthPassword = "/settings/auth/password"
 	InstanceAuthDemo     = "/settings/auth/demo"
 	InstanceAuthGithub   = "/settings/auth/github"
+	InstanceAuthGitlab   = "/settings/auth/gitlab"
+	InstanceAuthGoogle   = "/settings/auth/google"
+	InstanceAuthOIDC     = "/settings/auth/oidc"
+
+	InstanceServers         = "/settings/server"
+	InstanceTemplateSources = "/settings/templates"
 )
+
+func InstanceServerUID(uid int64) string {
+	return fmt.Sprintf("%s/%d", InstanceServers, uid)
+}
+
+func InstanceTemplateSourceUID(uid int64) string {
+	return fmt.Sprintf("%s/%d", InstanceTemplateSources, uid)
+}
@@ -13,11 +13,14 @@ const (
 	ProjectEnvironment = "environment"
 	ProjectConnections = "connections"
 	ProjectMembers     = "members"
+	ProjectNotify      = "notifications"
 	ProjectSettings    = "settings"
 	ProjectDelete      = "delete"
+	ProjectExport      = "export"
 	ProjectNav         = "/nav"
 
 	ProjectConnectionGithub = "connections/github"
+	ProjectConnectionGit    = "connections/git"
 )
 
 func Project(uid int64) string {
@@ -48,3 +51,23 @@ func ProjectConnectionGithubUID(ghAppUID int64) string {
 func ProjectConnectionGithubUIDCtx(ctx context.Context, ghAppUID int64) string {
 	return fmt.Sprintf("%s/%s/%d", ProjectCtx(ctx), ProjectConnectionGithub, ghAppUID)
 }
+
+func ProjectConnectionGitUID(connUID int64) string {
+	return fmt.Sprintf("%s/%d", ProjectConnectionGit, connUID)
+}
+
+func ProjectConnectionGitUIDCtx(ctx context.Context, connUID int64) string {
+	return fmt.Sprintf("%s/%s/%d", ProjectCtx(ctx), ProjectConnectionGit, connUID)
+}
+
+func ProjectNotificationsUrl(ctx context.Context) string {
+	return fmt.Sprintf("%s/%s", ProjectCtx(ctx), ProjectNotify)
+}
+
+func ProjectNotificationUrl(ctx context.Context, uid int64) string {
+	return fmt.Sprintf("%s/%d", ProjectNotificationsUrl(ctx), uid)
+}
+
+func ProjectExportUrl(ctx context.Context) string {
+	return fmt.Sprintf("%s/%s", ProjectCtx(ctx), ProjectExport)
+}
@@ -15,6 +15,13 @@ const (
 	TenantRestrictions  = "restrictions"
 	TenantDelete        = "delete"
 	TenantMembersAction = "/members"
+
+	TenantServiceAccounts     = "service-accounts"
+	TenantRegistryCredentials = "registry-credentials"
+	TenantNotifications       = "notifications"
+	TenantTemplateSources     = "templates"
+	TenantAuditLog            = "audit"
+	TenantAuditLogExport      = "audit/export"
 )
 
 func TenantBaseURL() string {
@@ -40,3 +47,43 @@ func TenantCtx(ctx context.Context) string {
 func TenantMembersUrl(ctx context.Context) string {
 	return fmt.Sprintf("%s%s", TenantCtx(ctx), TenantMembersAction)
 }
+
+func TenantServiceAccountsUrl(ctx context.Context) string {
+	return fmt.Sprintf("%s/%s", TenantCtx(ctx), TenantServiceAccounts)
+}
+
+func TenantServiceAccountUrl(ctx context.Context, uid string) string {
+	return fmt.Sprintf("%s/%s", TenantServiceAccountsUrl(ctx), uid)
+}
+
+func TenantRegistryCredentialsUrl(ctx context.Context) string {
+	return fmt.Sprintf("%s/%s", TenantCtx(ctx), TenantRegistryCredentials)
+}
+
+func TenantRegistryCredentialUrl(ctx context.Context, uid int64) string {
+	return fmt.Sprintf("%s/%d", TenantRegistryCredentialsUrl(ctx), uid)
+}
+
+func TenantNotificationsUrl(ctx context.Context) string {
+	return fmt.Sprintf("%s/%s", TenantCtx(ctx), TenantNotifications)
+}
+
+func TenantNotificationUrl(ctx context.Context, uid int64) string {
+	return fmt.Sprintf("%s/%d", TenantNotificationsUrl(ctx), uid)
+}
+
+func TenantTemplateSourcesUrl(ctx context.Context) string {
+	return fmt.Sprintf("%s/%s", TenantCtx(ctx), TenantTemplateSources)
+}
+
+func TenantTemplateSourceUrl(ctx context.Context, uid int64) string {
+	return fmt.Sprintf("%s/%d", TenantTemplateSourcesUrl(ctx), uid)
+}
+
+func TenantAuditLogUrl(ctx context.Context) string {
+	return fmt.Sprintf("%s/%s", TenantCtx(ctx), TenantAuditLog)
+}
+
+func TenantAuditLogExportUrl(ctx context.Context) string {
+	return fmt.Sprintf("%s/%s", TenantCtx(ctx), TenantAuditLogExport)
+}
@@ -0,0 +1,86 @@
+package account
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/controller/user"
+	"github.com/cloudness-io/cloudness/app/request"
+	"github.com/cloudness-io/cloudness/app/web/render"
+	"github.com/cloudness-io/cloudness/app/web/views/components/vaccount"
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/rs/zerolog/log"
+)
+
+func HandleListTokens(userCtrl *user.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		renderTokensPage(w, r, userCtrl, nil)
+	}
+}
+
+func HandleCreateToken(userCtrl *user.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		in := new(user.CreateTokenInput)
+		if err := json.NewDecoder(r.Body).Decode(in); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error decoding json")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		principal, _ := request.PrincipalFrom(ctx)
+		created, err := userCtrl.CreateToken(ctx, principal.ID, in)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error creating token")
+			render.ToastErrorWithValidation(ctx, w, in, err)
+			return
+		}
+
+		err = renderTokensPage(w, r, userCtrl, created)
+		if err == nil {
+			render.ToastSuccess(ctx, w, "Token created successfully")
+		}
+	}
+}
+
+func HandleDeleteToken(userCtrl *user.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		identifier, err := request.GetTokenIdentifierFromPath(r)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Invalid token identifier")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		principal, _ := request.PrincipalFrom(ctx)
+		if err := userCtrl.DeleteToken(ctx, principal.ID, identifier); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error revoking token")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		err = renderTokensPage(w, r, userCtrl, nil)
+		if err == nil {
+			render.ToastSuccess(ctx, w, "Token revoked successfully")
+		}
+	}
+}
+
+func renderTokensPage(w http.ResponseWriter, r *http.Request, userCtrl *user.Controller, created *types.TokenResponse) error {
+	ctx := r.Context()
+	principal, _ := request.PrincipalFrom(ctx)
+
+	tokens, err := userCtrl.ListTokens(ctx, principal.ID)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error listing tokens")
+		render.ToastError(ctx, w, err)
+		return err
+	}
+
+	render.Page(ctx, w, vaccount.Tokens(tokens, created))
+	return nil
+}
@@ -17,12 +17,22 @@ func HandleListDeployments(appCtrl *application.Controller, deploymentCtrl *depl
 		ctx := r.Context()
 		application, _ := request.ApplicationFrom(ctx)
 
-		deployments, err := deploymentCtrl.List(ctx, application.ID)
+		filter, err := request.ParseListQueryFilterFromRequest(r)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error parsing deployments filter")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		deployments, count, err := deploymentCtrl.List(ctx, application.ID, &filter)
 		if err != nil {
 			log.Ctx(ctx).Error().Err(err).Msg("Error listing deployments")
 			render.ToastError(ctx, w, err)
 		}
 
-		render.Page(ctx, w, vapplication.DeploymentsList(application, deployments))
+		render.Page(ctx, w, vapplication.DeploymentsList(application, deployments, &vapplication.DeploymentsPage{
+			Count:      count,
+			Pagination: filter.Pagination,
+		}))
 	}
 }
@@ -26,6 +26,12 @@ func HandleGetMetrics(appCtrl *application.Controller) http.HandlerFunc {
 			return
 		}
 
-		render.Page(ctx, w, vapplication.Metrics(application, metrics, metricsSpan))
+		// the autoscaler is informational, the metrics page renders without it
+		autoscaling, err := appCtrl.GetAutoscalingStatus(ctx, application)
+		if err != nil {
+			log.Ctx(ctx).Warn().Err(err).Msg("Error getting autoscaling status")
+		}
+
+		render.Page(ctx, w, vapplication.Metrics(application, metrics, autoscaling, metricsSpan))
 	}
 }
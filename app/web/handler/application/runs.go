@@ -0,0 +1,28 @@
+package application
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/controller/application"
+	"github.com/cloudness-io/cloudness/app/request"
+	"github.com/cloudness-io/cloudness/app/web/render"
+	"github.com/cloudness-io/cloudness/app/web/views/components/vapplication"
+
+	"github.com/rs/zerolog/log"
+)
+
+func HandleGetRuns(appCtrl *application.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		app, _ := request.ApplicationFrom(ctx)
+
+		runs, err := appCtrl.ListCronRuns(ctx, app)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("error listing cron runs")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		render.Page(ctx, w, vapplication.Runs(app, runs))
+	}
+}
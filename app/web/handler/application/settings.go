@@ -72,6 +72,23 @@ func renderSettings(ctx context.Context, w http.ResponseWriter, appCtrl *applica
 		}
 	}
 
-	render.Page(ctx, w, vapplication.Settings(project, app, ghApp, restrctions))
+	gitConn, err := appCtrl.FindGitConnection(ctx, app)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error getting git connection")
+		render.ToastError(ctx, w, err)
+		return err
+	}
+
+	var credentials []*types.RegistryCredential
+	if app.Spec.IsRegistry() {
+		credentials, err = appCtrl.ListRegistryCredentials(ctx, tenant.ID)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error listing registry credentials")
+			render.ToastError(ctx, w, err)
+			return err
+		}
+	}
+
+	render.Page(ctx, w, vapplication.Settings(project, app, ghApp, gitConn, credentials, restrctions))
 	return nil
 }
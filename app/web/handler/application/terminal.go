@@ -1,18 +1,183 @@
 package application
 
 import (
+	"context"
+	"encoding/json"
+	"io"
 	"net/http"
+	"sync"
+	"time"
 
+	"github.com/cloudness-io/cloudness/app/controller/application"
 	"github.com/cloudness-io/cloudness/app/request"
 	"github.com/cloudness-io/cloudness/app/web/render"
 	"github.com/cloudness-io/cloudness/app/web/views/components/vapplication"
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/gorilla/websocket"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// terminalIdleTimeout closes the session when the client sends no input for this long.
+	terminalIdleTimeout = 15 * time.Minute
+	terminalWriteWait   = 10 * time.Second
+	terminalMaxMessage  = 64 * 1024
 )
 
-func HandleGetTerminal() http.HandlerFunc {
+// terminalUpgrader keeps the default same origin check of gorilla.
+var terminalUpgrader = websocket.Upgrader{
+	ReadBufferSize:  4096,
+	WriteBufferSize: 4096,
+}
+
+// terminalMessage is a message sent by the browser terminal.
+type terminalMessage struct {
+	Type string `json:"type"`
+	Data string `json:"data,omitempty"`
+	Cols uint16 `json:"cols,omitempty"`
+	Rows uint16 `json:"rows,omitempty"`
+}
+
+func HandleGetTerminal(appCtrl *application.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		app, _ := request.ApplicationFrom(ctx)
+
+		targets, err := appCtrl.ListExecTargets(ctx, app)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("error listing terminal targets")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		render.Page(ctx, w, vapplication.Terminal(app, targets))
+	}
+}
+
+func HandleTerminalSession(appCtx context.Context, appCtrl *application.Controller) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
-		application, _ := request.ApplicationFrom(ctx)
+		app, _ := request.ApplicationFrom(ctx)
+
+		target := &types.ExecTarget{
+			Pod:       r.URL.Query().Get("pod"),
+			Container: r.URL.Query().Get("container"),
+		}
+		if target.Pod == "" {
+			http.Error(w, "pod is required", http.StatusBadRequest)
+			return
+		}
+
+		conn, err := terminalUpgrader.Upgrade(w, r, nil)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("terminal: error upgrading websocket")
+			return
+		}
+		defer conn.Close()
+		conn.SetReadLimit(terminalMaxMessage)
 
-		render.Page(ctx, w, vapplication.Terminal(application))
+		// the session outlives neither the request nor the server
+		sessCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		go func() {
+			select {
+			case <-appCtx.Done():
+				cancel()
+			case <-sessCtx.Done():
+			}
+		}()
+
+		stdinR, stdinW := io.Pipe()
+		resize := make(chan types.TerminalSize, 1)
+		stdout := &terminalWriter{conn: conn}
+
+		go readTerminalMessages(sessCtx, cancel, conn, stdinW, resize)
+
+		err = appCtrl.Exec(sessCtx, app, target, &types.ExecStreams{
+			Stdin:  stdinR,
+			Stdout: stdout,
+			Resize: resize,
+		})
+		stdinR.Close()
+
+		reason := "session closed"
+		if err != nil && sessCtx.Err() == nil {
+			log.Ctx(ctx).Warn().Err(err).Str("pod", target.Pod).Msg("terminal: exec session failed")
+			reason = err.Error()
+			if len(reason) > 120 {
+				reason = reason[:120]
+			}
+		}
+		stdout.close(websocket.FormatCloseMessage(websocket.CloseNormalClosure, reason))
+	}
+}
+
+// readTerminalMessages pumps browser messages into the exec session until the socket
+// closes or the client stays idle past terminalIdleTimeout.
+func readTerminalMessages(
+	ctx context.Context,
+	cancel context.CancelFunc,
+	conn *websocket.Conn,
+	stdin *io.PipeWriter,
+	resize chan types.TerminalSize,
+) {
+	defer cancel()
+	defer stdin.Close()
+	defer close(resize)
+
+	for {
+		_ = conn.SetReadDeadline(time.Now().Add(terminalIdleTimeout))
+		var msg terminalMessage
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		if err := json.Unmarshal(data, &msg); err != nil {
+			continue
+		}
+
+		switch msg.Type {
+		case "input":
+			if _, err := stdin.Write([]byte(msg.Data)); err != nil {
+				return
+			}
+		case "resize":
+			if msg.Cols == 0 || msg.Rows == 0 {
+				continue
+			}
+			// only the latest size matters, drop a pending one
+			select {
+			case <-resize:
+			default:
+			}
+			select {
+			case resize <- types.TerminalSize{Cols: msg.Cols, Rows: msg.Rows}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// terminalWriter forwards the exec output as binary websocket messages.
+type terminalWriter struct {
+	mu   sync.Mutex
+	conn *websocket.Conn
+}
+
+func (t *terminalWriter) Write(p []byte) (int, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	_ = t.conn.SetWriteDeadline(time.Now().Add(terminalWriteWait))
+	if err := t.conn.WriteMessage(websocket.BinaryMessage, p); err != nil {
+		return 0, err
 	}
+	return len(p), nil
+}
+
+func (t *terminalWriter) close(msg []byte) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	_ = t.conn.WriteControl(websocket.CloseMessage, msg, time.Now().Add(terminalWriteWait))
 }
@@ -21,6 +21,14 @@ func HandleCallback(authCtrl *auth.Controller, cookieName string) http.HandlerFu
 		authSettings, _ := request.AuthSettingFrom(ctx)
 
 		code := r.URL.Query().Get("code")
+		if !cookie.VerifyOAuthStateCookie(r, w, r.URL.Query().Get("state")) {
+			log.Ctx(ctx).Warn().Any("provider", authSettings.Provider).Msg("oauth state mismatch")
+			render.RootWithoutNav(ctx, w, shared.Maintainance(&shared.MaintainanceProps{
+				Header:    "Login session expired",
+				Subheader: "Please try logging in again.",
+			}), routes.GetOAuthCallbackUrl(authSettings.Provider))
+			return
+		}
 
 		tokenResponse, err := authCtrl.Callback(ctx, authSettings, code)
 		if err != nil {
@@ -4,9 +4,11 @@ import (
 	"net/http"
 
 	"github.com/cloudness-io/cloudness/app/controller/auth"
+	"github.com/cloudness-io/cloudness/app/cookie"
 	"github.com/cloudness-io/cloudness/app/request"
 	"github.com/cloudness-io/cloudness/app/web/render"
 
+	"github.com/dchest/uniuri"
 	"github.com/rs/zerolog/log"
 )
 
@@ -16,13 +18,15 @@ func HandleRedirect(authCtrl *auth.Controller) http.HandlerFunc {
 
 		authSettings, _ := request.AuthSettingFrom(ctx)
 
-		redirectUrl, err := authCtrl.GetRedirectUrl(ctx, authSettings)
+		state := uniuri.NewLen(32)
+		redirectUrl, err := authCtrl.GetRedirectUrl(ctx, authSettings, state)
 		if err != nil {
 			log.Error().Err(err).Msg("could not get redirect url")
 			render.ToastError(ctx, w, err)
 			return
 		}
 
+		cookie.IncludeOAuthStateCookie(r, w, state)
 		render.RedirectExternal(w, r, redirectUrl)
 	}
 }
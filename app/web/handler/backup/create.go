@@ -0,0 +1,29 @@
+package backup
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/controller/backup"
+	"github.com/cloudness-io/cloudness/app/request"
+	"github.com/cloudness-io/cloudness/app/web/render"
+
+	"github.com/rs/zerolog/log"
+)
+
+func HandleCreate(backupCtrl *backup.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		app, _ := request.ApplicationFrom(ctx)
+		session, _ := request.AuthSessionFrom(ctx)
+
+		if _, err := backupCtrl.Start(ctx, session.Principal.DisplayName, app); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error starting backup")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		if err := renderBackupsPage(ctx, w, app, backupCtrl); err == nil {
+			render.ToastSuccess(ctx, w, "Backup started")
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package backup
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/controller/backup"
+	"github.com/cloudness-io/cloudness/app/request"
+	"github.com/cloudness-io/cloudness/app/web/render"
+
+	"github.com/rs/zerolog/log"
+)
+
+func HandleDelete(backupCtrl *backup.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		app, _ := request.ApplicationFrom(ctx)
+
+		backupUID, err := request.GetBackupUIDFromPath(r)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Invalid backup uid")
+			render.ToastErrorMsg(ctx, w, "Invalid backup uid")
+			return
+		}
+
+		backup, err := backupCtrl.Get(ctx, app, backupUID)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error finding backup")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		if err := backupCtrl.Delete(ctx, backup); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error deleting backup")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		if err := renderBackupsPage(ctx, w, app, backupCtrl); err == nil {
+			render.ToastSuccess(ctx, w, "Backup deleted successfully")
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package backup
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/controller/backup"
+	"github.com/cloudness-io/cloudness/app/request"
+	"github.com/cloudness-io/cloudness/app/web/render"
+	"github.com/cloudness-io/cloudness/app/web/views/components/vapplication"
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/rs/zerolog/log"
+)
+
+func HandleList(backupCtrl *backup.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		app, _ := request.ApplicationFrom(ctx)
+
+		renderBackupsPage(ctx, w, app, backupCtrl)
+	}
+}
+
+func renderBackupsPage(ctx context.Context, w http.ResponseWriter, app *types.Application, backupCtrl *backup.Controller) error {
+	policy, err := backupCtrl.GetPolicy(ctx, app)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error getting backup policy")
+		render.ToastError(ctx, w, err)
+		return err
+	}
+
+	backups, err := backupCtrl.List(ctx, app)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error listing backups")
+		render.ToastError(ctx, w, err)
+		return err
+	}
+
+	render.Page(ctx, w, vapplication.Backups(app, policy, backups, backupCtrl.IsStoreEphemeral()))
+	return nil
+}
@@ -0,0 +1,37 @@
+package backup
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/controller/backup"
+	"github.com/cloudness-io/cloudness/app/request"
+	"github.com/cloudness-io/cloudness/app/web/render"
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/rs/zerolog/log"
+)
+
+func HandleUpdatePolicy(backupCtrl *backup.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		app, _ := request.ApplicationFrom(ctx)
+
+		in := new(types.BackupPolicyInput)
+		if err := json.NewDecoder(r.Body).Decode(in); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error decoding request body")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		if _, err := backupCtrl.UpdatePolicy(ctx, app, in); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error updating backup policy")
+			render.ToastErrorWithValidation(ctx, w, in, err)
+			return
+		}
+
+		if err := renderBackupsPage(ctx, w, app, backupCtrl); err == nil {
+			render.ToastSuccess(ctx, w, "Backup policy updated successfully")
+		}
+	}
+}
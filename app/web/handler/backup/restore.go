@@ -0,0 +1,57 @@
+package backup
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/controller/backup"
+	"github.com/cloudness-io/cloudness/app/request"
+	"github.com/cloudness-io/cloudness/app/web/render"
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/rs/zerolog/log"
+)
+
+func HandleRestore(backupCtrl *backup.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tenant, _ := request.TenantFrom(ctx)
+		project, _ := request.ProjectFrom(ctx)
+		env, _ := request.EnvironmentFrom(ctx)
+		app, _ := request.ApplicationFrom(ctx)
+		session, _ := request.AuthSessionFrom(ctx)
+
+		backupUID, err := request.GetBackupUIDFromPath(r)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Invalid backup uid")
+			render.ToastErrorMsg(ctx, w, "Invalid backup uid")
+			return
+		}
+
+		in := new(types.BackupRestoreInput)
+		if err := json.NewDecoder(r.Body).Decode(in); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error decoding request body")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		backup, err := backupCtrl.Get(ctx, app, backupUID)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error finding backup")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		target, err := backupCtrl.Restore(ctx, session.Principal.DisplayName, tenant, project, env, app, backup, in)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error restoring backup")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		if err := renderBackupsPage(ctx, w, app, backupCtrl); err == nil {
+			render.ToastSuccess(ctx, w, fmt.Sprintf("Restore into %s started", target.Name))
+		}
+	}
+}
@@ -4,10 +4,12 @@ import (
 	"encoding/json"
 	"net/http"
 
+	"github.com/cloudness-io/cloudness/app/controller/gitconnection"
 	"github.com/cloudness-io/cloudness/app/controller/githubapp"
 	"github.com/cloudness-io/cloudness/app/request"
 	"github.com/cloudness-io/cloudness/app/utils/routes"
 	"github.com/cloudness-io/cloudness/app/web/render"
+	"github.com/cloudness-io/cloudness/app/web/views/components/vsource/vgitconnection"
 	"github.com/cloudness-io/cloudness/app/web/views/components/vsource/vgithubapp"
 
 	"github.com/rs/zerolog/log"
@@ -48,3 +50,35 @@ func HandleAddGithubApp(ghCtrl *githubapp.Controller) http.HandlerFunc {
 		render.RedirectWithRefresh(w, routes.ProjectConnectionGithubUIDCtx(ctx, ghApp.UID))
 	}
 }
+
+func HandleNewGitConnection() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		render.Page(ctx, w, vgitconnection.AddGitConnectionPage())
+	}
+}
+
+func HandleAddGitConnection(gitConnCtrl *gitconnection.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		session, _ := request.AuthSessionFrom(ctx)
+		tenant, _ := request.TenantFrom(ctx)
+		project, _ := request.ProjectFrom(ctx)
+
+		in := new(gitconnection.CreateInput)
+		if err := json.NewDecoder(r.Body).Decode(in); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error decoding request body")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		conn, err := gitConnCtrl.Create(ctx, tenant, project, &session.Principal, in)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error creating git connection")
+			render.ToastErrorWithValidation(ctx, w, in, err)
+			return
+		}
+
+		render.RedirectWithRefresh(w, routes.ProjectConnectionGitUIDCtx(ctx, conn.UID))
+	}
+}
@@ -3,6 +3,7 @@ package connections
 import (
 	"net/http"
 
+	"github.com/cloudness-io/cloudness/app/controller/gitconnection"
 	"github.com/cloudness-io/cloudness/app/controller/githubapp"
 	"github.com/cloudness-io/cloudness/app/request"
 	"github.com/cloudness-io/cloudness/app/utils/routes"
@@ -27,3 +28,20 @@ func HandleDeleteGithubApp(ghCtrl *githubapp.Controller) http.HandlerFunc {
 		render.Redirect(w, routes.ProjectCtx(ctx)+"/"+routes.ProjectConnections)
 	}
 }
+
+func HandleDeleteGitConnection(gitConnCtrl *gitconnection.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		conn, _ := request.GitConnectionFrom(ctx)
+
+		err := gitConnCtrl.Delete(ctx, conn)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error deleting git connection")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		render.Redirect(w, routes.ProjectCtx(ctx)+"/"+routes.ProjectConnections)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/cloudness-io/cloudness/app/controller/githubapp"
 	"github.com/cloudness-io/cloudness/app/request"
 	"github.com/cloudness-io/cloudness/app/web/render"
+	"github.com/cloudness-io/cloudness/app/web/views/components/vsource/vgitconnection"
 	"github.com/cloudness-io/cloudness/app/web/views/components/vsource/vgithubapp"
 )
 
@@ -20,3 +21,13 @@ func HandleGetGithubApp(ghCtrl *githubapp.Controller) http.HandlerFunc {
 		render.Page(ctx, w, vgithubapp.GHAppInfoPage(tenant, project, ghApp))
 	}
 }
+
+func HandleGetGitConnection() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		conn, _ := request.GitConnectionFrom(ctx)
+
+		render.Page(ctx, w, vgitconnection.GitConnectionInfoPage(conn))
+	}
+}
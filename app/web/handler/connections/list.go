@@ -3,6 +3,7 @@ package connections
 import (
 	"net/http"
 
+	"github.com/cloudness-io/cloudness/app/controller/gitconnection"
 	"github.com/cloudness-io/cloudness/app/controller/githubapp"
 	"github.com/cloudness-io/cloudness/app/controller/gitpublic"
 	"github.com/cloudness-io/cloudness/app/request"
@@ -14,7 +15,7 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
-func HandleListForProject(ghAppCtrl *githubapp.Controller) http.HandlerFunc {
+func HandleListForProject(ghAppCtrl *githubapp.Controller, gitConnCtrl *gitconnection.Controller) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 
@@ -28,7 +29,14 @@ func HandleListForProject(ghAppCtrl *githubapp.Controller) http.HandlerFunc {
 			return
 		}
 
-		render.Page(ctx, w, vproject.ListConnections(project, ghApps))
+		gitConns, err := gitConnCtrl.List(ctx, tenant.ID, project.ID)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error listing git connections")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		render.Page(ctx, w, vproject.ListConnections(project, ghApps, gitConns))
 	}
 }
 
@@ -77,6 +85,45 @@ func HandleListGithubBranches(ghCtrl *githubapp.Controller) http.HandlerFunc {
 	}
 }
 
+func HandleListGitConnectionRepos(gitConnCtrl *gitconnection.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		selected := r.URL.Query().Get("selected")
+		conn, _ := request.GitConnectionFrom(ctx)
+
+		repos, err := gitConnCtrl.ListRepos(ctx, conn)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error listing repos")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		render.HTML(ctx, w, vgit.ListRepos(repos, selected))
+	}
+}
+
+func HandleListGitConnectionBranches(gitConnCtrl *gitconnection.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		conn, _ := request.GitConnectionFrom(ctx)
+		selected := r.URL.Query().Get("selected")
+		repo := r.URL.Query().Get("repo")
+
+		branches, err := gitConnCtrl.ListBranches(ctx, conn, repo)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error listing branches")
+			render.ToastError(ctx, w, err)
+			return
+		}
+		if selected == "" && len(branches) > 0 {
+			selected = branches[0].Name
+		}
+
+		render.HTML(ctx, w, vgit.ListBranches(branches, selected))
+	}
+}
+
 func HandleListGitpublicBranches(gitPublicCtrl *gitpublic.Controller) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
@@ -0,0 +1,92 @@
+package connections
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/controller/gitconnection"
+	"github.com/cloudness-io/cloudness/app/controller/githubapp"
+	"github.com/cloudness-io/cloudness/app/request"
+	cerrors "github.com/cloudness-io/cloudness/errors"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/rs/zerolog/log"
+)
+
+// github caps webhook payloads at 25MB
+const maxWebhookPayloadSize = 25 << 20
+
+func HandleGithubEvent(ghCtrl *githubapp.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		ghAppUID, err := request.GetSourceUIDFromPath(r)
+		if err != nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		payload, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookPayloadSize))
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		deployments, err := ghCtrl.HandleWebhookEvent(ctx, ghAppUID,
+			github.WebHookType(r),
+			r.Header.Get(github.SHA256SignatureHeader),
+			payload,
+		)
+		if err != nil {
+			switch {
+			case errors.Is(err, githubapp.ErrInvalidWebhookSignature):
+				w.WriteHeader(http.StatusUnauthorized)
+			case cerrors.IsBadRequest(err):
+				w.WriteHeader(http.StatusBadRequest)
+			default:
+				log.Ctx(ctx).Error().Err(err).Msg("Error handling github webhook event")
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+			return
+		}
+
+		log.Ctx(ctx).Debug().Int("deployments", len(deployments)).Msg("github webhook: event processed")
+		w.WriteHeader(http.StatusAccepted)
+	}
+}
+
+func HandleGitConnectionEvent(gitConnCtrl *gitconnection.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		connUID, err := request.GetSourceUIDFromPath(r)
+		if err != nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		payload, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookPayloadSize))
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		deployments, err := gitConnCtrl.HandleWebhookEvent(ctx, connUID, r, payload)
+		if err != nil {
+			switch {
+			case errors.Is(err, gitconnection.ErrInvalidWebhookSignature):
+				w.WriteHeader(http.StatusUnauthorized)
+			case cerrors.IsBadRequest(err):
+				w.WriteHeader(http.StatusBadRequest)
+			default:
+				log.Ctx(ctx).Error().Err(err).Msg("Error handling git webhook event")
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+			return
+		}
+
+		log.Ctx(ctx).Debug().Int("deployments", len(deployments)).Msg("git webhook: event processed")
+		w.WriteHeader(http.StatusAccepted)
+	}
+}
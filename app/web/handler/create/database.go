@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/cloudness-io/cloudness/app/controller/template"
+	"github.com/cloudness-io/cloudness/app/request"
 	"github.com/cloudness-io/cloudness/app/web/render"
 	"github.com/cloudness-io/cloudness/app/web/views/components/vcreate"
 
@@ -14,7 +15,8 @@ func HandleGetDatabaseView(templCtrl *template.Controller) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 
-		tmpls, err := templCtrl.ListDatabase(ctx)
+		tenant, _ := request.TenantFrom(ctx)
+		tmpls, err := templCtrl.ListDatabase(ctx, tenant.ID)
 		if err != nil {
 			log.Ctx(ctx).Error().Err(err).Msg("Error listing database templates")
 			render.ToastError(ctx, w, err)
@@ -14,11 +14,19 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
-func HandleGetRegistryView() http.HandlerFunc {
+func HandleGetRegistryView(appCtrl *application.Controller) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
+		tenant, _ := request.TenantFrom(ctx)
+
+		credentials, err := appCtrl.ListRegistryCredentials(ctx, tenant.ID)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error listing registry credentials")
+			render.ToastError(ctx, w, err)
+			return
+		}
 
-		render.Page(ctx, w, vcreate.RegistryView())
+		render.Page(ctx, w, vcreate.RegistryView(credentials))
 	}
 }
 
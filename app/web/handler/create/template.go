@@ -23,7 +23,8 @@ func HandleTemplatePreview(templCtrl *template.Controller, templType string) htt
 			return
 		}
 
-		templ, err := templCtrl.FindByID(ctx, templID)
+		tenant, _ := request.TenantFrom(ctx)
+		templ, err := templCtrl.FindByID(ctx, tenant.ID, templID)
 		if err != nil {
 			log.Ctx(ctx).Error().Err(err).Msg("Error finding template")
 			render.ToastError(ctx, w, err)
@@ -50,7 +51,8 @@ func HandleTemplateCreate(templCtrl *template.Controller) http.HandlerFunc {
 			return
 		}
 
-		templ, err := templCtrl.FindByID(ctx, templID)
+		tenant, _ := request.TenantFrom(ctx)
+		templ, err := templCtrl.FindByID(ctx, tenant.ID, templID)
 		if err != nil {
 			log.Ctx(ctx).Error().Err(err).Msg("Error finding template")
 			render.ToastError(ctx, w, err)
@@ -58,7 +60,6 @@ func HandleTemplateCreate(templCtrl *template.Controller) http.HandlerFunc {
 		}
 
 		session, _ := request.AuthSessionFrom(ctx)
-		tenant, _ := request.TenantFrom(ctx)
 		project, _ := request.ProjectFrom(ctx)
 		env, _ := request.EnvironmentFrom(ctx)
 		err = templCtrl.Create(ctx, session, tenant, project, env, templ)
@@ -0,0 +1,30 @@
+package deployment
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/controller/deployment"
+	"github.com/cloudness-io/cloudness/app/request"
+	"github.com/cloudness-io/cloudness/app/utils/routes"
+	"github.com/cloudness-io/cloudness/app/web/render"
+
+	"github.com/rs/zerolog/log"
+)
+
+func HandleRollback(deploymentCtrl *deployment.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		session, _ := request.AuthSessionFrom(ctx)
+		application, _ := request.ApplicationFrom(ctx)
+		source, _ := request.DeploymentFrom(ctx)
+
+		deployment, err := deploymentCtrl.Rollback(ctx, session.Principal.DisplayName, application, source)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("error rolling back deployment")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		render.Redirect(w, routes.DeploymentCtx(request.WithDeployment(ctx, deployment)))
+	}
+}
@@ -13,10 +13,18 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
-func HandleNew() http.HandlerFunc {
+func HandleNew(envCtrl *environment.Controller) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
-		render.Page(ctx, w, venvironment.AddEnvironmentPage())
+
+		servers, err := envCtrl.ListServers(ctx)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error listing servers")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		render.Page(ctx, w, venvironment.AddEnvironmentPage(servers))
 	}
 }
 
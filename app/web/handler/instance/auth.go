@@ -8,6 +8,7 @@ import (
 
 	"github.com/cloudness-io/cloudness/app/controller/auth"
 	"github.com/cloudness-io/cloudness/app/controller/instance"
+	"github.com/cloudness-io/cloudness/app/controller/tenant"
 	"github.com/cloudness-io/cloudness/app/web/render"
 	"github.com/cloudness-io/cloudness/app/web/views/components/vinstance"
 	"github.com/cloudness-io/cloudness/types"
@@ -16,28 +17,14 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
-func HandleGetAuth(instanceCtrl *instance.Controller, authCtrl *auth.Controller) http.HandlerFunc {
+func HandleGetAuth(instanceCtrl *instance.Controller, authCtrl *auth.Controller, tenantCtrl *tenant.Controller) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
-		instance, err := instanceCtrl.Get(ctx)
-		if err != nil {
-			log.Ctx(ctx).Error().Err(err).Msg("Error getting instance")
-			render.ToastError(ctx, w, err)
-			return
-		}
-
-		auths, err := authCtrl.ListAllMap(ctx)
-		if err != nil {
-			log.Ctx(ctx).Error().Err(err).Msg("Error listing auths")
-			render.ToastError(ctx, w, err)
-			return
-		}
-
-		render.Page(ctx, w, vinstance.Auth(instance, auths))
+		_ = renderAuthPage(ctx, w, instanceCtrl, authCtrl, tenantCtrl)
 	}
 }
 
-func renderAuthPage(ctx context.Context, w http.ResponseWriter, instanceCtrl *instance.Controller, authCtrl *auth.Controller) error {
+func renderAuthPage(ctx context.Context, w http.ResponseWriter, instanceCtrl *instance.Controller, authCtrl *auth.Controller, tenantCtrl *tenant.Controller) error {
 	instance, err := instanceCtrl.Get(ctx)
 	if err != nil {
 		log.Ctx(ctx).Error().Err(err).Msg("Error getting instance")
@@ -52,11 +39,18 @@ func renderAuthPage(ctx context.Context, w http.ResponseWriter, instanceCtrl *in
 		return err
 	}
 
-	render.Page(ctx, w, vinstance.Auth(instance, auths))
+	tenants, err := tenantCtrl.ListAll(ctx)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error listing tenants")
+		render.ToastError(ctx, w, err)
+		return err
+	}
+
+	render.Page(ctx, w, vinstance.Auth(instance, auths, tenants))
 	return nil
 }
 
-func HandlePatchPassword(instanceCtrl *instance.Controller, authCtrl *auth.Controller) http.HandlerFunc {
+func HandlePatchPassword(instanceCtrl *instance.Controller, authCtrl *auth.Controller, tenantCtrl *tenant.Controller) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 		in := new(auth.ChangePasswordSettings)
@@ -73,14 +67,14 @@ func HandlePatchPassword(instanceCtrl *instance.Controller, authCtrl *auth.Contr
 			return
 		}
 
-		err = renderAuthPage(ctx, w, instanceCtrl, authCtrl)
+		err = renderAuthPage(ctx, w, instanceCtrl, authCtrl, tenantCtrl)
 		if err == nil {
 			render.ToastSuccess(ctx, w, "Password authentication setting updated successfully")
 		}
 	}
 }
 
-func HandlePatchDemoUser(instanceCtrl *instance.Controller, authCtrl *auth.Controller) http.HandlerFunc {
+func HandlePatchDemoUser(instanceCtrl *instance.Controller, authCtrl *auth.Controller, tenantCtrl *tenant.Controller) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 		in := new(auth.DemoUserSettings)
@@ -97,14 +91,14 @@ func HandlePatchDemoUser(instanceCtrl *instance.Controller, authCtrl *auth.Contr
 			return
 		}
 
-		err = renderAuthPage(ctx, w, instanceCtrl, authCtrl)
+		err = renderAuthPage(ctx, w, instanceCtrl, authCtrl, tenantCtrl)
 		if err == nil {
 			render.ToastSuccess(ctx, w, "Demo user authentication setting updated successfully")
 		}
 	}
 }
 
-func HandlePatchOauthProvider(instanceCtrl *instance.Controller, authCtrl *auth.Controller, provider enum.AuthProvider) http.HandlerFunc {
+func HandlePatchOauthProvider(instanceCtrl *instance.Controller, authCtrl *auth.Controller, tenantCtrl *tenant.Controller, provider enum.AuthProvider) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 		in := new(types.AuthSetting)
@@ -121,9 +115,9 @@ func HandlePatchOauthProvider(instanceCtrl *instance.Controller, authCtrl *auth.
 			return
 		}
 
-		err = renderAuthPage(ctx, w, instanceCtrl, authCtrl)
+		err = renderAuthPage(ctx, w, instanceCtrl, authCtrl, tenantCtrl)
 		if err == nil {
-			render.ToastSuccess(ctx, w, fmt.Sprintf("%s setting updated successfully", provider))
+			render.ToastSuccess(ctx, w, fmt.Sprintf("%s setting updated successfully", provider.DisplayName()))
 		}
 	}
 }
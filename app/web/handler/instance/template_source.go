@@ -0,0 +1,202 @@
+package instance
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/controller/templatesource"
+	"github.com/cloudness-io/cloudness/app/request"
+	"github.com/cloudness-io/cloudness/app/web/render"
+	"github.com/cloudness-io/cloudness/app/web/views/components/vinstance"
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/rs/zerolog/log"
+)
+
+func HandleListTemplateSources(tsCtrl *templatesource.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		renderTemplateSourcesPage(w, r, tsCtrl)
+	}
+}
+
+func HandleAddTemplateSource(tsCtrl *templatesource.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		in := new(templatesource.CreateInput)
+		if err := json.NewDecoder(r.Body).Decode(in); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error decoding json")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		principal, _ := request.PrincipalFrom(ctx)
+		if _, err := tsCtrl.Create(ctx, nil, principal, in); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error adding template source")
+			render.ToastErrorWithValidation(ctx, w, in, err)
+			return
+		}
+
+		err := renderTemplateSourcesPage(w, r, tsCtrl)
+		if err == nil {
+			render.ToastSuccess(ctx, w, "Template source added, templates are syncing")
+		}
+	}
+}
+
+func HandleSyncTemplateSource(tsCtrl *templatesource.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		uid, err := request.GetTemplateSourceUIDFromPath(r)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Invalid template source uid")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		if err := tsCtrl.Sync(ctx, nil, uid); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error syncing template source")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		render.ToastSuccess(ctx, w, "Template source sync queued")
+	}
+}
+
+func HandleDeleteTemplateSource(tsCtrl *templatesource.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		uid, err := request.GetTemplateSourceUIDFromPath(r)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Invalid template source uid")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		if err := tsCtrl.Delete(ctx, nil, uid); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error deleting template source")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		err = renderTemplateSourcesPage(w, r, tsCtrl)
+		if err == nil {
+			render.ToastSuccess(ctx, w, "Template source deleted successfully")
+		}
+	}
+}
+
+func HandleGetSourceTemplate(tsCtrl *templatesource.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		source, templateID, ok := findSourceTemplate(w, r, tsCtrl)
+		if !ok {
+			return
+		}
+		renderSourceTemplatePage(w, r, tsCtrl, source, templateID)
+	}
+}
+
+func HandlePublishSourceTemplate(tsCtrl *templatesource.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		source, templateID, ok := findSourceTemplate(w, r, tsCtrl)
+		if !ok {
+			return
+		}
+
+		number, err := request.GetVersionFromPath(r)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Invalid template version")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		if _, err := tsCtrl.Publish(ctx, source, templateID, number); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error publishing template version")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		err = renderSourceTemplatePage(w, r, tsCtrl, source, templateID)
+		if err == nil {
+			render.ToastSuccess(ctx, w, "Template version published successfully")
+		}
+	}
+}
+
+func findSourceTemplate(w http.ResponseWriter, r *http.Request, tsCtrl *templatesource.Controller) (*types.TemplateSource, int64, bool) {
+	ctx := r.Context()
+
+	uid, err := request.GetTemplateSourceUIDFromPath(r)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Invalid template source uid")
+		render.ToastError(ctx, w, err)
+		return nil, 0, false
+	}
+	templateID, err := request.GetTemplateIDFromPath(r)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Invalid template ID")
+		render.ToastError(ctx, w, err)
+		return nil, 0, false
+	}
+
+	source, err := tsCtrl.Find(ctx, nil, uid)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error finding template source")
+		render.ToastError(ctx, w, err)
+		return nil, 0, false
+	}
+	return source, templateID, true
+}
+
+func renderSourceTemplatePage(
+	w http.ResponseWriter,
+	r *http.Request,
+	tsCtrl *templatesource.Controller,
+	source *types.TemplateSource,
+	templateID int64,
+) error {
+	ctx := r.Context()
+
+	tmpl, versions, err := tsCtrl.Preview(ctx, source, templateID)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error finding template versions")
+		render.ToastError(ctx, w, err)
+		return err
+	}
+
+	render.Page(ctx, w, vinstance.TemplateSourceTemplate(source, tmpl, versions))
+	return nil
+}
+
+func renderTemplateSourcesPage(w http.ResponseWriter, r *http.Request, tsCtrl *templatesource.Controller) error {
+	ctx := r.Context()
+
+	sources, err := tsCtrl.ListInstanceLevel(ctx)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error listing instance template sources")
+		render.ToastError(ctx, w, err)
+		return err
+	}
+
+	templates, err := tsCtrl.ListTemplatesBySource(ctx, sources)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error listing templates of template sources")
+		render.ToastError(ctx, w, err)
+		return err
+	}
+
+	conns, err := tsCtrl.ListGitConnections(ctx, nil)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error listing git connections")
+		render.ToastError(ctx, w, err)
+		return err
+	}
+
+	render.Page(ctx, w, vinstance.TemplateSources(sources, templates, conns))
+	return nil
+}
@@ -0,0 +1,36 @@
+package project
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/controller/export"
+	"github.com/cloudness-io/cloudness/app/request"
+	"github.com/cloudness-io/cloudness/app/usererror"
+	"github.com/cloudness-io/cloudness/app/web/render"
+
+	"github.com/rs/zerolog/log"
+)
+
+func HandleExport(exportCtrl *export.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		project, _ := request.ProjectFrom(ctx)
+
+		in, err := request.ParseExportInputFromRequest(r)
+		if err != nil {
+			uerr := usererror.Translate(ctx, err)
+			http.Error(w, uerr.Message, uerr.Status)
+			return
+		}
+
+		bundle, err := exportCtrl.ExportProject(ctx, project, in)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error exporting project")
+			uerr := usererror.Translate(ctx, err)
+			http.Error(w, uerr.Message, uerr.Status)
+			return
+		}
+
+		render.Download(ctx, w, "application/gzip", bundle.FileName(), bundle.WriteArchive, "Error writing project export")
+	}
+}
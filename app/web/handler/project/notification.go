@@ -0,0 +1,111 @@
+package project
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/controller/notification"
+	"github.com/cloudness-io/cloudness/app/request"
+	"github.com/cloudness-io/cloudness/app/web/render"
+	"github.com/cloudness-io/cloudness/app/web/views/components/vproject"
+
+	"github.com/rs/zerolog/log"
+)
+
+func HandleListNotificationChannels(notifyCtrl *notification.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		renderNotificationsPage(w, r, notifyCtrl)
+	}
+}
+
+func HandleAddNotificationChannel(notifyCtrl *notification.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		in := new(notification.CreateInput)
+		if err := json.NewDecoder(r.Body).Decode(in); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error decoding request body")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		tenant, _ := request.TenantFrom(ctx)
+		project, _ := request.ProjectFrom(ctx)
+		principal, _ := request.PrincipalFrom(ctx)
+		if _, err := notifyCtrl.Create(ctx, tenant, project, principal, in); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error adding notification channel")
+			render.ToastErrorWithValidation(ctx, w, in, err)
+			return
+		}
+
+		err := renderNotificationsPage(w, r, notifyCtrl)
+		if err == nil {
+			render.ToastSuccess(ctx, w, "Notification channel added successfully")
+		}
+	}
+}
+
+func HandleTestNotificationChannel(notifyCtrl *notification.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tenant, _ := request.TenantFrom(ctx)
+		project, _ := request.ProjectFrom(ctx)
+
+		uid, err := request.GetNotificationChannelUIDFromPath(r)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Invalid notification channel uid")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		if err := notifyCtrl.SendTest(ctx, tenant.ID, project, uid); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error sending test notification")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		render.ToastSuccess(ctx, w, "Test notification sent")
+	}
+}
+
+func HandleDeleteNotificationChannel(notifyCtrl *notification.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tenant, _ := request.TenantFrom(ctx)
+		project, _ := request.ProjectFrom(ctx)
+
+		uid, err := request.GetNotificationChannelUIDFromPath(r)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Invalid notification channel uid")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		if err := notifyCtrl.Delete(ctx, tenant.ID, project, uid); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error deleting notification channel")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		err = renderNotificationsPage(w, r, notifyCtrl)
+		if err == nil {
+			render.ToastSuccess(ctx, w, "Notification channel deleted successfully")
+		}
+	}
+}
+
+func renderNotificationsPage(w http.ResponseWriter, r *http.Request, notifyCtrl *notification.Controller) error {
+	ctx := r.Context()
+	tenant, _ := request.TenantFrom(ctx)
+	project, _ := request.ProjectFrom(ctx)
+
+	channels, err := notifyCtrl.ListProjectLevel(ctx, tenant.ID, project.ID)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error listing notification channels of project")
+		render.ToastError(ctx, w, err)
+		return err
+	}
+
+	render.Page(ctx, w, vproject.Notifications(channels, notifyCtrl.SupportsEmail()))
+	return nil
+}
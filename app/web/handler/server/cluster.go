@@ -0,0 +1,75 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/controller/server"
+	"github.com/cloudness-io/cloudness/app/request"
+	"github.com/cloudness-io/cloudness/app/utils/routes"
+	"github.com/cloudness-io/cloudness/app/web/render"
+	"github.com/cloudness-io/cloudness/app/web/views/components/vinstance"
+
+	"github.com/rs/zerolog/log"
+)
+
+func HandleBootstrap(serverCtrl *server.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		server, _ := request.ServerFrom(ctx)
+
+		if err := serverCtrl.Bootstrap(ctx, server); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error bootstrapping server")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		render.ToastSuccess(ctx, w, "Bootstrap queued, the health is updated once it is done")
+	}
+}
+
+func HandleIssueRunnerToken(serverCtrl *server.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		server, _ := request.ServerFrom(ctx)
+
+		runnerToken, err := serverCtrl.IssueRunnerToken(ctx, server)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error issuing runner token")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		render.Page(ctx, w, vinstance.ServerWithRunnerToken(server, runnerToken))
+	}
+}
+
+func HandleCheckHealth(serverCtrl *server.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		server, _ := request.ServerFrom(ctx)
+
+		server, err := serverCtrl.CheckHealth(ctx, server)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error checking server health")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		render.Page(ctx, w, vinstance.Server(server))
+	}
+}
+
+func HandleDelete(serverCtrl *server.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		server, _ := request.ServerFrom(ctx)
+
+		if err := serverCtrl.Delete(ctx, server); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error deleting server")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		render.Redirect(w, routes.InstanceServers)
+	}
+}
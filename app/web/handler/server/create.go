@@ -0,0 +1,34 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/controller/server"
+	"github.com/cloudness-io/cloudness/app/utils/routes"
+	"github.com/cloudness-io/cloudness/app/web/render"
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/rs/zerolog/log"
+)
+
+func HandleCreate(serverCtrl *server.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		in := new(types.ServerCreateInput)
+		if err := json.NewDecoder(r.Body).Decode(in); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error decoding request body")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		server, err := serverCtrl.Create(ctx, in)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error creating server")
+			render.ToastErrorWithValidation(ctx, w, in, err)
+			return
+		}
+
+		render.Redirect(w, routes.InstanceServerUID(server.UID))
+	}
+}
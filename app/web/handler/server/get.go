@@ -4,23 +4,33 @@ import (
 	"net/http"
 
 	"github.com/cloudness-io/cloudness/app/controller/server"
+	"github.com/cloudness-io/cloudness/app/request"
 	"github.com/cloudness-io/cloudness/app/web/render"
 	"github.com/cloudness-io/cloudness/app/web/views/components/vinstance"
 
 	"github.com/rs/zerolog/log"
 )
 
-func HandleGet(serverCtrl *server.Controller) http.HandlerFunc {
+func HandleList(serverCtrl *server.Controller) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 
-		server, err := serverCtrl.Get(ctx)
+		servers, err := serverCtrl.List(ctx)
 		if err != nil {
-			log.Ctx(ctx).Error().Err(err).Msg("Error getting server")
+			log.Ctx(ctx).Error().Err(err).Msg("Error listing servers")
 			render.ToastError(ctx, w, err)
 			return
 		}
 
+		render.Page(ctx, w, vinstance.Servers(servers))
+	}
+}
+
+func HandleGet() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		server, _ := request.ServerFrom(ctx)
+
 		render.Page(ctx, w, vinstance.Server(server))
 	}
 }
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/cloudness-io/cloudness/app/controller/server"
+	"github.com/cloudness-io/cloudness/app/request"
 	"github.com/cloudness-io/cloudness/app/web/render"
 	"github.com/cloudness-io/cloudness/app/web/views/components/vserver"
 
@@ -15,7 +16,8 @@ func HandleListCertificates(serverCtrl *server.Controller) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 		time.Sleep(1 * time.Second)
-		certs, err := serverCtrl.ListCertificates(ctx)
+		server, _ := request.ServerFrom(ctx)
+		certs, err := serverCtrl.ListCertificates(ctx, server)
 		if err != nil {
 			log.Ctx(ctx).Error().Err(err).Msg("Error listing certificates")
 			render.ToastErrorMsg(ctx, w, "Error listing certificates")
@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/cloudness-io/cloudness/app/controller/server"
+	"github.com/cloudness-io/cloudness/app/request"
 	"github.com/cloudness-io/cloudness/app/web/render"
 	"github.com/cloudness-io/cloudness/app/web/views/components/vinstance"
 
@@ -21,7 +22,8 @@ func HandlePatchGeneral(serverCtrl *server.Controller) http.HandlerFunc {
 			return
 		}
 
-		server, err := serverCtrl.UpdateGeneral(ctx, in)
+		server, _ := request.ServerFrom(ctx)
+		server, err := serverCtrl.UpdateGeneral(ctx, server, in)
 		if err != nil {
 			log.Ctx(ctx).Error().Err(err).Msg("Error updating server")
 			render.ToastErrorWithValidation(ctx, w, in, err)
@@ -43,7 +45,8 @@ func HandlePatchNetwork(serverCtrl *server.Controller) http.HandlerFunc {
 			return
 		}
 
-		server, err := serverCtrl.UpdateNetwork(ctx, in)
+		server, _ := request.ServerFrom(ctx)
+		server, err := serverCtrl.UpdateNetwork(ctx, server, in)
 		if err != nil {
 			log.Ctx(ctx).Error().Err(err).Msg("Error updating server")
 			render.ToastErrorWithValidation(ctx, w, in, err)
@@ -65,7 +68,8 @@ func HandlePatchBuilder(serverCtrl *server.Controller) http.HandlerFunc {
 			return
 		}
 
-		server, err := serverCtrl.UpdateBuilder(ctx, in)
+		server, _ := request.ServerFrom(ctx)
+		server, err := serverCtrl.UpdateBuilder(ctx, server, in)
 		if err != nil {
 			log.Ctx(ctx).Error().Err(err).Msg("Error updating server")
 			render.ToastErrorWithValidation(ctx, w, in, err)
@@ -87,7 +91,8 @@ func HandlePatchLimits(serverCtrl *server.Controller) http.HandlerFunc {
 			return
 		}
 
-		server, err := serverCtrl.UpdateLimits(ctx, in)
+		server, _ := request.ServerFrom(ctx)
+		server, err := serverCtrl.UpdateLimits(ctx, server, in)
 		if err != nil {
 			log.Ctx(ctx).Error().Err(err).Msg("Error updating server")
 			render.ToastErrorWithValidation(ctx, w, in, err)
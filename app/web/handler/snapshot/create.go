@@ -0,0 +1,30 @@
+package snapshot
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/controller/snapshot"
+	"github.com/cloudness-io/cloudness/app/request"
+	"github.com/cloudness-io/cloudness/app/web/render"
+
+	"github.com/rs/zerolog/log"
+)
+
+func HandleCreate(snapshotCtrl *snapshot.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		app, _ := request.ApplicationFrom(ctx)
+		volume, _ := request.VolumeFrom(ctx)
+		session, _ := request.AuthSessionFrom(ctx)
+
+		if _, err := snapshotCtrl.Start(ctx, session.Principal.DisplayName, volume); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error taking snapshot")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		if err := renderSnapshotsPage(ctx, w, app, volume, snapshotCtrl); err == nil {
+			render.ToastSuccess(ctx, w, "Snapshot started")
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package snapshot
+
+import (
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/controller/snapshot"
+	"github.com/cloudness-io/cloudness/app/request"
+	"github.com/cloudness-io/cloudness/app/web/render"
+
+	"github.com/rs/zerolog/log"
+)
+
+func HandleDelete(snapshotCtrl *snapshot.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		app, _ := request.ApplicationFrom(ctx)
+		volume, _ := request.VolumeFrom(ctx)
+
+		snapshotUID, err := request.GetSnapshotUIDFromPath(r)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Invalid snapshot uid")
+			render.ToastErrorMsg(ctx, w, "Invalid snapshot uid")
+			return
+		}
+
+		snapshot, err := snapshotCtrl.Get(ctx, volume, snapshotUID)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error finding snapshot")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		if err := snapshotCtrl.Delete(ctx, snapshot); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error deleting snapshot")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		if err := renderSnapshotsPage(ctx, w, app, volume, snapshotCtrl); err == nil {
+			render.ToastSuccess(ctx, w, "Snapshot deleted successfully")
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package snapshot
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/controller/snapshot"
+	"github.com/cloudness-io/cloudness/app/request"
+	"github.com/cloudness-io/cloudness/app/web/render"
+	"github.com/cloudness-io/cloudness/app/web/views/components/vapplication"
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/rs/zerolog/log"
+)
+
+func HandleList(snapshotCtrl *snapshot.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		app, _ := request.ApplicationFrom(ctx)
+		volume, _ := request.VolumeFrom(ctx)
+
+		renderSnapshotsPage(ctx, w, app, volume, snapshotCtrl)
+	}
+}
+
+func renderSnapshotsPage(ctx context.Context, w http.ResponseWriter, app *types.Application, volume *types.Volume, snapshotCtrl *snapshot.Controller) error {
+	enabled, err := snapshotCtrl.IsEnabled(ctx, volume)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error getting server")
+		render.ToastError(ctx, w, err)
+		return err
+	}
+
+	policy, err := snapshotCtrl.GetPolicy(ctx, volume)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error getting snapshot policy")
+		render.ToastError(ctx, w, err)
+		return err
+	}
+
+	snapshots, err := snapshotCtrl.List(ctx, volume)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error listing snapshots")
+		render.ToastError(ctx, w, err)
+		return err
+	}
+
+	render.Page(ctx, w, vapplication.VolumeSnapshots(app, volume, enabled, policy, snapshots))
+	return nil
+}
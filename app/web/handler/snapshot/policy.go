@@ -0,0 +1,38 @@
+package snapshot
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/controller/snapshot"
+	"github.com/cloudness-io/cloudness/app/request"
+	"github.com/cloudness-io/cloudness/app/web/render"
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/rs/zerolog/log"
+)
+
+func HandleUpdatePolicy(snapshotCtrl *snapshot.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		app, _ := request.ApplicationFrom(ctx)
+		volume, _ := request.VolumeFrom(ctx)
+
+		in := new(types.VolumeSnapshotPolicyInput)
+		if err := json.NewDecoder(r.Body).Decode(in); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error decoding request body")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		if _, err := snapshotCtrl.UpdatePolicy(ctx, volume, in); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error updating snapshot policy")
+			render.ToastErrorWithValidation(ctx, w, in, err)
+			return
+		}
+
+		if err := renderSnapshotsPage(ctx, w, app, volume, snapshotCtrl); err == nil {
+			render.ToastSuccess(ctx, w, "Snapshot policy updated successfully")
+		}
+	}
+}
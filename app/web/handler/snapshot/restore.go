@@ -0,0 +1,57 @@
+package snapshot
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/controller/snapshot"
+	"github.com/cloudness-io/cloudness/app/request"
+	"github.com/cloudness-io/cloudness/app/web/render"
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/rs/zerolog/log"
+)
+
+func HandleRestore(snapshotCtrl *snapshot.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tenant, _ := request.TenantFrom(ctx)
+		project, _ := request.ProjectFrom(ctx)
+		env, _ := request.EnvironmentFrom(ctx)
+		app, _ := request.ApplicationFrom(ctx)
+		volume, _ := request.VolumeFrom(ctx)
+
+		snapshotUID, err := request.GetSnapshotUIDFromPath(r)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Invalid snapshot uid")
+			render.ToastErrorMsg(ctx, w, "Invalid snapshot uid")
+			return
+		}
+
+		in := new(types.VolumeSnapshotRestoreInput)
+		if err := json.NewDecoder(r.Body).Decode(in); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error decoding request body")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		snapshot, err := snapshotCtrl.Get(ctx, volume, snapshotUID)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error finding snapshot")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		restored, err := snapshotCtrl.Restore(ctx, tenant, project, env, volume, snapshot, in)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error restoring snapshot")
+			render.ToastErrorWithValidation(ctx, w, in, err)
+			return
+		}
+
+		if err := renderSnapshotsPage(ctx, w, app, volume, snapshotCtrl); err == nil {
+			render.ToastSuccess(ctx, w, fmt.Sprintf("Restored into volume %s, attach it from the volumes of an application", restored.Name))
+		}
+	}
+}
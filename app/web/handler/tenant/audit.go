@@ -0,0 +1,75 @@
+package tenant
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/cloudness-io/cloudness/app/controller/project"
+	"github.com/cloudness-io/cloudness/app/controller/tenant"
+	"github.com/cloudness-io/cloudness/app/request"
+	"github.com/cloudness-io/cloudness/app/usererror"
+	"github.com/cloudness-io/cloudness/app/web/render"
+	"github.com/cloudness-io/cloudness/app/web/views/components/vtenant"
+
+	"github.com/rs/zerolog/log"
+)
+
+func HandleListAuditEvents(tenantCtrl *tenant.Controller, projectCtrl *project.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tenant, _ := request.TenantFrom(ctx)
+		principal, _ := request.PrincipalFrom(ctx)
+
+		filter, projectUID, err := request.ParseAuditFilterFromRequest(r)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error parsing audit filter")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		events, count, err := tenantCtrl.ListAuditEvents(ctx, tenant, projectUID, filter)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error listing audit events of tenant")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		projects, err := projectCtrl.List(ctx, tenant.ID, principal.ID)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error listing projects of tenant")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		canEdit := canEdit(ctx, tenantCtrl, tenant)
+
+		render.Page(ctx, w, vtenant.AuditLog(tenant, &vtenant.AuditLogProps{
+			Events:   events,
+			Count:    count,
+			Filter:   filter,
+			Projects: projects,
+			Query:    r.URL.Query(),
+		}, canEdit))
+	}
+}
+
+func HandleExportAuditEvents(tenantCtrl *tenant.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tenant, _ := request.TenantFrom(ctx)
+
+		filter, projectUID, err := request.ParseAuditFilterFromRequest(r)
+		if err != nil {
+			uerr := usererror.Translate(ctx, err)
+			http.Error(w, uerr.Message, uerr.Status)
+			return
+		}
+
+		filename := fmt.Sprintf("audit-%d-%s.jsonl", tenant.UID, time.Now().UTC().Format("20060102-150405"))
+		render.Download(ctx, w, "application/x-ndjson", filename, func(w io.Writer) error {
+			return tenantCtrl.ExportAuditEvents(ctx, tenant, projectUID, filter, w)
+		}, "Error exporting audit events of tenant")
+	}
+}
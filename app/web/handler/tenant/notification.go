@@ -0,0 +1,115 @@
+package tenant
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/controller/notification"
+	"github.com/cloudness-io/cloudness/app/controller/tenant"
+	"github.com/cloudness-io/cloudness/app/request"
+	"github.com/cloudness-io/cloudness/app/web/render"
+	"github.com/cloudness-io/cloudness/app/web/views/components/vtenant"
+
+	"github.com/rs/zerolog/log"
+)
+
+func HandleListNotificationChannels(tenantCtrl *tenant.Controller, notifyCtrl *notification.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		renderNotificationsPage(w, r, tenantCtrl, notifyCtrl)
+	}
+}
+
+func HandleAddNotificationChannel(tenantCtrl *tenant.Controller, notifyCtrl *notification.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		in := new(notification.CreateInput)
+		if err := json.NewDecoder(r.Body).Decode(in); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error decoding json")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		tenant, _ := request.TenantFrom(ctx)
+		principal, _ := request.PrincipalFrom(ctx)
+		if _, err := notifyCtrl.Create(ctx, tenant, nil, principal, in); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error adding notification channel")
+			render.ToastErrorWithValidation(ctx, w, in, err)
+			return
+		}
+
+		err := renderNotificationsPage(w, r, tenantCtrl, notifyCtrl)
+		if err == nil {
+			render.ToastSuccess(ctx, w, "Notification channel added successfully")
+		}
+	}
+}
+
+func HandleTestNotificationChannel(notifyCtrl *notification.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tenant, _ := request.TenantFrom(ctx)
+
+		uid, err := request.GetNotificationChannelUIDFromPath(r)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Invalid notification channel uid")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		if err := notifyCtrl.SendTest(ctx, tenant.ID, nil, uid); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error sending test notification")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		render.ToastSuccess(ctx, w, "Test notification sent")
+	}
+}
+
+func HandleDeleteNotificationChannel(tenantCtrl *tenant.Controller, notifyCtrl *notification.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tenant, _ := request.TenantFrom(ctx)
+
+		uid, err := request.GetNotificationChannelUIDFromPath(r)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Invalid notification channel uid")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		if err := notifyCtrl.Delete(ctx, tenant.ID, nil, uid); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error deleting notification channel")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		err = renderNotificationsPage(w, r, tenantCtrl, notifyCtrl)
+		if err == nil {
+			render.ToastSuccess(ctx, w, "Notification channel deleted successfully")
+		}
+	}
+}
+
+func renderNotificationsPage(
+	w http.ResponseWriter,
+	r *http.Request,
+	tenantCtrl *tenant.Controller,
+	notifyCtrl *notification.Controller,
+) error {
+	ctx := r.Context()
+	tenant, _ := request.TenantFrom(ctx)
+
+	channels, err := notifyCtrl.ListTenantLevel(ctx, tenant.ID)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error listing notification channels of tenant")
+		render.ToastError(ctx, w, err)
+		return err
+	}
+
+	canEdit := canEdit(ctx, tenantCtrl, tenant)
+
+	render.Page(ctx, w, vtenant.Notifications(tenant, channels, notifyCtrl.SupportsEmail(), canEdit))
+	return nil
+}
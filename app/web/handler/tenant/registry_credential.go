@@ -0,0 +1,93 @@
+package tenant
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/controller/registrycredential"
+	"github.com/cloudness-io/cloudness/app/controller/tenant"
+	"github.com/cloudness-io/cloudness/app/request"
+	"github.com/cloudness-io/cloudness/app/web/render"
+	"github.com/cloudness-io/cloudness/app/web/views/components/vtenant"
+
+	"github.com/rs/zerolog/log"
+)
+
+func HandleListRegistryCredentials(tenantCtrl *tenant.Controller, regCredCtrl *registrycredential.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		renderRegistryCredentialsPage(w, r, tenantCtrl, regCredCtrl)
+	}
+}
+
+func HandleAddRegistryCredential(tenantCtrl *tenant.Controller, regCredCtrl *registrycredential.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		in := new(registrycredential.CreateInput)
+		if err := json.NewDecoder(r.Body).Decode(in); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error decoding json")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		tenant, _ := request.TenantFrom(ctx)
+		principal, _ := request.PrincipalFrom(ctx)
+		if _, err := regCredCtrl.Create(ctx, tenant, principal, in); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error adding registry credential")
+			render.ToastErrorWithValidation(ctx, w, in, err)
+			return
+		}
+
+		err := renderRegistryCredentialsPage(w, r, tenantCtrl, regCredCtrl)
+		if err == nil {
+			render.ToastSuccess(ctx, w, "Registry credential added successfully")
+		}
+	}
+}
+
+func HandleDeleteRegistryCredential(tenantCtrl *tenant.Controller, regCredCtrl *registrycredential.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tenant, _ := request.TenantFrom(ctx)
+
+		uid, err := request.GetRegistryCredentialUIDFromPath(r)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Invalid registry credential uid")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		if err := regCredCtrl.Delete(ctx, tenant.ID, uid); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error deleting registry credential")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		err = renderRegistryCredentialsPage(w, r, tenantCtrl, regCredCtrl)
+		if err == nil {
+			render.ToastSuccess(ctx, w, "Registry credential deleted successfully")
+		}
+	}
+}
+
+func renderRegistryCredentialsPage(
+	w http.ResponseWriter,
+	r *http.Request,
+	tenantCtrl *tenant.Controller,
+	regCredCtrl *registrycredential.Controller,
+) error {
+	ctx := r.Context()
+	tenant, _ := request.TenantFrom(ctx)
+
+	credentials, err := regCredCtrl.List(ctx, tenant.ID)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error listing registry credentials of tenant")
+		render.ToastError(ctx, w, err)
+		return err
+	}
+
+	canEdit := canEdit(ctx, tenantCtrl, tenant)
+
+	render.Page(ctx, w, vtenant.RegistryCredentials(tenant, credentials, canEdit))
+	return nil
+}
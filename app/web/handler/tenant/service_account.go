@@ -0,0 +1,211 @@
+package tenant
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/controller/project"
+	"github.com/cloudness-io/cloudness/app/controller/serviceaccount"
+	"github.com/cloudness-io/cloudness/app/controller/tenant"
+	"github.com/cloudness-io/cloudness/app/request"
+	"github.com/cloudness-io/cloudness/app/utils/routes"
+	"github.com/cloudness-io/cloudness/app/web/render"
+	"github.com/cloudness-io/cloudness/app/web/views/components/vtenant"
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/rs/zerolog/log"
+)
+
+func HandleListServiceAccounts(tenantCtrl *tenant.Controller, projectCtrl *project.Controller, saCtrl *serviceaccount.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		renderServiceAccountsPage(w, r, tenantCtrl, projectCtrl, saCtrl)
+	}
+}
+
+func HandleAddServiceAccount(tenantCtrl *tenant.Controller, projectCtrl *project.Controller, saCtrl *serviceaccount.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		in := new(serviceaccount.CreateInput)
+		if err := json.NewDecoder(r.Body).Decode(in); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error decoding json")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		tenant, _ := request.TenantFrom(ctx)
+		principal, _ := request.PrincipalFrom(ctx)
+		if _, err := saCtrl.Create(ctx, tenant, principal, in); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error adding service account")
+			render.ToastErrorWithValidation(ctx, w, in, err)
+			return
+		}
+
+		err := renderServiceAccountsPage(w, r, tenantCtrl, projectCtrl, saCtrl)
+		if err == nil {
+			render.ToastSuccess(ctx, w, "Service account added successfully")
+		}
+	}
+}
+
+func HandleGetServiceAccount(tenantCtrl *tenant.Controller, saCtrl *serviceaccount.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		renderServiceAccountPage(w, r, tenantCtrl, saCtrl, nil)
+	}
+}
+
+func HandleDeleteServiceAccount(saCtrl *serviceaccount.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tenant, _ := request.TenantFrom(ctx)
+
+		uid, err := request.GetServiceAccountUIDFromPath(r)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Invalid service account uid")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		if err := saCtrl.Delete(ctx, tenant.ID, uid); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error deleting service account")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		render.Redirect(w, routes.TenantServiceAccountsUrl(ctx))
+	}
+}
+
+func HandleCreateServiceAccountToken(tenantCtrl *tenant.Controller, saCtrl *serviceaccount.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tenant, _ := request.TenantFrom(ctx)
+		principal, _ := request.PrincipalFrom(ctx)
+
+		uid, err := request.GetServiceAccountUIDFromPath(r)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Invalid service account uid")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		in := new(serviceaccount.CreateTokenInput)
+		if err := json.NewDecoder(r.Body).Decode(in); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error decoding json")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		created, err := saCtrl.CreateToken(ctx, tenant.ID, principal, uid, in)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error creating service account token")
+			render.ToastErrorWithValidation(ctx, w, in, err)
+			return
+		}
+
+		err = renderServiceAccountPage(w, r, tenantCtrl, saCtrl, created)
+		if err == nil {
+			render.ToastSuccess(ctx, w, "Token created successfully")
+		}
+	}
+}
+
+func HandleDeleteServiceAccountToken(tenantCtrl *tenant.Controller, saCtrl *serviceaccount.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tenant, _ := request.TenantFrom(ctx)
+
+		uid, err := request.GetServiceAccountUIDFromPath(r)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Invalid service account uid")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		identifier, err := request.GetTokenIdentifierFromPath(r)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Invalid token identifier")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		if err := saCtrl.DeleteToken(ctx, tenant.ID, uid, identifier); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error revoking service account token")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		err = renderServiceAccountPage(w, r, tenantCtrl, saCtrl, nil)
+		if err == nil {
+			render.ToastSuccess(ctx, w, "Token revoked successfully")
+		}
+	}
+}
+
+func renderServiceAccountsPage(
+	w http.ResponseWriter,
+	r *http.Request,
+	tenantCtrl *tenant.Controller,
+	projectCtrl *project.Controller,
+	saCtrl *serviceaccount.Controller,
+) error {
+	ctx := r.Context()
+	tenant, _ := request.TenantFrom(ctx)
+	principal, _ := request.PrincipalFrom(ctx)
+
+	accounts, err := saCtrl.List(ctx, tenant.ID)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error listing service accounts of tenant")
+		render.ToastError(ctx, w, err)
+		return err
+	}
+
+	projects, err := projectCtrl.List(ctx, tenant.ID, principal.ID)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error listing projects of tenant")
+		render.ToastError(ctx, w, err)
+		return err
+	}
+
+	canEdit := canEdit(ctx, tenantCtrl, tenant)
+
+	render.Page(ctx, w, vtenant.ServiceAccounts(tenant, accounts, projects, canEdit))
+	return nil
+}
+
+func renderServiceAccountPage(
+	w http.ResponseWriter,
+	r *http.Request,
+	tenantCtrl *tenant.Controller,
+	saCtrl *serviceaccount.Controller,
+	created *types.TokenResponse,
+) error {
+	ctx := r.Context()
+	tenant, _ := request.TenantFrom(ctx)
+
+	uid, err := request.GetServiceAccountUIDFromPath(r)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Invalid service account uid")
+		render.ToastError(ctx, w, err)
+		return err
+	}
+
+	sa, err := saCtrl.FindByUID(ctx, tenant.ID, uid)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error finding service account")
+		render.ToastError(ctx, w, err)
+		return err
+	}
+
+	tokens, err := saCtrl.ListTokens(ctx, tenant.ID, uid)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error listing service account tokens")
+		render.ToastError(ctx, w, err)
+		return err
+	}
+
+	canEdit := canEdit(ctx, tenantCtrl, tenant)
+
+	render.Page(ctx, w, vtenant.ServiceAccount(tenant, sa, tokens, created, canEdit))
+	return nil
+}
@@ -0,0 +1,215 @@
+package tenant
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cloudness-io/cloudness/app/controller/templatesource"
+	"github.com/cloudness-io/cloudness/app/controller/tenant"
+	"github.com/cloudness-io/cloudness/app/request"
+	"github.com/cloudness-io/cloudness/app/web/render"
+	"github.com/cloudness-io/cloudness/app/web/views/components/vtenant"
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/rs/zerolog/log"
+)
+
+func HandleListTemplateSources(tenantCtrl *tenant.Controller, tsCtrl *templatesource.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		renderTemplateSourcesPage(w, r, tenantCtrl, tsCtrl)
+	}
+}
+
+func HandleAddTemplateSource(tenantCtrl *tenant.Controller, tsCtrl *templatesource.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		in := new(templatesource.CreateInput)
+		if err := json.NewDecoder(r.Body).Decode(in); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error decoding json")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		tenant, _ := request.TenantFrom(ctx)
+		principal, _ := request.PrincipalFrom(ctx)
+		if _, err := tsCtrl.Create(ctx, tenant, principal, in); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error adding template source")
+			render.ToastErrorWithValidation(ctx, w, in, err)
+			return
+		}
+
+		err := renderTemplateSourcesPage(w, r, tenantCtrl, tsCtrl)
+		if err == nil {
+			render.ToastSuccess(ctx, w, "Template source added, templates are syncing")
+		}
+	}
+}
+
+func HandleSyncTemplateSource(tsCtrl *templatesource.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tenant, _ := request.TenantFrom(ctx)
+
+		uid, err := request.GetTemplateSourceUIDFromPath(r)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Invalid template source uid")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		if err := tsCtrl.Sync(ctx, tenant, uid); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error syncing template source")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		render.ToastSuccess(ctx, w, "Template source sync queued")
+	}
+}
+
+func HandleDeleteTemplateSource(tenantCtrl *tenant.Controller, tsCtrl *templatesource.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tenant, _ := request.TenantFrom(ctx)
+
+		uid, err := request.GetTemplateSourceUIDFromPath(r)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Invalid template source uid")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		if err := tsCtrl.Delete(ctx, tenant, uid); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error deleting template source")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		err = renderTemplateSourcesPage(w, r, tenantCtrl, tsCtrl)
+		if err == nil {
+			render.ToastSuccess(ctx, w, "Template source deleted successfully")
+		}
+	}
+}
+
+func HandleGetSourceTemplate(tenantCtrl *tenant.Controller, tsCtrl *templatesource.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		source, templateID, ok := findSourceTemplate(w, r, tsCtrl)
+		if !ok {
+			return
+		}
+		renderSourceTemplatePage(w, r, tenantCtrl, tsCtrl, source, templateID)
+	}
+}
+
+func HandlePublishSourceTemplate(tenantCtrl *tenant.Controller, tsCtrl *templatesource.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		source, templateID, ok := findSourceTemplate(w, r, tsCtrl)
+		if !ok {
+			return
+		}
+
+		number, err := request.GetVersionFromPath(r)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Invalid template version")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		if _, err := tsCtrl.Publish(ctx, source, templateID, number); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error publishing template version")
+			render.ToastError(ctx, w, err)
+			return
+		}
+
+		err = renderSourceTemplatePage(w, r, tenantCtrl, tsCtrl, source, templateID)
+		if err == nil {
+			render.ToastSuccess(ctx, w, "Template version published successfully")
+		}
+	}
+}
+
+func findSourceTemplate(w http.ResponseWriter, r *http.Request, tsCtrl *templatesource.Controller) (*types.TemplateSource, int64, bool) {
+	ctx := r.Context()
+	tenant, _ := request.TenantFrom(ctx)
+
+	uid, err := request.GetTemplateSourceUIDFromPath(r)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Invalid template source uid")
+		render.ToastError(ctx, w, err)
+		return nil, 0, false
+	}
+	templateID, err := request.GetTemplateIDFromPath(r)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Invalid template ID")
+		render.ToastError(ctx, w, err)
+		return nil, 0, false
+	}
+
+	source, err := tsCtrl.Find(ctx, tenant, uid)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error finding template source")
+		render.ToastError(ctx, w, err)
+		return nil, 0, false
+	}
+	return source, templateID, true
+}
+
+func renderSourceTemplatePage(
+	w http.ResponseWriter,
+	r *http.Request,
+	tenantCtrl *tenant.Controller,
+	tsCtrl *templatesource.Controller,
+	source *types.TemplateSource,
+	templateID int64,
+) error {
+	ctx := r.Context()
+	tenant, _ := request.TenantFrom(ctx)
+
+	tmpl, versions, err := tsCtrl.Preview(ctx, source, templateID)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error finding template versions")
+		render.ToastError(ctx, w, err)
+		return err
+	}
+
+	render.Page(ctx, w, vtenant.TemplateSourceTemplate(tenant, source, tmpl, versions, canEdit(ctx, tenantCtrl, tenant)))
+	return nil
+}
+
+func renderTemplateSourcesPage(
+	w http.ResponseWriter,
+	r *http.Request,
+	tenantCtrl *tenant.Controller,
+	tsCtrl *templatesource.Controller,
+) error {
+	ctx := r.Context()
+	tenant, _ := request.TenantFrom(ctx)
+
+	sources, err := tsCtrl.ListTenantLevel(ctx, tenant.ID)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error listing template sources of tenant")
+		render.ToastError(ctx, w, err)
+		return err
+	}
+
+	templates, err := tsCtrl.ListTemplatesBySource(ctx, sources)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error listing templates of template sources")
+		render.ToastError(ctx, w, err)
+		return err
+	}
+
+	conns, err := tsCtrl.ListGitConnections(ctx, tenant)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error listing git connections of tenant")
+		render.ToastError(ctx, w, err)
+		return err
+	}
+
+	render.Page(ctx, w, vtenant.TemplateSources(tenant, sources, templates, conns, canEdit(ctx, tenantCtrl, tenant)))
+	return nil
+}
@@ -0,0 +1,96 @@
+// Alpine.js component for the application terminal using xterm.js.
+// Keystrokes and resizes are sent as JSON messages over the websocket, the
+// container output comes back as binary messages and is written as is.
+
+function terminal(url) {
+    return {
+        target: '',
+        connected: false,
+        status: 'Disconnected',
+        term: null,
+        fit: null,
+        socket: null,
+        _resizeHandler: null,
+
+        init() {
+            this.target = this.$el.querySelector('select[name=target]').value;
+
+            this.term = new Terminal({
+                cursorBlink: true,
+                fontSize: 13,
+                fontFamily: 'ui-monospace, SFMono-Regular, Menlo, monospace',
+                theme: { background: '#000000' },
+            });
+            this.fit = new FitAddon.FitAddon();
+            this.term.loadAddon(this.fit);
+            this.term.open(this.$refs.terminal);
+            this.fit.fit();
+
+            this.term.onData((data) => this.send({ type: 'input', data: data }));
+            this.term.onResize(({ cols, rows }) => this.send({ type: 'resize', cols: cols, rows: rows }));
+
+            this._resizeHandler = () => this.fit.fit();
+            window.addEventListener('resize', this._resizeHandler);
+        },
+
+        connect() {
+            this.disconnect();
+            if (!this.target) {
+                return;
+            }
+
+            const [pod, container] = this.target.split('/');
+            const proto = location.protocol === 'https:' ? 'wss:' : 'ws:';
+            const params = new URLSearchParams({ pod: pod, container: container });
+            const socket = new WebSocket(`${proto}//${location.host}${url}?${params}`);
+            socket.binaryType = 'arraybuffer';
+
+            this.term.reset();
+            this.status = 'Connecting...';
+
+            socket.onopen = () => {
+                this.connected = true;
+                this.status = `Connected to ${container}`;
+                this.send({ type: 'resize', cols: this.term.cols, rows: this.term.rows });
+                this.term.focus();
+            };
+            socket.onmessage = (event) => {
+                this.term.write(new Uint8Array(event.data));
+            };
+            socket.onclose = (event) => {
+                if (this.socket !== socket) {
+                    return;
+                }
+                this.connected = false;
+                this.status = event.reason ? `Disconnected: ${event.reason}` : 'Disconnected';
+                this.socket = null;
+            };
+            this.socket = socket;
+        },
+
+        disconnect() {
+            if (this.socket) {
+                const socket = this.socket;
+                this.socket = null;
+                socket.close();
+            }
+            this.connected = false;
+        },
+
+        send(msg) {
+            if (this.socket && this.socket.readyState === WebSocket.OPEN) {
+                this.socket.send(JSON.stringify(msg));
+            }
+        },
+
+        destroy() {
+            this.disconnect();
+            if (this._resizeHandler) {
+                window.removeEventListener('resize', this._resizeHandler);
+            }
+            if (this.term) {
+                this.term.dispose();
+            }
+        },
+    };
+}
@@ -0,0 +1,28 @@
+package render
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Download sends the content written by write as an attachment named filename. The headers are sent with
+// the first write and the response is streamed, so an error of write can only be logged with the message.
+func Download(
+	ctx context.Context,
+	w http.ResponseWriter,
+	contentType string,
+	filename string,
+	write func(w io.Writer) error,
+	msg string,
+) {
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+
+	if err := write(w); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg(msg)
+	}
+}
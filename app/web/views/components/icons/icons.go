@@ -28,9 +28,14 @@ const (
 	UserProfileIcon = "ph ph-user-circle"
 	UserSessionIcon = "ph ph-notebook"
 	UserSignoutIcon = "ph ph-sign-out"
+	UserTokenIcon   = "ph ph-key"
 	MembersIcon     = "ph ph-user-list"
 	TeamMembersIcon = "ph ph-users"
+	ServiceAcctIcon = "ph ph-robot"
+	RegistryIcon    = "ph ph-package"
 	LimitsIcon      = "ph ph-prohibit"
+	AuditIcon       = "ph ph-scroll"
+	NotifyIcon      = "ph ph-bell"
 	SwitchIcon      = "ph ph-arrows-left-right"
 
 	NavUpIcon     = "ph ph-caret-up"
@@ -42,6 +47,8 @@ const (
 	SourceRegistryIcon = "container-registry.svg"
 	SourceOneclickIcon = "ph ph-hand-tap"
 	SourceGithubIcon   = "ph ph-github-logo"
+	SourceGitlabIcon   = "ph ph-gitlab-logo"
+	SourceGitConnIcon  = "ph ph-git-branch"
 	SourceTemplateIcon = "ph ph-folder-open"
 
 	//Action Icons
@@ -60,6 +67,7 @@ const (
 	MenuDropdownIcon = "ph ph-dots-three-vertical"
 	FunctionIcon     = "ph ph-function"
 	CopyIcon         = "ph ph-copy"
+	DownloadIcon     = "ph ph-download-simple"
 
 	SettingsIcon             = "ph ph-gear"
 	BuildSectionIcon         = "ph ph-hammer"
@@ -74,6 +82,8 @@ const (
 
 	DeploymentIcon = "ph ph-rocket text-cyan-700"
 	TerminalIcon   = "ph ph-terminal-window text-yellow-600"
+	CronIcon       = "ph ph-clock-countdown text-orange-500"
+	BackupIcon     = "ph ph-vault text-emerald-500"
 	VariablesIcon  = "ph ph-brackets-curly"
 	MetricsIcon    = "ph ph-chart-line-up text-teal-600"
 	TooltipIcon    = "ph ph-info"
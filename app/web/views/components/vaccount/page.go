@@ -9,6 +9,7 @@ import (
 const (
 	AccountNavInfo    string = "Account"
 	AccountNavSession string = "Sessions"
+	AccountNavTokens  string = "Access Tokens"
 	AccountNavDelete  string = "Delete"
 )
 
@@ -24,6 +25,11 @@ func getAccountPageNav() []*shared.PageNavItem {
 			Icon:      icons.UserSessionIcon,
 			ActionUrl: routes.AccountSession,
 		},
+		{
+			Name:      AccountNavTokens,
+			Icon:      icons.UserTokenIcon,
+			ActionUrl: routes.AccountTokens,
+		},
 		{
 			Name:      AccountNavDelete,
 			Icon:      icons.DeleteIcon,
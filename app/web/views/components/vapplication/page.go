@@ -3,6 +3,7 @@ package vapplication
 import (
 	"fmt"
 
+	specSvc "github.com/cloudness-io/cloudness/app/services/spec"
 	"github.com/cloudness-io/cloudness/app/utils/routes"
 	"github.com/cloudness-io/cloudness/app/web/views/components/icons"
 	"github.com/cloudness-io/cloudness/app/web/views/shared"
@@ -14,8 +15,10 @@ const (
 	AppNavOverview    string = "Overview"
 	AppNavDeployments string = "Deployments"
 	AppNavMetrics     string = "Metrics"
+	AppNavRuns        string = "Runs"
 	AppNavLogs        string = "Logs"
 	AppNavTerminal    string = "Terminal"
+	AppNavBackups     string = "Backups"
 	AppNavNetwork     string = "DNS"
 	AppNavVolume      string = "Volumes"
 	AppNavSettings    string = "Settings"
@@ -35,6 +38,12 @@ func getAppPageNav(app *types.Application) []*shared.PageNavItem {
 			Icon:      icons.MetricsIcon,
 			ActionUrl: fmt.Sprintf("%s/%s", routes.AppMetrics, enum.MetricsSpan1h),
 		},
+		{
+			Name:      AppNavRuns,
+			Icon:      icons.CronIcon,
+			ActionUrl: routes.AppRuns,
+			Hide:      !app.IsCron(),
+		},
 		{
 			Name:      AppNavLogs,
 			Icon:      icons.LogsSectionIcon,
@@ -45,6 +54,12 @@ func getAppPageNav(app *types.Application) []*shared.PageNavItem {
 			Icon:      icons.TerminalIcon,
 			ActionUrl: routes.AppTerminal,
 		},
+		{
+			Name:      AppNavBackups,
+			Icon:      icons.BackupIcon,
+			ActionUrl: routes.AppBackups,
+			Hide:      !specSvc.SupportsBackup(app.Spec),
+		},
 		{
 			Name:      AppNavVolume,
 			Icon:      icons.VolumeSectionIcon,
@@ -9,6 +9,7 @@ import (
 const (
 	navGitPublic = "GitPublic"
 	navGitHub    = "Github"
+	navGit       = "GitProviders"
 	navRegistry  = "Registry"
 	navDatabase  = "Database"
 	navOneclick  = "OneClick"
@@ -26,6 +27,11 @@ func getCreatePageNavs() []*shared.PageNavItem {
 			Icon:      icons.SourceGithubIcon,
 			ActionUrl: routes.AppNewGithub,
 		},
+		{
+			Name:      navGit,
+			Icon:      icons.SourceGitConnIcon,
+			ActionUrl: routes.AppNewGit,
+		},
 		{
 			Name:      navRegistry,
 			Icon:      icons.SourceRegistryIcon,
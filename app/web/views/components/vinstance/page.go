@@ -1,15 +1,17 @@
 package vinstance
 
 import (
+	"github.com/cloudness-io/cloudness/app/utils/routes"
 	"github.com/cloudness-io/cloudness/app/web/views/components/icons"
 	"github.com/cloudness-io/cloudness/app/web/views/shared"
 )
 
 const (
-	InstanceNavSettings string = "Settings"
-	InstanceNavAuth     string = "Auth"
-	InstanceNavRegistry string = "Registry"
-	InstanceNavServer   string = "Server"
+	InstanceNavSettings  string = "Settings"
+	InstanceNavAuth      string = "Auth"
+	InstanceNavRegistry  string = "Registry"
+	InstanceNavServer    string = "Server"
+	InstanceNavTemplates string = "Templates"
 )
 
 func getInstanceNav() []*shared.PageNavItem {
@@ -34,5 +36,10 @@ func getInstanceNav() []*shared.PageNavItem {
 			Icon:      icons.ServerIcon,
 			ActionUrl: "/settings/server",
 		},
+		{
+			Name:      InstanceNavTemplates,
+			Icon:      icons.SourceTemplateIcon,
+			ActionUrl: routes.InstanceTemplateSources,
+		},
 	}
 }
@@ -10,11 +10,12 @@ import (
 )
 
 const (
-	ProjectNavOverview     string = "Overview"
-	ProjectNavEnvironments string = "Environments"
-	ProjectNavConnections  string = "Connections"
-	ProjectNavMembers      string = "Members"
-	ProjectNavSettings     string = "Settings"
+	ProjectNavOverview      string = "Overview"
+	ProjectNavEnvironments  string = "Environments"
+	ProjectNavConnections   string = "Connections"
+	ProjectNavMembers       string = "Members"
+	ProjectNavNotifications string = "Notifications"
+	ProjectNavSettings      string = "Settings"
 )
 
 func getProjectPageNav(ctx context.Context) []*shared.PageNavItem {
@@ -41,6 +42,12 @@ func getProjectPageNav(ctx context.Context) []*shared.PageNavItem {
 			ActionUrl: routes.ProjectMembers,
 			Hide:      !request.IsProjectOwner(ctx),
 		},
+		{
+			Name:      ProjectNavNotifications,
+			Icon:      icons.NotifyIcon,
+			ActionUrl: routes.ProjectNotify,
+			Hide:      !request.IsProjectOwner(ctx),
+		},
 		{
 			Name:      ProjectNavSettings,
 			Icon:      icons.SettingsIcon,
@@ -8,11 +8,16 @@ import (
 )
 
 const (
-	TenantNavProjects     string = "Projects"
-	TenantNavSettings     string = "Team Settings"
-	TenantNavMembers      string = "Team"
-	TenantNavRestrictions string = "Restrictions"
-	TenantNavDelete       string = "Danger"
+	TenantNavProjects        string = "Projects"
+	TenantNavSettings        string = "Team Settings"
+	TenantNavMembers         string = "Team"
+	TenantNavServiceAccounts string = "Service Accounts"
+	TenantNavRegistryCreds   string = "Registry Credentials"
+	TenantNavNotifications   string = "Notifications"
+	TenantNavTemplates       string = "Templates"
+	TenantNavRestrictions    string = "Restrictions"
+	TenantNavAuditLog        string = "Audit Log"
+	TenantNavDelete          string = "Danger"
 )
 
 func getTenantNav(tenant *types.Tenant, canEdit bool) []*shared.PageNavItem {
@@ -28,6 +33,32 @@ func getTenantNav(tenant *types.Tenant, canEdit bool) []*shared.PageNavItem {
 			ActionUrl: routes.TenantMembers,
 			Disabled:  !canEdit,
 		},
+		{
+			Name:      TenantNavServiceAccounts,
+			Icon:      icons.ServiceAcctIcon,
+			ActionUrl: routes.TenantServiceAccounts,
+			Disabled:  !canEdit,
+		},
+		{
+			Name:      TenantNavRegistryCreds,
+			Icon:      icons.RegistryIcon,
+			ActionUrl: routes.TenantRegistryCredentials,
+			Disabled:  !canEdit,
+		},
+		{
+			Name:      TenantNavNotifications,
+			Icon:      icons.NotifyIcon,
+			ActionUrl: routes.TenantNotifications,
+			Disabled:  !canEdit,
+			Hide:      !canEdit,
+		},
+		{
+			Name:      TenantNavTemplates,
+			Icon:      icons.SourceTemplateIcon,
+			ActionUrl: routes.TenantTemplateSources,
+			Disabled:  !canEdit,
+			Hide:      !canEdit,
+		},
 		{
 			Name:      TenantNavRestrictions,
 			Icon:      icons.LimitsIcon,
@@ -35,6 +66,13 @@ func getTenantNav(tenant *types.Tenant, canEdit bool) []*shared.PageNavItem {
 			Disabled:  !canEdit,
 			Hide:      !canEdit,
 		},
+		{
+			Name:      TenantNavAuditLog,
+			Icon:      icons.AuditIcon,
+			ActionUrl: routes.TenantAuditLog,
+			Disabled:  !canEdit,
+			Hide:      !canEdit,
+		},
 		{
 			Name:      TenantNavSettings,
 			Icon:      icons.SettingsIcon,
@@ -0,0 +1,31 @@
+package blob
+
+import "path/filepath"
+
+type Provider string
+
+const (
+	ProviderFileSystem Provider = "filesystem"
+	ProviderS3         Provider = "s3"
+)
+
+type Config struct {
+	Provider Provider
+
+	// Bucket is the bucket name for s3 and the base directory for the filesystem.
+	Bucket string
+
+	// S3 defines the parameters of s3 compatible stores, eg. aws s3 or minio.
+	Endpoint     string
+	Region       string
+	AccessKey    string
+	SecretKey    string
+	UseSSL       bool
+	UsePathStyle bool
+}
+
+// IsEphemeral returns true if blobs are stored on a relative filesystem path, which is inside the
+// container unless the working directory is on a persistent volume.
+func (c Config) IsEphemeral() bool {
+	return c.Provider == ProviderFileSystem && !filepath.IsAbs(c.Bucket)
+}
@@ -0,0 +1,96 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+var _ Store = (*FileSystemStore)(nil)
+
+// FileSystemStore stores the blobs as files below a base directory.
+type FileSystemStore struct {
+	basePath string
+}
+
+// NewFileSystemStore returns a store below the base directory, directories are created on the first upload.
+func NewFileSystemStore(cfg Config) (*FileSystemStore, error) {
+	if cfg.Bucket == "" {
+		return nil, errors.New("filesystem blob store requires a base directory")
+	}
+	return &FileSystemStore{basePath: cfg.Bucket}, nil
+}
+
+func (s *FileSystemStore) Upload(_ context.Context, file io.Reader, filePath string) error {
+	fullPath, err := s.fullPath(filePath)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0o750); err != nil {
+		return fmt.Errorf("failed to create directory for %s: %w", filePath, err)
+	}
+
+	// write to a temporary file first so readers never observe a partial blob.
+	tmp, err := os.CreateTemp(filepath.Dir(fullPath), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("failed to create file for %s: %w", filePath, err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, file); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write file %s: %w", filePath, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close file %s: %w", filePath, err)
+	}
+
+	if err := os.Rename(tmp.Name(), fullPath); err != nil {
+		return fmt.Errorf("failed to move file %s in place: %w", filePath, err)
+	}
+	return nil
+}
+
+func (s *FileSystemStore) GetSignedURL(_ context.Context, _ string) (string, error) {
+	return "", ErrNotSupported
+}
+
+func (s *FileSystemStore) Download(_ context.Context, filePath string) (io.ReadCloser, error) {
+	fullPath, err := s.fullPath(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(fullPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file %s: %w", filePath, err)
+	}
+	return file, nil
+}
+
+func (s *FileSystemStore) Delete(_ context.Context, filePath string) error {
+	fullPath, err := s.fullPath(filePath)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(fullPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to delete file %s: %w", filePath, err)
+	}
+	return nil
+}
+
+// fullPath resolves the file path below the base directory, paths escaping it are rejected.
+func (s *FileSystemStore) fullPath(filePath string) (string, error) {
+	if !filepath.IsLocal(filePath) {
+		return "", fmt.Errorf("invalid blob path %q", filePath)
+	}
+	return filepath.Join(s.basePath, filePath), nil
+}
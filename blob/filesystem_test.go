@@ -0,0 +1,87 @@
+package blob
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFileSystemStore(t *testing.T) {
+	ctx := context.Background()
+	base := filepath.Join(t.TempDir(), "blobs")
+	store, err := NewFileSystemStore(Config{Bucket: base})
+	if err != nil {
+		t.Fatalf("failed to create store: %v", err)
+	}
+	if _, err := os.Stat(base); !os.IsNotExist(err) {
+		t.Errorf("base directory was created before the first upload")
+	}
+
+	if err := store.Upload(ctx, strings.NewReader("line 1\nline 2\n"), "logs/1/2.log"); err != nil {
+		t.Fatalf("failed to upload: %v", err)
+	}
+	assertBlob(t, store, "logs/1/2.log", "line 1\nline 2\n")
+
+	// uploads replace the blob
+	if err := store.Upload(ctx, strings.NewReader("replaced"), "logs/1/2.log"); err != nil {
+		t.Fatalf("failed to upload: %v", err)
+	}
+	assertBlob(t, store, "logs/1/2.log", "replaced")
+
+	if _, err := store.GetSignedURL(ctx, "logs/1/2.log"); err != ErrNotSupported {
+		t.Errorf("GetSignedURL() err = %v, want %v", err, ErrNotSupported)
+	}
+
+	if err := store.Delete(ctx, "logs/1/2.log"); err != nil {
+		t.Fatalf("failed to delete: %v", err)
+	}
+	if _, err := store.Download(ctx, "logs/1/2.log"); err != ErrNotFound {
+		t.Errorf("Download() after delete err = %v, want %v", err, ErrNotFound)
+	}
+	if err := store.Delete(ctx, "logs/1/2.log"); err != nil {
+		t.Errorf("deleting a missing blob failed: %v", err)
+	}
+}
+
+func TestFileSystemStoreRejectsEscapingPaths(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewFileSystemStore(Config{Bucket: t.TempDir()})
+	if err != nil {
+		t.Fatalf("failed to create store: %v", err)
+	}
+
+	for _, p := range []string{"../outside", "/etc/passwd", "logs/../../outside", ""} {
+		if err := store.Upload(ctx, strings.NewReader("x"), p); err == nil {
+			t.Errorf("Upload(%q) succeeded", p)
+		}
+		if _, err := store.Download(ctx, p); err == nil {
+			t.Errorf("Download(%q) succeeded", p)
+		}
+		if err := store.Delete(ctx, p); err == nil {
+			t.Errorf("Delete(%q) succeeded", p)
+		}
+	}
+
+	if _, err := NewFileSystemStore(Config{}); err == nil {
+		t.Errorf("expected an error without a base directory")
+	}
+}
+
+func assertBlob(t *testing.T, store Store, filePath, want string) {
+	t.Helper()
+	r, err := store.Download(context.Background(), filePath)
+	if err != nil {
+		t.Fatalf("failed to download %s: %v", filePath, err)
+	}
+	defer r.Close()
+	got, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("failed to read %s: %v", filePath, err)
+	}
+	if string(got) != want {
+		t.Errorf("%s = %q, want %q", filePath, got, want)
+	}
+}
@@ -0,0 +1,99 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+var _ Store = (*S3Store)(nil)
+
+// signedURLExpiry is the validity of urls returned by GetSignedURL.
+const signedURLExpiry = 15 * time.Minute
+
+// S3Store stores the blobs in a bucket of an s3 compatible object storage.
+type S3Store struct {
+	client *minio.Client
+	bucket string
+}
+
+func NewS3Store(ctx context.Context, cfg Config) (*S3Store, error) {
+	if cfg.Endpoint == "" || cfg.Bucket == "" {
+		return nil, errors.New("s3 blob store requires an endpoint and a bucket")
+	}
+
+	lookup := minio.BucketLookupAuto
+	if cfg.UsePathStyle {
+		lookup = minio.BucketLookupPath
+	}
+
+	client, err := minio.New(cfg.Endpoint, &minio.Options{
+		Creds:        credentials.NewStaticV4(cfg.AccessKey, cfg.SecretKey, ""),
+		Secure:       cfg.UseSSL,
+		Region:       cfg.Region,
+		BucketLookup: lookup,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create s3 client: %w", err)
+	}
+
+	return newS3Store(ctx, client, cfg.Bucket)
+}
+
+func newS3Store(ctx context.Context, client *minio.Client, bucket string) (*S3Store, error) {
+	exists, err := client.BucketExists(ctx, bucket)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check s3 bucket %s: %w", bucket, err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("s3 bucket %s does not exist", bucket)
+	}
+
+	return &S3Store{client: client, bucket: bucket}, nil
+}
+
+func (s *S3Store) Upload(ctx context.Context, file io.Reader, filePath string) error {
+	// size -1 lets the client switch to multipart uploads for large files.
+	if _, err := s.client.PutObject(ctx, s.bucket, filePath, file, -1, minio.PutObjectOptions{
+		ContentType: "application/octet-stream",
+	}); err != nil {
+		return fmt.Errorf("failed to upload %s: %w", filePath, err)
+	}
+	return nil
+}
+
+func (s *S3Store) GetSignedURL(ctx context.Context, filePath string) (string, error) {
+	u, err := s.client.PresignedGetObject(ctx, s.bucket, filePath, signedURLExpiry, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign url for %s: %w", filePath, err)
+	}
+	return u.String(), nil
+}
+
+func (s *S3Store) Download(ctx context.Context, filePath string) (io.ReadCloser, error) {
+	// GetObject is lazy, stat first so a missing object surfaces here and not on first read.
+	if _, err := s.client.StatObject(ctx, s.bucket, filePath, minio.StatObjectOptions{}); err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to stat %s: %w", filePath, err)
+	}
+
+	obj, err := s.client.GetObject(ctx, s.bucket, filePath, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to download %s: %w", filePath, err)
+	}
+	return obj, nil
+}
+
+func (s *S3Store) Delete(ctx context.Context, filePath string) error {
+	if err := s.client.RemoveObject(ctx, s.bucket, filePath, minio.RemoveObjectOptions{}); err != nil {
+		return fmt.Errorf("failed to delete %s: %w", filePath, err)
+	}
+	return nil
+}
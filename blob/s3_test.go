@@ -0,0 +1,143 @@
+package blob
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// fakeS3 serves the few s3 operations the store uses from memory, path style and without signature checks.
+// Uploads of unknown size are multipart uploads.
+type fakeS3 struct {
+	bucket  string
+	mu      sync.Mutex
+	objects map[string][]byte
+	parts   map[string][]byte
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	if bucket != f.bucket {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = io.WriteString(w, `<Error><Code>NoSuchBucket</Code></Error>`)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch {
+	case key == "" && r.Method == http.MethodHead:
+		w.WriteHeader(http.StatusOK)
+	case r.Method == http.MethodPost && r.URL.Query().Has("uploads"):
+		_, _ = io.WriteString(w, `<InitiateMultipartUploadResult><Bucket>`+bucket+`</Bucket><Key>`+key+`</Key><UploadId>upload</UploadId></InitiateMultipartUploadResult>`)
+	case r.Method == http.MethodPut && r.URL.Query().Has("uploadId"):
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		f.parts[key] = append(f.parts[key], data...)
+		w.Header().Set("ETag", `"etag"`)
+		w.WriteHeader(http.StatusOK)
+	case r.Method == http.MethodPost && r.URL.Query().Has("uploadId"):
+		f.objects[key] = f.parts[key]
+		delete(f.parts, key)
+		_, _ = io.WriteString(w, `<CompleteMultipartUploadResult><Bucket>`+bucket+`</Bucket><Key>`+key+`</Key><ETag>"etag"</ETag></CompleteMultipartUploadResult>`)
+	case r.Method == http.MethodPut:
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		f.objects[key] = data
+		w.Header().Set("ETag", `"etag"`)
+		w.WriteHeader(http.StatusOK)
+	case r.Method == http.MethodHead || r.Method == http.MethodGet:
+		data, ok := f.objects[key]
+		if !ok {
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusNotFound)
+			if r.Method == http.MethodGet {
+				_, _ = io.WriteString(w, `<Error><Code>NoSuchKey</Code></Error>`)
+			}
+			return
+		}
+		w.Header().Set("ETag", `"etag"`)
+		w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(data)
+		}
+	case r.Method == http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotImplemented)
+	}
+}
+
+func newTestS3Store(t *testing.T, bucket string) (*S3Store, error) {
+	t.Helper()
+	fake := &fakeS3{bucket: "cloudness", objects: map[string][]byte{}, parts: map[string][]byte{}}
+	server := httptest.NewTLSServer(fake)
+	t.Cleanup(server.Close)
+
+	u, _ := url.Parse(server.URL)
+	client, err := minio.New(u.Host, &minio.Options{
+		Creds:        credentials.NewStaticV4("access", "secret", ""),
+		Secure:       true,
+		Region:       "us-east-1",
+		BucketLookup: minio.BucketLookupPath,
+		Transport:    server.Client().Transport,
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	return newS3Store(context.Background(), client, bucket)
+}
+
+func TestS3Store(t *testing.T) {
+	ctx := context.Background()
+	store, err := newTestS3Store(t, "cloudness")
+	if err != nil {
+		t.Fatalf("failed to create store: %v", err)
+	}
+
+	if err := store.Upload(ctx, strings.NewReader("line 1\nline 2\n"), "logs/1/2.log"); err != nil {
+		t.Fatalf("failed to upload: %v", err)
+	}
+	assertBlob(t, store, "logs/1/2.log", "line 1\nline 2\n")
+
+	signed, err := store.GetSignedURL(ctx, "logs/1/2.log")
+	if err != nil {
+		t.Fatalf("failed to sign url: %v", err)
+	}
+	if !strings.Contains(signed, "/cloudness/logs/1/2.log") || !strings.Contains(signed, "X-Amz-Signature=") {
+		t.Errorf("signed url = %s", signed)
+	}
+
+	if err := store.Delete(ctx, "logs/1/2.log"); err != nil {
+		t.Fatalf("failed to delete: %v", err)
+	}
+	if _, err := store.Download(ctx, "logs/1/2.log"); err != ErrNotFound {
+		t.Errorf("Download() after delete err = %v, want %v", err, ErrNotFound)
+	}
+}
+
+func TestS3StoreMissingBucket(t *testing.T) {
+	if _, err := newTestS3Store(t, "missing"); err == nil {
+		t.Errorf("expected an error for a missing bucket")
+	}
+	if _, err := NewS3Store(context.Background(), Config{Bucket: "cloudness"}); err == nil {
+		t.Errorf("expected an error without an endpoint")
+	}
+}
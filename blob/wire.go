@@ -0,0 +1,23 @@
+package blob
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/wire"
+)
+
+var WireSet = wire.NewSet(
+	ProvideStore,
+)
+
+func ProvideStore(ctx context.Context, config Config) (Store, error) {
+	switch config.Provider {
+	case ProviderFileSystem:
+		return NewFileSystemStore(config)
+	case ProviderS3:
+		return NewS3Store(ctx, config)
+	default:
+		return nil, fmt.Errorf("invalid blob store provider: %s", config.Provider)
+	}
+}
@@ -0,0 +1,72 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/signal"
+	"syscall"
+
+	"github.com/cloudness-io/cloudness/app/pipeline/agent"
+	"github.com/cloudness-io/cloudness/app/pipeline/manager/client"
+	"github.com/cloudness-io/cloudness/app/services/manager"
+	"github.com/cloudness-io/cloudness/app/services/manager/docker"
+	"github.com/cloudness-io/cloudness/app/services/manager/kube"
+	"github.com/cloudness-io/cloudness/cli/operations/server"
+
+	"github.com/alecthomas/kingpin/v2"
+	"github.com/joho/godotenv"
+	"github.com/rs/zerolog/log"
+)
+
+type command struct {
+	envfile string
+}
+
+func (c *command) run(*kingpin.ParseContext) error {
+	// Create context that listens for the interrupt signal from the OS.
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	// load environment variables from file.
+	// no error handling needed when file is not present
+	_ = godotenv.Load(c.envfile)
+
+	config, err := server.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("encountered an error while loading configuration: %w", err)
+	}
+	if config.Runner.ServerURL == "" || config.Runner.Token == "" {
+		return errors.New("CLOUDNESS_RUNNER_SERVER_URL and CLOUDNESS_RUNNER_TOKEN are required to run the agent")
+	}
+
+	// configure the log level
+	server.SetupLogger(config)
+
+	// add logger to context
+	log := log.Logger.With().Logger()
+	ctx = log.WithContext(ctx)
+
+	// the agent only reads application statuses and metrics of the cluster or docker host it runs
+	// on, certificates and remote servers are managed by the control plane so neither the config
+	// service nor an encrypter is required.
+	factory := manager.NewManagerFactory(kube.NewK8sManager(nil, nil), docker.NewDockerManager())
+	runnerClient := client.NewHTTPClient(config.Runner.ServerURL, config.Runner.Token)
+
+	log.Info().
+		Str("server_url", config.Runner.ServerURL).
+		Msg("agent started")
+
+	return agent.New(runnerClient, factory).Start(ctx)
+}
+
+func Register(app *kingpin.Application) {
+	c := new(command)
+
+	cmd := app.Command("agent", "starts a runner agent connected to the control plane").
+		Action(c.run)
+
+	cmd.Arg("envfile", "load the environment variable file").
+		Default("").
+		StringVar(&c.envfile)
+}
@@ -5,6 +5,7 @@ import (
 	"os"
 	"unicode"
 
+	"github.com/cloudness-io/cloudness/blob"
 	"github.com/cloudness-io/cloudness/lock"
 	"github.com/cloudness-io/cloudness/pubsub"
 	"github.com/cloudness-io/cloudness/store/database"
@@ -111,3 +112,17 @@ func ProvidePubSubConfig(config *types.Config) pubsub.Config {
 		ChannelSize:    config.PubSub.ChannelSize,
 	}
 }
+
+// ProvideBlobStoreConfig generates the `blob` package config from the config.
+func ProvideBlobStoreConfig(config *types.Config) blob.Config {
+	return blob.Config{
+		Provider:     config.BlobStore.Provider,
+		Bucket:       config.BlobStore.Bucket,
+		Endpoint:     config.BlobStore.Endpoint,
+		Region:       config.BlobStore.Region,
+		AccessKey:    config.BlobStore.AccessKey,
+		SecretKey:    config.BlobStore.SecretKey,
+		UseSSL:       config.BlobStore.UseSSL,
+		UsePathStyle: config.BlobStore.UsePathStyle,
+	}
+}
@@ -72,6 +72,34 @@ func (c *command) run(*kingpin.ParseContext) error {
 			log.Error().Err(err).Msg("failed to register cleanup service")
 			return err
 		}
+		if err := system.services.Sleep.Register(gCtx); err != nil {
+			log.Error().Err(err).Msg("failed to register sleep service")
+			return err
+		}
+		if err := system.services.LogArchive.Register(gCtx); err != nil {
+			log.Error().Err(err).Msg("failed to register log archive service")
+			return err
+		}
+		if err := system.services.Backup.Register(gCtx); err != nil {
+			log.Error().Err(err).Msg("failed to register backup service")
+			return err
+		}
+		if err := system.services.Snapshot.Register(gCtx); err != nil {
+			log.Error().Err(err).Msg("failed to register snapshot service")
+			return err
+		}
+		if err := system.services.Notification.Register(gCtx); err != nil {
+			log.Error().Err(err).Msg("failed to register notification service")
+			return err
+		}
+		if err := system.services.Cluster.Register(gCtx); err != nil {
+			log.Error().Err(err).Msg("failed to register cluster service")
+			return err
+		}
+		if err := system.services.TemplateSource.Register(gCtx); err != nil {
+			log.Error().Err(err).Msg("failed to register template source service")
+			return err
+		}
 
 		return system.services.JobScheduler.Run(gCtx)
 	})
@@ -85,10 +113,12 @@ func (c *command) run(*kingpin.ParseContext) error {
 	gHTTP, shutdownHTTP := system.server.ListenAndServe()
 	g.Go(gHTTP.Wait)
 
-	//start runner agent for CI deployments
-	g.Go(func() error {
-		return system.agent.Start(gCtx)
-	})
+	//start runner agent for CI deployments, remote agents are used when disabled
+	if config.Runner.Embedded {
+		g.Go(func() error {
+			return system.agent.Start(gCtx)
+		})
+	}
 
 	log.Info().
 		Int("port", config.Server.HTTP.Port).
@@ -2,6 +2,7 @@ package main
 
 import (
 	"github.com/cloudness-io/cloudness/cli"
+	"github.com/cloudness-io/cloudness/cli/operations/agent"
 	"github.com/cloudness-io/cloudness/cli/operations/server"
 
 	"github.com/alecthomas/kingpin/v2"
@@ -18,6 +19,7 @@ func main() {
 	app := kingpin.New(applicationName, description)
 
 	server.Register(app, initSystem)
+	agent.Register(app)
 
 	// swagger.Register(app, openapi.NewOpenAPIService())
 
@@ -6,21 +6,29 @@ package main
 import (
 	"context"
 
-	// "github.com/cloudness-io/cloudness/app/api/openapi"
+	"github.com/cloudness-io/cloudness/app/api/openapi"
 	"github.com/cloudness-io/cloudness/app/auth/authn"
 	"github.com/cloudness-io/cloudness/app/bootstrap"
 	"github.com/cloudness-io/cloudness/app/controller/application"
 	"github.com/cloudness-io/cloudness/app/controller/auth"
+	"github.com/cloudness-io/cloudness/app/controller/backup"
 	"github.com/cloudness-io/cloudness/app/controller/deployment"
 	"github.com/cloudness-io/cloudness/app/controller/environment"
+	"github.com/cloudness-io/cloudness/app/controller/export"
 	"github.com/cloudness-io/cloudness/app/controller/favorite"
+	"github.com/cloudness-io/cloudness/app/controller/gitconnection"
 	"github.com/cloudness-io/cloudness/app/controller/githubapp"
 	"github.com/cloudness-io/cloudness/app/controller/gitpublic"
 	"github.com/cloudness-io/cloudness/app/controller/instance"
 	"github.com/cloudness-io/cloudness/app/controller/logs"
+	"github.com/cloudness-io/cloudness/app/controller/notification"
 	"github.com/cloudness-io/cloudness/app/controller/project"
+	"github.com/cloudness-io/cloudness/app/controller/registrycredential"
 	serverCtrl "github.com/cloudness-io/cloudness/app/controller/server"
+	"github.com/cloudness-io/cloudness/app/controller/serviceaccount"
+	"github.com/cloudness-io/cloudness/app/controller/snapshot"
 	"github.com/cloudness-io/cloudness/app/controller/template"
+	"github.com/cloudness-io/cloudness/app/controller/templatesource"
 	"github.com/cloudness-io/cloudness/app/controller/tenant"
 	"github.com/cloudness-io/cloudness/app/controller/user"
 	"github.com/cloudness-io/cloudness/app/controller/variable"
@@ -34,19 +42,30 @@ import (
 	"github.com/cloudness-io/cloudness/app/router"
 	"github.com/cloudness-io/cloudness/app/server"
 	"github.com/cloudness-io/cloudness/app/services"
+	auditSvc "github.com/cloudness-io/cloudness/app/services/audit"
 	backgroundSvc "github.com/cloudness-io/cloudness/app/services/background"
+	backupSvc "github.com/cloudness-io/cloudness/app/services/backup"
 	"github.com/cloudness-io/cloudness/app/services/cleanup"
+	clusterSvc "github.com/cloudness-io/cloudness/app/services/cluster"
+	"github.com/cloudness-io/cloudness/app/services/logarchive"
+	"github.com/cloudness-io/cloudness/app/services/sleep"
 	configSvc "github.com/cloudness-io/cloudness/app/services/config"
 	dnsSvc "github.com/cloudness-io/cloudness/app/services/dns"
+	gitconnectionSvc "github.com/cloudness-io/cloudness/app/services/gitconnection"
 	githubAppSvc "github.com/cloudness-io/cloudness/app/services/githubapp"
 	gitpublicSvc "github.com/cloudness-io/cloudness/app/services/gitpublic"
 	managerSvc "github.com/cloudness-io/cloudness/app/services/manager"
+	notificationSvc "github.com/cloudness-io/cloudness/app/services/notification"
 	proxySvc "github.com/cloudness-io/cloudness/app/services/proxy"
 	"github.com/cloudness-io/cloudness/app/services/schema"
+	snapshotSvc "github.com/cloudness-io/cloudness/app/services/snapshot"
 	specSvc "github.com/cloudness-io/cloudness/app/services/spec"
+	templateSourceSvc "github.com/cloudness-io/cloudness/app/services/templatesource"
 	"github.com/cloudness-io/cloudness/app/sse"
 	"github.com/cloudness-io/cloudness/app/store/database"
+	"github.com/cloudness-io/cloudness/blob"
 	cliserver "github.com/cloudness-io/cloudness/cli/operations/server"
+	"github.com/cloudness-io/cloudness/encrypt"
 	"github.com/cloudness-io/cloudness/job"
 	"github.com/cloudness-io/cloudness/lock"
 	"github.com/cloudness-io/cloudness/logstream"
@@ -63,13 +82,14 @@ func initSystem(ctx context.Context, config *types.Config) (*cliserver.System, e
 		cliserver.ProvideDatabaseConfig,
 		cliserver.ProvideLockConfig,
 		cliserver.ProvidePubSubConfig,
+		cliserver.ProvideBlobStoreConfig,
 		cliserver.ProvideRedis,
 		bootstrap.WireSet,
 		server.WireSet,
 		router.WireSet,
 		services.WireSet,
 		job.WireSet,
-		// openapi.WireSet,
+		openapi.WireSet,
 		auth.WireSet,
 		user.WireSet,
 		dbtx.WireSet,
@@ -77,25 +97,35 @@ func initSystem(ctx context.Context, config *types.Config) (*cliserver.System, e
 		instance.WireSet,
 		serverCtrl.WireSet,
 		tenant.WireSet,
+		serviceaccount.WireSet,
+		registrycredential.WireSet,
 		project.WireSet,
 		favorite.WireSet,
+		gitconnection.WireSet,
 		githubapp.WireSet,
 		gitpublic.WireSet,
 		database.WireSet,
 		application.WireSet,
 		variable.WireSet,
 		volume.WireSet,
+		backup.WireSet,
+		snapshot.WireSet,
 		environment.WireSet,
+		export.WireSet,
 		deployment.WireSet,
 		logs.WireSet,
+		notification.WireSet,
 		template.WireSet,
+		templatesource.WireSet,
 		sse.WireSet,
 		logstream.WireSet,
 
 		//services
 		schema.WireSet,
+		auditSvc.WireSet,
 		githubAppSvc.WireSet,
 		gitpublicSvc.WireSet,
+		gitconnectionSvc.WireSet,
 		managerSvc.WireSet,
 		configSvc.WireSet,
 		specSvc.WireSet,
@@ -103,6 +133,13 @@ func initSystem(ctx context.Context, config *types.Config) (*cliserver.System, e
 		proxySvc.WireSet,
 		backgroundSvc.WireSet,
 		cleanup.WireSet,
+		sleep.WireSet,
+		logarchive.WireSet,
+		backupSvc.WireSet,
+		snapshotSvc.WireSet,
+		notificationSvc.WireSet,
+		clusterSvc.WireSet,
+		templateSourceSvc.WireSet,
 
 		//pipelinerm
 		scheduler.WireSet,
@@ -114,7 +151,9 @@ func initSystem(ctx context.Context, config *types.Config) (*cliserver.System, e
 
 		//commons
 		lock.WireSet,
+		blob.WireSet,
 		pubsub.WireSet,
+		encrypt.WireSet,
 	)
 	return &cliserver.System{}, nil
 }
@@ -9,20 +9,29 @@ package main
 import (
 	"context"
 
+	"github.com/cloudness-io/cloudness/app/api/openapi"
 	"github.com/cloudness-io/cloudness/app/auth/authn"
 	"github.com/cloudness-io/cloudness/app/bootstrap"
 	"github.com/cloudness-io/cloudness/app/controller/application"
 	"github.com/cloudness-io/cloudness/app/controller/auth"
+	backup2 "github.com/cloudness-io/cloudness/app/controller/backup"
 	"github.com/cloudness-io/cloudness/app/controller/deployment"
 	"github.com/cloudness-io/cloudness/app/controller/environment"
+	"github.com/cloudness-io/cloudness/app/controller/export"
 	"github.com/cloudness-io/cloudness/app/controller/favorite"
+	gitconnection2 "github.com/cloudness-io/cloudness/app/controller/gitconnection"
 	githubapp2 "github.com/cloudness-io/cloudness/app/controller/githubapp"
 	gitpublic2 "github.com/cloudness-io/cloudness/app/controller/gitpublic"
 	"github.com/cloudness-io/cloudness/app/controller/instance"
 	"github.com/cloudness-io/cloudness/app/controller/logs"
+	notification2 "github.com/cloudness-io/cloudness/app/controller/notification"
 	"github.com/cloudness-io/cloudness/app/controller/project"
+	"github.com/cloudness-io/cloudness/app/controller/registrycredential"
 	server2 "github.com/cloudness-io/cloudness/app/controller/server"
+	"github.com/cloudness-io/cloudness/app/controller/serviceaccount"
+	snapshot2 "github.com/cloudness-io/cloudness/app/controller/snapshot"
 	"github.com/cloudness-io/cloudness/app/controller/template"
+	templatesource2 "github.com/cloudness-io/cloudness/app/controller/templatesource"
 	"github.com/cloudness-io/cloudness/app/controller/tenant"
 	"github.com/cloudness-io/cloudness/app/controller/user"
 	"github.com/cloudness-io/cloudness/app/controller/variable"
@@ -36,19 +45,30 @@ import (
 	"github.com/cloudness-io/cloudness/app/router"
 	server3 "github.com/cloudness-io/cloudness/app/server"
 	"github.com/cloudness-io/cloudness/app/services"
+	"github.com/cloudness-io/cloudness/app/services/audit"
 	"github.com/cloudness-io/cloudness/app/services/background"
+	"github.com/cloudness-io/cloudness/app/services/backup"
 	"github.com/cloudness-io/cloudness/app/services/cleanup"
+	"github.com/cloudness-io/cloudness/app/services/cluster"
 	"github.com/cloudness-io/cloudness/app/services/config"
 	"github.com/cloudness-io/cloudness/app/services/dns"
+	"github.com/cloudness-io/cloudness/app/services/gitconnection"
 	"github.com/cloudness-io/cloudness/app/services/githubapp"
 	"github.com/cloudness-io/cloudness/app/services/gitpublic"
+	"github.com/cloudness-io/cloudness/app/services/logarchive"
 	"github.com/cloudness-io/cloudness/app/services/manager"
+	"github.com/cloudness-io/cloudness/app/services/notification"
 	"github.com/cloudness-io/cloudness/app/services/proxy"
 	"github.com/cloudness-io/cloudness/app/services/schema"
+	"github.com/cloudness-io/cloudness/app/services/sleep"
+	"github.com/cloudness-io/cloudness/app/services/snapshot"
 	"github.com/cloudness-io/cloudness/app/services/spec"
+	"github.com/cloudness-io/cloudness/app/services/templatesource"
 	"github.com/cloudness-io/cloudness/app/sse"
 	"github.com/cloudness-io/cloudness/app/store/database"
+	"github.com/cloudness-io/cloudness/blob"
 	"github.com/cloudness-io/cloudness/cli/operations/server"
+	"github.com/cloudness-io/cloudness/encrypt"
 	"github.com/cloudness-io/cloudness/job"
 	"github.com/cloudness-io/cloudness/lock"
 	"github.com/cloudness-io/cloudness/logstream"
@@ -77,77 +97,117 @@ func initSystem(ctx context.Context, config2 *types.Config) (*server.System, err
 	service := dns.ProvideDNSService()
 	proxyService := proxy.ProvideProxyService()
 	configService := config.ProvideConfigService(config2, instanceStore)
-	k8sManager := manager.ProvideK8sManager(configService)
-	managerFactory := manager.ProvideManagerFactory(k8sManager)
+	encrypter, err := encrypt.ProvideEncrypter(config2)
+	if err != nil {
+		return nil, err
+	}
+	k8sManager := manager.ProvideK8sManager(configService, encrypter)
+	dockerManager := manager.ProvideDockerManager()
+	managerFactory := manager.ProvideManagerFactory(k8sManager, dockerManager)
 	controller := instance.ProvideController(transactor, instanceStore, serverStore, principalStore, service, proxyService, managerFactory)
-	serverController := server2.ProvideController(transactor, configService, service, proxyService, serverStore, instanceStore, managerFactory)
-	userController := user.ProvideController(transactor, principalStore)
+	store := database.ProvideJobStore(db)
+	pubsubConfig := server.ProvidePubSubConfig(config2)
+	universalClient, err := server.ProvideRedis(config2)
+	if err != nil {
+		return nil, err
+	}
+	pubSub := pubsub.ProvidePubSub(pubsubConfig, universalClient)
+	executor := job.ProvideExecutor(store, pubSub)
+	lockConfig := server.ProvideLockConfig(config2)
+	mutexManager := lock.ProvideMutexManager(lockConfig, universalClient)
+	jobScheduler, err := job.ProvideScheduler(store, executor, mutexManager, pubSub)
+	if err != nil {
+		return nil, err
+	}
+	clusterService := cluster.ProvideService(jobScheduler, executor, serverStore, managerFactory)
+	environmentStore := database.ProvideEnvironmentStore(db)
+	serverController := server2.ProvideController(transactor, configService, service, proxyService, clusterService, serverStore, instanceStore, environmentStore, encrypter, managerFactory)
+	tokenStore := database.ProvideTokenStore(db)
+	userController := user.ProvideController(transactor, principalStore, tokenStore)
 	tenantStore := database.ProvideTenantStore(db)
 	tenantMembershipStore := database.ProvideTenantMembershipStore(db)
 	schemaService := schema.ProviderSchemaService()
 	githubAppStore := database.ProvideGithubAppStore(db)
 	privateKeyStore := database.ProvidePrivateKeyStore(db)
 	githubappService := githubapp.ProvideService(transactor, githubAppStore, privateKeyStore)
+	gitConnectionStore := database.ProvideGitConnectionStore(db)
+	gitconnectionService := gitconnection.ProvideService(gitConnectionStore, encrypter)
 	gitpublicService := gitpublic.ProvideGitpublicService()
-	specService := spec.ProvideSpecService(githubappService, gitpublicService)
+	registryCredentialStore := database.ProvideRegistryCredentialStore(db)
+	specService := spec.ProvideSpecService(githubappService, gitconnectionService, gitpublicService, registryCredentialStore)
 	applicationStore := database.ProvideApplicationStore(db)
 	metricsStore := database.ProvideMetricsStore(db)
 	variableStore := database.ProvideVariableStore(db)
-	variableController := variable.ProvideController(variableStore)
+	auditEventStore := database.ProvideAuditEventStore(db)
+	auditService := audit.ProvideService(auditEventStore)
+	variableController := variable.ProvideController(variableStore, auditService)
 	gitpublicController := gitpublic2.ProvideController(gitpublicService)
 	volumeStore := database.ProvideVolumeStore(db)
-	volumeController := volume.ProvideController(configService, volumeStore)
+	volumeController := volume.ProvideController(configService, volumeStore, auditService)
 	deploymentStore := database.ProvideDeploymentStore(db)
-	lockConfig := server.ProvideLockConfig(config2)
-	universalClient, err := server.ProvideRedis(config2)
-	if err != nil {
-		return nil, err
-	}
-	mutexManager := lock.ProvideMutexManager(lockConfig, universalClient)
-	schedulerScheduler, err := scheduler.ProvideScheduler(deploymentStore, mutexManager)
+	schedulerScheduler, err := scheduler.ProvideScheduler(deploymentStore, applicationStore, mutexManager)
 	if err != nil {
 		return nil, err
 	}
-	pubsubConfig := server.ProvidePubSubConfig(config2)
-	pubSub := pubsub.ProvidePubSub(pubsubConfig, universalClient)
 	streamer := sse.ProvideEventStreamer(pubSub)
-	cancelerCanceler := canceler.ProvideCanceler(deploymentStore, streamer, schedulerScheduler)
-	triggererTriggerer := triggerer.ProvideTriggerer(transactor, applicationStore, deploymentStore, schedulerScheduler, cancelerCanceler)
-	applicationController := application.ProvideController(transactor, configService, schemaService, specService, applicationStore, metricsStore, serverController, variableController, gitpublicController, volumeController, triggererTriggerer, cancelerCanceler, managerFactory)
-	environmentStore := database.ProvideEnvironmentStore(db)
-	environmentController := environment.ProvideController(transactor, applicationController, volumeController, environmentStore)
+	notificationChannelStore := database.ProvideNotificationChannelStore(db)
+	notificationAlertStore := database.ProvideNotificationAlertStore(db)
+	notificationService := notification.ProvideService(config2, jobScheduler, executor, notificationChannelStore, notificationAlertStore, volumeStore, encrypter, serverController, managerFactory)
+	cancelerCanceler := canceler.ProvideCanceler(deploymentStore, applicationStore, streamer, schedulerScheduler, notificationService)
+	triggererTriggerer := triggerer.ProvideTriggerer(transactor, applicationStore, deploymentStore, tenantStore, configService, schemaService, specService, schedulerScheduler, cancelerCanceler)
+	applicationController := application.ProvideController(transactor, configService, schemaService, specService, applicationStore, metricsStore, registryCredentialStore, gitConnectionStore, serverController, variableController, gitpublicController, volumeController, triggererTriggerer, cancelerCanceler, managerFactory, auditService)
+	environmentController := environment.ProvideController(transactor, applicationController, volumeController, serverController, environmentStore, auditService)
 	projectStore := database.ProvideProjectStore(db)
 	projectMembershipStore := database.ProvideProjectMembershipStore(db)
-	projectController := project.ProviderController(transactor, configService, userController, environmentController, projectStore, projectMembershipStore, tenantMembershipStore, streamer)
-	tenantController := tenant.ProviderController(transactor, configService, tenantStore, tenantMembershipStore, userController, projectController)
-	tokenStore := database.ProvideTokenStore(db)
+	projectController := project.ProviderController(transactor, configService, userController, environmentController, projectStore, projectMembershipStore, tenantMembershipStore, streamer, auditService)
+	tenantController := tenant.ProviderController(transactor, configService, tenantStore, tenantMembershipStore, userController, projectController, auditService)
 	authSettingsStore := database.ProvideAuthSettingStore(db)
 	authController := auth.ProvideController(transactor, controller, userController, tenantController, tokenStore, authSettingsStore)
 	templateStore := database.ProvideTemplateStore(db)
 	templateController := template.ProvideController(templateStore, applicationController, schemaService, triggererTriggerer)
 	bootstrapBootstrap := bootstrap.ProvideBootstrap(config2, controller, serverController, authController, userController, templateController)
 	authenticator := authn.ProvideAuthenticator(config2, principalStore, tokenStore)
-	githubappController := githubapp2.ProvideController(githubappService)
-	deploymentController := deployment.ProvideController(deploymentStore)
+	openapiService := openapi.ProvideOpenAPIService()
+	serviceaccountController := serviceaccount.ProvideController(transactor, principalStore, tokenStore, projectStore, tenantMembershipStore, projectMembershipStore, auditService)
+	registrycredentialController := registrycredential.ProvideController(registryCredentialStore, encrypter, auditService)
+	deploymentController := deployment.ProvideController(deploymentStore, triggererTriggerer)
 	logStore := database.ProvideLogStore(db)
 	logStream := logstream.ProvideLogStream()
-	logsController := logs.ProvideController(logStore, logStream)
+	runnerManager := manager2.ProvideRunnerManager(schedulerScheduler, serverStore, applicationStore, deploymentStore, volumeStore, logStore, metricsStore, variableController, registrycredentialController, configService, githubappService, gitconnectionService, notificationService, streamer, logStream)
+	apiHandler := router.ProvideAPIHandler(ctx, config2, authenticator, openapiService, userController, tenantController, serviceaccountController, registrycredentialController, projectController, environmentController, applicationController, variableController, volumeController, deploymentController, runnerManager)
+	githubappController := githubapp2.ProvideController(githubappService, tenantStore, projectStore, applicationStore, environmentController, triggererTriggerer, auditService)
+	gitconnectionController := gitconnection2.ProvideController(gitconnectionService, applicationStore, triggererTriggerer, auditService)
+	blobConfig := server.ProvideBlobStoreConfig(config2)
+	blobStore, err := blob.ProvideStore(ctx, blobConfig)
+	if err != nil {
+		return nil, err
+	}
+	logsController := logs.ProvideController(logStore, blobStore, logStream)
+	backupStore := database.ProvideBackupStore(db)
+	backupPolicyStore := database.ProvideBackupPolicyStore(db)
+	backupService := backup.ProvideService(jobScheduler, executor, backupStore, backupPolicyStore, applicationStore, blobStore, serverController, managerFactory)
+	backupController := backup2.ProvideController(backupStore, backupPolicyStore, backupService, applicationController, auditService, blobConfig)
+	volumeSnapshotStore := database.ProvideVolumeSnapshotStore(db)
+	volumeSnapshotPolicyStore := database.ProvideVolumeSnapshotPolicyStore(db)
+	snapshotService := snapshot.ProvideService(jobScheduler, executor, volumeSnapshotStore, volumeSnapshotPolicyStore, volumeStore, serverController, managerFactory)
+	snapshotController := snapshot2.ProvideController(transactor, volumeSnapshotStore, volumeSnapshotPolicyStore, snapshotService, volumeController, serverController, auditService)
 	favoriteStore := database.ProvideFavoriteStore(db)
 	favoriteController := favorite.ProvideController(favoriteStore)
-	webHandler := router.ProvideWebHandler(ctx, config2, authenticator, controller, serverController, userController, tenantController, projectController, environmentController, authController, githubappController, gitpublicController, applicationController, variableController, deploymentController, logsController, volumeController, templateController, favoriteController)
-	routerRouter := router.ProvideRouter(webHandler)
+	notificationController := notification2.ProvideController(notificationChannelStore, encrypter, notificationService, auditService)
+	exportController := export.ProvideController(applicationStore, deploymentStore, volumeStore, variableController, registrycredentialController, configService, auditService)
+	templateSourceStore := database.ProvideTemplateSourceStore(db)
+	templatesourceService := templatesource.ProvideService(jobScheduler, executor, templateSourceStore, templateStore, schemaService, gitpublicService, githubappService, gitconnectionService, encrypter)
+	templatesourceController := templatesource2.ProvideController(templateSourceStore, templateStore, encrypter, templatesourceService, gitconnectionService, auditService)
+	webHandler := router.ProvideWebHandler(ctx, config2, authenticator, controller, serverController, userController, tenantController, projectController, environmentController, authController, githubappController, gitpublicController, gitconnectionController, applicationController, variableController, deploymentController, logsController, volumeController, backupController, snapshotController, templateController, favoriteController, serviceaccountController, registrycredentialController, notificationController, exportController, templatesourceController)
+	activator := sleep.ProvideActivator(serverController, managerFactory)
+	routerRouter := router.ProvideRouter(apiHandler, webHandler, activator)
 	serverServer := server3.ProvideServer(config2, routerRouter)
-	runnerManager := manager2.ProvideRunnerManager(schedulerScheduler, serverStore, applicationStore, deploymentStore, volumeStore, logStore, metricsStore, variableController, configService, githubappService, streamer, logStream)
 	runnerClient := client.ProvideRunnerClient(runnerManager)
 	agentAgent := agent.ProvideAgent(runnerClient, managerFactory)
-	store := database.ProvideJobStore(db)
-	executor := job.ProvideExecutor(store, pubSub)
-	jobScheduler, err := job.ProvideScheduler(store, executor, mutexManager, pubSub)
-	if err != nil {
-		return nil, err
-	}
-	cleanupService := cleanup.ProvideService(jobScheduler, executor, serverStore, tenantStore, projectStore, environmentStore, applicationStore, volumeStore, tokenStore, managerFactory)
-	servicesServices := services.ProvideServices(jobScheduler, cleanupService)
+	cleanupService := cleanup.ProvideService(jobScheduler, executor, serverStore, tenantStore, projectStore, environmentStore, applicationStore, volumeStore, tokenStore, backupStore, volumeSnapshotStore, blobStore, managerFactory)
+	sleepService := sleep.ProvideService(jobScheduler, executor, serverStore, managerFactory)
+	logarchiveService := logarchive.ProvideService(config2, jobScheduler, executor, logStore, blobStore)
+	servicesServices := services.ProvideServices(jobScheduler, cleanupService, sleepService, logarchiveService, backupService, snapshotService, notificationService, clusterService, templatesourceService)
 	backgroundService := background.ProvideService(mutexManager)
 	system := server.NewSystem(bootstrapBootstrap, serverServer, agentAgent, servicesServices, backgroundService)
 	return system, nil
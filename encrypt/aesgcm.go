@@ -0,0 +1,83 @@
+package encrypt
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"io"
+)
+
+// ErrKeySize is returned when the encryption key is not 32 bytes.
+var ErrKeySize = errors.New("encryption key must be 32 bytes")
+
+// aesgcmMarker prefixes the values written by the aesgcm encrypter.
+var aesgcmMarker = []byte("aesgcm:")
+
+// Aesgcm provides an encrypter that uses the aesgcm encryption algorithm.
+type Aesgcm struct {
+	block cipher.Block
+}
+
+// New returns a new aesgcm encrypter.
+func New(key string) (Encrypter, error) {
+	if len(key) != 32 {
+		return nil, ErrKeySize
+	}
+
+	block, err := aes.NewCipher([]byte(key))
+	if err != nil {
+		return nil, err
+	}
+	return &Aesgcm{block: block}, nil
+}
+
+// Encrypt encrypts the plaintext using aesgcm, the value is the marker followed by the nonce
+// and the ciphertext.
+func (e *Aesgcm) Encrypt(plaintext string) ([]byte, error) {
+	gcm, err := cipher.NewGCM(e.block)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+
+	out := append([]byte{}, aesgcmMarker...)
+	out = append(out, nonce...)
+	return gcm.Seal(out, nonce, []byte(plaintext), nil), nil
+}
+
+// Decrypt decrypts the ciphertext using aesgcm. Values stored before they were marked are either
+// aesgcm ciphertext or the plaintext written when no secret was configured, and are read as such.
+func (e *Aesgcm) Decrypt(ciphertext []byte) (string, error) {
+	if value, ok := bytes.CutPrefix(ciphertext, aesgcmMarker); ok {
+		return e.open(value)
+	}
+
+	if plaintext, err := e.open(ciphertext); err == nil {
+		return plaintext, nil
+	}
+	return string(ciphertext), nil
+}
+
+func (e *Aesgcm) open(ciphertext []byte) (string, error) {
+	gcm, err := cipher.NewGCM(e.block)
+	if err != nil {
+		return "", err
+	}
+
+	if len(ciphertext) < gcm.NonceSize() {
+		return "", errors.New("malformed ciphertext")
+	}
+
+	plaintext, err := gcm.Open(nil,
+		ciphertext[:gcm.NonceSize()],
+		ciphertext[gcm.NonceSize():],
+		nil,
+	)
+	return string(plaintext), err
+}
@@ -0,0 +1,99 @@
+package encrypt
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"io"
+	"testing"
+)
+
+const testKey = "0123456789abcdef0123456789abcdef"
+
+func TestAesgcmRoundTrip(t *testing.T) {
+	e, err := New(testKey)
+	if err != nil {
+		t.Fatalf("failed to create encrypter: %v", err)
+	}
+
+	for _, plaintext := range []string{"", "secret", "kubeconfig: {}\n", string(bytes.Repeat([]byte("x"), 4096))} {
+		ciphertext, err := e.Encrypt(plaintext)
+		if err != nil {
+			t.Fatalf("failed to encrypt: %v", err)
+		}
+		if !bytes.HasPrefix(ciphertext, aesgcmMarker) {
+			t.Errorf("ciphertext is not marked with the aesgcm marker")
+		}
+		if plaintext != "" && bytes.Contains(ciphertext, []byte(plaintext)) {
+			t.Errorf("ciphertext contains the plaintext")
+		}
+
+		got, err := e.Decrypt(ciphertext)
+		if err != nil {
+			t.Fatalf("failed to decrypt: %v", err)
+		}
+		if got != plaintext {
+			t.Errorf("Decrypt() = %q, want %q", got, plaintext)
+		}
+	}
+}
+
+func TestAesgcmNonceIsRandom(t *testing.T) {
+	e, _ := New(testKey)
+	first, _ := e.Encrypt("secret")
+	second, _ := e.Encrypt("secret")
+	if bytes.Equal(first, second) {
+		t.Errorf("encrypting the same plaintext twice returned the same ciphertext")
+	}
+}
+
+func TestAesgcmWrongKey(t *testing.T) {
+	e, _ := New(testKey)
+	other, _ := New("fedcba9876543210fedcba9876543210")
+
+	ciphertext, err := e.Encrypt("secret")
+	if err != nil {
+		t.Fatalf("failed to encrypt: %v", err)
+	}
+	if _, err := other.Decrypt(ciphertext); err == nil {
+		t.Errorf("expected an error decrypting with another key")
+	}
+
+	ciphertext[len(ciphertext)-1] ^= 0xff
+	if _, err := e.Decrypt(ciphertext); err == nil {
+		t.Errorf("expected an error decrypting tampered ciphertext")
+	}
+}
+
+func TestAesgcmUnmarkedValues(t *testing.T) {
+	e, _ := New(testKey)
+
+	// ciphertext written before values were marked
+	block, _ := aes.NewCipher([]byte(testKey))
+	gcm, _ := cipher.NewGCM(block)
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		t.Fatal(err)
+	}
+	legacy := gcm.Seal(nonce, nonce, []byte("secret"), nil)
+
+	got, err := e.Decrypt(legacy)
+	if err != nil || got != "secret" {
+		t.Errorf("Decrypt(unmarked ciphertext) = %q, %v, want %q", got, err, "secret")
+	}
+
+	// plaintext written when no secret was configured
+	got, err = e.Decrypt([]byte("password"))
+	if err != nil || got != "password" {
+		t.Errorf("Decrypt(plaintext) = %q, %v, want %q", got, err, "password")
+	}
+}
+
+func TestNewKeySize(t *testing.T) {
+	for _, key := range []string{"", "short", testKey + "x"} {
+		if _, err := New(key); err != ErrKeySize {
+			t.Errorf("New(%q) err = %v, want %v", key, err, ErrKeySize)
+		}
+	}
+}
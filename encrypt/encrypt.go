@@ -0,0 +1,9 @@
+package encrypt
+
+// Encrypter provides field level encryption and decryption.
+// Encrypted values are currently limited to strings, which is
+// reflected in the interface design.
+type Encrypter interface {
+	Encrypt(plaintext string) ([]byte, error)
+	Decrypt(ciphertext []byte) (string, error)
+}
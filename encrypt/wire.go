@@ -0,0 +1,67 @@
+package encrypt
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/cloudness-io/cloudness/types"
+
+	"github.com/google/wire"
+	"github.com/rs/zerolog/log"
+)
+
+// WireSet provides a wire set for this package.
+var WireSet = wire.NewSet(
+	ProvideEncrypter,
+)
+
+// ProvideEncrypter provides the encrypter used for secrets stored in the database.
+// Without a configured secret a key is generated on first boot and kept in the key file,
+// which is only allowed for sqlite where the file lives next to the data it protects.
+func ProvideEncrypter(config *types.Config) (Encrypter, error) {
+	if config.Encrypter.Secret != "" {
+		return New(config.Encrypter.Secret)
+	}
+	if config.Database.Driver != "sqlite3" {
+		return nil, errors.New("CLOUDNESS_ENCRYPTER_SECRET is required with the " + config.Database.Driver + " database driver")
+	}
+
+	key, err := loadOrCreateKey(config.Encrypter.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+	return New(key)
+}
+
+// loadOrCreateKey reads the key from the file, a new random key is written to it when the file does not exist.
+func loadOrCreateKey(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err == nil {
+		return strings.TrimSpace(string(data)), nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return "", fmt.Errorf("failed to read encrypter key file: %w", err)
+	}
+
+	raw := make([]byte, 24)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	key := base64.RawURLEncoding.EncodeToString(raw)
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return "", fmt.Errorf("failed to create encrypter key file: %w", err)
+	}
+	defer f.Close()
+	if _, err := f.WriteString(key); err != nil {
+		return "", fmt.Errorf("failed to write encrypter key file: %w", err)
+	}
+
+	log.Warn().Str("path", path).Msg("CLOUDNESS_ENCRYPTER_SECRET is not set, generated a new encrypter key, keep this file with the database")
+	return key, nil
+}
@@ -0,0 +1,57 @@
+package encrypt
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cloudness-io/cloudness/types"
+)
+
+func TestProvideEncrypterGeneratesKey(t *testing.T) {
+	config := &types.Config{}
+	config.Database.Driver = "sqlite3"
+	config.Encrypter.KeyFile = filepath.Join(t.TempDir(), "encrypter.key")
+
+	first, err := ProvideEncrypter(config)
+	if err != nil {
+		t.Fatalf("failed to provide encrypter: %v", err)
+	}
+	info, err := os.Stat(config.Encrypter.KeyFile)
+	if err != nil {
+		t.Fatalf("key file was not written: %v", err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Errorf("key file mode = %o, want 600", info.Mode().Perm())
+	}
+
+	ciphertext, err := first.Encrypt("secret")
+	if err != nil {
+		t.Fatalf("failed to encrypt: %v", err)
+	}
+
+	// the key is read back on the next boot
+	second, err := ProvideEncrypter(config)
+	if err != nil {
+		t.Fatalf("failed to provide encrypter: %v", err)
+	}
+	got, err := second.Decrypt(ciphertext)
+	if err != nil || got != "secret" {
+		t.Errorf("Decrypt() = %q, %v, want %q", got, err, "secret")
+	}
+}
+
+func TestProvideEncrypterRequiresSecret(t *testing.T) {
+	config := &types.Config{}
+	config.Database.Driver = "postgres"
+	config.Encrypter.KeyFile = filepath.Join(t.TempDir(), "encrypter.key")
+
+	if _, err := ProvideEncrypter(config); err == nil {
+		t.Errorf("expected an error without a secret for postgres")
+	}
+
+	config.Encrypter.Secret = testKey
+	if _, err := ProvideEncrypter(config); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -27,6 +27,15 @@ func SplitGitRepoUrl(url string) (owner, repo string, err error) {
 	return "", "", errors.BadRequest("invalid Github repository format: %s", url)
 }
 
+// GitRepoFullName returns the owner/repo full name of the repository url, empty if the url is invalid.
+func GitRepoFullName(url string) string {
+	owner, repo, err := SplitGitRepoUrl(url)
+	if err != nil {
+		return ""
+	}
+	return owner + "/" + repo
+}
+
 func GetGitHttpUrl(gitUrl string) string {
 	return strings.TrimSuffix(gitUrl, ".git")
 }
@@ -0,0 +1,73 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+// ErrAddressNotAllowed is returned when a request to a user supplied url resolves to an internal address.
+var ErrAddressNotAllowed = errors.New("address is not allowed")
+
+// cgnatPrefix is the shared address space of carrier grade NATs, often used for cluster and tailnet addresses.
+var cgnatPrefix = netip.MustParsePrefix("100.64.0.0/10")
+
+// NewExternalClient returns a client for requests to user supplied urls. Connections to loopback,
+// private, link-local and unspecified addresses are refused when dialing, so neither redirects nor
+// dns answers can point the client at internal services.
+func NewExternalClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: 10 * time.Second,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return err
+			}
+			if !IsPublicAddr(addrPort.Addr()) {
+				return fmt.Errorf("%s: %w", addrPort.Addr(), ErrAddressNotAllowed)
+			}
+			return nil
+		},
+	}
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			// a proxy would dial the target on our behalf and bypass the check
+			Proxy:               nil,
+			DialContext:         dialer.DialContext,
+			ForceAttemptHTTP2:   true,
+			TLSHandshakeTimeout: 10 * time.Second,
+			IdleConnTimeout:     90 * time.Second,
+		},
+	}
+}
+
+// IsPublicAddr reports whether the address is routable on the internet.
+func IsPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsValid() &&
+		!addr.IsLoopback() &&
+		!addr.IsPrivate() &&
+		!addr.IsLinkLocalUnicast() &&
+		!addr.IsLinkLocalMulticast() &&
+		!addr.IsInterfaceLocalMulticast() &&
+		!addr.IsMulticast() &&
+		!addr.IsUnspecified() &&
+		!cgnatPrefix.Contains(addr)
+}
+
+// IsPublicHost reports whether the host of a url may be public, ip literals are checked and names
+// are left to the dial time check of NewExternalClient.
+func IsPublicHost(host string) bool {
+	if host == "" || host == "localhost" {
+		return false
+	}
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return IsPublicAddr(addr)
+	}
+	return true
+}
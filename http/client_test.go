@@ -0,0 +1,64 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+)
+
+func TestIsPublicAddr(t *testing.T) {
+	tests := []struct {
+		addr string
+		want bool
+	}{
+		{"1.1.1.1", true},
+		{"2606:4700:4700::1111", true},
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"10.0.0.1", false},
+		{"172.16.5.4", false},
+		{"192.168.1.1", false},
+		{"169.254.169.254", false},
+		{"fe80::1", false},
+		{"fd00::1", false},
+		{"0.0.0.0", false},
+		{"100.64.0.1", false},
+		{"::ffff:127.0.0.1", false},
+		{"224.0.0.1", false},
+	}
+	for _, tc := range tests {
+		if got := IsPublicAddr(netip.MustParseAddr(tc.addr)); got != tc.want {
+			t.Errorf("IsPublicAddr(%s) = %v, want %v", tc.addr, got, tc.want)
+		}
+	}
+}
+
+func TestIsPublicHost(t *testing.T) {
+	tests := map[string]bool{
+		"hooks.slack.com": true,
+		"1.1.1.1":         true,
+		"localhost":       false,
+		"127.0.0.1":       false,
+		"":                false,
+	}
+	for host, want := range tests {
+		if got := IsPublicHost(host); got != want {
+			t.Errorf("IsPublicHost(%q) = %v, want %v", host, got, want)
+		}
+	}
+}
+
+func TestExternalClientRefusesLoopback(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	_, err := NewExternalClient(5 * time.Second).Get(srv.URL)
+	if !errors.Is(err, ErrAddressNotAllowed) {
+		t.Fatalf("expected ErrAddressNotAllowed, got %v", err)
+	}
+}
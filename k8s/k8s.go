@@ -0,0 +1,8 @@
+// Package k8s embeds the manifests bootstrapping the clusters managed by cloudness,
+// they are applied in the order of their file names.
+package k8s
+
+import "embed"
+
+//go:embed *.yaml
+var Manifests embed.FS
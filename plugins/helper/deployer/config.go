@@ -11,7 +11,7 @@ type Config struct {
 	// Application identifiers
 	AppIdentifier string
 	AppNamespace  string
-	AppType       AppType // "Stateless" or "Stateful"
+	AppType       AppType // "Stateless", "Stateful", "Cron" or "PostgresHA"
 
 	// Feature flags
 	HasVolume   bool
@@ -28,6 +28,7 @@ type Config struct {
 	// Timeouts
 	RolloutTimeoutStateless int // seconds
 	RolloutTimeoutStateful  int // seconds
+	RolloutTimeoutPostgres  int // seconds
 	PVCResizeTimeout        int // seconds
 	PVCResizePollInterval   int // seconds
 
@@ -38,8 +39,10 @@ type Config struct {
 type AppType string
 
 const (
-	AppTypeStateless AppType = "Stateless"
-	AppTypeStateful  AppType = "Stateful"
+	AppTypeStateless  AppType = "Stateless"
+	AppTypeStateful   AppType = "Stateful"
+	AppTypeCron       AppType = "Cron"
+	AppTypePostgresHA AppType = "PostgresHA" // cluster of the cloudnative-pg operator
 )
 
 // LoadConfigFromEnv loads configuration from environment variables
@@ -57,6 +60,7 @@ func LoadConfigFromEnv() (*Config, error) {
 		// Defaults
 		RolloutTimeoutStateless: getEnvInt("ROLLOUT_TIMEOUT_STATELESS", 60),
 		RolloutTimeoutStateful:  getEnvInt("ROLLOUT_TIMEOUT_STATEFUL", 120),
+		RolloutTimeoutPostgres:  getEnvInt("ROLLOUT_TIMEOUT_POSTGRES", 600),
 		PVCResizeTimeout:        getEnvInt("PVC_RESIZE_TIMEOUT", 300),
 		PVCResizePollInterval:   getEnvInt("PVC_RESIZE_POLL_INTERVAL", 5),
 	}
@@ -68,8 +72,10 @@ func LoadConfigFromEnv() (*Config, error) {
 	if cfg.AppNamespace == "" {
 		return nil, fmt.Errorf("CLOUDNESS_DEPLOY_APP_NAMESPACE is required")
 	}
-	if cfg.AppType != AppTypeStateless && cfg.AppType != AppTypeStateful {
-		return nil, fmt.Errorf("CLOUDNESS_DEPLOY_FLAG_APP_TYPE must be 'Stateless' or 'Stateful', got '%s'", cfg.AppType)
+	switch cfg.AppType {
+	case AppTypeStateless, AppTypeStateful, AppTypeCron, AppTypePostgresHA:
+	default:
+		return nil, fmt.Errorf("CLOUDNESS_DEPLOY_FLAG_APP_TYPE must be 'Stateless', 'Stateful', 'Cron' or 'PostgresHA', got '%s'", cfg.AppType)
 	}
 	if cfg.DeployPath == "" {
 		return nil, fmt.Errorf("CLOUDNESS_DEPLOY_PATH is required")
@@ -95,8 +101,13 @@ func getEnvInt(key string, defaultVal int) int {
 
 // ResourceType returns the Kubernetes resource type for this app
 func (c *Config) ResourceType() string {
-	if c.AppType == AppTypeStateless {
+	switch c.AppType {
+	case AppTypeStateless:
 		return "Deployment"
+	case AppTypeCron:
+		return "CronJob"
+	case AppTypePostgresHA:
+		return "Cluster"
 	}
 	return "StatefulSet"
 }
@@ -111,8 +122,11 @@ func (c *Config) OppositeResourceType() string {
 
 // RolloutTimeout returns the appropriate timeout based on app type
 func (c *Config) RolloutTimeout() int {
-	if c.AppType == AppTypeStateless {
+	switch c.AppType {
+	case AppTypeStateless:
 		return c.RolloutTimeoutStateless
+	case AppTypePostgresHA:
+		return c.RolloutTimeoutPostgres
 	}
 	return c.RolloutTimeoutStateful
 }
@@ -38,6 +38,10 @@ func NewDeployer(cfg *Config) (*Deployer, error) {
 		operator = NewStatelessOperator(cfg, clientset, logger)
 	case AppTypeStateful:
 		operator = NewStatefulOperator(cfg, clientset, logger)
+	case AppTypeCron:
+		operator = NewCronOperator(cfg, clientset, logger)
+	case AppTypePostgresHA:
+		operator = NewPostgresOperator(cfg, logger)
 	}
 
 	return &Deployer{
@@ -111,3 +111,31 @@ func (k *Kubectl) Delete(ctx context.Context, resource, name, namespace string)
 
 	return nil
 }
+
+// Wait runs kubectl wait until the condition of the resource is met or the timeout expires
+func (k *Kubectl) Wait(ctx context.Context, resource, name, namespace, condition string, timeout time.Duration) error {
+	args := []string{"wait", resource + "/" + name, "-n", namespace, "--for=condition=" + condition, fmt.Sprintf("--timeout=%ds", int(timeout.Seconds()))}
+
+	cmd := exec.CommandContext(ctx, "kubectl", args...)
+	output, err := cmd.CombinedOutput()
+
+	if err != nil {
+		return fmt.Errorf("kubectl wait failed: %s: %w", strings.TrimSpace(string(output)), err)
+	}
+
+	return nil
+}
+
+// Get runs kubectl get and returns the output of the jsonpath expression
+func (k *Kubectl) Get(ctx context.Context, resource, name, namespace, jsonPath string) (string, error) {
+	args := []string{"get", resource, name, "-n", namespace, "-o", "jsonpath=" + jsonPath}
+
+	cmd := exec.CommandContext(ctx, "kubectl", args...)
+	output, err := cmd.CombinedOutput()
+
+	if err != nil {
+		return "", fmt.Errorf("kubectl get failed: %s: %w", strings.TrimSpace(string(output)), err)
+	}
+
+	return strings.TrimSpace(string(output)), nil
+}
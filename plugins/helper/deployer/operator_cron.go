@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// CronOperator deploys scheduled applications as a CronJob, there is no rollout to wait for
+// as the pods only start on schedule.
+type CronOperator struct {
+	config    *Config
+	base      *BaseOpeator
+	volumes   *StatefulOperator
+	clientset kubernetes.Interface
+	kubectl   *Kubectl
+	log       *Logger
+}
+
+func NewCronOperator(cfg *Config, clientset kubernetes.Interface, log *Logger) *CronOperator {
+	kubectl := NewKubectl(cfg, log)
+	baseOperator := NewBaseOperator(cfg, kubectl, log)
+	return &CronOperator{
+		config:    cfg,
+		base:      baseOperator,
+		volumes:   NewStatefulOperator(cfg, clientset, log),
+		clientset: clientset,
+		kubectl:   kubectl,
+		log:       log,
+	}
+}
+
+func (c *CronOperator) ApplyCommon(ctx context.Context) error {
+	return c.base.ApplyCommon(ctx)
+}
+
+// Volumes provisions the claims the same way as stateful applications do.
+func (c *CronOperator) Volumes(ctx context.Context) error {
+	return c.volumes.Volumes(ctx)
+}
+
+func (c *CronOperator) Deploy(ctx context.Context) error {
+	if err := c.kubectl.ApplyYAMLFile(ctx, c.config.AppYAMLPath); err != nil {
+		return err
+	}
+
+	cronJob, err := c.clientset.BatchV1().CronJobs(c.config.AppNamespace).Get(ctx, c.config.AppIdentifier, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get cronjob: %w", err)
+	}
+	c.log.Info("Scheduled with %q", cronJob.Spec.Schedule)
+
+	return nil
+}
+
+func (c *CronOperator) Ingress(ctx context.Context) error { return nil }
+
+func (c *CronOperator) Cleanup(ctx context.Context) {
+	c.log.Debug("Running cleanup...")
+
+	for _, resource := range []string{"deployment", "statefulset"} {
+		err := c.kubectl.Delete(ctx, resource, c.config.AppIdentifier, c.config.AppNamespace)
+		if err != nil && !errors.IsNotFound(err) {
+			c.log.Debug("Cleanup: %v", err)
+		}
+	}
+}
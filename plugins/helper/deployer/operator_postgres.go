@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// clusterResource is the postgres cluster of the cloudnative-pg operator
+const clusterResource = "clusters.postgresql.cnpg.io"
+
+// PostgresOperator deploys a postgres cluster, the cloudnative-pg operator creates the instances, their
+// volumes and services, and fails over to a replica when the primary is lost.
+type PostgresOperator struct {
+	config  *Config
+	base    *BaseOpeator
+	kubectl *Kubectl
+	log     *Logger
+}
+
+func NewPostgresOperator(cfg *Config, log *Logger) *PostgresOperator {
+	kubectl := NewKubectl(cfg, log)
+	return &PostgresOperator{
+		config:  cfg,
+		base:    NewBaseOperator(cfg, kubectl, log),
+		kubectl: kubectl,
+		log:     log,
+	}
+}
+
+func (p *PostgresOperator) ApplyCommon(ctx context.Context) error {
+	return p.base.ApplyCommon(ctx)
+}
+
+// Volumes is a no-op, the operator claims a volume per instance with the storage of the cluster.
+func (p *PostgresOperator) Volumes(ctx context.Context) error {
+	return nil
+}
+
+func (p *PostgresOperator) Deploy(ctx context.Context) error {
+	if err := p.kubectl.ApplyYAMLFile(ctx, p.config.AppYAMLPath); err != nil {
+		return err
+	}
+
+	p.log.Info("Waiting for the postgres cluster to be ready...")
+	timeout := time.Duration(p.config.RolloutTimeout()) * time.Second
+	if err := p.kubectl.Wait(ctx, clusterResource, p.config.AppIdentifier, p.config.AppNamespace, "Ready", timeout); err != nil {
+		phase, _ := p.kubectl.Get(ctx, clusterResource, p.config.AppIdentifier, p.config.AppNamespace, "{.status.phase}")
+		if phase != "" {
+			return fmt.Errorf("postgres cluster is not ready (%s): %w", phase, err)
+		}
+		return fmt.Errorf("postgres cluster is not ready: %w", err)
+	}
+
+	primary, err := p.kubectl.Get(ctx, clusterResource, p.config.AppIdentifier, p.config.AppNamespace, "{.status.currentPrimary}")
+	if err == nil && primary != "" {
+		p.log.Info("Primary instance is %s", primary)
+	}
+	return nil
+}
+
+func (p *PostgresOperator) Ingress(ctx context.Context) error { return p.base.ApplyIngress(ctx) }
+
+func (p *PostgresOperator) Cleanup(ctx context.Context) {
+	p.log.Debug("Running cleanup...")
+
+	for _, resource := range []string{"deployment", "statefulset", "cronjob"} {
+		if err := p.kubectl.Delete(ctx, resource, p.config.AppIdentifier, p.config.AppNamespace); err != nil {
+			p.log.Debug("Cleanup: %v", err)
+		}
+	}
+}
@@ -60,9 +60,11 @@ func (s *StatefulOperator) Ingress(ctx context.Context) error { return s.base.Ap
 func (k *StatefulOperator) Cleanup(ctx context.Context) {
 	k.log.Debug("Running cleanup...")
 
-	err := k.kubectl.Delete(ctx, "deployment", k.config.AppIdentifier, k.config.AppNamespace)
-	if err != nil && !errors.IsNotFound(err) {
-		k.log.Debug("Cleanup: %v", err)
+	for _, resource := range []string{"deployment", "cronjob"} {
+		err := k.kubectl.Delete(ctx, resource, k.config.AppIdentifier, k.config.AppNamespace)
+		if err != nil && !errors.IsNotFound(err) {
+			k.log.Debug("Cleanup: %v", err)
+		}
 	}
 }
 
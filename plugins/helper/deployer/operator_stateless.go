@@ -56,9 +56,11 @@ func (s *StatelessOperator) Ingress(ctx context.Context) error { return s.base.A
 func (k *StatelessOperator) Cleanup(ctx context.Context) {
 	k.log.Debug("Running cleanup...")
 
-	err := k.kubectl.Delete(ctx, "statefulset", k.config.AppIdentifier, k.config.AppNamespace)
-	if err != nil && !errors.IsNotFound(err) {
-		k.log.Debug("Cleanup: %v", err)
+	for _, resource := range []string{"statefulset", "cronjob"} {
+		err := k.kubectl.Delete(ctx, resource, k.config.AppIdentifier, k.config.AppNamespace)
+		if err != nil && !errors.IsNotFound(err) {
+			k.log.Debug("Cleanup: %v", err)
+		}
 	}
 }
 
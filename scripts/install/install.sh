@@ -17,11 +17,13 @@ INSTALL_GATEWAY_API="${INSTALL_GATEWAY_API:-true}"
 INSTALL_CERT_MANAGER="${INSTALL_CERT_MANAGER:-true}"
 INSTALL_KGATEWAY="${INSTALL_KGATEWAY:-true}"
 INSTALL_KUBEBLOCKS="${INSTALL_KUBEBLOCKS:-true}"
+INSTALL_CNPG="${INSTALL_CNPG:-true}"
 INSTALL_HTTP_ROUTE="${INSTALL_HTTP_ROUTE:-true}"
 CERT_MANAGER_VERSION="${CERT_MANAGER_VERSION:-v1.18.2}"
 GATEWAY_API_VERSION="${GATEWAY_API_VERSION:-v1.4.0}"
 KUBEBLOCKS_VERSION="${KUBEBLOCKS_VERSION:-v1.0.1}"
 KGATEWAY_VERSION="${KGATEWAY_VERSION:-2.2.0}"
+CNPG_VERSION="${CNPG_VERSION:-1.25.1}"
 VERBOSE="${VERBOSE:-false}"
 
 # Installation URLs and paths
@@ -51,6 +53,7 @@ while [[ $# -gt 0 ]]; do
             echo "• cert-manager (v${CERT_MANAGER_VERSION}) - Automated TLS certificate management"
             echo "• Kgateway Gateway (v${KGATEWAY_VERSION}) - Ingress controller and load balancer"
             echo "• KubeBlocks (v${KUBEBLOCKS_VERSION}) - Database and application management platform"
+            echo "• CloudNativePG (v${CNPG_VERSION}) - Operator of the highly available Postgres clusters"
             echo ""
             echo "PLATFORM COMPONENTS:"
             echo "• Cloudness namespace - Application deployment namespace"
@@ -75,11 +78,13 @@ while [[ $# -gt 0 ]]; do
             echo "  INSTALL_CERT_MANAGER    Install Cert-Manager (default: true)"
             echo "  INSTALL_KGATEWAY        Install Kgateway (default: true)"
             echo "  INSTALL_KUBEBLOCKS      Install KubeBlocks (default: true)"
+            echo "  INSTALL_CNPG            Install CloudNativePG (default: true)"
             echo "  INSTALL_HTTP_ROUTE      Install Http Route (default: true)"
             echo "  CERT_MANAGER_VERSION    Cert-Manager version (default: ${CERT_MANAGER_VERSION})"
             echo "  GATEWAY_API_VERSION     Gateway API version (default: ${GATEWAY_API_VERSION})"
             echo "  KUBEBLOCKS_VERSION      KubeBlocks version (default: ${KUBEBLOCKS_VERSION})"
             echo "  KGATEWAY_VERSION         Kgateway version (default: ${KGATEWAY_VERSION})"
+            echo "  CNPG_VERSION            CloudNativePG version (default: ${CNPG_VERSION})"
             echo "  VERBOSE                 Show full output (default: false)"
             echo ""
             echo "Examples:"
@@ -278,7 +283,7 @@ fi
 
 echo ""
 print_info "Starting Cloudness Platform Installation..."
-print_info "This will install Gateway API, cert-manager, kgateway, Kubeblocks and Cloudness platform resources"
+print_info "This will install Gateway API, cert-manager, kgateway, Kubeblocks, CloudNativePG and Cloudness platform resources"
 echo ""
 
 # Install Gateway API CRDs
@@ -415,6 +420,20 @@ else
     print_warning "Skipping KubeBlocks installation"
 fi
 
+# Install CloudNativePG
+if [ "$INSTALL_CNPG" = "true" ]; then
+    print_info "Installing CloudNativePG ${CNPG_VERSION}..."
+
+    if ! run_command kubectl apply --server-side -f "https://github.com/cloudnative-pg/cloudnative-pg/releases/download/v${CNPG_VERSION}/cnpg-${CNPG_VERSION}.yaml"; then
+        print_error "Failed to apply CloudNativePG. Please check your network connection."
+        exit 1
+    fi
+
+    print_status "CloudNativePG installed"
+else
+    print_warning "Skipping CloudNativePG installation"
+fi
+
 # Verify installations
 echo ""
 print_info "Verifying installations..."
@@ -454,6 +473,15 @@ if [ "$INSTALL_KUBEBLOCKS" = "true" ]; then
     fi
 fi
 
+if [ "$INSTALL_CNPG" = "true" ]; then
+    print_info "Waiting for CloudNativePG to be ready..."
+    if run_command kubectl rollout status deployment/cnpg-controller-manager -n cnpg-system --timeout=120s >/dev/null 2>&1; then
+        print_status "CloudNativePG is running"
+    else
+        print_warning "CloudNativePG may not be fully ready yet. Check with: kubectl get pods -n cnpg-system"
+    fi
+fi
+
 # Install Cloudness Platform Resources
 install_cloudness_resources() {
     print_section "Installing Cloudness Platform Resources"
@@ -560,6 +588,16 @@ install_cloudness_resources() {
         END
         \$do\$;
     "
+    # Generate the encrypter secret once, secrets stored in the database can not be read without it
+    if ! run_command kubectl get secret cloudness-encrypter -n cloudness &>/dev/null; then
+        print_info "Generating Cloudness encrypter secret..."
+        if ! run_command kubectl create secret generic cloudness-encrypter -n cloudness \
+            --from-literal=secret="$(openssl rand -base64 24)"; then
+            print_error "Failed to create Cloudness encrypter secret"
+            exit 1
+        fi
+    fi
+
     # Apply Cloudness Postgres cluster
     apply_yaml "cloudness-app.yaml" "Cloudness Application"
 
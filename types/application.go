@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"strings"
 
 	"github.com/cloudness-io/cloudness/errors"
 	"github.com/cloudness-io/cloudness/types/enum"
@@ -24,6 +25,7 @@ type Application struct {
 	SpecJSON              string                           `db:"application_spec"                         json:"-"`
 	Spec                  *ApplicationSpec                 `db:"-"                                        json:"spec"`
 	GithubAppID           *int64                           `db:"application_githubapp_id"                 json:"-"`
+	GitConnectionID       *int64                           `db:"application_git_connection_id"            json:"-"`
 	Domain                string                           `db:"application_domain"                       json:"domain"`
 	CustomDomain          string                           `db:"application_custom_domain"                json:"custom_domain"`
 	PrivateDomain         string                           `db:"application_private_domain"               json:"private_domain"`
@@ -41,6 +43,9 @@ type ApplicationFilter struct {
 	TenantID          *int64               `json:"tenant_id,omitempty"`
 	ProjectID         *int64               `json:"project_id,omitempty"`
 	EnvironmentID     *int64               `json:"environment_id,omitempty"`
+	ServerID          *int64               `json:"server_id,omitempty"`
+	GithubAppID       *int64               `json:"github_app_id,omitempty"`
+	GitConnectionID   *int64               `json:"git_connection_id,omitempty"`
 	Sort              enum.ApplicationAttr `json:"sort"`
 	Order             enum.Order           `json:"order"`
 	DeletedAt         *int64               `json:"deleted_at,omitempty"`
@@ -60,6 +65,14 @@ func (a *Application) GetGithubAppID() int64 {
 	return *a.GithubAppID
 }
 
+// GetGitConnectionID returns the git connection id if it exists
+func (a *Application) GetGitConnectionID() int64 {
+	if a == nil || a.GitConnectionID == nil {
+		return 0
+	}
+	return *a.GitConnectionID
+}
+
 func (a *Application) UpdateSpecJSON() error {
 	specJSON, err := json.Marshal(a.Spec)
 	if err != nil {
@@ -76,3 +89,22 @@ func (a *Application) IsStateful() bool {
 func (a *Application) IsStateless() bool {
 	return a.Type == enum.ApplicationTypeStateless
 }
+
+func (a *Application) IsCron() bool {
+	return a.Type == enum.ApplicationTypeCron
+}
+
+func (a *Application) IsPostgresHA() bool {
+	return a.Type == enum.ApplicationTypePostgresHA
+}
+
+// TracksBranch returns true if the application builds from the branch of the repository,
+// fullName resolves the full name of the repository url the application builds from.
+func (a *Application) TracksBranch(repoFullName, branch string, fullName func(repoURL string) string) bool {
+	if !a.Spec.IsGit() {
+		return false
+	}
+
+	gitSpec := a.Spec.Build.Source.Git
+	return gitSpec.Branch == branch && strings.EqualFold(fullName(gitSpec.RepoURL), repoFullName)
+}
@@ -27,23 +27,41 @@ type GitInput struct {
 	PublishPath  string           `json:"publishPath"`
 	Dockerfile   string           `json:"dockerfile"`
 	BuildCommand string           `json:"buildCommand,omitempty"`
+	BuilderImage string           `json:"builderImage"`
+	ConfigPath   string           `json:"configPath"`
 }
 
 type RegistryInput struct {
-	Image string `json:"image"`
+	Image         string `json:"image"`
+	CredentialUID int64  `json:"credentialUID,string"`
 	*NetworkInput
 }
 
 type DeployInput struct {
 	StartCommand            string                 `json:"startCommand,omitempty"`
 	SleepApplication        bool                   `json:"sleepApplication,string"`
+	SleepAfterMinutes       int                    `json:"sleepAfterMinutes,string"`
 	MaxReplicas             int64                  `json:"maxReplicas,string"`
+	MinReplicas             int64                  `json:"minReplicas,string"`
 	CPU                     int64                  `json:"cpu,string"`
 	Memory                  float64                `json:"memory,string"`
 	HealthcheckPath         string                 `json:"healthcheckPath,omitempty"`
 	HealthcheckTimeout      int                    `json:"healthcheckTimeout,string,omitempty"`
 	RestartPolicyType       enum.RestartPolicyType `json:"restartPolicyType"`
 	RestartPolicyMaxRetries int                    `json:"restartPolicyMaxRetries"`
+
+	TargetCPUUtilization          int   `json:"targetCPUUtilization,string"`
+	TargetMemoryUtilization       int   `json:"targetMemoryUtilization,string"`
+	TargetRequestsPerReplica      int64 `json:"targetRequestsPerReplica,string"`
+	ScaleUpStabilizationSeconds   int   `json:"scaleUpStabilizationSeconds,string"`
+	ScaleDownStabilizationSeconds int   `json:"scaleDownStabilizationSeconds,string"`
+
+	Schedule                   string                     `json:"schedule"`
+	ConcurrencyPolicy          enum.CronConcurrencyPolicy `json:"concurrencyPolicy"`
+	SuccessfulJobsHistoryLimit int                        `json:"successfulJobsHistoryLimit,string,omitempty"`
+	FailedJobsHistoryLimit     int                        `json:"failedJobsHistoryLimit,string,omitempty"`
+
+	PostgresInstances int64 `json:"postgresInstances,string,omitempty"`
 }
 
 type NetworkInput struct {
@@ -35,24 +35,46 @@ type GitSource struct {
 	PublishPath  string           `json:"publishPath" yaml:"publishPath" mapstructure:"publishPath"`
 	Dockerfile   string           `json:"dockerfile,omitempty" yaml:"dockerfile,omitempty" mapstructure:"dockerfile"`
 	BuildCommand string           `json:"buildCommand,omitempty" yaml:"buildCommand,omitempty" mapstructure:"buildCommand"`
+	BuilderImage string           `json:"builderImage,omitempty" yaml:"builderImage,omitempty" mapstructure:"builderImage"`
+	// ConfigPath is the repository config merged over the spec on deploy, relative to BasePath.
+	ConfigPath string `json:"configPath,omitempty" yaml:"configPath,omitempty" mapstructure:"configPath"`
 }
 
 type RegistrySource struct {
-	Image     string `json:"image" yaml:"image" mapstructure:"image"`
-	IsPrivate bool   `json:"isPrivate,string" yaml:"isPrivate" mapstructure:"isPrivate"`
+	Image         string `json:"image" yaml:"image" mapstructure:"image"`
+	IsPrivate     bool   `json:"isPrivate,string" yaml:"isPrivate" mapstructure:"isPrivate"`
+	CredentialUID int64  `json:"credentialUID,omitempty" yaml:"credentialUID,omitempty" mapstructure:"credentialUID"`
 }
 
 // Deploy
 type DeployConfiguration struct {
 	StartCommand            string                 `json:"startCommand,omitempty" yaml:"startCommand,omitempty" mapstructure:"startCommand"`
 	SleepApplication        bool                   `json:"sleepApplication" yaml:"sleepApplication" mapstructure:"sleepApplication"`
+	SleepAfterMinutes       int                    `json:"sleepAfterMinutes,omitempty" yaml:"sleepAfterMinutes,omitempty" mapstructure:"sleepAfterMinutes"`
 	MaxReplicas             int64                  `json:"maxReplicas" yaml:"maxReplicas" mapstructure:"maxReplicas"`
+	MinReplicas             int64                  `json:"minReplicas,omitempty" yaml:"minReplicas,omitempty" mapstructure:"minReplicas"`
 	CPU                     int64                  `json:"cpu" yaml:"cpu" mapstructure:"cpu"`
 	Memory                  float64                `json:"memory" yaml:"memory" mapstructure:"memory"`
 	HealthcheckPath         string                 `json:"healthcheckPath,omitempty" yaml:"healthcheckPath" mapstructure:"healthcheckPath"`
 	HealthcheckTimeout      int                    `json:"healthcheckTimeout" yaml:"healthcheckTimeout" mapstructure:"healthcheckTimeout"`
 	RestartPolicyType       enum.RestartPolicyType `json:"restartPolicyType" yaml:"restartPolicyType" mapstructure:"restartPolicyType"`
 	RestartPolicyMaxRetries int                    `json:"restartPolicyMaxRetries" yaml:"restartPolicyMaxRetries" mapstructure:"restartPolicyMaxRetries"`
+
+	// Autoscaling, targets are in percent of the requested resources
+	TargetCPUUtilization          int   `json:"targetCPUUtilization,omitempty" yaml:"targetCPUUtilization,omitempty" mapstructure:"targetCPUUtilization"`
+	TargetMemoryUtilization       int   `json:"targetMemoryUtilization,omitempty" yaml:"targetMemoryUtilization,omitempty" mapstructure:"targetMemoryUtilization"`
+	TargetRequestsPerReplica      int64 `json:"targetRequestsPerReplica,omitempty" yaml:"targetRequestsPerReplica,omitempty" mapstructure:"targetRequestsPerReplica"`
+	ScaleUpStabilizationSeconds   int   `json:"scaleUpStabilizationSeconds,omitempty" yaml:"scaleUpStabilizationSeconds,omitempty" mapstructure:"scaleUpStabilizationSeconds"`
+	ScaleDownStabilizationSeconds int   `json:"scaleDownStabilizationSeconds,omitempty" yaml:"scaleDownStabilizationSeconds,omitempty" mapstructure:"scaleDownStabilizationSeconds"`
+
+	// Cron, the application runs as a scheduled job when a schedule is set
+	Schedule                   string                     `json:"schedule,omitempty" yaml:"schedule,omitempty" mapstructure:"schedule"`
+	ConcurrencyPolicy          enum.CronConcurrencyPolicy `json:"concurrencyPolicy,omitempty" yaml:"concurrencyPolicy,omitempty" mapstructure:"concurrencyPolicy"`
+	SuccessfulJobsHistoryLimit int                        `json:"successfulJobsHistoryLimit,omitempty" yaml:"successfulJobsHistoryLimit,omitempty" mapstructure:"successfulJobsHistoryLimit"`
+	FailedJobsHistoryLimit     int                        `json:"failedJobsHistoryLimit,omitempty" yaml:"failedJobsHistoryLimit,omitempty" mapstructure:"failedJobsHistoryLimit"`
+
+	// PostgresHA, the application runs as a replicated postgres cluster of the operator when instances are set
+	PostgresInstances int64 `json:"postgresInstances,omitempty" yaml:"postgresInstances,omitempty" mapstructure:"postgresInstances"`
 }
 
 type NetworkConfiguration struct {
@@ -30,7 +30,8 @@ func (s *ApplicationSpec) ToGeneralInput() *GeneralInput {
 func (s *ApplicationSpec) ToRegistryInput() *RegistryInput {
 	if s.IsRegistry() {
 		return &RegistryInput{
-			Image: s.Build.Source.Registry.Image,
+			Image:         s.Build.Source.Registry.Image,
+			CredentialUID: s.Build.Source.Registry.CredentialUID,
 		}
 	}
 	return nil
@@ -47,6 +48,8 @@ func (s *ApplicationSpec) ToGitInput() *GitInput {
 			IsStaticSite: s.Build.Source.Git.IsStaticSite,
 			Dockerfile:   s.Build.Source.Git.Dockerfile,
 			BuildCommand: s.Build.Source.Git.BuildCommand,
+			BuilderImage: s.Build.Source.Git.BuilderImage,
+			ConfigPath:   s.Build.Source.Git.ConfigPath,
 		}
 
 		owner, repo, err := helpers.SplitGitRepoUrl(s.Build.Source.Git.RepoURL)
@@ -62,13 +65,28 @@ func (s *ApplicationSpec) ToDeployInput() *DeployInput {
 	return &DeployInput{
 		StartCommand:            s.Deploy.StartCommand,
 		SleepApplication:        s.Deploy.SleepApplication,
+		SleepAfterMinutes:       s.Deploy.SleepAfterMinutes,
 		MaxReplicas:             s.Deploy.MaxReplicas,
+		MinReplicas:             s.Deploy.MinReplicas,
 		CPU:                     s.Deploy.CPU,
 		Memory:                  s.Deploy.Memory,
 		HealthcheckPath:         s.Deploy.HealthcheckPath,
 		HealthcheckTimeout:      s.Deploy.HealthcheckTimeout,
 		RestartPolicyType:       s.Deploy.RestartPolicyType,
 		RestartPolicyMaxRetries: s.Deploy.RestartPolicyMaxRetries,
+
+		TargetCPUUtilization:          s.Deploy.TargetCPUUtilization,
+		TargetMemoryUtilization:       s.Deploy.TargetMemoryUtilization,
+		TargetRequestsPerReplica:      s.Deploy.TargetRequestsPerReplica,
+		ScaleUpStabilizationSeconds:   s.Deploy.ScaleUpStabilizationSeconds,
+		ScaleDownStabilizationSeconds: s.Deploy.ScaleDownStabilizationSeconds,
+
+		Schedule:                   s.Deploy.Schedule,
+		ConcurrencyPolicy:          s.Deploy.ConcurrencyPolicy,
+		SuccessfulJobsHistoryLimit: s.Deploy.SuccessfulJobsHistoryLimit,
+		FailedJobsHistoryLimit:     s.Deploy.FailedJobsHistoryLimit,
+
+		PostgresInstances: s.Deploy.PostgresInstances,
 	}
 }
 
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"encoding/json"
+
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+// AuditEvent records who changed what within a tenant, the scope ids are zero when the change
+// happened above that level.
+type AuditEvent struct {
+	ID            int64                  `db:"audit_event_id"             json:"-"`
+	TenantID      int64                  `db:"audit_event_tenant_id"      json:"tenant_id"`
+	ProjectID     int64                  `db:"audit_event_project_id"     json:"project_id,omitempty"`
+	EnvironmentID int64                  `db:"audit_event_environment_id" json:"environment_id,omitempty"`
+	ApplicationID int64                  `db:"audit_event_application_id" json:"application_id,omitempty"`
+	ActorID       int64                  `db:"audit_event_actor_id"       json:"actor_id"`
+	ActorName     string                 `db:"audit_event_actor_name"     json:"actor_name"`
+	ActorEmail    string                 `db:"audit_event_actor_email"    json:"actor_email"`
+	Action        enum.AuditAction       `db:"audit_event_action"         json:"action"`
+	ResourceType  enum.AuditResourceType `db:"audit_event_resource_type"  json:"resource_type"`
+	ResourceID    string                 `db:"audit_event_resource_id"    json:"resource_id"`
+	ResourceName  string                 `db:"audit_event_resource_name"  json:"resource_name"`
+	Scope         string                 `db:"audit_event_scope"          json:"scope"` // human readable path, eg. project/environment/application
+	Diff          string                 `db:"audit_event_diff"           json:"-"`     // json encoded map of AuditDiffEntry
+	ClientIP      string                 `db:"audit_event_client_ip"      json:"client_ip"`
+	RequestID     string                 `db:"audit_event_request_id"     json:"request_id"`
+	RequestMethod string                 `db:"audit_event_request_method" json:"request_method"`
+	Created       int64                  `db:"audit_event_created"        json:"created"`
+}
+
+// AuditDiffEntry is the change of a single field, secrets are redacted before they are recorded.
+type AuditDiffEntry struct {
+	Old any `json:"old,omitempty"`
+	New any `json:"new,omitempty"`
+}
+
+// GetDiff returns the changed fields of the event.
+func (e *AuditEvent) GetDiff() map[string]*AuditDiffEntry {
+	diff := map[string]*AuditDiffEntry{}
+	if e.Diff == "" {
+		return diff
+	}
+	_ = json.Unmarshal([]byte(e.Diff), &diff)
+	return diff
+}
+
+// AuditEventDTO is the exported form of an audit event, the diff is inlined as an object.
+type AuditEventDTO struct {
+	*AuditEvent
+	Diff map[string]*AuditDiffEntry `json:"diff,omitempty"`
+}
+
+// ToDTO returns the exported form of the event.
+func (e *AuditEvent) ToDTO() *AuditEventDTO {
+	return &AuditEventDTO{AuditEvent: e, Diff: e.GetDiff()}
+}
+
+type AuditFilter struct {
+	ListQueryFilter
+	CreatedFilter
+	TenantID     int64                  `json:"-"`
+	ProjectID    int64                  `json:"project_id,string"`
+	ActorID      int64                  `json:"actor_id,string"`
+	Action       enum.AuditAction       `json:"action"`
+	ResourceType enum.AuditResourceType `json:"resource_type"`
+}
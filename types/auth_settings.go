@@ -1,6 +1,10 @@
 package types
 
-import "github.com/cloudness-io/cloudness/types/enum"
+import (
+	"strings"
+
+	"github.com/cloudness-io/cloudness/types/enum"
+)
 
 type AuthSetting struct {
 	ID           int64             `db:"auth_id"              json:"-"`
@@ -9,6 +13,62 @@ type AuthSetting struct {
 	ClientID     string            `db:"auth_client_id"       json:"client_id"`
 	ClientSecret string            `db:"auth_client_secret"   json:"client_secret"`
 	BaseURL      string            `db:"auth_base_url"        json:"base_url"`
+	DisplayName  string            `db:"auth_display_name"    json:"display_name"`
+	Scopes       string            `db:"auth_scopes"          json:"scopes"`
+	EmailClaim   string            `db:"auth_email_claim"     json:"email_claim"`
+	NameClaim    string            `db:"auth_name_claim"      json:"name_claim"`
+	GroupsClaim  string            `db:"auth_groups_claim"    json:"groups_claim"`
 	Created      int64             `db:"auth_created"         json:"created"`
 	Updated      int64             `db:"auth_updated"         json:"updated"`
+
+	// auto join of users into a tenant, matched by email domain or group claim
+	JoinTenantUID int64           `db:"auth_join_tenant_uid" json:"join_tenant_uid,string"`
+	JoinRole      enum.TenantRole `db:"auth_join_role"       json:"join_role"`
+	JoinDomains   string          `db:"auth_join_domains"    json:"join_domains"`
+	JoinGroups    string          `db:"auth_join_groups"     json:"join_groups"`
+}
+
+// GetDisplayName returns the name shown on the login button.
+func (a *AuthSetting) GetDisplayName() string {
+	if a.DisplayName != "" {
+		return a.DisplayName
+	}
+	return a.Provider.DisplayName()
+}
+
+// GetScopes returns the requested oauth scopes, separated by space or comma.
+func (a *AuthSetting) GetScopes() []string {
+	return splitList(a.Scopes)
+}
+
+// ShouldJoinTenant reports whether a user with the given email and groups is auto joined
+// into the configured tenant.
+func (a *AuthSetting) ShouldJoinTenant(email string, groups []string) bool {
+	if a.JoinTenantUID == 0 {
+		return false
+	}
+
+	if _, domain, ok := strings.Cut(email, "@"); ok {
+		for _, d := range splitList(a.JoinDomains) {
+			if strings.EqualFold(strings.TrimPrefix(d, "@"), domain) {
+				return true
+			}
+		}
+	}
+
+	for _, g := range splitList(a.JoinGroups) {
+		for _, group := range groups {
+			// keycloak prefixes group names with their full path
+			if strings.EqualFold(strings.TrimPrefix(g, "/"), strings.TrimPrefix(group, "/")) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func splitList(s string) []string {
+	return strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\n'
+	})
 }
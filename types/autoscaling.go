@@ -0,0 +1,24 @@
+package types
+
+// GatewayRequestsMetric is the external metric with the per service request rate of the gateway,
+// see scripts/install/metrics-adapter.yaml.
+const GatewayRequestsMetric = "cloudness_gateway_requests_per_second"
+
+// AutoscalingStatus is the live state of the autoscaler of an application.
+type AutoscalingStatus struct {
+	MinReplicas     int32               `json:"min_replicas"`
+	MaxReplicas     int32               `json:"max_replicas"`
+	CurrentReplicas int32               `json:"current_replicas"`
+	DesiredReplicas int32               `json:"desired_replicas"`
+	LastScaleTime   int64               `json:"last_scale_time,omitempty"`
+	Events          []*AutoscalingEvent `json:"events"`
+}
+
+// AutoscalingEvent is a scaling decision or warning reported by the autoscaler.
+type AutoscalingEvent struct {
+	Type    string `json:"type"`
+	Reason  string `json:"reason"`
+	Message string `json:"message"`
+	Count   int32  `json:"count"`
+	Time    int64  `json:"time"`
+}
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+// BackupPolicy schedules the backups of a database application.
+type BackupPolicy struct {
+	ID            int64  `db:"backup_policy_id"              json:"-"`
+	ApplicationID int64  `db:"backup_policy_application_id"  json:"-"`
+	Enabled       bool   `db:"backup_policy_enabled"         json:"enabled"`
+	Schedule      string `db:"backup_policy_schedule"        json:"schedule"`
+	Retention     int    `db:"backup_policy_retention"       json:"retention"` // number of successful backups kept
+	NextRun       int64  `db:"backup_policy_next_run"        json:"next_run"`
+	Created       int64  `db:"backup_policy_created"         json:"created"`
+	Updated       int64  `db:"backup_policy_updated"         json:"updated"`
+}
+
+// Backup is a logical dump of a database application stored in the blob store.
+type Backup struct {
+	ID            int64              `db:"backup_id"              json:"-"`
+	UID           int64              `db:"backup_uid"             json:"uid"`
+	TenantID      int64              `db:"backup_tenant_id"       json:"-"`
+	ProjectID     int64              `db:"backup_project_id"      json:"-"`
+	EnvironmentID int64              `db:"backup_environment_id"  json:"-"`
+	ApplicationID int64              `db:"backup_application_id"  json:"-"`
+	Kind          enum.BackupKind    `db:"backup_kind"            json:"kind"`
+	Trigger       enum.BackupTrigger `db:"backup_trigger"         json:"trigger"`
+	Status        enum.BackupStatus  `db:"backup_status"          json:"status"`
+	BlobPath      string             `db:"backup_blob_path"       json:"-"`
+	Size          int64              `db:"backup_size"            json:"size"`
+	Error         string             `db:"backup_error"           json:"error"`
+	CreatedBy     string             `db:"backup_created_by"      json:"created_by"`
+	Started       int64              `db:"backup_started"         json:"started"`
+	Finished      int64              `db:"backup_finished"        json:"finished"`
+
+	// state of the latest restore of the backup
+	RestoreStatus enum.BackupStatus `db:"backup_restore_status"  json:"restore_status"`
+	RestoreError  string            `db:"backup_restore_error"   json:"restore_error"`
+	Restored      int64             `db:"backup_restored"        json:"restored"`
+
+	Created int64 `db:"backup_created" json:"created"`
+	Updated int64 `db:"backup_updated" json:"updated"`
+}
+
+// BackupJob describes a backup or restore run of a database application on the server.
+type BackupJob struct {
+	Name   string
+	Image  string
+	Script string
+	Env    map[string]string
+}
+
+// BackupPolicyInput is the form input of a backup policy.
+type BackupPolicyInput struct {
+	Enabled   bool   `json:"enabled,string"`
+	Schedule  string `json:"schedule"`
+	Retention int    `json:"retention,string"`
+}
+
+// BackupRestoreInput is the form input of a restore.
+type BackupRestoreInput struct {
+	Target enum.BackupRestoreTarget `json:"target"`
+}
+
+// ToInput maps the policy to the form input.
+func (p *BackupPolicy) ToInput() *BackupPolicyInput {
+	return &BackupPolicyInput{
+		Enabled:   p.Enabled,
+		Schedule:  p.Schedule,
+		Retention: p.Retention,
+	}
+}
+
+// IsRestoring returns true while a restore of the backup is queued or running.
+func (b *Backup) IsRestoring() bool {
+	return b.RestoreStatus == enum.BackupStatusPending || b.RestoreStatus == enum.BackupStatusRunning
+}
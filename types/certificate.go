@@ -12,4 +12,5 @@ type Certificate struct {
 	NotAfter    time.Time
 	RenewalTime time.Time
 	Ready       string
+	Message     string
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/cloudness-io/cloudness/blob"
 	"github.com/cloudness-io/cloudness/lock"
 	"github.com/cloudness-io/cloudness/pubsub"
 )
@@ -40,6 +41,15 @@ type Config struct {
 		UseStaging bool   `envconfig:"CLOUDNESS_ACME_USE_STAGING" default:"false"`
 	}
 
+	// Encrypter defines the parameters used to encrypt secrets at rest,
+	// the secret must be 32 bytes long.
+	Encrypter struct {
+		Secret string `envconfig:"CLOUDNESS_ENCRYPTER_SECRET"`
+		// KeyFile holds the secret generated on first boot when no secret is set, it is only
+		// used with the sqlite3 driver where it is kept next to the database.
+		KeyFile string `envconfig:"CLOUDNESS_ENCRYPTER_KEY_FILE" default:"encrypter.key"`
+	}
+
 	// Token defines token configuration parameters.
 	Token struct {
 		CookieName string        `envconfig:"CLOUDNESS_TOKEN_COOKIE_NAME" default:"token"`
@@ -58,6 +68,49 @@ type Config struct {
 		SSLMode    string `envconfig:"CLOUDNESS_DATABASE_SSL_MODE"`
 	}
 
+	// BlobStore defines the blob storage configuration parameters,
+	// the bucket is the base directory when using the filesystem provider.
+	BlobStore struct {
+		Provider     blob.Provider `envconfig:"CLOUDNESS_BLOBSTORE_PROVIDER"       default:"filesystem"`
+		Bucket       string        `envconfig:"CLOUDNESS_BLOBSTORE_BUCKET"         default:"blobs"`
+		Endpoint     string        `envconfig:"CLOUDNESS_BLOBSTORE_ENDPOINT"`
+		Region       string        `envconfig:"CLOUDNESS_BLOBSTORE_REGION"`
+		AccessKey    string        `envconfig:"CLOUDNESS_BLOBSTORE_ACCESS_KEY"`
+		SecretKey    string        `envconfig:"CLOUDNESS_BLOBSTORE_SECRET_KEY"`
+		UseSSL       bool          `envconfig:"CLOUDNESS_BLOBSTORE_USE_SSL"        default:"true"`
+		UsePathStyle bool          `envconfig:"CLOUDNESS_BLOBSTORE_USE_PATH_STYLE"`
+	}
+
+	// LogArchive defines when the logs of finished deployments are moved from the database to the blob store.
+	LogArchive struct {
+		Enabled   bool `envconfig:"CLOUDNESS_LOG_ARCHIVE_ENABLED"    default:"false"`
+		AfterDays int  `envconfig:"CLOUDNESS_LOG_ARCHIVE_AFTER_DAYS" default:"14"`
+	}
+
+	// Notification defines the delivery of notifications, email channels require the smtp server.
+	Notification struct {
+		VolumeUsageThreshold int `envconfig:"CLOUDNESS_NOTIFICATION_VOLUME_THRESHOLD" default:"90"`
+
+		SMTP struct {
+			Host     string `envconfig:"CLOUDNESS_SMTP_HOST"`
+			Port     int    `envconfig:"CLOUDNESS_SMTP_PORT"     default:"587"`
+			Username string `envconfig:"CLOUDNESS_SMTP_USERNAME"`
+			Password string `envconfig:"CLOUDNESS_SMTP_PASSWORD"`
+			From     string `envconfig:"CLOUDNESS_SMTP_FROM"     default:"cloudness@localhost.com"`
+		}
+	}
+
+	// Runner defines the runner api of the control plane and the standalone agent connecting to it.
+	Runner struct {
+		// Embedded runs an agent for the primary server in the server process.
+		Embedded bool `envconfig:"CLOUDNESS_RUNNER_EMBEDDED" default:"true"`
+
+		// ServerURL and Token configure the standalone agent, it runs the deployments of the server
+		// the token was issued for.
+		ServerURL string `envconfig:"CLOUDNESS_RUNNER_SERVER_URL"`
+		Token     string `envconfig:"CLOUDNESS_RUNNER_TOKEN"`
+	}
+
 	PubSub struct {
 		Provider         pubsub.Provider `envconfig:"CLOUDNESS_PUBSUB_PROVIDER"          default:"inmemory"`
 		AppNamespace     string          `envconfig:"CLOUDNESS_PUBSUB_APP_NAMESPACE"     default:"cloudness"`
@@ -178,5 +231,6 @@ type TenantConfig struct {
 }
 
 type KubeServerConfig struct {
-	DefaultVolumeSupportsOnlineExpansion bool `envconfig:"CLOUDNESS_KUBE_UNMOUNT_BEFORE_RESIZE"  default:"true"`
+	DefaultVolumeSupportsOnlineExpansion bool   `envconfig:"CLOUDNESS_KUBE_UNMOUNT_BEFORE_RESIZE"  default:"true"`
+	DefaultVolumeSnapshotClass           string `envconfig:"CLOUDNESS_KUBE_VOLUME_SNAPSHOT_CLASS"`
 }
@@ -0,0 +1,11 @@
+package types
+
+// CronRun is a single execution of a cron application.
+type CronRun struct {
+	Name      string `json:"name"`
+	Status    string `json:"status"`
+	Started   int64  `json:"started,omitempty"`
+	Finished  int64  `json:"finished,omitempty"`
+	ExitCode  *int32 `json:"exit_code,omitempty"`
+	Succeeded bool   `json:"succeeded"`
+}
@@ -13,6 +13,8 @@ type Deployment struct {
 	ApplicationID int64                 `db:"deployment_application_id"  json:"application_id"`
 	SpecJson      string                `db:"deployment_spec"            json:"spec"`
 	Spec          *ApplicationSpec      `db:"-"                          json:"-"`
+	BaseSpecJson  string                `db:"deployment_base_spec"       json:"base_spec,omitempty"`
+	BaseSpec      *ApplicationSpec      `db:"-"                          json:"-"`
 	NeedsBuild    bool                  `db:"deployment_needs_build"     json:"needs_build"`
 	Triggerer     string                `db:"deployment_triggerer"       json:"triggerer"`
 	Title         string                `db:"deployment_title"           json:"title"`
@@ -21,6 +23,8 @@ type Deployment struct {
 	Error         string                `db:"deployment_error"           json:"error"`
 	Version       int64                 `db:"deployment_version"         json:"version"`
 	Machine       string                `db:"deployment_machine"         json:"machine,omitempty"`
+	SourceUID     *int64                `db:"deployment_source_uid"      json:"source_uid,omitempty"`
+	ImageUID      *int64                `db:"deployment_image_uid"       json:"image_uid,omitempty"`
 	Started       int64                 `db:"deployment_started"         json:"started"`
 	Stopped       int64                 `db:"deployment_stopped"         json:"stopped"`
 	Created       int64                 `db:"deployment_created"         json:"created"`
@@ -46,6 +50,26 @@ func (d *Deployment) GetInfo() *DeploymentInfo {
 	return info
 }
 
+// GetImageUID returns the uid of the deployment that built the image, rollbacks reuse the image
+// of the deployment they were rolled back from.
+func (d *Deployment) GetImageUID() int64 {
+	if d.ImageUID != nil {
+		return *d.ImageUID
+	}
+	return d.UID
+}
+
+// HasRepoConfig returns true if the repository config was merged over the spec of the application settings,
+// BaseSpec then holds the spec of the settings.
+func (d *Deployment) HasRepoConfig() bool {
+	return d.BaseSpecJson != ""
+}
+
+// IsRollback returns true if the deployment restores a previous deployment.
+func (d *Deployment) IsRollback() bool {
+	return d.Action == enum.TriggerActionRollback
+}
+
 func (d *Deployment) Start() {
 	d.Status = enum.DeploymentStatusRunning
 	d.Started = time.Now().UTC().UnixMilli()
@@ -6,4 +6,5 @@ const (
 	ApplicationTypeStateless  ApplicationType = "Stateless"
 	ApplicationTypeStateful   ApplicationType = "Stateful"
 	ApplicationTypePostgresHA ApplicationType = "PostgresHA"
+	ApplicationTypeCron       ApplicationType = "Cron"
 )
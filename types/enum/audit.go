@@ -0,0 +1,103 @@
+package enum
+
+// AuditAction represents the kind of change recorded by an audit event.
+type AuditAction string
+
+const (
+	AuditActionCreated     AuditAction = "created"
+	AuditActionUpdated     AuditAction = "updated"
+	AuditActionDeleted     AuditAction = "deleted"
+	AuditActionDeployed    AuditAction = "deployed"
+	AuditActionRoleChanged AuditAction = "role_changed"
+	AuditActionRestored    AuditAction = "restored"
+	AuditActionExported    AuditAction = "exported"
+)
+
+var AuditActionsStr = []string{
+	string(AuditActionCreated),
+	string(AuditActionUpdated),
+	string(AuditActionDeleted),
+	string(AuditActionDeployed),
+	string(AuditActionRoleChanged),
+	string(AuditActionRestored),
+	string(AuditActionExported),
+}
+
+func AuditActionFromString(s string) AuditAction {
+	switch s {
+	case string(AuditActionCreated):
+		return AuditActionCreated
+	case string(AuditActionUpdated):
+		return AuditActionUpdated
+	case string(AuditActionDeleted):
+		return AuditActionDeleted
+	case string(AuditActionDeployed):
+		return AuditActionDeployed
+	case string(AuditActionRoleChanged):
+		return AuditActionRoleChanged
+	case string(AuditActionRestored):
+		return AuditActionRestored
+	case string(AuditActionExported):
+		return AuditActionExported
+	default:
+		return ""
+	}
+}
+
+// AuditResourceType represents the kind of resource an audit event was recorded for.
+type AuditResourceType string
+
+const (
+	AuditResourceTenant              AuditResourceType = "tenant"
+	AuditResourceTenantMember        AuditResourceType = "tenant_member"
+	AuditResourceProject             AuditResourceType = "project"
+	AuditResourceProjectMember       AuditResourceType = "project_member"
+	AuditResourceEnvironment         AuditResourceType = "environment"
+	AuditResourceApplication         AuditResourceType = "application"
+	AuditResourceDomain              AuditResourceType = "domain"
+	AuditResourceVariable            AuditResourceType = "variable"
+	AuditResourceVolume              AuditResourceType = "volume"
+	AuditResourceRegistryCredential  AuditResourceType = "registry_credential"
+	AuditResourceGitConnection       AuditResourceType = "git_connection"
+	AuditResourceGithubApp           AuditResourceType = "github_app"
+	AuditResourceServiceAccount      AuditResourceType = "service_account"
+	AuditResourceToken               AuditResourceType = "token"
+	AuditResourceBackup              AuditResourceType = "backup"
+	AuditResourceBackupPolicy        AuditResourceType = "backup_policy"
+	AuditResourceVolumeSnapshot      AuditResourceType = "volume_snapshot"
+	AuditResourceSnapshotPolicy      AuditResourceType = "snapshot_policy"
+	AuditResourceNotificationChannel AuditResourceType = "notification_channel"
+	AuditResourceTemplateSource      AuditResourceType = "template_source"
+)
+
+var AuditResourceTypesStr = []string{
+	string(AuditResourceTenant),
+	string(AuditResourceTenantMember),
+	string(AuditResourceProject),
+	string(AuditResourceProjectMember),
+	string(AuditResourceEnvironment),
+	string(AuditResourceApplication),
+	string(AuditResourceDomain),
+	string(AuditResourceVariable),
+	string(AuditResourceVolume),
+	string(AuditResourceRegistryCredential),
+	string(AuditResourceGitConnection),
+	string(AuditResourceGithubApp),
+	string(AuditResourceServiceAccount),
+	string(AuditResourceToken),
+	string(AuditResourceBackup),
+	string(AuditResourceBackupPolicy),
+	string(AuditResourceVolumeSnapshot),
+	string(AuditResourceSnapshotPolicy),
+	string(AuditResourceNotificationChannel),
+	string(AuditResourceTemplateSource),
+}
+
+func AuditResourceTypeFromString(s string) AuditResourceType {
+	for _, t := range AuditResourceTypesStr {
+		if t == s {
+			return AuditResourceType(s)
+		}
+	}
+	return ""
+}
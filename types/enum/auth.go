@@ -7,6 +7,7 @@ const (
 	AuthProviderGithub   AuthProvider = "github"
 	AuthProviderGitlab   AuthProvider = "gitlab"
 	AuthProviderGoogle   AuthProvider = "google"
+	AuthProviderOIDC     AuthProvider = "oidc"
 )
 
 func ProviderFromString(s string) AuthProvider {
@@ -19,7 +20,27 @@ func ProviderFromString(s string) AuthProvider {
 		return AuthProviderGitlab
 	case string(AuthProviderGoogle):
 		return AuthProviderGoogle
+	case string(AuthProviderOIDC):
+		return AuthProviderOIDC
 	default:
 		return ""
 	}
 }
+
+// DisplayName returns the human readable name of the provider.
+func (p AuthProvider) DisplayName() string {
+	switch p {
+	case AuthProviderPassword:
+		return "Password"
+	case AuthProviderGithub:
+		return "Github"
+	case AuthProviderGitlab:
+		return "GitLab"
+	case AuthProviderGoogle:
+		return "Google"
+	case AuthProviderOIDC:
+		return "OpenID Connect"
+	default:
+		return string(p)
+	}
+}
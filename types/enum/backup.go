@@ -0,0 +1,42 @@
+package enum
+
+// BackupKind represents the database engine of a backup, it decides the dump and restore commands.
+type BackupKind string
+
+const (
+	BackupKindPostgres BackupKind = "postgres"
+	BackupKindMySQL    BackupKind = "mysql"
+	BackupKindRedis    BackupKind = "redis"
+	BackupKindValkey   BackupKind = "valkey"
+)
+
+// BackupStatus represents the state of a backup or restore run.
+type BackupStatus string
+
+const (
+	BackupStatusPending   BackupStatus = "pending"
+	BackupStatusRunning   BackupStatus = "running"
+	BackupStatusSucceeded BackupStatus = "succeeded"
+	BackupStatusFailed    BackupStatus = "failed"
+)
+
+// IsDone returns true if the run has finished.
+func (s BackupStatus) IsDone() bool {
+	return s == BackupStatusSucceeded || s == BackupStatusFailed
+}
+
+// BackupTrigger represents what started a backup.
+type BackupTrigger string
+
+const (
+	BackupTriggerSchedule BackupTrigger = "schedule"
+	BackupTriggerManual   BackupTrigger = "manual"
+)
+
+// BackupRestoreTarget represents where a backup is restored to.
+type BackupRestoreTarget string
+
+const (
+	BackupRestoreTargetSame BackupRestoreTarget = "same"
+	BackupRestoreTargetNew  BackupRestoreTarget = "new"
+)
@@ -10,11 +10,14 @@ const (
 
 	BuilderTypeNixpacks BuilderType = "Nixpacks"
 
+	BuilderTypeBuildpacks BuilderType = "Buildpacks"
+
 	BuilderTypeStatic BuilderType = "Static"
 )
 
 var builderTypesStr = sortEnum([]string{
 	string(BuilderTypeDockerfile),
 	string(BuilderTypeNixpacks),
+	string(BuilderTypeBuildpacks),
 	string(BuilderTypeStatic),
 })
@@ -0,0 +1,29 @@
+package enum
+
+// CronConcurrencyPolicy defines how overlapping runs of a cron application are handled.
+type CronConcurrencyPolicy string
+
+const (
+	CronConcurrencyPolicyAllow   CronConcurrencyPolicy = "Allow"
+	CronConcurrencyPolicyForbid  CronConcurrencyPolicy = "Forbid"
+	CronConcurrencyPolicyReplace CronConcurrencyPolicy = "Replace"
+)
+
+var CronConcurrencyPoliciesStr = []string{
+	string(CronConcurrencyPolicyForbid),
+	string(CronConcurrencyPolicyAllow),
+	string(CronConcurrencyPolicyReplace),
+}
+
+func CronConcurrencyPolicyFromString(s string) CronConcurrencyPolicy {
+	switch s {
+	case string(CronConcurrencyPolicyAllow):
+		return CronConcurrencyPolicyAllow
+	case string(CronConcurrencyPolicyForbid):
+		return CronConcurrencyPolicyForbid
+	case string(CronConcurrencyPolicyReplace):
+		return CronConcurrencyPolicyReplace
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,29 @@
+package enum
+
+// ExportFormat represents the layout of an exported bundle of kubernetes manifests.
+type ExportFormat string
+
+const (
+	ExportFormatManifests ExportFormat = "manifests"
+	ExportFormatKustomize ExportFormat = "kustomize"
+	ExportFormatHelm      ExportFormat = "helm"
+)
+
+var ExportFormatsStr = []string{
+	string(ExportFormatManifests),
+	string(ExportFormatKustomize),
+	string(ExportFormatHelm),
+}
+
+func ExportFormatFromString(s string) ExportFormat {
+	switch s {
+	case string(ExportFormatManifests):
+		return ExportFormatManifests
+	case string(ExportFormatKustomize):
+		return ExportFormatKustomize
+	case string(ExportFormatHelm):
+		return ExportFormatHelm
+	default:
+		return ""
+	}
+}
@@ -6,4 +6,59 @@ const (
 	GitHubProvider GitProvider = "GITHUB"
 
 	GitLabProvider GitProvider = "GITLAB"
+
+	GiteaProvider GitProvider = "GITEA"
+
+	BitbucketProvider GitProvider = "BITBUCKET"
 )
+
+// GitConnectionProvidersStr lists the providers a git connection can be created for,
+// github repositories are connected through github apps instead.
+var GitConnectionProvidersStr = []string{
+	string(GitLabProvider),
+	string(GiteaProvider),
+	string(BitbucketProvider),
+}
+
+func GitConnectionProviderFromString(s string) GitProvider {
+	switch s {
+	case string(GitLabProvider):
+		return GitLabProvider
+	case string(GiteaProvider):
+		return GiteaProvider
+	case string(BitbucketProvider):
+		return BitbucketProvider
+	default:
+		return ""
+	}
+}
+
+// DisplayName returns the human readable name of the provider.
+func (p GitProvider) DisplayName() string {
+	switch p {
+	case GitHubProvider:
+		return "GitHub"
+	case GitLabProvider:
+		return "GitLab"
+	case GiteaProvider:
+		return "Gitea"
+	case BitbucketProvider:
+		return "Bitbucket"
+	default:
+		return string(p)
+	}
+}
+
+// DefaultServer returns the address of the hosted offering of the provider, empty for self-hosted only providers.
+func (p GitProvider) DefaultServer() string {
+	switch p {
+	case GitHubProvider:
+		return "https://github.com"
+	case GitLabProvider:
+		return "https://gitlab.com"
+	case BitbucketProvider:
+		return "https://bitbucket.org"
+	default:
+		return ""
+	}
+}
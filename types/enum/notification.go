@@ -0,0 +1,103 @@
+package enum
+
+// NotificationChannelType represents the service a notification channel delivers to.
+type NotificationChannelType string
+
+const (
+	NotificationChannelTypeSlack   NotificationChannelType = "slack"
+	NotificationChannelTypeDiscord NotificationChannelType = "discord"
+	NotificationChannelTypeEmail   NotificationChannelType = "email"
+	NotificationChannelTypeWebhook NotificationChannelType = "webhook"
+)
+
+var NotificationChannelTypesStr = []string{
+	string(NotificationChannelTypeSlack),
+	string(NotificationChannelTypeDiscord),
+	string(NotificationChannelTypeEmail),
+	string(NotificationChannelTypeWebhook),
+}
+
+func NotificationChannelTypeFromString(s string) NotificationChannelType {
+	switch s {
+	case string(NotificationChannelTypeSlack):
+		return NotificationChannelTypeSlack
+	case string(NotificationChannelTypeDiscord):
+		return NotificationChannelTypeDiscord
+	case string(NotificationChannelTypeEmail):
+		return NotificationChannelTypeEmail
+	case string(NotificationChannelTypeWebhook):
+		return NotificationChannelTypeWebhook
+	default:
+		return ""
+	}
+}
+
+// NotificationEvent represents the kind of event a notification channel subscribes to.
+type NotificationEvent string
+
+const (
+	NotificationEventDeployStarted     NotificationEvent = "deploy_started"
+	NotificationEventDeploySucceeded   NotificationEvent = "deploy_succeeded"
+	NotificationEventDeployFailed      NotificationEvent = "deploy_failed"
+	NotificationEventDeployCancelled   NotificationEvent = "deploy_cancelled"
+	NotificationEventAppCrashed        NotificationEvent = "app_crashed"
+	NotificationEventCertificateFailed NotificationEvent = "certificate_failed"
+	NotificationEventVolumeNearFull    NotificationEvent = "volume_near_full"
+)
+
+var NotificationEventsStr = []string{
+	string(NotificationEventDeployStarted),
+	string(NotificationEventDeploySucceeded),
+	string(NotificationEventDeployFailed),
+	string(NotificationEventDeployCancelled),
+	string(NotificationEventAppCrashed),
+	string(NotificationEventCertificateFailed),
+	string(NotificationEventVolumeNearFull),
+}
+
+func NotificationEventFromString(s string) NotificationEvent {
+	for _, e := range NotificationEventsStr {
+		if e == s {
+			return NotificationEvent(s)
+		}
+	}
+	return ""
+}
+
+// Title returns the human readable name of the event.
+func (e NotificationEvent) Title() string {
+	switch e {
+	case NotificationEventDeployStarted:
+		return "Deployment started"
+	case NotificationEventDeploySucceeded:
+		return "Deployment succeeded"
+	case NotificationEventDeployFailed:
+		return "Deployment failed"
+	case NotificationEventDeployCancelled:
+		return "Deployment cancelled"
+	case NotificationEventAppCrashed:
+		return "Application crashed"
+	case NotificationEventCertificateFailed:
+		return "Certificate failed"
+	case NotificationEventVolumeNearFull:
+		return "Volume near full"
+	default:
+		return string(e)
+	}
+}
+
+// NotificationEventFromDeploymentStatus returns the event of a deployment status, empty for statuses that are not notified.
+func NotificationEventFromDeploymentStatus(status DeploymentStatus) NotificationEvent {
+	switch status {
+	case DeploymentStatusRunning:
+		return NotificationEventDeployStarted
+	case DeploymentStatusSuccess:
+		return NotificationEventDeploySucceeded
+	case DeploymentStatusFailed:
+		return NotificationEventDeployFailed
+	case DeploymentStatusCancelled:
+		return NotificationEventDeployCancelled
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,53 @@
+package enum
+
+// RegistryProvider represents the container registry a credential authenticates against.
+type RegistryProvider string
+
+const (
+	RegistryProviderDockerHub RegistryProvider = "dockerhub"
+	RegistryProviderGHCR      RegistryProvider = "ghcr"
+	RegistryProviderECR       RegistryProvider = "ecr"
+	RegistryProviderGeneric   RegistryProvider = "generic"
+)
+
+var RegistryProvidersStr = []string{
+	string(RegistryProviderDockerHub),
+	string(RegistryProviderGHCR),
+	string(RegistryProviderECR),
+	string(RegistryProviderGeneric),
+}
+
+func RegistryProviderFromString(s string) RegistryProvider {
+	switch s {
+	case string(RegistryProviderDockerHub):
+		return RegistryProviderDockerHub
+	case string(RegistryProviderGHCR):
+		return RegistryProviderGHCR
+	case string(RegistryProviderECR):
+		return RegistryProviderECR
+	case string(RegistryProviderGeneric):
+		return RegistryProviderGeneric
+	default:
+		return ""
+	}
+}
+
+// DefaultServer returns the well known server of the provider, empty for registries without one.
+func (p RegistryProvider) DefaultServer() string {
+	switch p {
+	case RegistryProviderDockerHub:
+		return "https://index.docker.io/v1/"
+	case RegistryProviderGHCR:
+		return "ghcr.io"
+	default:
+		return ""
+	}
+}
+
+// DefaultUsername returns the fixed username of the provider, ecr tokens always authenticate as AWS.
+func (p RegistryProvider) DefaultUsername() string {
+	if p == RegistryProviderECR {
+		return "AWS"
+	}
+	return ""
+}
@@ -6,3 +6,21 @@ const (
 	ServerTypeK8s    ServerType = "k8s"
 	ServerTypeDocker ServerType = "docker"
 )
+
+// ServerHealthStatus is the result of the last health check of a server.
+type ServerHealthStatus string
+
+const (
+	ServerHealthStatusUnknown     ServerHealthStatus = "unknown"
+	ServerHealthStatusHealthy     ServerHealthStatus = "healthy"
+	ServerHealthStatusDegraded    ServerHealthStatus = "degraded"
+	ServerHealthStatusUnreachable ServerHealthStatus = "unreachable"
+)
+
+// ServerCredentialType is how the credentials of a cluster are provided.
+type ServerCredentialType string
+
+const (
+	ServerCredentialTypeKubeconfig ServerCredentialType = "kubeconfig"
+	ServerCredentialTypeToken      ServerCredentialType = "token"
+)
@@ -0,0 +1,25 @@
+package enum
+
+// TemplateSourceType represents where the templates of a template source are read from.
+type TemplateSourceType string
+
+const (
+	TemplateSourceTypeGit   TemplateSourceType = "git"
+	TemplateSourceTypeHTTPS TemplateSourceType = "https"
+)
+
+var TemplateSourceTypesStr = []string{
+	string(TemplateSourceTypeGit),
+	string(TemplateSourceTypeHTTPS),
+}
+
+func TemplateSourceTypeFromString(s string) TemplateSourceType {
+	switch s {
+	case string(TemplateSourceTypeGit):
+		return TemplateSourceTypeGit
+	case string(TemplateSourceTypeHTTPS):
+		return TemplateSourceTypeHTTPS
+	default:
+		return ""
+	}
+}
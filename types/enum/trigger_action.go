@@ -3,7 +3,8 @@ package enum
 type TriggerAction string
 
 const (
-	TriggerActionCreate TriggerAction = "Created"
-	TriggerActionManual TriggerAction = "Manual"
-	TriggerActionHook   TriggerAction = "Hook"
+	TriggerActionCreate   TriggerAction = "Created"
+	TriggerActionManual   TriggerAction = "Manual"
+	TriggerActionHook     TriggerAction = "Hook"
+	TriggerActionRollback TriggerAction = "Rollback"
 )
@@ -0,0 +1,23 @@
+package enum
+
+// VolumeSnapshotStatus represents the state of a volume snapshot on the server.
+type VolumeSnapshotStatus string
+
+const (
+	VolumeSnapshotStatusPending VolumeSnapshotStatus = "pending"
+	VolumeSnapshotStatusReady   VolumeSnapshotStatus = "ready"
+	VolumeSnapshotStatusFailed  VolumeSnapshotStatus = "failed"
+)
+
+// IsDone returns true if the snapshot is no longer being taken.
+func (s VolumeSnapshotStatus) IsDone() bool {
+	return s == VolumeSnapshotStatusReady || s == VolumeSnapshotStatusFailed
+}
+
+// VolumeSnapshotTrigger represents what started a volume snapshot.
+type VolumeSnapshotTrigger string
+
+const (
+	VolumeSnapshotTriggerSchedule VolumeSnapshotTrigger = "schedule"
+	VolumeSnapshotTriggerManual   VolumeSnapshotTrigger = "manual"
+)
@@ -11,6 +11,12 @@ type Environment struct {
 	Name      string `db:"environment_name"         json:"name"`
 	Slug      string `db:"environment_slug"         json:"slug"`
 	CreateBy  int64  `db:"environment_created_by"   json:"-"`
+	ServerID  int64  `db:"environment_server_id"    json:"-"`
+
+	// Preview environments are ephemeral clones of a source environment for a pull request.
+	PreviewSourceID *int64 `db:"environment_preview_source_id" json:"-"`
+	PreviewRepo     string `db:"environment_preview_repo"      json:"preview_repo,omitempty"`
+	PreviewNumber   int64  `db:"environment_preview_number"    json:"preview_number,omitempty"`
 
 	Created int64  `db:"environment_created"                json:"created"`
 	Updated int64  `db:"environment_updated"                json:"updated"`
@@ -22,8 +28,17 @@ type EnvironmentFilter struct {
 	ListQueryFilter
 	TenantID          *int64               `json:"tenant_id,omitempty"`
 	ProjectID         *int64               `json:"project_id,omitempty"`
+	ServerID          *int64               `json:"server_id,omitempty"`
 	Sort              enum.EnvironmentAttr `json:"sort"`
 	Order             enum.Order           `json:"order"`
 	DeletedAt         *int64               `json:"deleted_at,omitempty"`
 	DeletedBeforeOrAt *int64               `json:"deleted_before_or_at,omitempty"`
+	PreviewSourceID   *int64               `json:"preview_source_id,omitempty"`
+	PreviewRepo       *string              `json:"preview_repo,omitempty"`
+	PreviewNumber     *int64               `json:"preview_number,omitempty"`
+}
+
+// IsPreview returns true if the environment is a pull request preview.
+func (e *Environment) IsPreview() bool {
+	return e.PreviewSourceID != nil
 }
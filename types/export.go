@@ -0,0 +1,9 @@
+package types
+
+import "github.com/cloudness-io/cloudness/types/enum"
+
+// ExportInput configures the export of the applications of a project or environment.
+type ExportInput struct {
+	Format enum.ExportFormat `json:"format"`
+	Redact bool              `json:"redact"` // exports secrets without their values
+}
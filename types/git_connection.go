@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+// GitConnection connects a project to the repositories of a GitLab, Gitea or Bitbucket account
+// through an access token.
+type GitConnection struct {
+	ID            int64            `db:"git_connection_id"              json:"-"`
+	UID           int64            `db:"git_connection_uid"             json:"uid"`
+	TenantID      int64            `db:"git_connection_tenant_id"       json:"-"`
+	ProjectID     int64            `db:"git_connection_project_id"      json:"-"`
+	Name          string           `db:"git_connection_name"            json:"name"`
+	Provider      enum.GitProvider `db:"git_connection_provider"        json:"provider"`
+	ServerURL     string           `db:"git_connection_server_url"      json:"server_url"`
+	Username      string           `db:"git_connection_username"        json:"username"`
+	Secret        []byte           `db:"git_connection_secret"          json:"-"` // encrypted access token
+	WebhookSecret string           `db:"git_connection_webhook_secret"  json:"-"`
+	CreatedBy     int64            `db:"git_connection_created_by"      json:"-"`
+	Created       int64            `db:"git_connection_created"         json:"created"`
+	Updated       int64            `db:"git_connection_updated"         json:"updated"`
+
+	// Token is the decrypted access token, only populated when the connection is resolved.
+	Token string `db:"-" json:"-"`
+}
+
+// GetHttpUrl returns the clone url of the repository.
+func (c *GitConnection) GetHttpUrl(repo string) string {
+	return fmt.Sprintf("%s/%s.git", c.ServerURL, repo)
+}
+
+// RepoFullName returns the path of the repository on the server, including nested groups,
+// or empty when the url does not belong to the connection.
+func (c *GitConnection) RepoFullName(repoURL string) string {
+	path, ok := strings.CutPrefix(repoURL, c.ServerURL+"/")
+	if !ok {
+		return ""
+	}
+	return strings.TrimSuffix(path, ".git")
+}
+
+// NetrcLogin returns the login used alongside the access token when cloning over https.
+func (c *GitConnection) NetrcLogin() string {
+	if c.Username != "" {
+		return c.Username
+	}
+	if c.Provider == enum.BitbucketProvider {
+		return "x-token-auth"
+	}
+	return "oauth2"
+}
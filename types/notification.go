@@ -0,0 +1,86 @@
+package types
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+// NotificationChannel delivers the subscribed events of a tenant, or of a single project when ProjectID is set.
+type NotificationChannel struct {
+	ID          int64                        `db:"notification_channel_id"          json:"-"`
+	UID         int64                        `db:"notification_channel_uid"         json:"uid"`
+	TenantID    int64                        `db:"notification_channel_tenant_id"   json:"-"`
+	ProjectID   *int64                       `db:"notification_channel_project_id"  json:"-"`
+	Name        string                       `db:"notification_channel_name"        json:"name"`
+	Type        enum.NotificationChannelType `db:"notification_channel_type"        json:"type"`
+	Events      string                       `db:"notification_channel_events"      json:"events"` // comma separated list of events
+	Destination []byte                       `db:"notification_channel_destination" json:"-"`      // encrypted webhook url or email recipients
+	SigningKey  []byte                       `db:"notification_channel_signing_key" json:"-"`      // encrypted signing key of generic webhooks
+	CreatedBy   int64                        `db:"notification_channel_created_by"  json:"-"`
+	Created     int64                        `db:"notification_channel_created"     json:"created"`
+	Updated     int64                        `db:"notification_channel_updated"     json:"updated"`
+
+	// Target and Secret are the decrypted destination and signing key, only populated when the channel is resolved.
+	Target string `db:"-" json:"-"`
+	Secret string `db:"-" json:"-"`
+}
+
+// EventList returns the events the channel subscribes to.
+func (c *NotificationChannel) EventList() []enum.NotificationEvent {
+	events := []enum.NotificationEvent{}
+	for _, e := range strings.Split(c.Events, ",") {
+		if event := enum.NotificationEventFromString(strings.TrimSpace(e)); event != "" {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+// Subscribes returns true if the channel subscribes to the event.
+func (c *NotificationChannel) Subscribes(event enum.NotificationEvent) bool {
+	for _, e := range c.EventList() {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// DisplayTarget returns the resolved target without the secret path of webhook urls.
+func (c *NotificationChannel) DisplayTarget() string {
+	if c.Type == enum.NotificationChannelTypeEmail {
+		return strings.ReplaceAll(c.Target, ",", ", ")
+	}
+	u, err := url.Parse(c.Target)
+	if err != nil || u.Host == "" {
+		return ""
+	}
+	return u.Scheme + "://" + u.Host + "/…"
+}
+
+// NotificationMessage is the content delivered to notification channels, it is the payload of generic webhooks.
+type NotificationMessage struct {
+	Event      enum.NotificationEvent `json:"event"`
+	Title      string                 `json:"title"`
+	Text       string                 `json:"text"`
+	Attributes map[string]string      `json:"attributes,omitempty"`
+	Timestamp  int64                  `json:"timestamp"`
+}
+
+// VolumeUsage is the disk usage of a persistent volume claim reported by the kubelet.
+type VolumeUsage struct {
+	Namespace     string
+	Name          string
+	UsedBytes     int64
+	CapacityBytes int64
+}
+
+// UsedPercent returns the used share of the volume capacity.
+func (u *VolumeUsage) UsedPercent() float64 {
+	if u.CapacityBytes <= 0 {
+		return 0
+	}
+	return float64(u.UsedBytes) * 100 / float64(u.CapacityBytes)
+}
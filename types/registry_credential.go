@@ -0,0 +1,59 @@
+package types
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+// RegistryCredential stores the login of a private container registry for the tenant.
+type RegistryCredential struct {
+	ID        int64                 `db:"registry_credential_id"          json:"-"`
+	UID       int64                 `db:"registry_credential_uid"         json:"uid"`
+	TenantID  int64                 `db:"registry_credential_tenant_id"   json:"-"`
+	Name      string                `db:"registry_credential_name"        json:"name"`
+	Provider  enum.RegistryProvider `db:"registry_credential_provider"    json:"provider"`
+	Server    string                `db:"registry_credential_server"      json:"server"`
+	Username  string                `db:"registry_credential_username"    json:"username"`
+	Secret    []byte                `db:"registry_credential_secret"      json:"-"` // encrypted password or token
+	CreatedBy int64                 `db:"registry_credential_created_by"  json:"-"`
+	Created   int64                 `db:"registry_credential_created"     json:"created"`
+	Updated   int64                 `db:"registry_credential_updated"     json:"updated"`
+
+	// Password is the decrypted secret, only populated when the credential is resolved for a deployment.
+	Password string `db:"-" json:"-"`
+}
+
+// DockerConfigJSON returns the base64 encoded content of a kubernetes.io/dockerconfigjson secret.
+func (c *RegistryCredential) DockerConfigJSON() (string, error) {
+	auth := base64.StdEncoding.EncodeToString([]byte(c.Username + ":" + c.Password))
+	config := map[string]any{
+		"auths": map[string]any{
+			c.Server: map[string]string{
+				"username": c.Username,
+				"password": c.Password,
+				"auth":     auth,
+			},
+		},
+	}
+
+	out, err := json.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(out), nil
+}
+
+// EngineAuth returns the credentials encoded for the registry auth header of the docker engine.
+func (c *RegistryCredential) EngineAuth() (string, error) {
+	out, err := json.Marshal(map[string]string{
+		"username":      c.Username,
+		"password":      c.Password,
+		"serveraddress": c.Server,
+	})
+	if err != nil {
+		return "", err
+	}
+	return base64.URLEncoding.EncodeToString(out), nil
+}
@@ -0,0 +1,23 @@
+package types
+
+// RunnerDeployment is the deployment exchanged with remote agents, the json of Deployment omits the id.
+type RunnerDeployment struct {
+	ID int64 `json:"id"`
+	Deployment
+}
+
+// RunnerAcceptInput is the machine accepting a deployment.
+type RunnerAcceptInput struct {
+	Machine string `json:"machine"`
+}
+
+// RunnerWatchResult is the result of watching a deployment for cancellation, agents watch again on retry.
+type RunnerWatchResult struct {
+	Done  bool `json:"done"`
+	Retry bool `json:"retry"`
+}
+
+// RunnerServer identifies the server an agent runs deployments for.
+type RunnerServer struct {
+	UID int64 `json:"uid"`
+}
@@ -1,5 +1,7 @@
 package types
 
+import "github.com/cloudness-io/cloudness/types/enum"
+
 type RunnerConfig struct {
 	EnableRunner    bool
 	Engine          string
@@ -9,6 +11,7 @@ type RunnerConfig struct {
 	MaxCPU          float64
 	MaxMemory       float64
 	UpdatedAt       int64
+	ServerType      enum.ServerType
 
 	//kubernetes
 	KubeNameSpace string
@@ -23,6 +23,7 @@ type Server struct {
 	Port                          int64            `db:"server_port"                                 json:"port"`
 	VolumeSupportsOnlineExpansion bool             `db:"server_volume_supports_online_expansion"     json:"volume_supports_online_expansion"`
 	VolumeMinSize                 int64            `db:"server_volume_min_size"                      json:"volume_min_size"`
+	VolumeSnapshotClass           string           `db:"server_volume_snapshot_class"                json:"volume_snapshot_class"`
 	BuildEnabled                  bool             `db:"server_builder_is_enabled"                   json:"build_enabled"`
 	IsBuildServer                 bool             `db:"server_builder_is_build_server"              json:"is_build_server"`
 	PollingInterval               int64            `db:"server_builder_polling_interval"             json:"polling_interval"`
@@ -30,15 +31,40 @@ type Server struct {
 	MaxCPUPerBuild                float64          `db:"server_builder_max_cpu"                      json:"max_cpu_per_build"`
 	MaxMemoryPerBuild             float64          `db:"server_builder_max_memory"                   json:"max_memory_per_build"`
 
+	// KubeConfig is the encrypted kubeconfig of the cluster, empty for the primary server which
+	// uses the cluster cloudness runs in.
+	KubeConfig []byte `db:"server_kubeconfig"     json:"-"`
+	// DockerHost is the address of the docker engine of a docker server, empty for the local socket.
+	DockerHost    string                  `db:"server_docker_host"    json:"docker_host"`
+	HealthStatus  enum.ServerHealthStatus `db:"server_health_status"  json:"health_status"`
+	HealthMessage string                  `db:"server_health_message" json:"health_message"`
+	HealthChecked int64                   `db:"server_health_checked" json:"health_checked"`
+	// RunnerTokenHash is the sha256 of the token remote agents of the server authenticate with,
+	// empty until a token is issued.
+	RunnerTokenHash string `db:"server_runner_token_hash" json:"-"`
+
 	Created int64 `db:"server_created"          json:"created"`
 	Updated int64 `db:"server_updated"          json:"updated"`
 }
 
+// PrimaryServerID is the id of the server created on bootstrap for the cluster cloudness runs in.
+const PrimaryServerID = 1
+
 type ServerDomain struct {
 	Hostname string
 	Scheme   string
 }
 
+// IsPrimary returns true if the server is the cluster cloudness runs in.
+func (s *Server) IsPrimary() bool {
+	return s.ID == PrimaryServerID
+}
+
+// SupportsVolumeSnapshots returns true if the server has a VolumeSnapshotClass to take snapshots with.
+func (s *Server) SupportsVolumeSnapshots() bool {
+	return s.VolumeSnapshotClass != ""
+}
+
 func (s *Server) GetDomain() (*ServerDomain, error) {
 	if s.WildCardDomain != "" {
 		domain, err := url.Parse(s.WildCardDomain)
@@ -60,3 +86,23 @@ func (s *Server) GetDomain() (*ServerDomain, error) {
 
 	return nil, usererror.BadRequest("No wildcard domain or ipv4 found")
 }
+
+// ServerCreateInput registers an additional server, a kubernetes cluster either by kubeconfig or by the
+// token of a service account, or a single docker host.
+type ServerCreateInput struct {
+	Name           string                    `json:"name"`
+	Description    string                    `json:"description"`
+	Type           enum.ServerType           `json:"type"`
+	DockerHost     string                    `json:"docker_host"`
+	CredentialType enum.ServerCredentialType `json:"credential_type"`
+	KubeConfig     string                    `json:"kubeconfig"`
+	APIServerURL   string                    `json:"api_server_url"`
+	CACertificate  string                    `json:"ca_certificate"`
+	Token          string                    `json:"token"`
+}
+
+// ServerHealth is the result of a health check of a server.
+type ServerHealth struct {
+	Status  enum.ServerHealthStatus
+	Message string
+}
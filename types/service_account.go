@@ -0,0 +1,40 @@
+package types
+
+import (
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+type (
+	// ServiceAccount is a principal representing a non-human identity scoped to a tenant.
+	ServiceAccount struct {
+		ID          int64  `db:"principal_id"             json:"-"`
+		UID         string `db:"principal_uid"            json:"uid"`
+		Email       string `db:"principal_email"          json:"email"`
+		DisplayName string `db:"principal_display_name"   json:"display_name"`
+		Blocked     bool   `db:"principal_blocked"        json:"blocked"`
+		Salt        string `db:"principal_salt"           json:"-"`
+		Created     int64  `db:"principal_created"        json:"created"`
+		Updated     int64  `db:"principal_updated"        json:"updated"`
+
+		// TenantRole is the role of the service account within its tenant.
+		TenantRole enum.TenantRole `db:"tenant_membership_role"   json:"tenant_role"`
+	}
+)
+
+func (s *ServiceAccount) ToPrincipal() *Principal {
+	return &Principal{
+		ID:          s.ID,
+		UID:         s.UID,
+		Email:       s.Email,
+		Type:        enum.PrincipalTypeServiceAccount,
+		DisplayName: s.DisplayName,
+		Blocked:     s.Blocked,
+		Salt:        s.Salt,
+		Created:     s.Created,
+		Updated:     s.Updated,
+	}
+}
+
+func (s *ServiceAccount) ToPrincipalInfo() *PrincipalInfo {
+	return s.ToPrincipal().ToPrincipalInfo()
+}
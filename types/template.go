@@ -10,4 +10,15 @@ type Template struct {
 	Spec     *TemplateSpec `db:"-"                   json:"spec"`
 	SpecJson string        `db:"template_spec"       json:"-"`
 	Created  int64         `db:"template_created"    json:"created"`
+
+	// SourceID is the template source the template is synced from, nil for the embedded templates.
+	SourceID *int64 `db:"template_source_id"  json:"-"`
+	// Version is the published version of a synced template, LatestVersion the newest synced one.
+	Version       int64 `db:"template_version"  json:"version"`
+	LatestVersion int64 `db:"-"                 json:"latest_version"`
+}
+
+// HasPendingVersion returns true if a synced version of the template is waiting to be published.
+func (t *Template) HasPendingVersion() bool {
+	return t.LatestVersion > t.Version
 }
@@ -0,0 +1,40 @@
+package types
+
+import "github.com/cloudness-io/cloudness/types/enum"
+
+// TemplateSource is a catalog of templates synced from a git repository or an https index, the templates
+// are available to every team when TenantID is not set.
+type TemplateSource struct {
+	ID              int64                   `db:"template_source_id"                 json:"-"`
+	UID             int64                   `db:"template_source_uid"                json:"uid"`
+	TenantID        *int64                  `db:"template_source_tenant_id"          json:"-"`
+	Name            string                  `db:"template_source_name"               json:"name"`
+	Type            enum.TemplateSourceType `db:"template_source_type"               json:"type"`
+	URL             string                  `db:"template_source_url"                json:"url"`    // repository url, or url of the index
+	Branch          string                  `db:"template_source_branch"             json:"branch"` // branch of git sources
+	Path            string                  `db:"template_source_path"               json:"path"`   // directory of the templates in git sources
+	Token           []byte                  `db:"template_source_token"              json:"-"`      // encrypted bearer token of https sources
+	GitConnectionID *int64                  `db:"template_source_git_connection_id"  json:"-"`      // git connection of private git sources
+	Revision        string                  `db:"template_source_revision"           json:"revision"`
+	Synced          int64                   `db:"template_source_synced"             json:"synced"`
+	SyncError       string                  `db:"template_source_sync_error"         json:"sync_error"`
+	CreatedBy       int64                   `db:"template_source_created_by"         json:"-"`
+	Created         int64                   `db:"template_source_created"            json:"created"`
+	Updated         int64                   `db:"template_source_updated"            json:"updated"`
+}
+
+// IsInstanceLevel returns true if the templates of the source are available to every team.
+func (s *TemplateSource) IsInstanceLevel() bool {
+	return s.TenantID == nil
+}
+
+// TemplateVersion is a revision of a template of a template source, versions newer than the version
+// of the template are pending until they are published.
+type TemplateVersion struct {
+	ID         int64  `db:"template_version_id"           json:"-"`
+	TemplateID int64  `db:"template_version_template_id"  json:"-"`
+	Number     int64  `db:"template_version_number"       json:"number"`
+	Revision   string `db:"template_version_revision"     json:"revision"` // revision of the source the version was synced from
+	SpecJson   string `db:"template_version_spec"         json:"-"`
+	Created    int64  `db:"template_version_created"      json:"created"`
+}
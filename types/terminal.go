@@ -0,0 +1,24 @@
+package types
+
+import "io"
+
+// ExecTarget is a container of an application a terminal session can be opened into.
+type ExecTarget struct {
+	Pod       string `json:"pod"`
+	Container string `json:"container"`
+	Ready     bool   `json:"ready"`
+}
+
+// TerminalSize is the size of the terminal window in characters.
+type TerminalSize struct {
+	Cols uint16 `json:"cols"`
+	Rows uint16 `json:"rows"`
+}
+
+// ExecStreams are the streams of an interactive terminal session, the session ends once
+// stdin returns io.EOF.
+type ExecStreams struct {
+	Stdin  io.Reader
+	Stdout io.Writer
+	Resize <-chan TerminalSize
+}
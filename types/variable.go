@@ -19,8 +19,8 @@ type Variable struct {
 
 // DTO to render frontend
 type VariableDTO struct {
-	SystemVariable []*SystemVariable
-	UserVariable   []*UserVariable
+	SystemVariable []*SystemVariable `json:"system_variables"`
+	UserVariable   []*UserVariable   `json:"user_variables"`
 }
 
 type SystemVariable struct {
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"github.com/cloudness-io/cloudness/types/enum"
+)
+
+// VolumeSnapshotPolicy schedules the snapshots of a volume.
+type VolumeSnapshotPolicy struct {
+	ID        int64  `db:"snapshot_policy_id"         json:"-"`
+	VolumeID  int64  `db:"snapshot_policy_volume_id"  json:"-"`
+	Enabled   bool   `db:"snapshot_policy_enabled"    json:"enabled"`
+	Schedule  string `db:"snapshot_policy_schedule"   json:"schedule"`
+	Retention int    `db:"snapshot_policy_retention"  json:"retention"` // number of ready snapshots kept
+	NextRun   int64  `db:"snapshot_policy_next_run"   json:"next_run"`
+	Created   int64  `db:"snapshot_policy_created"    json:"created"`
+	Updated   int64  `db:"snapshot_policy_updated"    json:"updated"`
+}
+
+// VolumeSnapshot is a CSI snapshot of the persistent volume claim of a volume.
+type VolumeSnapshot struct {
+	ID            int64                      `db:"volume_snapshot_id"              json:"-"`
+	UID           int64                      `db:"volume_snapshot_uid"             json:"uid"`
+	TenantID      int64                      `db:"volume_snapshot_tenant_id"       json:"-"`
+	ProjectID     int64                      `db:"volume_snapshot_project_id"      json:"-"`
+	EnvironmentID int64                      `db:"volume_snapshot_environment_id"  json:"-"`
+	VolumeID      int64                      `db:"volume_snapshot_volume_id"       json:"-"`
+	ServerID      int64                      `db:"volume_snapshot_server_id"       json:"-"`
+	Namespace     string                     `db:"volume_snapshot_namespace"       json:"-"`
+	Name          string                     `db:"volume_snapshot_name"            json:"name"` // name of the VolumeSnapshot object
+	Class         string                     `db:"volume_snapshot_class"           json:"class"`
+	Trigger       enum.VolumeSnapshotTrigger `db:"volume_snapshot_trigger"         json:"trigger"`
+	Status        enum.VolumeSnapshotStatus  `db:"volume_snapshot_status"          json:"status"`
+	Size          int64                      `db:"volume_snapshot_size"            json:"size"` // restore size in bytes
+	Error         string                     `db:"volume_snapshot_error"           json:"error"`
+	CreatedBy     string                     `db:"volume_snapshot_created_by"      json:"created_by"`
+
+	Created int64 `db:"volume_snapshot_created" json:"created"`
+	Updated int64 `db:"volume_snapshot_updated" json:"updated"`
+}
+
+// VolumeSnapshotState is the state of a volume snapshot as reported by the server.
+type VolumeSnapshotState struct {
+	ReadyToUse  bool
+	RestoreSize int64
+	Error       string
+}
+
+// VolumeSnapshotClass is a snapshot class available on the server.
+type VolumeSnapshotClass struct {
+	Name      string
+	Driver    string
+	IsDefault bool
+}
+
+// VolumeSnapshotPolicyInput is the form input of a snapshot policy.
+type VolumeSnapshotPolicyInput struct {
+	Enabled   bool   `json:"enabled,string"`
+	Schedule  string `json:"schedule"`
+	Retention int    `json:"retention,string"`
+}
+
+// VolumeSnapshotRestoreInput is the form input of a restore into a new volume.
+type VolumeSnapshotRestoreInput struct {
+	Name string `json:"name"`
+}
+
+// ToInput maps the policy to the form input.
+func (p *VolumeSnapshotPolicy) ToInput() *VolumeSnapshotPolicyInput {
+	return &VolumeSnapshotPolicyInput{
+		Enabled:   p.Enabled,
+		Schedule:  p.Schedule,
+		Retention: p.Retention,
+	}
+}